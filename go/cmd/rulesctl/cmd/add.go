@@ -4,10 +4,12 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/rulesctl/common"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 	vtrules "vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 )
@@ -22,6 +24,11 @@ var (
 	addOptQueryRE           string
 	addOptLeadingCommentRE  string
 	addOptTrailingCommentRE string
+	addOptMaxQPS            int
+	addOptMaxConcurrency    int
+	addOptDelay             time.Duration
+	addOptWorkload          string
+	addOptTimeout           time.Duration
 	// TODO: other stuff, bind vars etc
 )
 
@@ -30,6 +37,24 @@ func runAdd(cmd *cobra.Command, args []string) {
 	ruleAction := mkAction()
 
 	rule := vtrules.NewQueryRule(addOptDescription, addOptName, ruleAction)
+	switch ruleAction {
+	case vtrules.QRRateLimit:
+		if err := rule.SetRateLimit(addOptMaxQPS, addOptMaxConcurrency); err != nil {
+			log.Fatalf("Rate limit invalid: %v", err)
+		}
+	case vtrules.QRDelay:
+		if err := rule.SetDelay(addOptDelay); err != nil {
+			log.Fatalf("Delay invalid: %v", err)
+		}
+	case vtrules.QRRewrite:
+		workload, ok := querypb.ExecuteOptions_Workload_value[strings.ToUpper(addOptWorkload)]
+		if addOptWorkload != "" && !ok {
+			log.Fatalf("Unknown workload '%v'", addOptWorkload)
+		}
+		if err := rule.SetRewrite(querypb.ExecuteOptions_Workload(workload), addOptTimeout); err != nil {
+			log.Fatalf("Rewrite invalid: %v", err)
+		}
+	}
 	for _, pt := range rulePlans {
 		rule.AddPlanCond(pt)
 	}
@@ -105,6 +130,12 @@ func mkAction() vtrules.Action {
 		return vtrules.QRFailRetry
 	case "continue":
 		return vtrules.QRContinue
+	case "rate_limit":
+		return vtrules.QRRateLimit
+	case "delay":
+		return vtrules.QRDelay
+	case "rewrite":
+		return vtrules.QRRewrite
 	default:
 		log.Fatalf("Unknown action '%v'", addOptAction)
 	}
@@ -139,7 +170,7 @@ func Add() *cobra.Command {
 		&addOptAction,
 		"action", "a",
 		"",
-		"What action should be taken when this rule is matched {continue, fail, fail-retry} (required)")
+		"What action should be taken when this rule is matched {continue, fail, fail_retry, rate_limit, delay, rewrite} (required)")
 	addCmd.Flags().StringSliceVarP(
 		&addOptPlans,
 		"plan", "p",
@@ -166,6 +197,32 @@ func Add() *cobra.Command {
 		"",
 		"A regexp that will be applied to comments after a SQL statement")
 
+	addCmd.Flags().IntVar(
+		&addOptMaxQPS,
+		"max-qps",
+		0,
+		"For the rate_limit action, the max number of matching queries per second")
+	addCmd.Flags().IntVar(
+		&addOptMaxConcurrency,
+		"max-concurrency",
+		0,
+		"For the rate_limit action, the max number of matching queries running at the same time")
+	addCmd.Flags().DurationVar(
+		&addOptDelay,
+		"delay",
+		0,
+		"For the delay action, how long matching queries are delayed")
+	addCmd.Flags().StringVar(
+		&addOptWorkload,
+		"workload",
+		"",
+		"For the rewrite action, the workload that matching queries run with {oltp, olap, dba}")
+	addCmd.Flags().DurationVar(
+		&addOptTimeout,
+		"timeout",
+		0,
+		"For the rewrite action, the timeout that matching queries run with")
+
 	for _, f := range []string{"name", "action"} {
 		addCmd.MarkFlagRequired(f)
 	}
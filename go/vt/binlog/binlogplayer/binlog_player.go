@@ -96,6 +96,11 @@ type Stats struct {
 	ErrorCounts    *stats.CountersWithMultiLabels
 	NoopQueryCount *stats.CountersWithSingleLabel
 
+	// TableCopyRowCounts and TableCopyTimings are the rows copied and the
+	// time spent copying in the copy phase, per table.
+	TableCopyRowCounts *stats.CountersWithSingleLabel
+	TableCopyTimings   *stats.Timings
+
 	VReplicationLags     *stats.Timings
 	VReplicationLagRates *stats.Rates
 }
@@ -140,6 +145,20 @@ func (bps *Stats) MessageHistory() []string {
 	return strs
 }
 
+// CopyRowsPerSecond returns the rate at which rows were copied
+// while the copy phase was running.
+func (bps *Stats) CopyRowsPerSecond() int64 {
+	copyTimings, ok := bps.PhaseTimings.Histograms()["copy"]
+	if !ok {
+		return 0
+	}
+	elapsed := time.Duration(copyTimings.Total())
+	if elapsed < time.Second {
+		return 0
+	}
+	return int64(float64(bps.CopyRowCount.Get()) / elapsed.Seconds())
+}
+
 // NewStats creates a new Stats structure.
 func NewStats() *Stats {
 	bps := &Stats{}
@@ -152,6 +171,8 @@ func NewStats() *Stats {
 	bps.QueryCount = stats.NewCountersWithSingleLabel("", "", "Phase", "")
 	bps.CopyRowCount = stats.NewCounter("", "")
 	bps.CopyLoopCount = stats.NewCounter("", "")
+	bps.TableCopyRowCounts = stats.NewCountersWithSingleLabel("", "", "Table", "")
+	bps.TableCopyTimings = stats.NewTimings("", "", "Table")
 	bps.ErrorCounts = stats.NewCountersWithMultiLabels("", "", []string{"type"})
 	bps.NoopQueryCount = stats.NewCountersWithSingleLabel("", "", "Statement", "")
 	bps.VReplicationLags = stats.NewTimings("", "", "")
@@ -1295,10 +1295,14 @@ type VEvent struct {
 	// This can be used to compenssate for clock skew.
 	CurrentTime int64 `protobuf:"varint,20,opt,name=current_time,json=currentTime,proto3" json:"current_time,omitempty"`
 	// LastPK is the last PK for a table
-	LastPKEvent          *LastPKEvent `protobuf:"bytes,21,opt,name=last_p_k_event,json=lastPKEvent,proto3" json:"last_p_k_event,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	LastPKEvent *LastPKEvent `protobuf:"bytes,21,opt,name=last_p_k_event,json=lastPKEvent,proto3" json:"last_p_k_event,omitempty"`
+	// Keyspace and Shard identify the source of FIELD, ROW and DDL events.
+	// They are only set by VTGate's VStream function.
+	Keyspace             string   `protobuf:"bytes,22,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Shard                string   `protobuf:"bytes,23,opt,name=shard,proto3" json:"shard,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *VEvent) Reset()         { *m = VEvent{} }
@@ -1403,6 +1407,20 @@ func (m *VEvent) GetLastPKEvent() *LastPKEvent {
 	return nil
 }
 
+func (m *VEvent) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *VEvent) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
 type MinimalTable struct {
 	Name                 string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Fields               []*query.Field `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
@@ -2017,125 +2035,125 @@ func init() {
 func init() { proto.RegisterFile("binlogdata.proto", fileDescriptor_5fd02bcb2e350dad) }
 
 var fileDescriptor_5fd02bcb2e350dad = []byte{
-	// 1914 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x73, 0xe3, 0xc6,
-	0x11, 0x5e, 0xbe, 0xc9, 0x06, 0x45, 0x41, 0xa3, 0x47, 0x98, 0x2d, 0xdb, 0x25, 0xa3, 0xb2, 0x5e,
-	0x59, 0x55, 0xa1, 0x1c, 0x26, 0xde, 0x5c, 0x62, 0x3b, 0x7c, 0x60, 0xb5, 0x5c, 0xf1, 0xa1, 0x1d,
-	0x62, 0xb5, 0x2e, 0x5f, 0x50, 0x58, 0x70, 0x24, 0x21, 0x02, 0x08, 0x2c, 0x30, 0x94, 0xcc, 0x1f,
-	0x90, 0xaa, 0xdc, 0xf3, 0x2b, 0x72, 0xce, 0x31, 0xc9, 0x35, 0xf9, 0x13, 0xb9, 0xe6, 0x94, 0x5f,
-	0x90, 0x5b, 0x6a, 0x1e, 0x78, 0x69, 0xed, 0x95, 0xd6, 0x55, 0x39, 0xc4, 0x17, 0xd6, 0x4c, 0x4f,
-	0x77, 0x4f, 0xbf, 0xbe, 0x46, 0x73, 0x40, 0x7d, 0xed, 0x2c, 0x5d, 0xff, 0x62, 0x61, 0x51, 0xab,
-	0x13, 0x84, 0x3e, 0xf5, 0x11, 0xa4, 0x94, 0x87, 0xca, 0x35, 0x0d, 0x03, 0x5b, 0x1c, 0x3c, 0x54,
-	0xde, 0xac, 0x48, 0xb8, 0x96, 0x9b, 0x16, 0xf5, 0x03, 0x3f, 0x95, 0xd2, 0x26, 0x50, 0x1b, 0x5c,
-	0x5a, 0x61, 0x44, 0x28, 0xda, 0x83, 0xaa, 0xed, 0x3a, 0x64, 0x49, 0xdb, 0x85, 0xfd, 0xc2, 0x41,
-	0x05, 0xcb, 0x1d, 0x42, 0x50, 0xb6, 0xfd, 0xe5, 0xb2, 0x5d, 0xe4, 0x54, 0xbe, 0x66, 0xbc, 0x11,
-	0x09, 0xaf, 0x49, 0xd8, 0x2e, 0x09, 0x5e, 0xb1, 0xd3, 0xfe, 0x55, 0x82, 0xad, 0x3e, 0xb7, 0xc3,
-	0x08, 0xad, 0x65, 0x64, 0xd9, 0xd4, 0xf1, 0x97, 0xe8, 0x18, 0x20, 0xa2, 0x16, 0x25, 0x1e, 0x59,
-	0xd2, 0xa8, 0x5d, 0xd8, 0x2f, 0x1d, 0x28, 0xdd, 0xc7, 0x9d, 0x8c, 0x07, 0x6f, 0x89, 0x74, 0xe6,
-	0x31, 0x3f, 0xce, 0x88, 0xa2, 0x2e, 0x28, 0xe4, 0x9a, 0x2c, 0xa9, 0x49, 0xfd, 0x2b, 0xb2, 0x6c,
-	0x97, 0xf7, 0x0b, 0x07, 0x4a, 0x77, 0xab, 0x23, 0x1c, 0xd4, 0xd9, 0x89, 0xc1, 0x0e, 0x30, 0x90,
-	0x64, 0xfd, 0xf0, 0xef, 0x45, 0x68, 0x24, 0xda, 0xd0, 0x18, 0xea, 0xb6, 0x45, 0xc9, 0x85, 0x1f,
-	0xae, 0xb9, 0x9b, 0xad, 0xee, 0x67, 0xf7, 0x34, 0xa4, 0x33, 0x90, 0x72, 0x38, 0xd1, 0x80, 0x7e,
-	0x0e, 0x35, 0x5b, 0x44, 0x8f, 0x47, 0x47, 0xe9, 0x6e, 0x67, 0x95, 0xc9, 0xc0, 0xe2, 0x98, 0x07,
-	0xa9, 0x50, 0x8a, 0xde, 0xb8, 0x3c, 0x64, 0x4d, 0xcc, 0x96, 0xda, 0x9f, 0x0a, 0x50, 0x8f, 0xf5,
-	0xa2, 0x6d, 0xd8, 0xec, 0x8f, 0xcd, 0x97, 0x53, 0xac, 0x0f, 0x66, 0xc7, 0xd3, 0xd1, 0x37, 0xfa,
-	0x50, 0x7d, 0x80, 0x9a, 0x50, 0xef, 0x8f, 0xcd, 0xbe, 0x7e, 0x3c, 0x9a, 0xaa, 0x05, 0xb4, 0x01,
-	0x8d, 0xfe, 0xd8, 0x1c, 0xcc, 0x26, 0x93, 0x91, 0xa1, 0x16, 0xd1, 0x26, 0x28, 0xfd, 0xb1, 0x89,
-	0x67, 0xe3, 0x71, 0xbf, 0x37, 0x38, 0x51, 0x4b, 0x68, 0x17, 0xb6, 0xfa, 0x63, 0x73, 0x38, 0x19,
-	0x9b, 0x43, 0xfd, 0x14, 0xeb, 0x83, 0x9e, 0xa1, 0x0f, 0xd5, 0x32, 0x02, 0xa8, 0x32, 0xf2, 0x70,
-	0xac, 0x56, 0xe4, 0x7a, 0xae, 0x1b, 0x6a, 0x55, 0xaa, 0x1b, 0x4d, 0xe7, 0x3a, 0x36, 0xd4, 0x9a,
-	0xdc, 0xbe, 0x3c, 0x1d, 0xf6, 0x0c, 0x5d, 0xad, 0xcb, 0xed, 0x50, 0x1f, 0xeb, 0x86, 0xae, 0x36,
-	0x9e, 0x97, 0xeb, 0x45, 0xb5, 0xf4, 0xbc, 0x5c, 0x2f, 0xa9, 0x65, 0xed, 0x8f, 0x05, 0xd8, 0x9d,
-	0xd3, 0x90, 0x58, 0xde, 0x09, 0x59, 0x63, 0x6b, 0x79, 0x41, 0x30, 0x79, 0xb3, 0x22, 0x11, 0x45,
-	0x0f, 0xa1, 0x1e, 0xf8, 0x91, 0xc3, 0x62, 0xc7, 0x03, 0xdc, 0xc0, 0xc9, 0x1e, 0x1d, 0x41, 0xe3,
-	0x8a, 0xac, 0xcd, 0x90, 0xf1, 0xcb, 0x80, 0xa1, 0x4e, 0x52, 0x90, 0x89, 0xa6, 0xfa, 0x95, 0x5c,
-	0x65, 0xe3, 0x5b, 0xba, 0x3b, 0xbe, 0xda, 0x39, 0xec, 0xdd, 0x36, 0x2a, 0x0a, 0xfc, 0x65, 0x44,
-	0xd0, 0x18, 0x90, 0x10, 0x34, 0x69, 0x9a, 0x5b, 0x6e, 0x9f, 0xd2, 0xfd, 0xf0, 0x9d, 0x05, 0x80,
-	0xb7, 0x5e, 0xdf, 0x26, 0x69, 0xdf, 0xc2, 0xb6, 0xb8, 0xc7, 0xb0, 0x5e, 0xbb, 0x24, 0xba, 0x8f,
-	0xeb, 0x7b, 0x50, 0xa5, 0x9c, 0xb9, 0x5d, 0xdc, 0x2f, 0x1d, 0x34, 0xb0, 0xdc, 0xbd, 0xaf, 0x87,
-	0x0b, 0xd8, 0xc9, 0xdf, 0xfc, 0x3f, 0xf1, 0xef, 0x57, 0x50, 0xc6, 0x2b, 0x97, 0xa0, 0x1d, 0xa8,
-	0x78, 0x16, 0xb5, 0x2f, 0xa5, 0x37, 0x62, 0xc3, 0x5c, 0x39, 0x77, 0x5c, 0x4a, 0x42, 0x9e, 0xc2,
-	0x06, 0x96, 0x3b, 0xed, 0xcf, 0x05, 0xa8, 0x3e, 0xe5, 0x4b, 0xf4, 0x09, 0x54, 0xc2, 0x15, 0x73,
-	0x56, 0x60, 0x5d, 0xcd, 0x5a, 0xc0, 0x34, 0x63, 0x71, 0x8c, 0x46, 0xd0, 0x3a, 0x77, 0x88, 0xbb,
-	0xe0, 0xd0, 0x9d, 0xf8, 0x0b, 0x51, 0x15, 0xad, 0xee, 0xc7, 0x59, 0x01, 0xa1, 0xb3, 0xf3, 0x34,
-	0xc7, 0x88, 0x6f, 0x09, 0x6a, 0x4f, 0xa0, 0x95, 0xe7, 0x60, 0x70, 0xd2, 0x31, 0x36, 0x67, 0x53,
-	0x73, 0x32, 0x9a, 0x4f, 0x7a, 0xc6, 0xe0, 0x99, 0xfa, 0x80, 0x23, 0x46, 0x9f, 0x1b, 0xa6, 0xfe,
-	0xf4, 0xe9, 0x0c, 0x1b, 0x6a, 0x41, 0xfb, 0x77, 0x11, 0x9a, 0x22, 0x28, 0x73, 0x7f, 0x15, 0xda,
-	0x84, 0x65, 0xf1, 0x8a, 0xac, 0xa3, 0xc0, 0xb2, 0x49, 0x9c, 0xc5, 0x78, 0xcf, 0x02, 0x12, 0x5d,
-	0x5a, 0xe1, 0x42, 0x7a, 0x2e, 0x36, 0xe8, 0x73, 0x50, 0x78, 0x36, 0xa9, 0x49, 0xd7, 0x01, 0xe1,
-	0x79, 0x6c, 0x75, 0x77, 0xd2, 0xc2, 0xe6, 0xb9, 0xa2, 0xc6, 0x3a, 0x20, 0x18, 0x68, 0xb2, 0xce,
-	0xa3, 0xa1, 0x7c, 0x0f, 0x34, 0xa4, 0x35, 0x54, 0xc9, 0xd5, 0xd0, 0x61, 0x92, 0x90, 0xaa, 0xd4,
-	0xf2, 0x56, 0xf4, 0xe2, 0x24, 0xa1, 0x0e, 0x54, 0xfd, 0xa5, 0xb9, 0x58, 0xb8, 0xed, 0x1a, 0x37,
-	0xf3, 0x27, 0x59, 0xde, 0xd9, 0x72, 0x38, 0x1c, 0xf7, 0x44, 0x59, 0x54, 0xfc, 0xe5, 0x70, 0xe1,
-	0xa2, 0x47, 0xd0, 0x22, 0xdf, 0x52, 0x12, 0x2e, 0x2d, 0xd7, 0xf4, 0xd6, 0xac, 0x7b, 0xd5, 0xb9,
-	0xeb, 0x1b, 0x31, 0x75, 0xc2, 0x88, 0xe8, 0x13, 0xd8, 0x8c, 0xa8, 0x1f, 0x98, 0xd6, 0x39, 0x25,
-	0xa1, 0x69, 0xfb, 0xc1, 0xba, 0xdd, 0xd8, 0x2f, 0x1c, 0xd4, 0xf1, 0x06, 0x23, 0xf7, 0x18, 0x75,
-	0xe0, 0x07, 0x6b, 0xed, 0x05, 0x34, 0xb0, 0x7f, 0x33, 0xb8, 0xe4, 0xfe, 0x68, 0x50, 0x7d, 0x4d,
-	0xce, 0xfd, 0x90, 0xc8, 0x42, 0x05, 0xd9, 0xc8, 0xb1, 0x7f, 0x83, 0xe5, 0x09, 0xda, 0x87, 0x0a,
-	0xd7, 0x29, 0xdb, 0x45, 0x96, 0x45, 0x1c, 0x68, 0x16, 0xd4, 0xb1, 0x7f, 0xc3, 0xd3, 0x8e, 0x3e,
-	0x04, 0x11, 0x60, 0x73, 0x69, 0x79, 0x71, 0xf6, 0x1a, 0x9c, 0x32, 0xb5, 0x3c, 0x82, 0x9e, 0x80,
-	0x12, 0xfa, 0x37, 0xa6, 0xcd, 0xaf, 0x17, 0x48, 0x54, 0xba, 0xbb, 0xb9, 0xe2, 0x8c, 0x8d, 0xc3,
-	0x10, 0xc6, 0xcb, 0x48, 0x7b, 0x01, 0x90, 0xd6, 0xd6, 0x5d, 0x97, 0xfc, 0x8c, 0x65, 0x83, 0xb8,
-	0x8b, 0x58, 0x7f, 0x53, 0x9a, 0xcc, 0x35, 0x60, 0x79, 0xa6, 0xfd, 0xa1, 0x00, 0x8d, 0x39, 0xab,
-	0x9e, 0x63, 0xea, 0x2c, 0x7e, 0x40, 0xcd, 0x21, 0x28, 0x5f, 0x50, 0x67, 0xc1, 0x8b, 0xad, 0x81,
-	0xf9, 0x1a, 0x7d, 0x1e, 0x1b, 0x16, 0x98, 0x57, 0x51, 0xbb, 0xcc, 0x6f, 0xcf, 0xe5, 0x97, 0x17,
-	0xe2, 0xd8, 0x8a, 0xe8, 0xe9, 0x09, 0xae, 0x73, 0xd6, 0xd3, 0x93, 0x48, 0xfb, 0x0a, 0x2a, 0x67,
-	0xdc, 0x8a, 0x27, 0xa0, 0x70, 0xe5, 0x26, 0xd3, 0x16, 0x63, 0x37, 0x17, 0x9e, 0xc4, 0x62, 0x0c,
-	0x51, 0xbc, 0x8c, 0xb4, 0x1e, 0x6c, 0x9c, 0x48, 0x6b, 0x39, 0xc3, 0xfb, 0xbb, 0xa3, 0xfd, 0xb5,
-	0x08, 0xb5, 0xe7, 0xfe, 0x8a, 0x15, 0x14, 0x6a, 0x41, 0xd1, 0x59, 0x70, 0xb9, 0x12, 0x2e, 0x3a,
-	0x0b, 0xf4, 0x5b, 0x68, 0x79, 0xce, 0x45, 0x68, 0xb1, 0xb2, 0x14, 0x08, 0x13, 0x4d, 0xe2, 0xa7,
-	0x59, 0xcb, 0x26, 0x31, 0x07, 0x87, 0xd9, 0x86, 0x97, 0xdd, 0x66, 0x80, 0x53, 0xca, 0x01, 0xe7,
-	0x11, 0xb4, 0x5c, 0xdf, 0xb6, 0x5c, 0x33, 0x69, 0xdb, 0x65, 0x51, 0xdc, 0x9c, 0x7a, 0x1a, 0xf7,
-	0xee, 0x5b, 0x71, 0xa9, 0xdc, 0x33, 0x2e, 0xe8, 0x0b, 0x68, 0x06, 0x56, 0x48, 0x1d, 0xdb, 0x09,
-	0x2c, 0x36, 0xf8, 0x54, 0xb9, 0x60, 0xce, 0xec, 0x5c, 0xdc, 0x70, 0x8e, 0x1d, 0x7d, 0x0a, 0x6a,
-	0xc4, 0x5b, 0x92, 0x79, 0xe3, 0x87, 0x57, 0xe7, 0xae, 0x7f, 0x13, 0xb5, 0x6b, 0xdc, 0xfe, 0x4d,
-	0x41, 0x7f, 0x15, 0x93, 0xb5, 0xbf, 0x94, 0xa0, 0x7a, 0x26, 0xaa, 0xf3, 0x10, 0xca, 0x3c, 0x46,
-	0x62, 0xb8, 0xd9, 0xcb, 0x5e, 0x26, 0x38, 0x78, 0x80, 0x38, 0x0f, 0xfa, 0x00, 0x1a, 0xd4, 0xf1,
-	0x48, 0x44, 0x2d, 0x2f, 0xe0, 0x41, 0x2d, 0xe1, 0x94, 0xf0, 0x9d, 0x25, 0xf6, 0x01, 0x34, 0x92,
-	0x71, 0x4c, 0x06, 0x2b, 0x25, 0xa0, 0x5f, 0x40, 0x83, 0xe1, 0x8b, 0x0f, 0x5f, 0xed, 0x0a, 0x07,
-	0xec, 0xce, 0x2d, 0x74, 0x71, 0x13, 0x70, 0x3d, 0x8c, 0x11, 0xfb, 0x6b, 0x50, 0x38, 0x22, 0xa4,
-	0x90, 0x68, 0x60, 0x7b, 0xf9, 0x06, 0x16, 0x23, 0x0f, 0x43, 0xda, 0xf3, 0xd1, 0x63, 0xa8, 0x5c,
-	0x73, 0xf3, 0x6a, 0x72, 0x08, 0xcc, 0x3a, 0xca, 0x53, 0x21, 0xce, 0xd9, 0x17, 0xf6, 0x77, 0xa2,
-	0xb2, 0x78, 0xeb, 0xba, 0xf5, 0x85, 0x95, 0x45, 0x87, 0x63, 0x1e, 0x36, 0xa3, 0x2d, 0x3c, 0x97,
-	0x77, 0xaf, 0x06, 0x66, 0x4b, 0xf4, 0x31, 0x34, 0xed, 0x55, 0x18, 0xf2, 0xb1, 0xd3, 0xf1, 0x48,
-	0x7b, 0x87, 0x07, 0x4a, 0x91, 0x34, 0xc3, 0xf1, 0x08, 0xfa, 0x0d, 0xb4, 0x5c, 0x2b, 0xa2, 0x0c,
-	0x78, 0xd2, 0x91, 0x5d, 0x7e, 0x55, 0x0e, 0x7d, 0x02, 0x78, 0xc2, 0x13, 0xc5, 0x4d, 0x37, 0xda,
-	0x25, 0x34, 0x27, 0xce, 0xd2, 0xf1, 0x2c, 0x97, 0x03, 0x94, 0x05, 0x3e, 0xd3, 0x5a, 0xf8, 0xfa,
-	0x7e, 0x5d, 0x05, 0x7d, 0x04, 0x0a, 0x33, 0xc1, 0xf6, 0xdd, 0x95, 0xb7, 0x14, 0xd5, 0x5e, 0xc2,
-	0x8d, 0xe0, 0x64, 0x20, 0x08, 0x0c, 0xa9, 0xf2, 0xa6, 0xb9, 0x7d, 0x49, 0x3c, 0x0b, 0x7d, 0x96,
-	0x20, 0x43, 0xa0, 0xbd, 0x9d, 0xc7, 0x54, 0x6a, 0x54, 0x8c, 0x19, 0xed, 0x1f, 0x45, 0x68, 0x9d,
-	0x89, 0x19, 0x24, 0x9e, 0x7b, 0xbe, 0x82, 0x6d, 0x72, 0x7e, 0x4e, 0x6c, 0xea, 0x5c, 0x13, 0xd3,
-	0xb6, 0x5c, 0x97, 0x84, 0xa6, 0x44, 0xb0, 0xd2, 0xdd, 0xec, 0x88, 0xff, 0x22, 0x03, 0x4e, 0x1f,
-	0x0d, 0xf1, 0x56, 0xc2, 0x2b, 0x49, 0x0b, 0xa4, 0xc3, 0xb6, 0xe3, 0x79, 0x64, 0xe1, 0x58, 0x34,
-	0xab, 0x40, 0xb4, 0xfc, 0x5d, 0xe9, 0xe9, 0x99, 0x71, 0x6c, 0x51, 0x92, 0xaa, 0x49, 0x24, 0x12,
-	0x35, 0x8f, 0x98, 0x33, 0xe1, 0x45, 0x32, 0x4a, 0x6d, 0x48, 0x49, 0x83, 0x13, 0xb1, 0x3c, 0xcc,
-	0x8d, 0x69, 0xe5, 0x5b, 0x63, 0x5a, 0xfa, 0x29, 0xad, 0xdc, 0xf9, 0x29, 0xfd, 0x12, 0x36, 0x45,
-	0xbb, 0x8d, 0x53, 0x1f, 0x23, 0xfc, 0x7b, 0x7b, 0x6e, 0x93, 0xa6, 0x9b, 0x48, 0xfb, 0x02, 0x36,
-	0x93, 0x40, 0xca, 0x31, 0xee, 0x10, 0xaa, 0xbc, 0x7c, 0xe2, 0x74, 0xa0, 0xb7, 0xe1, 0x8b, 0x25,
-	0x87, 0xf6, 0xfb, 0x22, 0xa0, 0x58, 0xde, 0xbf, 0x89, 0xfe, 0x4f, 0x93, 0xb1, 0x03, 0x15, 0x4e,
-	0x97, 0x99, 0x10, 0x1b, 0x16, 0x07, 0x16, 0xd4, 0xe0, 0x2a, 0x49, 0x83, 0x10, 0x7e, 0xc1, 0x7e,
-	0x31, 0x89, 0x56, 0x2e, 0xc5, 0x92, 0x43, 0xfb, 0x5b, 0x01, 0xb6, 0x73, 0x71, 0x90, 0xb1, 0x4c,
-	0x11, 0x53, 0x78, 0x07, 0x62, 0x0e, 0xa0, 0x1e, 0x5c, 0xbd, 0x03, 0x59, 0xc9, 0xe9, 0x77, 0xb6,
-	0xc3, 0x8f, 0xa0, 0x1c, 0xb2, 0xb6, 0x2c, 0xbe, 0xb5, 0xd9, 0xe1, 0x84, 0xd3, 0xd9, 0x84, 0x93,
-	0xf3, 0x23, 0x37, 0xe1, 0x48, 0xfb, 0x1d, 0x50, 0x32, 0x9d, 0x81, 0xb5, 0x92, 0x7c, 0x55, 0xc9,
-	0xd4, 0x7d, 0x6f, 0x51, 0x29, 0x99, 0xa2, 0x62, 0xfd, 0xd9, 0xf6, 0xbd, 0xc0, 0x25, 0x94, 0x88,
-	0x94, 0xd5, 0x71, 0x4a, 0xd0, 0xbe, 0x06, 0x25, 0x23, 0x79, 0xd7, 0x20, 0x93, 0x26, 0xa1, 0x74,
-	0x67, 0x12, 0xfe, 0x59, 0x80, 0xdd, 0xb4, 0x98, 0x57, 0x2e, 0xfd, 0x51, 0xd5, 0xa3, 0x16, 0xc2,
-	0xde, 0x6d, 0xef, 0xde, 0xab, 0xca, 0x7e, 0x40, 0xed, 0x1c, 0x7e, 0x09, 0x4a, 0x66, 0x1e, 0x67,
-	0x7f, 0xdb, 0x47, 0xc7, 0xd3, 0x19, 0xd6, 0xd5, 0x07, 0xa8, 0x0e, 0xe5, 0xb9, 0x31, 0x3b, 0x55,
-	0x0b, 0x6c, 0xa5, 0x7f, 0xad, 0x0f, 0xc4, 0x53, 0x00, 0x5b, 0x99, 0x92, 0xa9, 0x74, 0xf8, 0x9f,
-	0x02, 0x40, 0xfa, 0xc5, 0x47, 0x0a, 0xd4, 0x5e, 0x4e, 0x4f, 0xa6, 0xb3, 0x57, 0x53, 0xa1, 0xe0,
-	0xd8, 0x18, 0x0d, 0xd5, 0x02, 0x6a, 0x40, 0x45, 0xbc, 0x2d, 0x14, 0xd9, 0x0d, 0xf2, 0x61, 0xa1,
-	0x84, 0x9a, 0x50, 0x4f, 0x5e, 0x15, 0xca, 0xa8, 0x06, 0xa5, 0xe4, 0xed, 0x40, 0x3e, 0x16, 0x54,
-	0x99, 0x42, 0xac, 0x9f, 0x8e, 0x7b, 0x03, 0x5d, 0xad, 0xb1, 0x83, 0xe4, 0xd9, 0x00, 0xa0, 0x1a,
-	0xbf, 0x19, 0x30, 0xc9, 0xb9, 0x6e, 0xa8, 0xc0, 0xee, 0x99, 0x19, 0xcf, 0x74, 0xac, 0x2a, 0x8c,
-	0x86, 0x67, 0xaf, 0xd4, 0x26, 0xa3, 0x3d, 0x1d, 0xe9, 0xe3, 0xa1, 0xba, 0x81, 0x36, 0xa0, 0xf1,
-	0x4c, 0xef, 0x61, 0xa3, 0xaf, 0xf7, 0x0c, 0xb5, 0xc5, 0x4e, 0xce, 0xb8, 0x81, 0x9b, 0xec, 0x9a,
-	0xe7, 0xb3, 0x97, 0x78, 0xda, 0x1b, 0xab, 0x2a, 0xdb, 0x9c, 0xe9, 0x78, 0x3e, 0x9a, 0x4d, 0xd5,
-	0x2d, 0x76, 0xcf, 0xb8, 0x37, 0x37, 0x4e, 0x4f, 0x54, 0xc4, 0xe4, 0xe7, 0xbd, 0x33, 0xfd, 0x74,
-	0x36, 0x9a, 0x1a, 0xea, 0xf6, 0xe1, 0x63, 0xf6, 0x9d, 0xcb, 0x4e, 0x80, 0x00, 0x55, 0xa3, 0xd7,
-	0x1f, 0xeb, 0x73, 0xf5, 0x01, 0x5b, 0xcf, 0x9f, 0xf5, 0xf0, 0x70, 0xae, 0x16, 0xfa, 0x9f, 0x7e,
-	0xf3, 0xf8, 0xda, 0xa1, 0x24, 0x8a, 0x3a, 0x8e, 0x7f, 0x24, 0x56, 0x47, 0x17, 0xfe, 0xd1, 0x35,
-	0x3d, 0xe2, 0xcf, 0x63, 0x47, 0x29, 0xe6, 0x5e, 0x57, 0x39, 0xe5, 0x97, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x23, 0xf6, 0xf5, 0x62, 0x7a, 0x13, 0x00, 0x00,
+	// 1917 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x49, 0x73, 0x1b, 0xc7,
+	0x15, 0x16, 0x76, 0xe0, 0x0d, 0x09, 0x0e, 0x9b, 0x8b, 0x11, 0x95, 0xed, 0xa2, 0xa7, 0x62, 0x8b,
+	0x66, 0x55, 0x40, 0x07, 0x89, 0x95, 0x4b, 0x6c, 0x07, 0xcb, 0x88, 0x82, 0x88, 0x85, 0x6a, 0x8c,
+	0x28, 0x97, 0x2f, 0x53, 0x43, 0xa0, 0x49, 0x4e, 0x38, 0x9b, 0x66, 0x1a, 0xa4, 0xf1, 0x03, 0x52,
+	0x95, 0x7b, 0x7e, 0x45, 0xce, 0xb9, 0x26, 0xd7, 0xe4, 0x4f, 0xe4, 0x9a, 0x53, 0x2a, 0x3f, 0x20,
+	0xb7, 0x54, 0x2f, 0xb3, 0x91, 0x96, 0x48, 0xb9, 0x2a, 0x87, 0xe4, 0x82, 0xea, 0x7e, 0xfd, 0xde,
+	0xeb, 0xb7, 0x7d, 0x6f, 0x1e, 0x1a, 0xd4, 0x33, 0xdb, 0x73, 0xfc, 0x8b, 0x85, 0x45, 0xad, 0x76,
+	0x10, 0xfa, 0xd4, 0x47, 0x90, 0x52, 0x1e, 0x2b, 0xd7, 0x34, 0x0c, 0xe6, 0xe2, 0xe0, 0xb1, 0xf2,
+	0x66, 0x49, 0xc2, 0x95, 0xdc, 0x34, 0xa9, 0x1f, 0xf8, 0xa9, 0x94, 0x36, 0x86, 0x5a, 0xff, 0xd2,
+	0x0a, 0x23, 0x42, 0xd1, 0x2e, 0x54, 0xe7, 0x8e, 0x4d, 0x3c, 0xda, 0x2a, 0xec, 0x15, 0xf6, 0x2b,
+	0x58, 0xee, 0x10, 0x82, 0xf2, 0xdc, 0xf7, 0xbc, 0x56, 0x91, 0x53, 0xf9, 0x9a, 0xf1, 0x46, 0x24,
+	0xbc, 0x26, 0x61, 0xab, 0x24, 0x78, 0xc5, 0x4e, 0xfb, 0x47, 0x09, 0x36, 0x7b, 0xdc, 0x0e, 0x23,
+	0xb4, 0xbc, 0xc8, 0x9a, 0x53, 0xdb, 0xf7, 0xd0, 0x11, 0x40, 0x44, 0x2d, 0x4a, 0x5c, 0xe2, 0xd1,
+	0xa8, 0x55, 0xd8, 0x2b, 0xed, 0x2b, 0x9d, 0x27, 0xed, 0x8c, 0x07, 0x77, 0x44, 0xda, 0xb3, 0x98,
+	0x1f, 0x67, 0x44, 0x51, 0x07, 0x14, 0x72, 0x4d, 0x3c, 0x6a, 0x52, 0xff, 0x8a, 0x78, 0xad, 0xf2,
+	0x5e, 0x61, 0x5f, 0xe9, 0x6c, 0xb6, 0x85, 0x83, 0x3a, 0x3b, 0x31, 0xd8, 0x01, 0x06, 0x92, 0xac,
+	0x1f, 0xff, 0xb5, 0x08, 0x8d, 0x44, 0x1b, 0x1a, 0x41, 0x7d, 0x6e, 0x51, 0x72, 0xe1, 0x87, 0x2b,
+	0xee, 0x66, 0xb3, 0xf3, 0xc5, 0x03, 0x0d, 0x69, 0xf7, 0xa5, 0x1c, 0x4e, 0x34, 0xa0, 0x9f, 0x41,
+	0x6d, 0x2e, 0xa2, 0xc7, 0xa3, 0xa3, 0x74, 0xb6, 0xb2, 0xca, 0x64, 0x60, 0x71, 0xcc, 0x83, 0x54,
+	0x28, 0x45, 0x6f, 0x1c, 0x1e, 0xb2, 0x35, 0xcc, 0x96, 0xda, 0x1f, 0x0b, 0x50, 0x8f, 0xf5, 0xa2,
+	0x2d, 0xd8, 0xe8, 0x8d, 0xcc, 0x57, 0x13, 0xac, 0xf7, 0xa7, 0x47, 0x93, 0xe1, 0x77, 0xfa, 0x40,
+	0x7d, 0x84, 0xd6, 0xa0, 0xde, 0x1b, 0x99, 0x3d, 0xfd, 0x68, 0x38, 0x51, 0x0b, 0x68, 0x1d, 0x1a,
+	0xbd, 0x91, 0xd9, 0x9f, 0x8e, 0xc7, 0x43, 0x43, 0x2d, 0xa2, 0x0d, 0x50, 0x7a, 0x23, 0x13, 0x4f,
+	0x47, 0xa3, 0x5e, 0xb7, 0x7f, 0xac, 0x96, 0xd0, 0x0e, 0x6c, 0xf6, 0x46, 0xe6, 0x60, 0x3c, 0x32,
+	0x07, 0xfa, 0x09, 0xd6, 0xfb, 0x5d, 0x43, 0x1f, 0xa8, 0x65, 0x04, 0x50, 0x65, 0xe4, 0xc1, 0x48,
+	0xad, 0xc8, 0xf5, 0x4c, 0x37, 0xd4, 0xaa, 0x54, 0x37, 0x9c, 0xcc, 0x74, 0x6c, 0xa8, 0x35, 0xb9,
+	0x7d, 0x75, 0x32, 0xe8, 0x1a, 0xba, 0x5a, 0x97, 0xdb, 0x81, 0x3e, 0xd2, 0x0d, 0x5d, 0x6d, 0xbc,
+	0x28, 0xd7, 0x8b, 0x6a, 0xe9, 0x45, 0xb9, 0x5e, 0x52, 0xcb, 0xda, 0x1f, 0x0a, 0xb0, 0x33, 0xa3,
+	0x21, 0xb1, 0xdc, 0x63, 0xb2, 0xc2, 0x96, 0x77, 0x41, 0x30, 0x79, 0xb3, 0x24, 0x11, 0x45, 0x8f,
+	0xa1, 0x1e, 0xf8, 0x91, 0xcd, 0x62, 0xc7, 0x03, 0xdc, 0xc0, 0xc9, 0x1e, 0x1d, 0x42, 0xe3, 0x8a,
+	0xac, 0xcc, 0x90, 0xf1, 0xcb, 0x80, 0xa1, 0x76, 0x52, 0x90, 0x89, 0xa6, 0xfa, 0x95, 0x5c, 0x65,
+	0xe3, 0x5b, 0xba, 0x3f, 0xbe, 0xda, 0x39, 0xec, 0xde, 0x36, 0x2a, 0x0a, 0x7c, 0x2f, 0x22, 0x68,
+	0x04, 0x48, 0x08, 0x9a, 0x34, 0xcd, 0x2d, 0xb7, 0x4f, 0xe9, 0x7c, 0xf4, 0xce, 0x02, 0xc0, 0x9b,
+	0x67, 0xb7, 0x49, 0xda, 0xf7, 0xb0, 0x25, 0xee, 0x31, 0xac, 0x33, 0x87, 0x44, 0x0f, 0x71, 0x7d,
+	0x17, 0xaa, 0x94, 0x33, 0xb7, 0x8a, 0x7b, 0xa5, 0xfd, 0x06, 0x96, 0xbb, 0xf7, 0xf5, 0x70, 0x01,
+	0xdb, 0xf9, 0x9b, 0xff, 0x2b, 0xfe, 0xfd, 0x12, 0xca, 0x78, 0xe9, 0x10, 0xb4, 0x0d, 0x15, 0xd7,
+	0xa2, 0xf3, 0x4b, 0xe9, 0x8d, 0xd8, 0x30, 0x57, 0xce, 0x6d, 0x87, 0x92, 0x90, 0xa7, 0xb0, 0x81,
+	0xe5, 0x4e, 0xfb, 0x53, 0x01, 0xaa, 0xcf, 0xf8, 0x12, 0x7d, 0x06, 0x95, 0x70, 0xe9, 0x90, 0x18,
+	0xeb, 0x6a, 0xd6, 0x02, 0xa6, 0x19, 0x8b, 0x63, 0x34, 0x84, 0xe6, 0xb9, 0x4d, 0x9c, 0x05, 0x87,
+	0xee, 0xd8, 0x5f, 0x88, 0xaa, 0x68, 0x76, 0x3e, 0xc9, 0x0a, 0x08, 0x9d, 0xed, 0x67, 0x39, 0x46,
+	0x7c, 0x4b, 0x50, 0x7b, 0x0a, 0xcd, 0x3c, 0x07, 0x83, 0x93, 0x8e, 0xb1, 0x39, 0x9d, 0x98, 0xe3,
+	0xe1, 0x6c, 0xdc, 0x35, 0xfa, 0xcf, 0xd5, 0x47, 0x1c, 0x31, 0xfa, 0xcc, 0x30, 0xf5, 0x67, 0xcf,
+	0xa6, 0xd8, 0x50, 0x0b, 0xda, 0x3f, 0x8b, 0xb0, 0x26, 0x82, 0x32, 0xf3, 0x97, 0xe1, 0x9c, 0xb0,
+	0x2c, 0x5e, 0x91, 0x55, 0x14, 0x58, 0x73, 0x12, 0x67, 0x31, 0xde, 0xb3, 0x80, 0x44, 0x97, 0x56,
+	0xb8, 0x90, 0x9e, 0x8b, 0x0d, 0xfa, 0x12, 0x14, 0x9e, 0x4d, 0x6a, 0xd2, 0x55, 0x40, 0x78, 0x1e,
+	0x9b, 0x9d, 0xed, 0xb4, 0xb0, 0x79, 0xae, 0xa8, 0xb1, 0x0a, 0x08, 0x06, 0x9a, 0xac, 0xf3, 0x68,
+	0x28, 0x3f, 0x00, 0x0d, 0x69, 0x0d, 0x55, 0x72, 0x35, 0x74, 0x90, 0x24, 0xa4, 0x2a, 0xb5, 0xdc,
+	0x89, 0x5e, 0x9c, 0x24, 0xd4, 0x86, 0xaa, 0xef, 0x99, 0x8b, 0x85, 0xd3, 0xaa, 0x71, 0x33, 0x3f,
+	0xc8, 0xf2, 0x4e, 0xbd, 0xc1, 0x60, 0xd4, 0x15, 0x65, 0x51, 0xf1, 0xbd, 0xc1, 0xc2, 0x41, 0x9f,
+	0x42, 0x93, 0x7c, 0x4f, 0x49, 0xe8, 0x59, 0x8e, 0xe9, 0xae, 0x58, 0xf7, 0xaa, 0x73, 0xd7, 0xd7,
+	0x63, 0xea, 0x98, 0x11, 0xd1, 0x67, 0xb0, 0x11, 0x51, 0x3f, 0x30, 0xad, 0x73, 0x4a, 0x42, 0x73,
+	0xee, 0x07, 0xab, 0x56, 0x63, 0xaf, 0xb0, 0x5f, 0xc7, 0xeb, 0x8c, 0xdc, 0x65, 0xd4, 0xbe, 0x1f,
+	0xac, 0xb4, 0x97, 0xd0, 0xc0, 0xfe, 0x4d, 0xff, 0x92, 0xfb, 0xa3, 0x41, 0xf5, 0x8c, 0x9c, 0xfb,
+	0x21, 0x91, 0x85, 0x0a, 0xb2, 0x91, 0x63, 0xff, 0x06, 0xcb, 0x13, 0xb4, 0x07, 0x15, 0xae, 0xb3,
+	0x55, 0xbc, 0xc3, 0x22, 0x0e, 0x34, 0x0b, 0xea, 0xd8, 0xbf, 0xe1, 0x69, 0x47, 0x1f, 0x81, 0x08,
+	0xb0, 0xe9, 0x59, 0x6e, 0x9c, 0xbd, 0x06, 0xa7, 0x4c, 0x2c, 0x97, 0xa0, 0xa7, 0xa0, 0x84, 0xfe,
+	0x8d, 0x39, 0xe7, 0xd7, 0x0b, 0x24, 0x2a, 0x9d, 0x9d, 0x5c, 0x71, 0xc6, 0xc6, 0x61, 0x08, 0xe3,
+	0x65, 0xa4, 0xbd, 0x04, 0x48, 0x6b, 0xeb, 0xbe, 0x4b, 0x7e, 0xca, 0xb2, 0x41, 0x9c, 0x45, 0xac,
+	0x7f, 0x4d, 0x9a, 0xcc, 0x35, 0x60, 0x79, 0xa6, 0xfd, 0xbe, 0x00, 0x8d, 0x19, 0xab, 0x9e, 0x23,
+	0x6a, 0x2f, 0x7e, 0x44, 0xcd, 0x21, 0x28, 0x5f, 0x50, 0x7b, 0xc1, 0x8b, 0xad, 0x81, 0xf9, 0x1a,
+	0x7d, 0x19, 0x1b, 0x16, 0x98, 0x57, 0x51, 0xab, 0xcc, 0x6f, 0xcf, 0xe5, 0x97, 0x17, 0xe2, 0xc8,
+	0x8a, 0xe8, 0xc9, 0x31, 0xae, 0x73, 0xd6, 0x93, 0xe3, 0x48, 0xfb, 0x06, 0x2a, 0xa7, 0xdc, 0x8a,
+	0xa7, 0xa0, 0x70, 0xe5, 0x26, 0xd3, 0x16, 0x63, 0x37, 0x17, 0x9e, 0xc4, 0x62, 0x0c, 0x51, 0xbc,
+	0x8c, 0xb4, 0x2e, 0xac, 0x1f, 0x4b, 0x6b, 0x39, 0xc3, 0xfb, 0xbb, 0xa3, 0xfd, 0xb9, 0x08, 0xb5,
+	0x17, 0xfe, 0x92, 0x15, 0x14, 0x6a, 0x42, 0xd1, 0x5e, 0x70, 0xb9, 0x12, 0x2e, 0xda, 0x0b, 0xf4,
+	0x1b, 0x68, 0xba, 0xf6, 0x45, 0x68, 0xb1, 0xb2, 0x14, 0x08, 0x13, 0x4d, 0xe2, 0x27, 0x59, 0xcb,
+	0xc6, 0x31, 0x07, 0x87, 0xd9, 0xba, 0x9b, 0xdd, 0x66, 0x80, 0x53, 0xca, 0x01, 0xe7, 0x53, 0x68,
+	0x3a, 0xfe, 0xdc, 0x72, 0xcc, 0xa4, 0x6d, 0x97, 0x45, 0x71, 0x73, 0xea, 0x89, 0x24, 0xde, 0x8e,
+	0x4b, 0xe5, 0x81, 0x71, 0x41, 0x5f, 0xc1, 0x5a, 0x60, 0x85, 0xd4, 0x9e, 0xdb, 0x81, 0xc5, 0x06,
+	0x9f, 0x2a, 0x17, 0xcc, 0x99, 0x9d, 0x8b, 0x1b, 0xce, 0xb1, 0xa3, 0xcf, 0x41, 0x8d, 0x78, 0x4b,
+	0x32, 0x6f, 0xfc, 0xf0, 0xea, 0xdc, 0xf1, 0x6f, 0xa2, 0x56, 0x8d, 0xdb, 0xbf, 0x21, 0xe8, 0xaf,
+	0x63, 0xb2, 0xf6, 0xaf, 0x12, 0x54, 0x4f, 0x45, 0x75, 0x1e, 0x40, 0x99, 0xc7, 0x48, 0x0c, 0x37,
+	0xbb, 0xd9, 0xcb, 0x04, 0x07, 0x0f, 0x10, 0xe7, 0x41, 0x1f, 0x42, 0x83, 0xda, 0x2e, 0x89, 0xa8,
+	0xe5, 0x06, 0x3c, 0xa8, 0x25, 0x9c, 0x12, 0x7e, 0xb0, 0xc4, 0x3e, 0x84, 0x46, 0x32, 0x8e, 0xc9,
+	0x60, 0xa5, 0x04, 0xf4, 0x73, 0x68, 0x30, 0x7c, 0xf1, 0xe1, 0xab, 0x55, 0xe1, 0x80, 0xdd, 0xbe,
+	0x85, 0x2e, 0x6e, 0x02, 0xae, 0x87, 0x72, 0x85, 0x7e, 0x05, 0x0a, 0x47, 0x84, 0x14, 0x12, 0x0d,
+	0x6c, 0x37, 0xdf, 0xc0, 0x62, 0xe4, 0x61, 0x48, 0x7b, 0x3e, 0x7a, 0x02, 0x95, 0x6b, 0x6e, 0x5e,
+	0x4d, 0x0e, 0x81, 0x59, 0x47, 0x79, 0x2a, 0xc4, 0x39, 0xfb, 0xc2, 0xfe, 0x56, 0x54, 0x56, 0xab,
+	0x7e, 0xf7, 0x0b, 0x2b, 0x8b, 0x0e, 0xc7, 0x3c, 0x6c, 0x46, 0x5b, 0xb8, 0x0e, 0xef, 0x5e, 0x0d,
+	0xcc, 0x96, 0xe8, 0x13, 0x58, 0x9b, 0x2f, 0xc3, 0x90, 0x8f, 0x9d, 0xb6, 0x4b, 0x5a, 0xdb, 0x3c,
+	0x50, 0x8a, 0xa4, 0x19, 0xb6, 0x4b, 0xd0, 0xaf, 0xa1, 0xe9, 0x58, 0x11, 0x65, 0xc0, 0x93, 0x8e,
+	0xec, 0xec, 0x15, 0x6e, 0xa3, 0x4f, 0x00, 0x4f, 0x78, 0xa2, 0x38, 0xe9, 0x26, 0x07, 0x97, 0xdd,
+	0xb7, 0xc1, 0xe5, 0x83, 0x2c, 0x5c, 0x2e, 0x61, 0x6d, 0x6c, 0x7b, 0xb6, 0x6b, 0x39, 0x1c, 0xd2,
+	0x2c, 0x55, 0x99, 0x66, 0x54, 0xf6, 0x1e, 0xdc, 0x87, 0xd0, 0xc7, 0xa0, 0x30, 0xa3, 0xe7, 0xbe,
+	0xb3, 0x74, 0x3d, 0x81, 0x8f, 0x12, 0x6e, 0x04, 0xc7, 0x7d, 0x41, 0x60, 0xd8, 0x96, 0x37, 0xcd,
+	0xe6, 0x97, 0xc4, 0xb5, 0xd0, 0x17, 0x09, 0x96, 0x44, 0x7f, 0x68, 0xe5, 0x51, 0x98, 0x1a, 0x15,
+	0xa3, 0x4c, 0xfb, 0x5b, 0x11, 0x9a, 0xa7, 0x62, 0x6a, 0x89, 0x27, 0xa5, 0x6f, 0x60, 0x8b, 0x9c,
+	0x9f, 0x93, 0x39, 0xb5, 0xaf, 0x89, 0x39, 0xb7, 0x1c, 0x87, 0x84, 0xa6, 0xc4, 0xbc, 0xd2, 0xd9,
+	0x68, 0x8b, 0x7f, 0x2f, 0x7d, 0x4e, 0x1f, 0x0e, 0xf0, 0x66, 0xc2, 0x2b, 0x49, 0x0b, 0xa4, 0xc3,
+	0x96, 0xed, 0xba, 0x64, 0x61, 0x5b, 0x34, 0xab, 0x40, 0x7c, 0x24, 0x76, 0xa4, 0xa7, 0xa7, 0xc6,
+	0x91, 0x45, 0x49, 0xaa, 0x26, 0x91, 0x48, 0xd4, 0x7c, 0xca, 0x9c, 0x09, 0x2f, 0x92, 0xe1, 0x6b,
+	0x5d, 0x4a, 0x1a, 0x9c, 0x88, 0xe5, 0x61, 0x6e, 0xb0, 0x2b, 0xdf, 0x1a, 0xec, 0xd2, 0x8f, 0x6f,
+	0xe5, 0xde, 0x8f, 0xef, 0xd7, 0xb0, 0x21, 0x1a, 0x74, 0x5c, 0x2c, 0x71, 0x4f, 0x78, 0x6b, 0x97,
+	0x5e, 0xa3, 0xe9, 0x26, 0xd2, 0xbe, 0x82, 0x8d, 0x24, 0x90, 0x72, 0xf0, 0x3b, 0x80, 0x2a, 0x2f,
+	0xb8, 0x38, 0x1d, 0xe8, 0x2e, 0xe0, 0xb1, 0xe4, 0xd0, 0x7e, 0x57, 0x04, 0x14, 0xcb, 0xfb, 0x37,
+	0xd1, 0xff, 0x68, 0x32, 0xb6, 0xa1, 0xc2, 0xe9, 0x32, 0x13, 0x62, 0xc3, 0xe2, 0xc0, 0x82, 0x1a,
+	0x5c, 0x25, 0x69, 0x10, 0xc2, 0x2f, 0xd9, 0x2f, 0x26, 0xd1, 0xd2, 0xa1, 0x58, 0x72, 0x68, 0x7f,
+	0x29, 0xc0, 0x56, 0x2e, 0x0e, 0x32, 0x96, 0x29, 0x62, 0x0a, 0xef, 0x40, 0xcc, 0x3e, 0xd4, 0x83,
+	0xab, 0x77, 0x20, 0x2b, 0x39, 0xfd, 0xc1, 0x06, 0xfa, 0x31, 0x94, 0x43, 0xff, 0x26, 0xfe, 0x3a,
+	0x67, 0xc7, 0x19, 0x4e, 0x67, 0x33, 0x51, 0xce, 0x8f, 0x2c, 0x47, 0x6c, 0xbf, 0x0d, 0x4a, 0xa6,
+	0x97, 0xb0, 0xe6, 0x93, 0xaf, 0x2a, 0x99, 0xba, 0xb7, 0x16, 0x95, 0x92, 0x29, 0x2a, 0xd6, 0xd1,
+	0xe7, 0xbe, 0x1b, 0x38, 0x84, 0x12, 0x91, 0xb2, 0x3a, 0x4e, 0x09, 0xda, 0xb7, 0xa0, 0x64, 0x24,
+	0xef, 0x1b, 0x7d, 0xd2, 0x24, 0x94, 0xee, 0x4d, 0xc2, 0xdf, 0x0b, 0xb0, 0x93, 0x16, 0xf3, 0xd2,
+	0xa1, 0xff, 0x57, 0xf5, 0xa8, 0x85, 0xb0, 0x7b, 0xdb, 0xbb, 0xf7, 0xaa, 0xb2, 0x1f, 0x51, 0x3b,
+	0x07, 0x5f, 0x83, 0x92, 0x99, 0xe0, 0xd9, 0x1f, 0xfd, 0xe1, 0xd1, 0x64, 0x8a, 0x75, 0xf5, 0x11,
+	0xaa, 0x43, 0x79, 0x66, 0x4c, 0x4f, 0xd4, 0x02, 0x5b, 0xe9, 0xdf, 0xea, 0x7d, 0xf1, 0x78, 0xc0,
+	0x56, 0xa6, 0x64, 0x2a, 0x1d, 0xfc, 0xbb, 0x00, 0x90, 0xce, 0x08, 0x48, 0x81, 0xda, 0xab, 0xc9,
+	0xf1, 0x64, 0xfa, 0x7a, 0x22, 0x14, 0x1c, 0x19, 0xc3, 0x81, 0x5a, 0x40, 0x0d, 0xa8, 0x88, 0xd7,
+	0x88, 0x22, 0xbb, 0x41, 0x3e, 0x45, 0x94, 0xd8, 0x3b, 0x45, 0xf2, 0x0e, 0x51, 0x46, 0x35, 0x28,
+	0x25, 0xaf, 0x0d, 0xf2, 0x79, 0xa1, 0xca, 0x14, 0x62, 0xfd, 0x64, 0xd4, 0xed, 0xeb, 0x6a, 0x8d,
+	0x1d, 0x24, 0x0f, 0x0d, 0x00, 0xd5, 0xf8, 0x95, 0x81, 0x49, 0xb2, 0xb7, 0x09, 0x60, 0xf7, 0x4c,
+	0x8d, 0xe7, 0x3a, 0x56, 0x15, 0x46, 0xc3, 0xd3, 0xd7, 0xea, 0x1a, 0xa3, 0x3d, 0x1b, 0xea, 0xa3,
+	0x81, 0xba, 0xce, 0x1e, 0x27, 0x9e, 0xeb, 0x5d, 0x6c, 0xf4, 0xf4, 0xae, 0xa1, 0x36, 0xd9, 0xc9,
+	0x29, 0x37, 0x70, 0x83, 0x5d, 0xf3, 0x62, 0xfa, 0x0a, 0x4f, 0xba, 0x23, 0x55, 0x65, 0x9b, 0x53,
+	0x1d, 0xcf, 0x86, 0xd3, 0x89, 0xba, 0xc9, 0xee, 0x19, 0x75, 0x67, 0xc6, 0xc9, 0xb1, 0x8a, 0x98,
+	0xfc, 0xac, 0x7b, 0xaa, 0x9f, 0x4c, 0x87, 0x13, 0x43, 0xdd, 0x3a, 0x78, 0xc2, 0xbe, 0x73, 0xd9,
+	0x99, 0x11, 0xa0, 0x6a, 0x74, 0x7b, 0x23, 0x7d, 0xa6, 0x3e, 0x62, 0xeb, 0xd9, 0xf3, 0x2e, 0x1e,
+	0xcc, 0xd4, 0x42, 0xef, 0xf3, 0xef, 0x9e, 0x5c, 0xdb, 0x94, 0x44, 0x51, 0xdb, 0xf6, 0x0f, 0xc5,
+	0xea, 0xf0, 0xc2, 0x3f, 0xbc, 0xa6, 0x87, 0xfc, 0x41, 0xed, 0x30, 0xc5, 0xdc, 0x59, 0x95, 0x53,
+	0x7e, 0xf1, 0x9f, 0x01, 0x00, 0x0e, 0x22, 0xc6, 0xd5, 0xac, 0x13, 0x00, 0x00,
 }
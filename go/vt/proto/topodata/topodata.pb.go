@@ -157,6 +157,39 @@ func (TabletType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_52c350cb619f972e, []int{2}
 }
 
+// Priority is the priority class of an app. Each class throttles
+// at its own ratio of the throttler threshold.
+type ThrottlerApp_Priority int32
+
+const (
+	// NORMAL apps are throttled at the threshold.
+	ThrottlerApp_NORMAL ThrottlerApp_Priority = 0
+	// LOW apps are throttled first, below the threshold.
+	ThrottlerApp_LOW ThrottlerApp_Priority = 1
+	// HIGH apps keep running above the threshold.
+	ThrottlerApp_HIGH ThrottlerApp_Priority = 2
+)
+
+var ThrottlerApp_Priority_name = map[int32]string{
+	0: "NORMAL",
+	1: "LOW",
+	2: "HIGH",
+}
+
+var ThrottlerApp_Priority_value = map[string]int32{
+	"NORMAL": 0,
+	"LOW":    1,
+	"HIGH":   2,
+}
+
+func (x ThrottlerApp_Priority) String() string {
+	return proto.EnumName(ThrottlerApp_Priority_name, int32(x))
+}
+
+func (ThrottlerApp_Priority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{11, 0}
+}
+
 // KeyRange describes a range of sharding keys, when range-based
 // sharding is used.
 type KeyRange struct {
@@ -1348,10 +1381,182 @@ func (m *CellsAlias) GetCells() []string {
 	return nil
 }
 
+// ThrottlerApp is an app registered with the tablet throttler.
+type ThrottlerApp struct {
+	// name is the app name, as passed to the throttler checks.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// priority is the priority class of the app.
+	Priority ThrottlerApp_Priority `protobuf:"varint,2,opt,name=priority,proto3,enum=topodata.ThrottlerApp_Priority" json:"priority,omitempty"`
+	// threshold_ratio, if set, overrides the threshold ratio of
+	// the priority class of the app.
+	ThresholdRatio       float64  `protobuf:"fixed64,3,opt,name=threshold_ratio,json=thresholdRatio,proto3" json:"threshold_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThrottlerApp) Reset()         { *m = ThrottlerApp{} }
+func (m *ThrottlerApp) String() string { return proto.CompactTextString(m) }
+func (*ThrottlerApp) ProtoMessage()    {}
+func (*ThrottlerApp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{11}
+}
+
+func (m *ThrottlerApp) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrottlerApp.Unmarshal(m, b)
+}
+func (m *ThrottlerApp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrottlerApp.Marshal(b, m, deterministic)
+}
+func (m *ThrottlerApp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottlerApp.Merge(m, src)
+}
+func (m *ThrottlerApp) XXX_Size() int {
+	return xxx_messageInfo_ThrottlerApp.Size(m)
+}
+func (m *ThrottlerApp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottlerApp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottlerApp proto.InternalMessageInfo
+
+func (m *ThrottlerApp) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ThrottlerApp) GetPriority() ThrottlerApp_Priority {
+	if m != nil {
+		return m.Priority
+	}
+	return ThrottlerApp_NORMAL
+}
+
+func (m *ThrottlerApp) GetThresholdRatio() float64 {
+	if m != nil {
+		return m.ThresholdRatio
+	}
+	return 0
+}
+
+// ThrottlerApps is the app table of the tablet throttler of a shard.
+type ThrottlerApps struct {
+	Apps                 []*ThrottlerApp `protobuf:"bytes,1,rep,name=apps,proto3" json:"apps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ThrottlerApps) Reset()         { *m = ThrottlerApps{} }
+func (m *ThrottlerApps) String() string { return proto.CompactTextString(m) }
+func (*ThrottlerApps) ProtoMessage()    {}
+func (*ThrottlerApps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{12}
+}
+
+func (m *ThrottlerApps) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrottlerApps.Unmarshal(m, b)
+}
+func (m *ThrottlerApps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrottlerApps.Marshal(b, m, deterministic)
+}
+func (m *ThrottlerApps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottlerApps.Merge(m, src)
+}
+func (m *ThrottlerApps) XXX_Size() int {
+	return xxx_messageInfo_ThrottlerApps.Size(m)
+}
+func (m *ThrottlerApps) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottlerApps.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottlerApps proto.InternalMessageInfo
+
+func (m *ThrottlerApps) GetApps() []*ThrottlerApp {
+	if m != nil {
+		return m.Apps
+	}
+	return nil
+}
+
+// ThrottlerConfig is the configuration of the tablet throttler of a keyspace.
+type ThrottlerConfig struct {
+	// enabled enables the throttler checks. When disabled, all checks pass.
+	// A new configuration is enabled. Enabling the checks doesn't start the
+	// throttler of the tablets that run without -enable-lag-throttler.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// threshold, if positive, is the throttle threshold of the metric.
+	Threshold float64 `protobuf:"fixed64,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// metric_name is the metric checked by apps that don't name one.
+	// Empty means replication lag.
+	MetricName string `protobuf:"bytes,3,opt,name=metric_name,json=metricName,proto3" json:"metric_name,omitempty"`
+	// exempted_apps are never throttled.
+	ExemptedApps         []string `protobuf:"bytes,4,rep,name=exempted_apps,json=exemptedApps,proto3" json:"exempted_apps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ThrottlerConfig) Reset()         { *m = ThrottlerConfig{} }
+func (m *ThrottlerConfig) String() string { return proto.CompactTextString(m) }
+func (*ThrottlerConfig) ProtoMessage()    {}
+func (*ThrottlerConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52c350cb619f972e, []int{13}
+}
+
+func (m *ThrottlerConfig) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ThrottlerConfig.Unmarshal(m, b)
+}
+func (m *ThrottlerConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ThrottlerConfig.Marshal(b, m, deterministic)
+}
+func (m *ThrottlerConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottlerConfig.Merge(m, src)
+}
+func (m *ThrottlerConfig) XXX_Size() int {
+	return xxx_messageInfo_ThrottlerConfig.Size(m)
+}
+func (m *ThrottlerConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottlerConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ThrottlerConfig proto.InternalMessageInfo
+
+func (m *ThrottlerConfig) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *ThrottlerConfig) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *ThrottlerConfig) GetMetricName() string {
+	if m != nil {
+		return m.MetricName
+	}
+	return ""
+}
+
+func (m *ThrottlerConfig) GetExemptedApps() []string {
+	if m != nil {
+		return m.ExemptedApps
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("topodata.KeyspaceType", KeyspaceType_name, KeyspaceType_value)
 	proto.RegisterEnum("topodata.KeyspaceIdType", KeyspaceIdType_name, KeyspaceIdType_value)
 	proto.RegisterEnum("topodata.TabletType", TabletType_name, TabletType_value)
+	proto.RegisterEnum("topodata.ThrottlerApp_Priority", ThrottlerApp_Priority_name, ThrottlerApp_Priority_value)
 	proto.RegisterType((*KeyRange)(nil), "topodata.KeyRange")
 	proto.RegisterType((*TabletAlias)(nil), "topodata.TabletAlias")
 	proto.RegisterType((*Tablet)(nil), "topodata.Tablet")
@@ -1372,95 +1577,108 @@ func init() {
 	proto.RegisterType((*SrvKeyspace_ServedFrom)(nil), "topodata.SrvKeyspace.ServedFrom")
 	proto.RegisterType((*CellInfo)(nil), "topodata.CellInfo")
 	proto.RegisterType((*CellsAlias)(nil), "topodata.CellsAlias")
+	proto.RegisterType((*ThrottlerApp)(nil), "topodata.ThrottlerApp")
+	proto.RegisterType((*ThrottlerApps)(nil), "topodata.ThrottlerApps")
+	proto.RegisterType((*ThrottlerConfig)(nil), "topodata.ThrottlerConfig")
 }
 
 func init() { proto.RegisterFile("topodata.proto", fileDescriptor_52c350cb619f972e) }
 
 var fileDescriptor_52c350cb619f972e = []byte{
-	// 1349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6e, 0xdb, 0x46,
-	0x13, 0x0f, 0xf5, 0xcf, 0xd4, 0x88, 0x92, 0x99, 0x8d, 0x63, 0x10, 0xfa, 0xbe, 0xa0, 0x86, 0x8a,
-	0xa0, 0x82, 0x8b, 0xca, 0xad, 0x93, 0xb4, 0x46, 0x8a, 0x02, 0x51, 0x64, 0xa5, 0x71, 0x6c, 0xcb,
-	0xc2, 0x4a, 0x46, 0x9b, 0x5e, 0x08, 0x5a, 0x5a, 0x3b, 0x84, 0x25, 0x52, 0xd9, 0x5d, 0x0b, 0x50,
-	0x5f, 0xa1, 0x87, 0xf6, 0xdc, 0x37, 0xe8, 0xfb, 0xf4, 0xd8, 0x4b, 0xfb, 0x1c, 0x3d, 0x14, 0x3b,
-	0x4b, 0x52, 0x94, 0x14, 0xa7, 0x4e, 0xe1, 0xdb, 0xcc, 0xec, 0xcc, 0x70, 0xe6, 0xb7, 0xbf, 0x99,
-	0x95, 0xa0, 0x22, 0xc3, 0x49, 0x38, 0xf4, 0xa4, 0xd7, 0x98, 0xf0, 0x50, 0x86, 0xc4, 0x8c, 0xf5,
-	0xaa, 0x35, 0x95, 0xd2, 0x1f, 0x33, 0x6d, 0xaf, 0xed, 0x82, 0x79, 0xc8, 0x66, 0xd4, 0x0b, 0x2e,
-	0x18, 0xd9, 0x80, 0xbc, 0x90, 0x1e, 0x97, 0x8e, 0xb1, 0x65, 0xd4, 0x2d, 0xaa, 0x15, 0x62, 0x43,
-	0x96, 0x05, 0x43, 0x27, 0x83, 0x36, 0x25, 0xd6, 0x1e, 0x41, 0xa9, 0xef, 0x9d, 0x8d, 0x98, 0x6c,
-	0x8e, 0x7c, 0x4f, 0x10, 0x02, 0xb9, 0x01, 0x1b, 0x8d, 0x30, 0xaa, 0x48, 0x51, 0x56, 0x41, 0x57,
-	0xbe, 0x0e, 0x2a, 0x53, 0x25, 0xd6, 0xfe, 0xce, 0x41, 0x41, 0x47, 0x91, 0x4f, 0x21, 0xef, 0xa9,
-	0x48, 0x8c, 0x28, 0xed, 0xde, 0x6f, 0x24, 0xb5, 0xa6, 0xd2, 0x52, 0xed, 0x43, 0xaa, 0x60, 0xbe,
-	0x09, 0x85, 0x0c, 0xbc, 0x31, 0xc3, 0x74, 0x45, 0x9a, 0xe8, 0x64, 0x0f, 0xcc, 0x49, 0xc8, 0xa5,
-	0x3b, 0xf6, 0x26, 0x4e, 0x6e, 0x2b, 0x5b, 0x2f, 0xed, 0x3e, 0x58, 0xce, 0xd5, 0xe8, 0x86, 0x5c,
-	0x1e, 0x7b, 0x93, 0x76, 0x20, 0xf9, 0x8c, 0xae, 0x4d, 0xb4, 0xa6, 0xb2, 0x5e, 0xb2, 0x99, 0x98,
-	0x78, 0x03, 0xe6, 0xe4, 0x75, 0xd6, 0x58, 0x47, 0x18, 0xde, 0x78, 0x7c, 0xe8, 0x14, 0xf0, 0x40,
-	0x2b, 0x64, 0x07, 0x8a, 0x97, 0x6c, 0xe6, 0x72, 0x85, 0x94, 0xb3, 0x86, 0x85, 0x93, 0xf9, 0xc7,
-	0x62, 0x0c, 0x31, 0x8d, 0x46, 0xb3, 0x0e, 0x39, 0x39, 0x9b, 0x30, 0xc7, 0xdc, 0x32, 0xea, 0x95,
-	0xdd, 0x8d, 0xe5, 0xc2, 0xfa, 0xb3, 0x09, 0xa3, 0xe8, 0x41, 0xea, 0x60, 0x0f, 0xcf, 0x5c, 0xd5,
-	0x91, 0x1b, 0x4e, 0x19, 0xe7, 0xfe, 0x90, 0x39, 0x45, 0xfc, 0x76, 0x65, 0x78, 0xd6, 0xf1, 0xc6,
-	0xec, 0x24, 0xb2, 0x92, 0x06, 0xe4, 0xa4, 0x77, 0x21, 0x1c, 0xc0, 0x66, 0xab, 0x2b, 0xcd, 0xf6,
-	0xbd, 0x0b, 0xa1, 0x3b, 0x45, 0x3f, 0xf2, 0x10, 0x2a, 0xe3, 0x99, 0x78, 0x3b, 0x72, 0x13, 0x08,
-	0x2d, 0xcc, 0x5b, 0x46, 0xeb, 0xcb, 0x18, 0xc7, 0x07, 0x00, 0xda, 0x4d, 0xc1, 0xe3, 0x94, 0xb7,
-	0x8c, 0x7a, 0x9e, 0x16, 0xd1, 0xa2, 0xd0, 0x23, 0x4d, 0xd8, 0x1c, 0x7b, 0x42, 0x32, 0xee, 0x4a,
-	0xc6, 0xc7, 0x2e, 0xd2, 0xc2, 0x55, 0x1c, 0x72, 0x2a, 0x88, 0x83, 0xd5, 0x88, 0x28, 0xd5, 0xf7,
-	0xc7, 0x8c, 0xde, 0xd3, 0xbe, 0x7d, 0xc6, 0xc7, 0x3d, 0xe5, 0xa9, 0x8c, 0xd5, 0xa7, 0x60, 0xa5,
-	0x2f, 0x42, 0xf1, 0xe3, 0x92, 0xcd, 0x22, 0xca, 0x28, 0x51, 0xa1, 0x3e, 0xf5, 0x46, 0x57, 0xfa,
-	0x92, 0xf3, 0x54, 0x2b, 0x4f, 0x33, 0x7b, 0x46, 0xf5, 0x2b, 0x28, 0x26, 0x7d, 0xfd, 0x5b, 0x60,
-	0x31, 0x15, 0xf8, 0x2a, 0x67, 0x66, 0xed, 0xdc, 0xab, 0x9c, 0x59, 0xb2, 0xad, 0xda, 0xef, 0x05,
-	0xc8, 0xf7, 0xf0, 0x22, 0xf7, 0xc0, 0x8a, 0xba, 0xb9, 0x01, 0x09, 0x4b, 0xda, 0x55, 0x13, 0xfd,
-	0x7a, 0x1c, 0xcc, 0x1b, 0xe2, 0xb0, 0xc8, 0xa2, 0xcc, 0x0d, 0x58, 0xf4, 0x0d, 0x58, 0x82, 0xf1,
-	0x29, 0x1b, 0xba, 0x8a, 0x2a, 0xc2, 0xc9, 0x2e, 0xdf, 0x3c, 0x36, 0xd5, 0xe8, 0xa1, 0x0f, 0x72,
-	0xaa, 0x24, 0x12, 0x59, 0x90, 0x67, 0x50, 0x16, 0xe1, 0x15, 0x1f, 0x30, 0x17, 0x59, 0x2c, 0xa2,
-	0x31, 0xf9, 0xdf, 0x4a, 0x3c, 0x3a, 0xa1, 0x4c, 0x2d, 0x31, 0x57, 0x04, 0x79, 0x01, 0xeb, 0x12,
-	0x01, 0x71, 0x07, 0x61, 0x20, 0x79, 0x38, 0x12, 0x4e, 0x61, 0x79, 0xd4, 0x74, 0x0e, 0x8d, 0x5b,
-	0x4b, 0x7b, 0xd1, 0x8a, 0x4c, 0xab, 0x82, 0x6c, 0xc3, 0x5d, 0x5f, 0xb8, 0x11, 0x7e, 0xaa, 0x44,
-	0x3f, 0xb8, 0xc0, 0x39, 0x32, 0xe9, 0xba, 0x2f, 0x8e, 0xd1, 0xde, 0xd3, 0xe6, 0xea, 0x6b, 0x80,
-	0x79, 0x43, 0xe4, 0x09, 0x94, 0xa2, 0x0a, 0x70, 0x9e, 0x8c, 0xf7, 0xcc, 0x13, 0xc8, 0x44, 0x56,
-	0xbc, 0x50, 0xab, 0x48, 0x38, 0x99, 0xad, 0xac, 0xe2, 0x05, 0x2a, 0xd5, 0x5f, 0x0d, 0x28, 0xa5,
-	0x9a, 0x8d, 0x17, 0x95, 0x91, 0x2c, 0xaa, 0x85, 0xd5, 0x90, 0xb9, 0x6e, 0x35, 0x64, 0xaf, 0x5d,
-	0x0d, 0xb9, 0x1b, 0x5c, 0xea, 0x26, 0x14, 0xb0, 0x50, 0xe1, 0xe4, 0xb1, 0xb6, 0x48, 0xab, 0xfe,
-	0x66, 0x40, 0x79, 0x01, 0xc5, 0x5b, 0xed, 0x9d, 0x7c, 0x06, 0xe4, 0x6c, 0xe4, 0x0d, 0x2e, 0x47,
-	0xbe, 0x90, 0x8a, 0x50, 0xba, 0x84, 0x1c, 0xba, 0xdc, 0x4d, 0x9d, 0x60, 0x52, 0xa1, 0xaa, 0x3c,
-	0xe7, 0xe1, 0x8f, 0x2c, 0xc0, 0x0d, 0x69, 0xd2, 0x48, 0x4b, 0xc6, 0x2a, 0x6f, 0x17, 0x6a, 0x7f,
-	0x64, 0xf1, 0xfd, 0xd0, 0xe8, 0x7c, 0x0e, 0x1b, 0x08, 0x88, 0x1f, 0x5c, 0xb8, 0x83, 0x70, 0x74,
-	0x35, 0x0e, 0x70, 0xa9, 0x45, 0xc3, 0x4a, 0xe2, 0xb3, 0x16, 0x1e, 0xa9, 0xbd, 0x46, 0x5e, 0xad,
-	0x46, 0x60, 0x9f, 0x19, 0xec, 0xd3, 0x59, 0x00, 0x11, 0xbf, 0x71, 0xa0, 0x39, 0xbe, 0x94, 0x0b,
-	0x7b, 0x7e, 0x96, 0x4c, 0xca, 0x39, 0x0f, 0xc7, 0x62, 0xf5, 0x41, 0x88, 0x73, 0x44, 0xc3, 0xf2,
-	0x82, 0x87, 0xe3, 0x78, 0x58, 0x94, 0x2c, 0xc8, 0xd7, 0x50, 0x8e, 0x6f, 0x5a, 0x97, 0x91, 0xc7,
-	0x32, 0x36, 0x57, 0x53, 0x60, 0x11, 0xd6, 0x65, 0x4a, 0x23, 0x1f, 0x43, 0xf9, 0xcc, 0x13, 0xcc,
-	0x4d, 0xb8, 0xa3, 0x5f, 0x0f, 0x4b, 0x19, 0x13, 0x84, 0xbe, 0x80, 0xb2, 0x08, 0xbc, 0x89, 0x78,
-	0x13, 0x46, 0x8b, 0x63, 0xed, 0x1d, 0x8b, 0xc3, 0x8a, 0x5d, 0x70, 0x73, 0x5e, 0xc5, 0xb3, 0xa0,
-	0x6a, 0xbc, 0x5d, 0x3e, 0xa4, 0x99, 0x9e, 0x5d, 0x64, 0xba, 0xbe, 0xe4, 0xda, 0x4f, 0x06, 0xd8,
-	0x7a, 0x29, 0xb0, 0xc9, 0xc8, 0x1f, 0x78, 0xd2, 0x0f, 0x03, 0xf2, 0x04, 0xf2, 0x41, 0x38, 0x64,
-	0x6a, 0x73, 0x2a, 0x84, 0x3f, 0x5a, 0xda, 0x03, 0x29, 0xd7, 0x46, 0x27, 0x1c, 0x32, 0xaa, 0xbd,
-	0xab, 0xcf, 0x20, 0xa7, 0x54, 0xb5, 0x7f, 0xa3, 0x16, 0x6e, 0xb2, 0x7f, 0xe5, 0x5c, 0xa9, 0x9d,
-	0x42, 0x25, 0xfa, 0xc2, 0x39, 0xe3, 0x2c, 0x18, 0x30, 0xf5, 0xd3, 0x23, 0xc5, 0x30, 0x94, 0x3f,
-	0x78, 0xc5, 0xd6, 0x7e, 0x36, 0x80, 0x60, 0xde, 0xc5, 0xd1, 0xbb, 0x8d, 0xdc, 0xe4, 0x31, 0x6c,
-	0xbe, 0xbd, 0x62, 0x7c, 0xa6, 0x37, 0xde, 0x80, 0xb9, 0x43, 0x5f, 0xa8, 0xaf, 0xe8, 0x0d, 0x62,
-	0xd2, 0x0d, 0x3c, 0xed, 0xe9, 0xc3, 0xfd, 0xe8, 0xac, 0xf6, 0x57, 0x0e, 0x4a, 0x3d, 0x3e, 0x4d,
-	0x68, 0xf3, 0x2d, 0xc0, 0xc4, 0xe3, 0xd2, 0x57, 0x98, 0xc6, 0xb0, 0x7f, 0x92, 0x82, 0x7d, 0xee,
-	0x9a, 0x30, 0xb4, 0x1b, 0xfb, 0xd3, 0x54, 0xe8, 0xb5, 0x13, 0x9a, 0xf9, 0xe0, 0x09, 0xcd, 0xfe,
-	0x87, 0x09, 0x6d, 0x42, 0x29, 0x35, 0xa1, 0xd1, 0x80, 0x6e, 0xbd, 0xbb, 0x8f, 0xd4, 0x8c, 0xc2,
-	0x7c, 0x46, 0xab, 0x7f, 0x1a, 0x70, 0x77, 0xa5, 0x45, 0x35, 0x15, 0xa9, 0x47, 0xf2, 0xfd, 0x53,
-	0x31, 0x7f, 0x1d, 0x49, 0x0b, 0x6c, 0xac, 0xd2, 0xe5, 0x31, 0xa1, 0xf4, 0x80, 0x94, 0xd2, 0x7d,
-	0x2d, 0x32, 0x8e, 0xae, 0x8b, 0x05, 0x5d, 0x90, 0x2e, 0xdc, 0xd7, 0x49, 0x96, 0x5f, 0x49, 0xfd,
-	0x52, 0xff, 0x7f, 0x29, 0xd3, 0xe2, 0x23, 0x79, 0x4f, 0xac, 0xd8, 0x44, 0xd5, 0xbd, 0x8d, 0x89,
-	0x7f, 0xcf, 0x2b, 0x16, 0xad, 0xee, 0x43, 0x30, 0x5b, 0x6c, 0x34, 0x3a, 0x08, 0xce, 0x43, 0xf5,
-	0x3b, 0x11, 0x71, 0xe1, 0xae, 0x37, 0x1c, 0x72, 0x26, 0x44, 0xc4, 0xfa, 0xb2, 0xb6, 0x36, 0xb5,
-	0x51, 0x8d, 0x04, 0x0f, 0x43, 0x19, 0x25, 0x44, 0x39, 0x5a, 0x14, 0x35, 0x00, 0x95, 0x4c, 0xe8,
-	0x1f, 0x4a, 0xef, 0x5c, 0x37, 0xdb, 0x75, 0xb0, 0xd2, 0xfb, 0x93, 0x00, 0x14, 0x3a, 0x27, 0xf4,
-	0xb8, 0x79, 0x64, 0xdf, 0x21, 0x16, 0x98, 0xbd, 0x4e, 0xb3, 0xdb, 0x7b, 0x79, 0xd2, 0xb7, 0x8d,
-	0xed, 0x5d, 0xa8, 0x2c, 0xd2, 0x89, 0x14, 0x21, 0x7f, 0xda, 0xe9, 0xb5, 0xfb, 0xf6, 0x1d, 0x15,
-	0x76, 0x7a, 0xd0, 0xe9, 0x7f, 0xf9, 0xd8, 0x36, 0x94, 0xf9, 0xf9, 0xeb, 0x7e, 0xbb, 0x67, 0x67,
-	0xb6, 0x7f, 0x31, 0x00, 0xe6, 0x58, 0x90, 0x12, 0xac, 0x9d, 0x76, 0x0e, 0x3b, 0x27, 0xdf, 0x75,
-	0x74, 0xc8, 0x71, 0xb3, 0xd7, 0x6f, 0x53, 0xdb, 0x50, 0x07, 0xb4, 0xdd, 0x3d, 0x3a, 0x68, 0x35,
-	0xed, 0x8c, 0x3a, 0xa0, 0xfb, 0x27, 0x9d, 0xa3, 0xd7, 0x76, 0x16, 0x73, 0x35, 0xfb, 0xad, 0x97,
-	0x5a, 0xec, 0x75, 0x9b, 0xb4, 0x6d, 0xe7, 0x88, 0x0d, 0x56, 0xfb, 0xfb, 0x6e, 0x9b, 0x1e, 0x1c,
-	0xb7, 0x3b, 0xfd, 0xe6, 0x91, 0x9d, 0x57, 0x31, 0xcf, 0x9b, 0xad, 0xc3, 0xd3, 0xae, 0x5d, 0xd0,
-	0xc9, 0x7a, 0xfd, 0x13, 0xda, 0xb6, 0xd7, 0x94, 0xb2, 0x4f, 0x9b, 0x07, 0x9d, 0xf6, 0xbe, 0x6d,
-	0x56, 0x33, 0xb6, 0xf1, 0x7c, 0x0f, 0xd6, 0xfd, 0xb0, 0x31, 0xf5, 0x25, 0x13, 0x42, 0xff, 0xdd,
-	0xfa, 0xe1, 0x61, 0xa4, 0xf9, 0xe1, 0x8e, 0x96, 0x76, 0x2e, 0xc2, 0x9d, 0xa9, 0xdc, 0xc1, 0xd3,
-	0x9d, 0xf8, 0x52, 0xcf, 0x0a, 0xa8, 0x3f, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0x51, 0xac, 0x2b,
-	0xc1, 0xc6, 0x0d, 0x00, 0x00,
+	// 1513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcf, 0x6e, 0xdb, 0xce,
+	0x11, 0x0e, 0xf5, 0xcf, 0xd4, 0x88, 0x92, 0x99, 0x8d, 0x63, 0x10, 0x6a, 0x82, 0x18, 0x0a, 0x82,
+	0xb8, 0x2e, 0x2a, 0xb7, 0x4e, 0xd2, 0x1a, 0x09, 0x0a, 0x44, 0x91, 0x95, 0xd8, 0xb1, 0x2d, 0x0b,
+	0x2b, 0x19, 0x69, 0x7a, 0x21, 0x68, 0x69, 0x6d, 0x13, 0x96, 0x48, 0x66, 0x77, 0x6d, 0x54, 0x7d,
+	0x85, 0x1e, 0xda, 0x73, 0xdf, 0xa0, 0xe7, 0xbe, 0x4a, 0x8f, 0xbd, 0xb4, 0xcf, 0xd1, 0x43, 0xb1,
+	0xb3, 0x24, 0x45, 0xc9, 0x8e, 0xeb, 0xfc, 0xe0, 0xdb, 0xce, 0xec, 0xcc, 0x70, 0x66, 0x76, 0xbe,
+	0x6f, 0x24, 0xa8, 0xc9, 0x30, 0x0a, 0x47, 0x9e, 0xf4, 0x9a, 0x11, 0x0f, 0x65, 0x48, 0xcc, 0x44,
+	0xae, 0x5b, 0x57, 0x52, 0xfa, 0x13, 0xa6, 0xf5, 0x8d, 0x2d, 0x30, 0xf7, 0xd9, 0x94, 0x7a, 0xc1,
+	0x19, 0x23, 0x2b, 0x50, 0x14, 0xd2, 0xe3, 0xd2, 0x31, 0xd6, 0x8c, 0x75, 0x8b, 0x6a, 0x81, 0xd8,
+	0x90, 0x67, 0xc1, 0xc8, 0xc9, 0xa1, 0x4e, 0x1d, 0x1b, 0xaf, 0xa0, 0x32, 0xf0, 0x4e, 0xc6, 0x4c,
+	0xb6, 0xc6, 0xbe, 0x27, 0x08, 0x81, 0xc2, 0x90, 0x8d, 0xc7, 0xe8, 0x55, 0xa6, 0x78, 0x56, 0x4e,
+	0x97, 0xbe, 0x76, 0xaa, 0x52, 0x75, 0x6c, 0xfc, 0xb7, 0x00, 0x25, 0xed, 0x45, 0x7e, 0x01, 0x45,
+	0x4f, 0x79, 0xa2, 0x47, 0x65, 0xeb, 0x71, 0x33, 0xcd, 0x35, 0x13, 0x96, 0x6a, 0x1b, 0x52, 0x07,
+	0xf3, 0x3c, 0x14, 0x32, 0xf0, 0x26, 0x0c, 0xc3, 0x95, 0x69, 0x2a, 0x93, 0x6d, 0x30, 0xa3, 0x90,
+	0x4b, 0x77, 0xe2, 0x45, 0x4e, 0x61, 0x2d, 0xbf, 0x5e, 0xd9, 0x7a, 0xba, 0x18, 0xab, 0xd9, 0x0b,
+	0xb9, 0x3c, 0xf4, 0xa2, 0x4e, 0x20, 0xf9, 0x94, 0x2e, 0x45, 0x5a, 0x52, 0x51, 0x2f, 0xd8, 0x54,
+	0x44, 0xde, 0x90, 0x39, 0x45, 0x1d, 0x35, 0x91, 0xb1, 0x0d, 0xe7, 0x1e, 0x1f, 0x39, 0x25, 0xbc,
+	0xd0, 0x02, 0xd9, 0x84, 0xf2, 0x05, 0x9b, 0xba, 0x5c, 0x75, 0xca, 0x59, 0xc2, 0xc4, 0xc9, 0xec,
+	0x63, 0x49, 0x0f, 0x31, 0x0c, 0x9e, 0xc8, 0x3a, 0x14, 0xe4, 0x34, 0x62, 0x8e, 0xb9, 0x66, 0xac,
+	0xd7, 0xb6, 0x56, 0x16, 0x13, 0x1b, 0x4c, 0x23, 0x46, 0xd1, 0x82, 0xac, 0x83, 0x3d, 0x3a, 0x71,
+	0x55, 0x45, 0x6e, 0x78, 0xc5, 0x38, 0xf7, 0x47, 0xcc, 0x29, 0xe3, 0xb7, 0x6b, 0xa3, 0x93, 0xae,
+	0x37, 0x61, 0x47, 0xb1, 0x96, 0x34, 0xa1, 0x20, 0xbd, 0x33, 0xe1, 0x00, 0x16, 0x5b, 0xbf, 0x56,
+	0xec, 0xc0, 0x3b, 0x13, 0xba, 0x52, 0xb4, 0x23, 0x2f, 0xa0, 0x36, 0x99, 0x8a, 0x6f, 0x63, 0x37,
+	0x6d, 0xa1, 0x85, 0x71, 0xab, 0xa8, 0xdd, 0x4d, 0xfa, 0xf8, 0x14, 0x40, 0x9b, 0xa9, 0xf6, 0x38,
+	0xd5, 0x35, 0x63, 0xbd, 0x48, 0xcb, 0xa8, 0x51, 0xdd, 0x23, 0x2d, 0x58, 0x9d, 0x78, 0x42, 0x32,
+	0xee, 0x4a, 0xc6, 0x27, 0x2e, 0x8e, 0x85, 0xab, 0x66, 0xc8, 0xa9, 0x61, 0x1f, 0xac, 0x66, 0x3c,
+	0x52, 0x03, 0x7f, 0xc2, 0xe8, 0x23, 0x6d, 0x3b, 0x60, 0x7c, 0xd2, 0x57, 0x96, 0x4a, 0x59, 0x7f,
+	0x0b, 0x56, 0xf6, 0x21, 0xd4, 0x7c, 0x5c, 0xb0, 0x69, 0x3c, 0x32, 0xea, 0xa8, 0xba, 0x7e, 0xe5,
+	0x8d, 0x2f, 0xf5, 0x23, 0x17, 0xa9, 0x16, 0xde, 0xe6, 0xb6, 0x8d, 0xfa, 0x6f, 0xa1, 0x9c, 0xd6,
+	0xf5, 0xff, 0x1c, 0xcb, 0x19, 0xc7, 0xcf, 0x05, 0x33, 0x6f, 0x17, 0x3e, 0x17, 0xcc, 0x8a, 0x6d,
+	0x35, 0xfe, 0x59, 0x82, 0x62, 0x1f, 0x1f, 0x72, 0x1b, 0xac, 0xb8, 0x9a, 0x3b, 0x0c, 0x61, 0x45,
+	0x9b, 0xa2, 0x70, 0x4b, 0x1f, 0xcc, 0x3b, 0xf6, 0x61, 0x7e, 0x8a, 0x72, 0x77, 0x98, 0xa2, 0xdf,
+	0x81, 0x25, 0x18, 0xbf, 0x62, 0x23, 0x57, 0x8d, 0x8a, 0x70, 0xf2, 0x8b, 0x2f, 0x8f, 0x45, 0x35,
+	0xfb, 0x68, 0x83, 0x33, 0x55, 0x11, 0xe9, 0x59, 0x90, 0xf7, 0x50, 0x15, 0xe1, 0x25, 0x1f, 0x32,
+	0x17, 0xa7, 0x58, 0xc4, 0x30, 0xf9, 0xd9, 0x35, 0x7f, 0x34, 0xc2, 0x33, 0xb5, 0xc4, 0x4c, 0x10,
+	0xe4, 0x23, 0x2c, 0x4b, 0x6c, 0x88, 0x3b, 0x0c, 0x03, 0xc9, 0xc3, 0xb1, 0x70, 0x4a, 0x8b, 0x50,
+	0xd3, 0x31, 0x74, 0xdf, 0xda, 0xda, 0x8a, 0xd6, 0x64, 0x56, 0x14, 0x64, 0x03, 0x1e, 0xfa, 0xc2,
+	0x8d, 0xfb, 0xa7, 0x52, 0xf4, 0x83, 0x33, 0xc4, 0x91, 0x49, 0x97, 0x7d, 0x71, 0x88, 0xfa, 0xbe,
+	0x56, 0xd7, 0xbf, 0x02, 0xcc, 0x0a, 0x22, 0x6f, 0xa0, 0x12, 0x67, 0x80, 0x78, 0x32, 0x6e, 0xc1,
+	0x13, 0xc8, 0xf4, 0xac, 0xe6, 0x42, 0x51, 0x91, 0x70, 0x72, 0x6b, 0x79, 0x35, 0x17, 0x28, 0xd4,
+	0xff, 0x66, 0x40, 0x25, 0x53, 0x6c, 0x42, 0x54, 0x46, 0x4a, 0x54, 0x73, 0xd4, 0x90, 0xfb, 0x1e,
+	0x35, 0xe4, 0xbf, 0x4b, 0x0d, 0x85, 0x3b, 0x3c, 0xea, 0x2a, 0x94, 0x30, 0x51, 0xe1, 0x14, 0x31,
+	0xb7, 0x58, 0xaa, 0xff, 0xdd, 0x80, 0xea, 0x5c, 0x17, 0xef, 0xb5, 0x76, 0xf2, 0x4b, 0x20, 0x27,
+	0x63, 0x6f, 0x78, 0x31, 0xf6, 0x85, 0x54, 0x03, 0xa5, 0x53, 0x28, 0xa0, 0xc9, 0xc3, 0xcc, 0x0d,
+	0x06, 0x15, 0x2a, 0xcb, 0x53, 0x1e, 0xfe, 0x89, 0x05, 0xc8, 0x90, 0x26, 0x8d, 0xa5, 0x14, 0x56,
+	0x45, 0xbb, 0xd4, 0xf8, 0x57, 0x1e, 0xf7, 0x87, 0xee, 0xce, 0xaf, 0x60, 0x05, 0x1b, 0xe2, 0x07,
+	0x67, 0xee, 0x30, 0x1c, 0x5f, 0x4e, 0x02, 0x24, 0xb5, 0x18, 0xac, 0x24, 0xb9, 0x6b, 0xe3, 0x95,
+	0xe2, 0x35, 0xf2, 0xf9, 0xba, 0x07, 0xd6, 0x99, 0xc3, 0x3a, 0x9d, 0xb9, 0x26, 0xe2, 0x37, 0xf6,
+	0xf4, 0x8c, 0x2f, 0xc4, 0xc2, 0x9a, 0xdf, 0xa7, 0x48, 0x39, 0xe5, 0xe1, 0x44, 0x5c, 0x5f, 0x08,
+	0x49, 0x8c, 0x18, 0x2c, 0x1f, 0x79, 0x38, 0x49, 0xc0, 0xa2, 0xce, 0x82, 0xbc, 0x83, 0x6a, 0xf2,
+	0xd2, 0x3a, 0x8d, 0x22, 0xa6, 0xb1, 0x7a, 0x3d, 0x04, 0x26, 0x61, 0x5d, 0x64, 0x24, 0xf2, 0x1c,
+	0xaa, 0x27, 0x9e, 0x60, 0x6e, 0x3a, 0x3b, 0x7a, 0x7b, 0x58, 0x4a, 0x99, 0x76, 0xe8, 0xd7, 0x50,
+	0x15, 0x81, 0x17, 0x89, 0xf3, 0x30, 0x26, 0x8e, 0xa5, 0x1b, 0x88, 0xc3, 0x4a, 0x4c, 0x90, 0x39,
+	0x2f, 0x13, 0x2c, 0xa8, 0x1c, 0xef, 0x77, 0x1e, 0xb2, 0x93, 0x9e, 0x9f, 0x9f, 0x74, 0xfd, 0xc8,
+	0x8d, 0x3f, 0x1b, 0x60, 0x6b, 0x52, 0x60, 0xd1, 0xd8, 0x1f, 0x7a, 0xd2, 0x0f, 0x03, 0xf2, 0x06,
+	0x8a, 0x41, 0x38, 0x62, 0x8a, 0x39, 0x55, 0x87, 0x9f, 0x2d, 0xf0, 0x40, 0xc6, 0xb4, 0xd9, 0x0d,
+	0x47, 0x8c, 0x6a, 0xeb, 0xfa, 0x7b, 0x28, 0x28, 0x51, 0xf1, 0x6f, 0x5c, 0xc2, 0x5d, 0xf8, 0x57,
+	0xce, 0x84, 0xc6, 0x31, 0xd4, 0xe2, 0x2f, 0x9c, 0x32, 0xce, 0x82, 0x21, 0x53, 0x3f, 0x3d, 0x32,
+	0x13, 0x86, 0xe7, 0x1f, 0xa6, 0xd8, 0xc6, 0x5f, 0x0c, 0x20, 0x18, 0x77, 0x1e, 0x7a, 0xf7, 0x11,
+	0x9b, 0xbc, 0x86, 0xd5, 0x6f, 0x97, 0x8c, 0x4f, 0x35, 0xe3, 0x0d, 0x99, 0x3b, 0xf2, 0x85, 0xfa,
+	0x8a, 0x66, 0x10, 0x93, 0xae, 0xe0, 0x6d, 0x5f, 0x5f, 0xee, 0xc4, 0x77, 0x8d, 0xff, 0x14, 0xa0,
+	0xd2, 0xe7, 0x57, 0xe9, 0xd8, 0x7c, 0x02, 0x88, 0x3c, 0x2e, 0x7d, 0xd5, 0xd3, 0xa4, 0xed, 0x2f,
+	0x33, 0x6d, 0x9f, 0x99, 0xa6, 0x13, 0xda, 0x4b, 0xec, 0x69, 0xc6, 0xf5, 0xbb, 0x08, 0xcd, 0xfd,
+	0x30, 0x42, 0xf3, 0x3f, 0x01, 0xa1, 0x2d, 0xa8, 0x64, 0x10, 0x1a, 0x03, 0x74, 0xed, 0xe6, 0x3a,
+	0x32, 0x18, 0x85, 0x19, 0x46, 0xeb, 0xff, 0x36, 0xe0, 0xe1, 0xb5, 0x12, 0x15, 0x2a, 0x32, 0x4b,
+	0xf2, 0x76, 0x54, 0xcc, 0xb6, 0x23, 0x69, 0x83, 0x8d, 0x59, 0xba, 0x3c, 0x19, 0x28, 0x0d, 0x90,
+	0x4a, 0xb6, 0xae, 0xf9, 0x89, 0xa3, 0xcb, 0x62, 0x4e, 0x16, 0xa4, 0x07, 0x8f, 0x75, 0x90, 0xc5,
+	0x2d, 0xa9, 0x37, 0xf5, 0x93, 0x85, 0x48, 0xf3, 0x4b, 0xf2, 0x91, 0xb8, 0xa6, 0x13, 0x75, 0xf7,
+	0x3e, 0x10, 0x7f, 0xcb, 0x16, 0x8b, 0xa9, 0x7b, 0x1f, 0xcc, 0x36, 0x1b, 0x8f, 0xf7, 0x82, 0xd3,
+	0x50, 0xfd, 0x4e, 0xc4, 0xbe, 0x70, 0xd7, 0x1b, 0x8d, 0x38, 0x13, 0x22, 0x9e, 0xfa, 0xaa, 0xd6,
+	0xb6, 0xb4, 0x52, 0x41, 0x82, 0x87, 0xa1, 0x8c, 0x03, 0xe2, 0x39, 0x26, 0x8a, 0x06, 0x80, 0x0a,
+	0x26, 0xf4, 0x0f, 0xa5, 0x1b, 0xe9, 0xa6, 0xf1, 0x0f, 0x03, 0xac, 0xc1, 0x39, 0x0f, 0xa5, 0x1c,
+	0x33, 0xde, 0x8a, 0xa2, 0x1b, 0x11, 0xf6, 0x0e, 0xcc, 0x88, 0xfb, 0x21, 0xf7, 0xe5, 0x34, 0xde,
+	0x02, 0x19, 0x7e, 0xc9, 0x7a, 0x37, 0x7b, 0xb1, 0x19, 0x4d, 0x1d, 0xc8, 0x4b, 0x58, 0x96, 0xe7,
+	0x9c, 0x89, 0xf3, 0x70, 0x3c, 0x72, 0xb9, 0xa2, 0x20, 0x9c, 0x53, 0x83, 0xd6, 0x52, 0x35, 0x55,
+	0xda, 0xc6, 0xcf, 0xc1, 0x4c, 0xdc, 0x09, 0x40, 0xa9, 0x7b, 0x44, 0x0f, 0x5b, 0x07, 0xf6, 0x03,
+	0xb2, 0x04, 0xf9, 0x83, 0xa3, 0x2f, 0xb6, 0x41, 0x4c, 0x28, 0xec, 0xee, 0x7d, 0xda, 0xb5, 0x73,
+	0x8d, 0x77, 0x50, 0xcd, 0x7e, 0x56, 0xfd, 0x90, 0x29, 0x78, 0x51, 0x94, 0xc0, 0x70, 0xf5, 0xe6,
+	0xec, 0x28, 0xda, 0x28, 0x6a, 0x59, 0x4e, 0xd5, 0xed, 0x30, 0x38, 0xf5, 0xcf, 0x88, 0x03, 0x4b,
+	0x2c, 0xd0, 0x1c, 0x60, 0x20, 0x07, 0x24, 0x22, 0x79, 0x02, 0xe5, 0x34, 0x4f, 0x2c, 0xde, 0xa0,
+	0x33, 0x05, 0x79, 0x06, 0x95, 0x09, 0x93, 0xdc, 0x1f, 0x6a, 0xc8, 0x6a, 0xc2, 0x06, 0xad, 0x42,
+	0xa8, 0x3e, 0x87, 0x2a, 0xfb, 0x23, 0x9b, 0x44, 0x6a, 0xb7, 0x63, 0x86, 0x7a, 0xb3, 0x5b, 0x89,
+	0x52, 0x65, 0xbf, 0xb1, 0x0e, 0x56, 0x76, 0x89, 0xcd, 0x55, 0x6f, 0x81, 0xd9, 0xef, 0xb6, 0x7a,
+	0xfd, 0xdd, 0xa3, 0x81, 0x6d, 0x6c, 0x6c, 0x41, 0x6d, 0x1e, 0xd3, 0xa4, 0x0c, 0xc5, 0xe3, 0x6e,
+	0xbf, 0x33, 0xb0, 0x1f, 0x28, 0xb7, 0xe3, 0xbd, 0xee, 0xe0, 0x37, 0xaf, 0x6d, 0x43, 0xa9, 0x3f,
+	0x7c, 0x1d, 0x74, 0xfa, 0x76, 0x6e, 0xe3, 0xaf, 0x06, 0xc0, 0x6c, 0x20, 0x49, 0x05, 0x96, 0x8e,
+	0xbb, 0xfb, 0xdd, 0xa3, 0x2f, 0x5d, 0xed, 0x72, 0xd8, 0xea, 0x0f, 0x3a, 0xd4, 0x36, 0xd4, 0x05,
+	0xed, 0xf4, 0x0e, 0xf6, 0xda, 0x2d, 0x3b, 0xa7, 0x2e, 0xe8, 0xce, 0x51, 0xf7, 0xe0, 0xab, 0x9d,
+	0xc7, 0x58, 0xad, 0x41, 0x7b, 0x57, 0x1f, 0xfb, 0xbd, 0x16, 0xed, 0xd8, 0x05, 0x62, 0x83, 0xd5,
+	0xf9, 0x7d, 0xaf, 0x43, 0xf7, 0x0e, 0x3b, 0xdd, 0x41, 0xeb, 0xc0, 0x2e, 0x2a, 0x9f, 0x0f, 0xad,
+	0xf6, 0xfe, 0x71, 0xcf, 0x2e, 0xe9, 0x60, 0xfd, 0xc1, 0x11, 0xed, 0xd8, 0x4b, 0x4a, 0xd8, 0xa1,
+	0xad, 0xbd, 0x6e, 0x67, 0xc7, 0x36, 0xeb, 0x39, 0xdb, 0xf8, 0xb0, 0x0d, 0xcb, 0x7e, 0xd8, 0xbc,
+	0xf2, 0x25, 0x13, 0x42, 0xff, 0xe7, 0xfd, 0xc3, 0x8b, 0x58, 0xf2, 0xc3, 0x4d, 0x7d, 0xda, 0x3c,
+	0x0b, 0x37, 0xaf, 0xe4, 0x26, 0xde, 0x6e, 0x26, 0xef, 0x79, 0x52, 0x42, 0xf9, 0xd5, 0xff, 0x06,
+	0x00, 0x37, 0xda, 0xef, 0xbc, 0x4b, 0x0f, 0x00, 0x00,
 }
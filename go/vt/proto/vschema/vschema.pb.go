@@ -504,11 +504,14 @@ func (m *Column) GetType() query.Type {
 // SrvVSchema is the roll-up of all the Keyspace schema for a cell.
 type SrvVSchema struct {
 	// keyspaces is a map of keyspace name -> Keyspace object.
-	Keyspaces            map[string]*Keyspace `protobuf:"bytes,1,rep,name=keyspaces,proto3" json:"keyspaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	RoutingRules         *RoutingRules        `protobuf:"bytes,2,opt,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+	Keyspaces    map[string]*Keyspace `protobuf:"bytes,1,rep,name=keyspaces,proto3" json:"keyspaces,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RoutingRules *RoutingRules        `protobuf:"bytes,2,opt,name=routing_rules,json=routingRules,proto3" json:"routing_rules,omitempty"`
+	// shard_routing_rules route the writes to some tables of some shards
+	// of a keyspace to another keyspace.
+	ShardRoutingRules    *ShardRoutingRules `protobuf:"bytes,3,opt,name=shard_routing_rules,json=shardRoutingRules,proto3" json:"shard_routing_rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *SrvVSchema) Reset()         { *m = SrvVSchema{} }
@@ -550,6 +553,119 @@ func (m *SrvVSchema) GetRoutingRules() *RoutingRules {
 	return nil
 }
 
+func (m *SrvVSchema) GetShardRoutingRules() *ShardRoutingRules {
+	if m != nil {
+		return m.ShardRoutingRules
+	}
+	return nil
+}
+
+// ShardRoutingRules specify the shard level routing rules for the VSchema.
+type ShardRoutingRules struct {
+	Rules                []*ShardRoutingRule `protobuf:"bytes,1,rep,name=rules,proto3" json:"rules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ShardRoutingRules) Reset()         { *m = ShardRoutingRules{} }
+func (m *ShardRoutingRules) String() string { return proto.CompactTextString(m) }
+func (*ShardRoutingRules) ProtoMessage()    {}
+func (*ShardRoutingRules) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f6849254fea3e77, []int{9}
+}
+
+func (m *ShardRoutingRules) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardRoutingRules.Unmarshal(m, b)
+}
+func (m *ShardRoutingRules) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardRoutingRules.Marshal(b, m, deterministic)
+}
+func (m *ShardRoutingRules) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardRoutingRules.Merge(m, src)
+}
+func (m *ShardRoutingRules) XXX_Size() int {
+	return xxx_messageInfo_ShardRoutingRules.Size(m)
+}
+func (m *ShardRoutingRules) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardRoutingRules.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardRoutingRules proto.InternalMessageInfo
+
+func (m *ShardRoutingRules) GetRules() []*ShardRoutingRule {
+	if m != nil {
+		return m.Rules
+	}
+	return nil
+}
+
+// ShardRoutingRule routes the writes to some tables of a shard of
+// from_keyspace to the shard with the same name in to_keyspace.
+type ShardRoutingRule struct {
+	FromKeyspace string `protobuf:"bytes,1,opt,name=from_keyspace,json=fromKeyspace,proto3" json:"from_keyspace,omitempty"`
+	ToKeyspace   string `protobuf:"bytes,2,opt,name=to_keyspace,json=toKeyspace,proto3" json:"to_keyspace,omitempty"`
+	Shard        string `protobuf:"bytes,3,opt,name=shard,proto3" json:"shard,omitempty"`
+	// tables are the tables whose writes are routed.
+	Tables               []string `protobuf:"bytes,4,rep,name=tables,proto3" json:"tables,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShardRoutingRule) Reset()         { *m = ShardRoutingRule{} }
+func (m *ShardRoutingRule) String() string { return proto.CompactTextString(m) }
+func (*ShardRoutingRule) ProtoMessage()    {}
+func (*ShardRoutingRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3f6849254fea3e77, []int{10}
+}
+
+func (m *ShardRoutingRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShardRoutingRule.Unmarshal(m, b)
+}
+func (m *ShardRoutingRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShardRoutingRule.Marshal(b, m, deterministic)
+}
+func (m *ShardRoutingRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShardRoutingRule.Merge(m, src)
+}
+func (m *ShardRoutingRule) XXX_Size() int {
+	return xxx_messageInfo_ShardRoutingRule.Size(m)
+}
+func (m *ShardRoutingRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShardRoutingRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShardRoutingRule proto.InternalMessageInfo
+
+func (m *ShardRoutingRule) GetFromKeyspace() string {
+	if m != nil {
+		return m.FromKeyspace
+	}
+	return ""
+}
+
+func (m *ShardRoutingRule) GetToKeyspace() string {
+	if m != nil {
+		return m.ToKeyspace
+	}
+	return ""
+}
+
+func (m *ShardRoutingRule) GetShard() string {
+	if m != nil {
+		return m.Shard
+	}
+	return ""
+}
+
+func (m *ShardRoutingRule) GetTables() []string {
+	if m != nil {
+		return m.Tables
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*RoutingRules)(nil), "vschema.RoutingRules")
 	proto.RegisterType((*RoutingRule)(nil), "vschema.RoutingRule")
@@ -564,53 +680,60 @@ func init() {
 	proto.RegisterType((*Column)(nil), "vschema.Column")
 	proto.RegisterType((*SrvVSchema)(nil), "vschema.SrvVSchema")
 	proto.RegisterMapType((map[string]*Keyspace)(nil), "vschema.SrvVSchema.KeyspacesEntry")
+	proto.RegisterType((*ShardRoutingRules)(nil), "vschema.ShardRoutingRules")
+	proto.RegisterType((*ShardRoutingRule)(nil), "vschema.ShardRoutingRule")
 }
 
 func init() { proto.RegisterFile("vschema.proto", fileDescriptor_3f6849254fea3e77) }
 
 var fileDescriptor_3f6849254fea3e77 = []byte{
-	// 673 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xcf, 0x4e, 0xdb, 0x4e,
-	0x10, 0x96, 0x13, 0x62, 0x92, 0x31, 0x09, 0xbf, 0xdf, 0x0a, 0xa8, 0x1b, 0x84, 0x88, 0x2c, 0xda,
-	0xa6, 0x3d, 0x24, 0x52, 0x50, 0x25, 0x9a, 0x8a, 0xaa, 0x14, 0x71, 0x40, 0x45, 0x6a, 0x65, 0x10,
-	0x87, 0x5e, 0x2c, 0xe3, 0x6c, 0x61, 0x45, 0xe2, 0x35, 0xbb, 0x6b, 0x97, 0x3c, 0x4a, 0xaf, 0x7d,
-	0xad, 0x3e, 0x42, 0x5f, 0xa2, 0xf2, 0xfe, 0x31, 0x1b, 0x48, 0x6f, 0x3b, 0x3b, 0xf3, 0x7d, 0xf3,
-	0xed, 0xec, 0xcc, 0x40, 0xbb, 0xe0, 0xc9, 0x0d, 0x9e, 0xc5, 0x83, 0x8c, 0x51, 0x41, 0xd1, 0xaa,
-	0x36, 0xbb, 0xde, 0x5d, 0x8e, 0xd9, 0x5c, 0xdd, 0x06, 0x63, 0x58, 0x0b, 0x69, 0x2e, 0x48, 0x7a,
-	0x1d, 0xe6, 0x53, 0xcc, 0xd1, 0x1b, 0x68, 0xb0, 0xf2, 0xe0, 0x3b, 0xbd, 0x7a, 0xdf, 0x1b, 0x6d,
-	0x0c, 0x0c, 0x89, 0x15, 0x15, 0xaa, 0x90, 0xe0, 0x14, 0x3c, 0xeb, 0x16, 0xed, 0x00, 0x7c, 0x67,
-	0x74, 0x16, 0x89, 0xf8, 0x6a, 0x8a, 0x7d, 0xa7, 0xe7, 0xf4, 0x5b, 0x61, 0xab, 0xbc, 0xb9, 0x28,
-	0x2f, 0xd0, 0x36, 0xb4, 0x04, 0x55, 0x4e, 0xee, 0xd7, 0x7a, 0xf5, 0x7e, 0x2b, 0x6c, 0x0a, 0x2a,
-	0x7d, 0x3c, 0xf8, 0x53, 0x83, 0xe6, 0x67, 0x3c, 0xe7, 0x59, 0x9c, 0x60, 0xe4, 0xc3, 0x2a, 0xbf,
-	0x89, 0xd9, 0x04, 0x4f, 0x24, 0x4b, 0x33, 0x34, 0x26, 0x7a, 0x0f, 0xcd, 0x82, 0xa4, 0x13, 0x7c,
-	0xaf, 0x29, 0xbc, 0xd1, 0x6e, 0x25, 0xd0, 0xc0, 0x07, 0x97, 0x3a, 0xe2, 0x24, 0x15, 0x6c, 0x1e,
-	0x56, 0x00, 0xf4, 0x16, 0x5c, 0x9d, 0xbd, 0x2e, 0xa1, 0x3b, 0x4f, 0xa1, 0x4a, 0x8d, 0x02, 0xea,
-	0x60, 0x74, 0x00, 0x3e, 0xc3, 0x77, 0x39, 0x61, 0x38, 0xc2, 0xf7, 0xd9, 0x94, 0x24, 0x44, 0x44,
-	0x4c, 0x3d, 0xdb, 0x5f, 0x91, 0xf2, 0xb6, 0xb4, 0xff, 0x44, 0xbb, 0x75, 0x51, 0xba, 0x67, 0xd0,
-	0x5e, 0xd0, 0x82, 0xfe, 0x83, 0xfa, 0x2d, 0x9e, 0xeb, 0xd2, 0x94, 0x47, 0xf4, 0x02, 0x1a, 0x45,
-	0x3c, 0xcd, 0xb1, 0x5f, 0xeb, 0x39, 0x7d, 0x6f, 0xb4, 0x5e, 0x49, 0x52, 0xc0, 0x50, 0x79, 0xc7,
-	0xb5, 0x03, 0xa7, 0x7b, 0x0a, 0x9e, 0x25, 0x6f, 0x09, 0xd7, 0xde, 0x22, 0x57, 0xa7, 0xe2, 0x92,
-	0x30, 0x8b, 0x2a, 0xf8, 0xe5, 0x80, 0xab, 0x12, 0x20, 0x04, 0x2b, 0x62, 0x9e, 0x99, 0xef, 0x92,
-	0x67, 0xb4, 0x0f, 0x6e, 0x16, 0xb3, 0x78, 0x66, 0x6a, 0xbc, 0xfd, 0x48, 0xd5, 0xe0, 0xab, 0xf4,
-	0xea, 0x32, 0xa9, 0x50, 0xb4, 0x01, 0x0d, 0xfa, 0x23, 0xc5, 0xcc, 0xaf, 0x4b, 0x26, 0x65, 0x74,
-	0xdf, 0x81, 0x67, 0x05, 0x2f, 0x11, 0xbd, 0x61, 0x8b, 0x6e, 0xd9, 0x22, 0x7f, 0xd6, 0xa0, 0xa1,
-	0x3a, 0x67, 0x99, 0xc6, 0x0f, 0xb0, 0x9e, 0xd0, 0x69, 0x3e, 0x4b, 0xa3, 0x47, 0x0d, 0xb1, 0x59,
-	0x89, 0x3d, 0x96, 0x7e, 0x5d, 0xc8, 0x4e, 0x62, 0x59, 0x98, 0xa3, 0x43, 0xe8, 0xc4, 0xb9, 0xa0,
-	0x11, 0x49, 0x13, 0x86, 0x67, 0x38, 0x15, 0x52, 0xb7, 0x37, 0xda, 0xaa, 0xe0, 0x47, 0xb9, 0xa0,
-	0xa7, 0xc6, 0x1b, 0xb6, 0x63, 0xdb, 0x44, 0xaf, 0x61, 0x55, 0x11, 0x72, 0x7f, 0x45, 0xa6, 0x5d,
-	0x7f, 0x94, 0x36, 0x34, 0x7e, 0xb4, 0x05, 0x6e, 0x46, 0xd2, 0x14, 0x4f, 0xfc, 0x86, 0xd4, 0xaf,
-	0x2d, 0x34, 0x86, 0xe7, 0xfa, 0x05, 0x53, 0xc2, 0x45, 0x14, 0xe7, 0xe2, 0x86, 0x32, 0x22, 0x62,
-	0x41, 0x0a, 0xec, 0xbb, 0xb2, 0xb1, 0x9e, 0xa9, 0x80, 0x33, 0xc2, 0xc5, 0x91, 0xed, 0x0e, 0x2e,
-	0x60, 0xcd, 0x7e, 0x5d, 0x99, 0x43, 0x85, 0xea, 0x1a, 0x69, 0xab, 0xac, 0x5c, 0x1a, 0xcf, 0x4c,
-	0x71, 0xe5, 0xb9, 0x9c, 0x2e, 0x23, 0xbd, 0x2e, 0xa7, 0xd0, 0x98, 0xc1, 0x31, 0xb4, 0x17, 0x1e,
-	0xfd, 0x4f, 0xda, 0x2e, 0x34, 0x39, 0xbe, 0xcb, 0x71, 0x9a, 0x18, 0xea, 0xca, 0x0e, 0x0e, 0xc1,
-	0x3d, 0x5e, 0x4c, 0xee, 0x58, 0xc9, 0x77, 0xf5, 0x57, 0x96, 0xa8, 0xce, 0xc8, 0x1b, 0xa8, 0x55,
-	0x74, 0x31, 0xcf, 0xb0, 0xfa, 0xd7, 0xe0, 0xb7, 0x03, 0x70, 0xce, 0x8a, 0xcb, 0x73, 0x59, 0x4c,
-	0xf4, 0x11, 0x5a, 0xb7, 0x7a, 0x38, 0xcd, 0x4a, 0x0a, 0xaa, 0x4a, 0x3f, 0xc4, 0x55, 0x13, 0xac,
-	0x9b, 0xf2, 0x01, 0x84, 0xc6, 0xd0, 0xd6, 0xd3, 0x1a, 0xa9, 0xc5, 0xa6, 0xa6, 0x63, 0x73, 0xd9,
-	0x62, 0xe3, 0xe1, 0x1a, 0xb3, 0xac, 0xee, 0x17, 0xe8, 0x2c, 0x12, 0x2f, 0x69, 0xe0, 0x57, 0x8b,
-	0x53, 0xf7, 0xff, 0x93, 0xa5, 0x62, 0xf5, 0xf4, 0xa7, 0x97, 0xdf, 0xf6, 0x0a, 0x22, 0x30, 0xe7,
-	0x03, 0x42, 0x87, 0xea, 0x34, 0xbc, 0xa6, 0xc3, 0x42, 0x0c, 0xe5, 0x36, 0x1e, 0x6a, 0xec, 0x95,
-	0x2b, 0xcd, 0xfd, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb8, 0xa7, 0x99, 0x19, 0xc3, 0x05, 0x00,
-	0x00,
+	// 756 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0x96, 0xf3, 0x47, 0x32, 0x4e, 0x02, 0x6c, 0x03, 0x35, 0x41, 0x88, 0xc8, 0xa5, 0x6d, 0xda,
+	0x43, 0x22, 0x05, 0x55, 0xa2, 0xa9, 0xa8, 0x4a, 0x29, 0x07, 0x5a, 0xa4, 0x56, 0x06, 0x71, 0xe8,
+	0xc5, 0x32, 0xc9, 0x16, 0x2c, 0x12, 0x6f, 0xd8, 0x5d, 0xa7, 0xe4, 0x05, 0xfa, 0x0e, 0xbd, 0xf6,
+	0xd2, 0x87, 0xeb, 0x4b, 0x54, 0xde, 0x1f, 0x67, 0x1d, 0xc2, 0x6d, 0xbf, 0x9d, 0x99, 0x6f, 0xbe,
+	0x1d, 0xcf, 0x8c, 0xa1, 0x36, 0x65, 0x83, 0x1b, 0x3c, 0x0e, 0x3a, 0x13, 0x4a, 0x38, 0x41, 0x2b,
+	0x0a, 0x36, 0xed, 0xbb, 0x18, 0xd3, 0x99, 0xbc, 0x75, 0xfb, 0x50, 0xf5, 0x48, 0xcc, 0xc3, 0xe8,
+	0xda, 0x8b, 0x47, 0x98, 0xa1, 0xd7, 0x50, 0xa4, 0xc9, 0xc1, 0xb1, 0x5a, 0xf9, 0xb6, 0xdd, 0x6b,
+	0x74, 0x34, 0x89, 0xe1, 0xe5, 0x49, 0x17, 0xf7, 0x14, 0x6c, 0xe3, 0x16, 0xed, 0x00, 0xfc, 0xa0,
+	0x64, 0xec, 0xf3, 0xe0, 0x6a, 0x84, 0x1d, 0xab, 0x65, 0xb5, 0x2b, 0x5e, 0x25, 0xb9, 0xb9, 0x48,
+	0x2e, 0xd0, 0x36, 0x54, 0x38, 0x91, 0x46, 0xe6, 0xe4, 0x5a, 0xf9, 0x76, 0xc5, 0x2b, 0x73, 0x22,
+	0x6c, 0xcc, 0xfd, 0x97, 0x83, 0xf2, 0x17, 0x3c, 0x63, 0x93, 0x60, 0x80, 0x91, 0x03, 0x2b, 0xec,
+	0x26, 0xa0, 0x43, 0x3c, 0x14, 0x2c, 0x65, 0x4f, 0x43, 0xf4, 0x0e, 0xca, 0xd3, 0x30, 0x1a, 0xe2,
+	0x7b, 0x45, 0x61, 0xf7, 0x76, 0x53, 0x81, 0x3a, 0xbc, 0x73, 0xa9, 0x3c, 0x4e, 0x22, 0x4e, 0x67,
+	0x5e, 0x1a, 0x80, 0xde, 0x40, 0x49, 0x65, 0xcf, 0x8b, 0xd0, 0x9d, 0x87, 0xa1, 0x52, 0x8d, 0x0c,
+	0x54, 0xce, 0xe8, 0x00, 0x1c, 0x8a, 0xef, 0xe2, 0x90, 0x62, 0x1f, 0xdf, 0x4f, 0x46, 0xe1, 0x20,
+	0xe4, 0x3e, 0x95, 0xcf, 0x76, 0x0a, 0x42, 0xde, 0xa6, 0xb2, 0x9f, 0x28, 0xb3, 0x2a, 0x4a, 0xf3,
+	0x0c, 0x6a, 0x19, 0x2d, 0x68, 0x0d, 0xf2, 0xb7, 0x78, 0xa6, 0x4a, 0x93, 0x1c, 0xd1, 0x73, 0x28,
+	0x4e, 0x83, 0x51, 0x8c, 0x9d, 0x5c, 0xcb, 0x6a, 0xdb, 0xbd, 0xd5, 0x54, 0x92, 0x0c, 0xf4, 0xa4,
+	0xb5, 0x9f, 0x3b, 0xb0, 0x9a, 0xa7, 0x60, 0x1b, 0xf2, 0x96, 0x70, 0xed, 0x65, 0xb9, 0xea, 0x29,
+	0x97, 0x08, 0x33, 0xa8, 0xdc, 0x3f, 0x16, 0x94, 0x64, 0x02, 0x84, 0xa0, 0xc0, 0x67, 0x13, 0xfd,
+	0xb9, 0xc4, 0x19, 0xed, 0x43, 0x69, 0x12, 0xd0, 0x60, 0xac, 0x6b, 0xbc, 0xbd, 0xa0, 0xaa, 0xf3,
+	0x4d, 0x58, 0x55, 0x99, 0xa4, 0x2b, 0x6a, 0x40, 0x91, 0xfc, 0x8c, 0x30, 0x75, 0xf2, 0x82, 0x49,
+	0x82, 0xe6, 0x5b, 0xb0, 0x0d, 0xe7, 0x25, 0xa2, 0x1b, 0xa6, 0xe8, 0x8a, 0x29, 0xf2, 0x77, 0x0e,
+	0x8a, 0xb2, 0x73, 0x96, 0x69, 0x7c, 0x0f, 0xab, 0x03, 0x32, 0x8a, 0xc7, 0x91, 0xbf, 0xd0, 0x10,
+	0x1b, 0xa9, 0xd8, 0x63, 0x61, 0x57, 0x85, 0xac, 0x0f, 0x0c, 0x84, 0x19, 0x3a, 0x84, 0x7a, 0x10,
+	0x73, 0xe2, 0x87, 0xd1, 0x80, 0xe2, 0x31, 0x8e, 0xb8, 0xd0, 0x6d, 0xf7, 0x36, 0xd3, 0xf0, 0xa3,
+	0x98, 0x93, 0x53, 0x6d, 0xf5, 0x6a, 0x81, 0x09, 0xd1, 0x2b, 0x58, 0x91, 0x84, 0xcc, 0x29, 0xb4,
+	0xf2, 0x99, 0x2f, 0x27, 0xd3, 0x7a, 0xda, 0x8e, 0x36, 0xa1, 0x34, 0x09, 0xa3, 0x08, 0x0f, 0x9d,
+	0xa2, 0xd0, 0xaf, 0x10, 0xea, 0xc3, 0x96, 0x7a, 0xc1, 0x28, 0x64, 0xdc, 0x0f, 0x62, 0x7e, 0x43,
+	0x68, 0xc8, 0x03, 0x1e, 0x4e, 0xb1, 0x53, 0x12, 0x8d, 0xf5, 0x54, 0x3a, 0x9c, 0x85, 0x8c, 0x1f,
+	0x99, 0x66, 0xf7, 0x02, 0xaa, 0xe6, 0xeb, 0x92, 0x1c, 0xd2, 0x55, 0xd5, 0x48, 0xa1, 0xa4, 0x72,
+	0x51, 0x30, 0xd6, 0xc5, 0x15, 0xe7, 0x64, 0xba, 0xb4, 0xf4, 0xbc, 0x98, 0x42, 0x0d, 0xdd, 0x63,
+	0xa8, 0x65, 0x1e, 0xfd, 0x28, 0x6d, 0x13, 0xca, 0x0c, 0xdf, 0xc5, 0x38, 0x1a, 0x68, 0xea, 0x14,
+	0xbb, 0x87, 0x50, 0x3a, 0xce, 0x26, 0xb7, 0x8c, 0xe4, 0xbb, 0xea, 0x53, 0x26, 0x51, 0xf5, 0x9e,
+	0xdd, 0x91, 0xab, 0xe8, 0x62, 0x36, 0xc1, 0xf2, 0xbb, 0xba, 0x7f, 0x73, 0x00, 0xe7, 0x74, 0x7a,
+	0x79, 0x2e, 0x8a, 0x89, 0x3e, 0x40, 0xe5, 0x56, 0x0d, 0xa7, 0x5e, 0x49, 0x6e, 0x5a, 0xe9, 0xb9,
+	0x5f, 0x3a, 0xc1, 0xaa, 0x29, 0xe7, 0x41, 0xa8, 0x0f, 0x35, 0x35, 0xad, 0xbe, 0x5c, 0x6c, 0x72,
+	0x3a, 0x36, 0x96, 0x2d, 0x36, 0xe6, 0x55, 0xa9, 0x81, 0xd0, 0x67, 0x78, 0x22, 0x36, 0x8f, 0x9f,
+	0x65, 0x90, 0x9d, 0xd2, 0x9c, 0xeb, 0x48, 0x7c, 0x32, 0x34, 0xeb, 0x6c, 0xf1, 0xaa, 0xf9, 0x15,
+	0xea, 0x59, 0x91, 0x4b, 0x86, 0xe1, 0x65, 0x76, 0x82, 0xd7, 0x1f, 0x2c, 0x28, 0x73, 0x3e, 0x3e,
+	0xc1, 0xfa, 0x83, 0xc4, 0xa8, 0x9b, 0x5d, 0xdf, 0x5b, 0x8f, 0x6a, 0xd4, 0x3b, 0xfc, 0x97, 0x05,
+	0x6b, 0x8b, 0x36, 0xf4, 0x0c, 0x6a, 0x62, 0x93, 0xeb, 0x2a, 0x2a, 0x8d, 0xd5, 0xe4, 0x32, 0xdd,
+	0xd2, 0xbb, 0x60, 0x73, 0x32, 0x77, 0x91, 0x7d, 0x00, 0x9c, 0xa4, 0x0e, 0x0d, 0x28, 0x8a, 0x32,
+	0xe8, 0x8d, 0x20, 0x40, 0xd2, 0x53, 0x6a, 0x0b, 0x17, 0x44, 0xf7, 0x29, 0xf4, 0xf1, 0xc5, 0xf7,
+	0xbd, 0x69, 0xc8, 0x31, 0x63, 0x9d, 0x90, 0x74, 0xe5, 0xa9, 0x7b, 0x4d, 0xba, 0x53, 0xde, 0x15,
+	0x3f, 0xaa, 0xae, 0x7a, 0xc8, 0x55, 0x49, 0xc0, 0xfd, 0xff, 0x03, 0x00, 0x76, 0x54, 0x03, 0x2b,
+	0xde, 0x06, 0x00, 0x00,
 }
@@ -37,6 +37,7 @@ func TestPreview(t *testing.T) {
 		{"    select ...", StmtSelect},
 		{"(select ...", StmtSelect},
 		{"( select ...", StmtSelect},
+		{"with t as (select ...", StmtSelect},
 		{"insert ...", StmtInsert},
 		{"replace ....", StmtReplace},
 		{"   update ...", StmtUpdate},
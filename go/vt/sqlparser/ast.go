@@ -192,6 +192,7 @@ type (
 
 	// Select represents a SELECT statement.
 	Select struct {
+		With             *With
 		Cache            *bool // a reference here so it can be nil
 		Distinct         bool
 		StraightJoinHint bool
@@ -202,12 +203,26 @@ type (
 		Where            *Where
 		GroupBy          GroupBy
 		Having           *Where
+		Windows          WindowDefinitions
 		OrderBy          OrderBy
 		Limit            *Limit
 		Lock             Lock
 		Into             *SelectInto
 	}
 
+	// With represents the WITH clause of a SELECT or UNION statement.
+	With struct {
+		Recursive bool
+		CTEs      []*CommonTableExpr
+	}
+
+	// CommonTableExpr represents a single named query of a WITH clause.
+	CommonTableExpr struct {
+		Name     TableIdent
+		Columns  Columns
+		Subquery *Subquery
+	}
+
 	// SelectInto is a struct that represent the INTO part of a select query
 	SelectInto struct {
 		Type         SelectIntoType
@@ -232,6 +247,7 @@ type (
 	}
 	// Union represents a UNION statement.
 	Union struct {
+		With           *With
 		FirstStatement SelectStatement
 		UnionSelects   []*UnionSelect
 		OrderBy        OrderBy
@@ -1608,6 +1624,7 @@ type (
 		Name      ColIdent
 		Distinct  bool
 		Exprs     SelectExprs
+		Over      *OverClause
 	}
 
 	// GroupConcatExpr represents a call to GROUP_CONCAT
@@ -1753,6 +1770,51 @@ type Order struct {
 // OrderDirection is an enum for the direction in which to order - asc or desc.
 type OrderDirection int8
 
+// OverClause represents the OVER clause of a window function call.
+// Either WindowName or WindowSpec is set.
+type OverClause struct {
+	WindowName ColIdent
+	WindowSpec *WindowSpec
+}
+
+// WindowSpec represents a window specification.
+type WindowSpec struct {
+	Name        ColIdent
+	PartitionBy Exprs
+	OrderBy     OrderBy
+	Frame       *FrameClause
+}
+
+// FrameClause represents the frame of a window specification.
+// End is nil if the frame only specifies its start.
+type FrameClause struct {
+	Unit  FrameUnitType
+	Start *FramePoint
+	End   *FramePoint
+}
+
+// FrameUnitType is an enum for FrameClause.Unit
+type FrameUnitType int8
+
+// FramePoint represents one of the boundaries of a window frame.
+// Expr is only set for the ExprPreceding and ExprFollowing types.
+type FramePoint struct {
+	Type FramePointType
+	Expr Expr
+}
+
+// FramePointType is an enum for FramePoint.Type
+type FramePointType int8
+
+// WindowDefinition represents a named window of the WINDOW clause.
+type WindowDefinition struct {
+	Name       ColIdent
+	WindowSpec *WindowSpec
+}
+
+// WindowDefinitions represents the WINDOW clause of a SELECT.
+type WindowDefinitions []*WindowDefinition
+
 // Limit represents a LIMIT clause.
 type Limit struct {
 	Offset, Rowcount Expr
@@ -1821,10 +1883,10 @@ func (node *Select) Format(buf *TrackedBuffer) {
 	addIf(node.StraightJoinHint, StraightJoinHint)
 	addIf(node.SQLCalcFoundRows, SQLCalcFoundRowsStr)
 
-	buf.astPrintf(node, "select %v%s%v from %v%v%v%v%v%v%s%v",
-		node.Comments, options, node.SelectExprs,
+	buf.astPrintf(node, "%vselect %v%s%v from %v%v%v%v%v%v%v%s%v",
+		node.With, node.Comments, options, node.SelectExprs,
 		node.From, node.Where,
-		node.GroupBy, node.Having, node.OrderBy,
+		node.GroupBy, node.Having, node.Windows, node.OrderBy,
 		node.Limit, node.Lock.ToString(), node.Into)
 }
 
@@ -1835,13 +1897,35 @@ func (node *ParenSelect) Format(buf *TrackedBuffer) {
 
 // Format formats the node.
 func (node *Union) Format(buf *TrackedBuffer) {
-	buf.astPrintf(node, "%v", node.FirstStatement)
+	buf.astPrintf(node, "%v%v", node.With, node.FirstStatement)
 	for _, us := range node.UnionSelects {
 		buf.astPrintf(node, "%v", us)
 	}
 	buf.astPrintf(node, "%v%v%s", node.OrderBy, node.Limit, node.Lock.ToString())
 }
 
+// Format formats the node.
+func (node *With) Format(buf *TrackedBuffer) {
+	if node == nil {
+		return
+	}
+	buf.WriteString("with ")
+	if node.Recursive {
+		buf.WriteString("recursive ")
+	}
+	prefix := ""
+	for _, cte := range node.CTEs {
+		buf.astPrintf(node, "%s%v", prefix, cte)
+		prefix = ", "
+	}
+	buf.WriteString(" ")
+}
+
+// Format formats the node.
+func (node *CommonTableExpr) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v%v as %v", node.Name, node.Columns, node.Subquery)
+}
+
 // Format formats the node.
 func (node *UnionSelect) Format(buf *TrackedBuffer) {
 	if node.Distinct {
@@ -2820,6 +2904,9 @@ func (node *FuncExpr) Format(buf *TrackedBuffer) {
 		buf.WriteString(funcName)
 	}
 	buf.astPrintf(node, "(%s%v)", distinct, node.Exprs)
+	if node.Over != nil {
+		buf.astPrintf(node, " %v", node.Over)
+	}
 }
 
 // Format formats the node
@@ -2930,6 +3017,72 @@ func (node OrderBy) Format(buf *TrackedBuffer) {
 	}
 }
 
+// Format formats the node.
+func (node *OverClause) Format(buf *TrackedBuffer) {
+	if node.WindowSpec != nil {
+		buf.astPrintf(node, "over (%v)", node.WindowSpec)
+		return
+	}
+	buf.astPrintf(node, "over %v", node.WindowName)
+}
+
+// Format formats the node.
+func (node *WindowSpec) Format(buf *TrackedBuffer) {
+	sep := ""
+	if !node.Name.IsEmpty() {
+		buf.astPrintf(node, "%v", node.Name)
+		sep = " "
+	}
+	if len(node.PartitionBy) > 0 {
+		buf.astPrintf(node, "%spartition by %v", sep, node.PartitionBy)
+		sep = " "
+	}
+	if len(node.OrderBy) > 0 {
+		prefix := sep + "order by "
+		for _, order := range node.OrderBy {
+			buf.astPrintf(node, "%s%v", prefix, order)
+			prefix = ", "
+		}
+		sep = " "
+	}
+	if node.Frame != nil {
+		buf.astPrintf(node, "%s%v", sep, node.Frame)
+	}
+}
+
+// Format formats the node.
+func (node *FrameClause) Format(buf *TrackedBuffer) {
+	if node.End == nil {
+		buf.astPrintf(node, "%s %v", node.Unit.ToString(), node.Start)
+		return
+	}
+	buf.astPrintf(node, "%s between %v and %v", node.Unit.ToString(), node.Start, node.End)
+}
+
+// Format formats the node.
+func (node *FramePoint) Format(buf *TrackedBuffer) {
+	switch node.Type {
+	case ExprPrecedingType, ExprFollowingType:
+		buf.astPrintf(node, "%v %s", node.Expr, node.Type.ToString())
+	default:
+		buf.WriteString(node.Type.ToString())
+	}
+}
+
+// Format formats the node.
+func (node *WindowDefinition) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "%v as (%v)", node.Name, node.WindowSpec)
+}
+
+// Format formats the node.
+func (node WindowDefinitions) Format(buf *TrackedBuffer) {
+	prefix := " window "
+	for _, n := range node {
+		buf.astPrintf(node, "%s%v", prefix, n)
+		prefix = ", "
+	}
+}
+
 // Format formats the node.
 func (node *Order) Format(buf *TrackedBuffer) {
 	if node, ok := node.Expr.(*NullVal); ok {
@@ -836,6 +836,28 @@ func (node *Union) MakeDistinct() {
 	node.UnionSelects[len(node.UnionSelects)-1].Distinct = true
 }
 
+// SetWith sets the WITH clause of a SELECT or UNION statement.
+func SetWith(stmt SelectStatement, with *With) SelectStatement {
+	switch stmt := stmt.(type) {
+	case *Select:
+		stmt.With = with
+	case *Union:
+		stmt.With = with
+	}
+	return stmt
+}
+
+// GetWith returns the WITH clause of a SELECT or UNION statement, if any.
+func GetWith(stmt SelectStatement) *With {
+	switch stmt := stmt.(type) {
+	case *Select:
+		return stmt.With
+	case *Union:
+		return stmt.With
+	}
+	return nil
+}
+
 //Unionize returns a UNION, either creating one or adding SELECT to an existing one
 func Unionize(lhs, rhs SelectStatement, distinct bool, by OrderBy, limit *Limit, lock Lock) *Union {
 	union, isUnion := lhs.(*Union)
@@ -1178,6 +1200,36 @@ func (sel SelectIntoType) ToString() string {
 	}
 }
 
+// ToString returns the unit as a string
+func (unit FrameUnitType) ToString() string {
+	switch unit {
+	case RowsUnit:
+		return RowsStr
+	case RangeUnit:
+		return RangeStr
+	default:
+		return "Unknown Frame Unit Type"
+	}
+}
+
+// ToString returns the type as a string
+func (typ FramePointType) ToString() string {
+	switch typ {
+	case CurrentRowType:
+		return CurrentRowStr
+	case UnboundedPrecedingType:
+		return UnboundedPrecedingStr
+	case UnboundedFollowingType:
+		return UnboundedFollowingStr
+	case ExprPrecedingType:
+		return PrecedingStr
+	case ExprFollowingType:
+		return FollowingStr
+	default:
+		return "Unknown Frame Point Type"
+	}
+}
+
 // ToString returns the type as a string
 func (node CollateAndCharsetType) ToString() string {
 	switch node {
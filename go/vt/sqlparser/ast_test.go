@@ -105,6 +105,33 @@ func TestSelect(t *testing.T) {
 	}
 }
 
+func TestCloneSelectStatement(t *testing.T) {
+	for _, query := range []string{
+		"select a, b + 1 as c from t as x join u on x.id = u.id where x.a in (1, 2) and u.b like 'a%' order by a desc limit 5",
+		"select * from t where a = (select max(b) from u) union select 1 from dual",
+		"with cte as (select id from t) select * from cte",
+	} {
+		tree, err := Parse(query)
+		require.NoError(t, err)
+		sel := tree.(SelectStatement)
+		want := String(sel)
+
+		clone := CloneSelectStatement(sel)
+		assert.Equal(t, want, String(clone))
+
+		// Changing the clone must not change the original.
+		_ = Rewrite(clone, func(cursor *Cursor) bool {
+			if col, ok := cursor.Node().(*ColName); ok {
+				col.Name = NewColIdent("changed")
+			}
+			return true
+		}, nil)
+		assert.NotEqual(t, want, String(clone))
+		assert.Equal(t, want, String(sel))
+	}
+	assert.Nil(t, CloneSelectStatement(nil))
+}
+
 func TestRemoveHints(t *testing.T) {
 	for _, query := range []string{
 		"select * from t use index (i)",
@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import "reflect"
+
+var sqlNodeType = reflect.TypeOf((*SQLNode)(nil)).Elem()
+
+// CloneSelectStatement returns a deep copy of the statement.
+func CloneSelectStatement(in SelectStatement) SelectStatement {
+	if in == nil {
+		return nil
+	}
+	return cloneValue(reflect.ValueOf(in)).Interface().(SelectStatement)
+}
+
+// cloneValue deep copies the AST nodes reachable from v. Values held
+// in interfaces that are not AST nodes, like the Metadata of a ColName,
+// are not part of the tree and are shared with the copy.
+func cloneValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(v.Type().Elem())
+		out.Elem().Set(cloneValue(v.Elem()))
+		return out
+	case reflect.Interface:
+		if v.IsNil() || !v.Elem().Type().Implements(sqlNodeType) {
+			return v
+		}
+		out := reflect.New(v.Type()).Elem()
+		out.Set(cloneValue(v.Elem()))
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(cloneValue(v.Index(i)))
+		}
+		return out
+	case reflect.Struct:
+		// Copying the struct also copies its unexported fields,
+		// which only hold values, like the names of identifiers.
+		out := reflect.New(v.Type()).Elem()
+		out.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if out.Field(i).CanSet() {
+				out.Field(i).Set(cloneValue(v.Field(i)))
+			}
+		}
+		return out
+	}
+	return v
+}
@@ -36,6 +36,8 @@ const (
 	DirectiveIgnoreMaxPayloadSize = "IGNORE_MAX_PAYLOAD_SIZE"
 	// DirectiveIgnoreMaxMemoryRows skips memory row validation when set.
 	DirectiveIgnoreMaxMemoryRows = "IGNORE_MAX_MEMORY_ROWS"
+	// DirectiveNoHashJoin keeps the planner from executing cross-shard joins as hash joins.
+	DirectiveNoHashJoin = "NO_HASH_JOIN"
 )
 
 func isNonSpace(r rune) bool {
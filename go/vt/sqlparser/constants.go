@@ -177,6 +177,17 @@ const (
 	IntoOutfileS3Str = " into outfile s3 "
 	IntoDumpfileStr  = " into dumpfile "
 
+	// FrameClause.Unit
+	RowsStr  = "rows"
+	RangeStr = "range"
+
+	// FramePoint.Type
+	CurrentRowStr         = "current row"
+	UnboundedPrecedingStr = "unbounded preceding"
+	UnboundedFollowingStr = "unbounded following"
+	PrecedingStr          = "preceding"
+	FollowingStr          = "following"
+
 	// Order.Direction
 	AscScr  = "asc"
 	DescScr = "desc"
@@ -431,6 +442,21 @@ const (
 	IntoDumpfile
 )
 
+// Constant for Enum Type - FrameUnitType
+const (
+	RowsUnit FrameUnitType = iota
+	RangeUnit
+)
+
+// Constant for Enum Type - FramePointType
+const (
+	CurrentRowType FramePointType = iota
+	UnboundedPrecedingType
+	UnboundedFollowingType
+	ExprPrecedingType
+	ExprFollowingType
+)
+
 // Constant for Enum Type - CollateAndCharsetType
 const (
 	CollateType CollateAndCharsetType = iota
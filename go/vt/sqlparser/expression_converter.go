@@ -18,7 +18,12 @@ package sqlparser
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
@@ -27,6 +32,25 @@ var ErrExprNotSupported = fmt.Errorf("Expr Not Supported")
 
 //Convert converts between AST expressions and executable expressions
 func Convert(e Expr) (evalengine.Expr, error) {
+	return ConvertWith(e, nil)
+}
+
+// ConvertWith is like Convert, but resolve is called first for every
+// sub-expression. If it returns a non-nil expression, it is used instead
+// of converting the sub-expression. This lets the caller convert the
+// parts of the expression that are computed by another primitive, like
+// columns or aggregates, into references to that primitive's output.
+func ConvertWith(e Expr, resolve func(Expr) (evalengine.Expr, error)) (evalengine.Expr, error) {
+	if resolve != nil {
+		evalExpr, err := resolve(e)
+		if err != nil || evalExpr != nil {
+			return evalExpr, err
+		}
+	}
+	convert := func(e Expr) (evalengine.Expr, error) {
+		return ConvertWith(e, resolve)
+	}
+
 	switch node := e.(type) {
 	case Argument:
 		return evalengine.NewBindVar(string(node[1:])), nil
@@ -44,6 +68,8 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			return evalengine.NewLiteralIntFromBytes([]byte("1"))
 		}
 		return evalengine.NewLiteralIntFromBytes([]byte("0"))
+	case *NullVal:
+		return evalengine.NewLiteralNull(), nil
 	case *BinaryExpr:
 		var op evalengine.BinaryExpr
 		switch node.Operator {
@@ -58,11 +84,11 @@ func Convert(e Expr) (evalengine.Expr, error) {
 		default:
 			return nil, ErrExprNotSupported
 		}
-		left, err := Convert(node.Left)
+		left, err := convert(node.Left)
 		if err != nil {
 			return nil, err
 		}
-		right, err := Convert(node.Right)
+		right, err := convert(node.Right)
 		if err != nil {
 			return nil, err
 		}
@@ -71,7 +97,255 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			Left:  left,
 			Right: right,
 		}, nil
-
+	case *UnaryExpr:
+		inner, err := convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		switch node.Operator {
+		case BinaryOp, UBinaryOp:
+			return evalengine.NewCastExpr(inner, sqltypes.VarBinary, -1)
+		case UMinusOp:
+		default:
+			return nil, ErrExprNotSupported
+		}
+		return &evalengine.BinaryOp{
+			Expr:  &evalengine.Subtraction{},
+			Left:  evalengine.NewLiteralInt(0),
+			Right: inner,
+		}, nil
+	case *ComparisonExpr:
+		return convertComparison(node, convert)
+	case *RangeCond:
+		// a BETWEEN b AND c is evaluated as a >= b AND a <= c.
+		left, err := convert(node.Left)
+		if err != nil {
+			return nil, err
+		}
+		from, err := convert(node.From)
+		if err != nil {
+			return nil, err
+		}
+		to, err := convert(node.To)
+		if err != nil {
+			return nil, err
+		}
+		if !evalengine.CanCompare(left, from) || !evalengine.CanCompare(left, to) {
+			return nil, ErrExprNotSupported
+		}
+		if node.Operator == NotBetweenOp {
+			return &evalengine.LogicalExpr{
+				Op:    evalengine.OrOp,
+				Left:  &evalengine.ComparisonExpr{Op: &evalengine.LessThanOp{}, Left: left, Right: from},
+				Right: &evalengine.ComparisonExpr{Op: &evalengine.GreaterThanOp{}, Left: left, Right: to},
+			}, nil
+		}
+		return &evalengine.LogicalExpr{
+			Op:    evalengine.AndOp,
+			Left:  &evalengine.ComparisonExpr{Op: &evalengine.GreaterEqualOp{}, Left: left, Right: from},
+			Right: &evalengine.ComparisonExpr{Op: &evalengine.LessEqualOp{}, Left: left, Right: to},
+		}, nil
+	case *AndExpr:
+		return convertLogical(evalengine.AndOp, node.Left, node.Right, convert)
+	case *OrExpr:
+		return convertLogical(evalengine.OrOp, node.Left, node.Right, convert)
+	case *XorExpr:
+		return convertLogical(evalengine.XorOp, node.Left, node.Right, convert)
+	case *NotExpr:
+		inner, err := convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.NotExpr{Inner: inner}, nil
+	case *IsExpr:
+		var op evalengine.IsOp
+		switch node.Operator {
+		case IsNullOp:
+			op = evalengine.IsNullOp
+		case IsNotNullOp:
+			op = evalengine.IsNotNullOp
+		case IsTrueOp:
+			op = evalengine.IsTrueOp
+		case IsNotTrueOp:
+			op = evalengine.IsNotTrueOp
+		case IsFalseOp:
+			op = evalengine.IsFalseOp
+		case IsNotFalseOp:
+			op = evalengine.IsNotFalseOp
+		default:
+			return nil, ErrExprNotSupported
+		}
+		inner, err := convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.IsExpr{Inner: inner, Op: op}, nil
+	case *CaseExpr:
+		caseExpr := &evalengine.CaseExpr{}
+		var err error
+		if node.Expr != nil {
+			if caseExpr.Base, err = convert(node.Expr); err != nil {
+				return nil, err
+			}
+		}
+		for _, when := range node.Whens {
+			cond, err := convert(when.Cond)
+			if err != nil {
+				return nil, err
+			}
+			if caseExpr.Base != nil && !evalengine.CanCompare(caseExpr.Base, cond) {
+				return nil, ErrExprNotSupported
+			}
+			val, err := convert(when.Val)
+			if err != nil {
+				return nil, err
+			}
+			caseExpr.Whens = append(caseExpr.Whens, evalengine.WhenExpr{Cond: cond, Val: val})
+		}
+		if node.Else != nil {
+			if caseExpr.Else, err = convert(node.Else); err != nil {
+				return nil, err
+			}
+		}
+		return caseExpr, nil
+	case *FuncExpr:
+		if !node.Qualifier.IsEmpty() || node.Distinct || node.Over != nil || !evalengine.SupportedFunction(node.Name.String()) {
+			return nil, ErrExprNotSupported
+		}
+		args := make([]evalengine.Expr, 0, len(node.Exprs))
+		for _, expr := range node.Exprs {
+			aliased, ok := expr.(*AliasedExpr)
+			if !ok {
+				return nil, ErrExprNotSupported
+			}
+			arg, err := convert(aliased.Expr)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		call, err := evalengine.NewCallExpr(node.Name.String(), args)
+		if err != nil {
+			return nil, ErrExprNotSupported
+		}
+		return call, nil
+	case *ConvertExpr:
+		typ, length, ok := convertTypeToSQLType(node.Type)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		inner, err := convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewCastExpr(inner, typ, length)
 	}
 	return nil, ErrExprNotSupported
 }
+
+func convertComparison(node *ComparisonExpr, convert func(Expr) (evalengine.Expr, error)) (evalengine.Expr, error) {
+	left, err := convert(node.Left)
+	if err != nil {
+		return nil, err
+	}
+	switch node.Operator {
+	case InOp, NotInOp:
+		tuple, ok := node.Right.(ValTuple)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		in := &evalengine.InExpr{Left: left, Negate: node.Operator == NotInOp}
+		for _, expr := range tuple {
+			val, err := convert(expr)
+			if err != nil {
+				return nil, err
+			}
+			if !evalengine.CanCompare(left, val) {
+				return nil, ErrExprNotSupported
+			}
+			in.Right = append(in.Right, val)
+		}
+		return in, nil
+	}
+
+	var op evalengine.ComparisonOp
+	switch node.Operator {
+	case EqualOp:
+		op = &evalengine.EqualOp{}
+	case NotEqualOp:
+		op = &evalengine.NotEqualOp{}
+	case NullSafeEqualOp:
+		op = &evalengine.NullSafeEqualOp{}
+	case LessThanOp:
+		op = &evalengine.LessThanOp{}
+	case LessEqualOp:
+		op = &evalengine.LessEqualOp{}
+	case GreaterThanOp:
+		op = &evalengine.GreaterThanOp{}
+	case GreaterEqualOp:
+		op = &evalengine.GreaterEqualOp{}
+	case LikeOp, NotLikeOp:
+		escape := '\\'
+		if node.Escape != nil {
+			lit, ok := node.Escape.(*Literal)
+			if !ok || lit.Type != StrVal || utf8.RuneCount(lit.Val) != 1 {
+				return nil, ErrExprNotSupported
+			}
+			escape, _ = utf8.DecodeRune(lit.Val)
+		}
+		op = &evalengine.LikeOp{Negate: node.Operator == NotLikeOp, Escape: escape}
+	default:
+		return nil, ErrExprNotSupported
+	}
+	right, err := convert(node.Right)
+	if err != nil {
+		return nil, err
+	}
+	// The strings whose collation isn't known are compared by MySQL.
+	if _, like := op.(*evalengine.LikeOp); like && !evalengine.CanMatch(left, right) {
+		return nil, ErrExprNotSupported
+	}
+	if !evalengine.CanCompare(left, right) {
+		return nil, ErrExprNotSupported
+	}
+	return &evalengine.ComparisonExpr{Op: op, Left: left, Right: right}, nil
+}
+
+func convertLogical(op evalengine.LogicalOp, l, r Expr, convert func(Expr) (evalengine.Expr, error)) (evalengine.Expr, error) {
+	left, err := convert(l)
+	if err != nil {
+		return nil, err
+	}
+	right, err := convert(r)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.LogicalExpr{Op: op, Left: left, Right: right}, nil
+}
+
+// convertTypeToSQLType returns the type and length of the CAST and
+// CONVERT types supported by evalengine. The length is -1 if not set.
+func convertTypeToSQLType(convertType *ConvertType) (querypb.Type, int, bool) {
+	length := -1
+	if convertType.Length != nil {
+		l, err := strconv.Atoi(string(convertType.Length.Val))
+		if err != nil {
+			return 0, 0, false
+		}
+		length = l
+	}
+	switch strings.ToLower(convertType.Type) {
+	case "signed":
+		return sqltypes.Int64, length, true
+	case "unsigned":
+		return sqltypes.Uint64, length, true
+	case "binary":
+		return sqltypes.VarBinary, length, true
+	case "char", "nchar":
+		if strings.ToLower(convertType.Charset) == "binary" {
+			return sqltypes.VarBinary, length, true
+		}
+		return sqltypes.VarChar, length, true
+	}
+	return 0, 0, false
+}
@@ -70,6 +70,183 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: ":float_bind_variable",
 		expected:   sqltypes.NewFloat64(2.2),
+	}, {
+		expression: "40/0",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: ":null_bind_variable + 2",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "-:exp",
+		expected:   sqltypes.NewInt64(-66),
+	}, {
+		expression: "null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "1 = 1",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 != 1.0",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "'10' > 9",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "binary '10' > '9'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "_binary 'abc' = 'abc  '",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "cast('abc' as binary) = 'ABC'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "'2a' = 2",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: ":uint64_bind_variable >= -1",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null = null",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "null <=> null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "1 <=> null",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "binary 'Hello' like 'H_l%'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "binary 'Hello' like 'h%'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "binary '50%' not like '50|%' escape '|'",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "2 in (1, 2, null)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "3 in (1, 2, null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "3 not in (1, 2)",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "5 between 1 and 10",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "5 not between 1 and 4",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null and 0",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "null or 1",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null and 1",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "1 xor 1",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "not 'abc'",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "null is null",
+		expected:   sqltypes.NewInt64(1),
+	}, {
+		expression: "0 is not false",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "case when 1 > 2 then 'a' when 2 > 1 then 'b' else 'c' end",
+		expected:   sqltypes.NewVarBinary("b"),
+	}, {
+		expression: "case :exp when 1 then 'one' end",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "concat('a', 1, 2.5)",
+		expected:   sqltypes.NewVarChar("a12.5"),
+	}, {
+		expression: "concat('a', null)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "concat_ws(',', 'a', null, 'b')",
+		expected:   sqltypes.NewVarChar("a,b"),
+	}, {
+		expression: "upper('abc')",
+		expected:   sqltypes.NewVarChar("ABC"),
+	}, {
+		expression: "lower(cast('ABC' as binary))",
+		expected:   sqltypes.NewVarChar("ABC"),
+	}, {
+		expression: "length('héllo')",
+		expected:   sqltypes.NewInt64(6),
+	}, {
+		expression: "char_length('héllo')",
+		expected:   sqltypes.NewInt64(5),
+	}, {
+		expression: "substring('vitess', 2, 3)",
+		expected:   sqltypes.NewVarChar("ite"),
+	}, {
+		expression: "substr('vitess', -3)",
+		expected:   sqltypes.NewVarChar("ess"),
+	}, {
+		expression: "left('vitess', 2)",
+		expected:   sqltypes.NewVarChar("vi"),
+	}, {
+		expression: "replace('a.b.c', '.', '-')",
+		expected:   sqltypes.NewVarChar("a-b-c"),
+	}, {
+		expression: "trim('  a  ')",
+		expected:   sqltypes.NewVarChar("a"),
+	}, {
+		expression: "abs(-3)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "floor(2.5)",
+		expected:   sqltypes.NewFloat64(2),
+	}, {
+		expression: "round(2.345, 2)",
+		expected:   sqltypes.NewFloat64(2.35),
+	}, {
+		expression: "round(1234, -2)",
+		expected:   sqltypes.NewInt64(1200),
+	}, {
+		expression: "truncate(2.345, 2)",
+		expected:   sqltypes.NewFloat64(2.34),
+	}, {
+		expression: "coalesce(null, :null_bind_variable, 3)",
+		expected:   sqltypes.NewInt64(3),
+	}, {
+		expression: "ifnull(null, 'x')",
+		expected:   sqltypes.NewVarBinary("x"),
+	}, {
+		expression: "if(1 > 2, 'yes', 'no')",
+		expected:   sqltypes.NewVarBinary("no"),
+	}, {
+		expression: "nullif(1, 1)",
+		expected:   sqltypes.NULL,
+	}, {
+		expression: "greatest(1, 5, 3)",
+		expected:   sqltypes.NewInt64(5),
+	}, {
+		expression: "least(binary 'b', 'A', 'c')",
+		expected:   sqltypes.NewVarBinary("A"),
+	}, {
+		expression: "cast('12abc' as signed)",
+		expected:   sqltypes.NewInt64(12),
+	}, {
+		expression: "cast(-1 as unsigned)",
+		expected:   sqltypes.NewUint64(18446744073709551615),
+	}, {
+		expression: "cast(12345 as char(3))",
+		expected:   sqltypes.NewVarChar("123"),
+	}, {
+		expression: "convert('ab', binary(3))",
+		expected:   sqltypes.MakeTrusted(sqltypes.VarBinary, []byte("ab\x00")),
 	}}
 
 	for _, test := range tests {
@@ -87,6 +264,7 @@ func TestEvaluate(t *testing.T) {
 					"string_bind_variable": sqltypes.StringBindVariable("bar"),
 					"uint64_bind_variable": sqltypes.Uint64BindVariable(22),
 					"float_bind_variable":  sqltypes.Float64BindVariable(2.2),
+					"null_bind_variable":   sqltypes.NullBindVariable,
 				},
 				Row: nil,
 			}
@@ -100,3 +278,34 @@ func TestEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertNotSupported(t *testing.T) {
+	tests := []string{
+		"a + 1",
+		"sysdate()",
+		"count(*)",
+		"1 in ::list",
+		"cast(1 as decimal(10, 2))",
+		"concat()",
+		"1 << 2",
+		// MySQL compares these strings with the collation of the
+		// connection, which isn't known.
+		"'abc' = 'ABC'",
+		"'Hello' like 'h%'",
+		"'a' in ('b', 'c')",
+		"'b' between 'a' and 'c'",
+		"case 'a' when 'b' then 1 end",
+		"least('b', 'a')",
+		"nullif('a', :v)",
+		"concat('a', 'b') = cast('ab' as char)",
+	}
+	for _, expression := range tests {
+		t.Run(expression, func(t *testing.T) {
+			stmt, err := Parse("select " + expression)
+			require.NoError(t, err)
+			astExpr := stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr
+			_, err = Convert(astExpr)
+			require.Equal(t, ErrExprNotSupported, err)
+		})
+	}
+}
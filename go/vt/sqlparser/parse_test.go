@@ -134,6 +134,43 @@ var (
 	}, {
 		input:  "(select id, a from t order by id limit 1) union (select id, b as a from s order by id limit 1) order by a limit 1",
 		output: "(select id, a from t order by id asc limit 1) union (select id, b as a from s order by id asc limit 1) order by a asc limit 1",
+	}, {
+		input: "with t as (select id, a from t1) select /* cte */ a from t where id = 1",
+	}, {
+		input: "with t(x, y) as (select id, a from t1 where id = 1), s as (select x from t) select * from s join t on s.x = t.x",
+	}, {
+		input:  "WITH RECURSIVE t (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM t WHERE n < 5) SELECT n FROM t",
+		output: "with recursive t(n) as (select 1 from dual union all select n + 1 from t where n < 5) select n from t",
+	}, {
+		input: "with t as (select a from t1) select a from t union select a from t2 order by a asc",
+	}, {
+		input: "select /* cte in derived table */ a from (with t as (select a from t1) select a from t) as x",
+	}, {
+		input: "select /* cte in subquery */ a from t1 where a in (with t as (select b from t2) select b from t)",
+	}, {
+		input: "insert /* cte in select */ into a with t as (select b, c from d) select b, c from t",
+	}, {
+		input: "insert /* cte in select with columns */ into a(b, c) with t as (select b, c from d) select b, c from t",
+	}, {
+		input: "select /* window function */ a, row_number() over (partition by b order by c asc) from t",
+	}, {
+		input: "select a, sum(b) over (order by c desc rows between unbounded preceding and current row) from t",
+	}, {
+		input:  "select a, avg(b) OVER (PARTITION BY c, d ORDER BY e RANGE BETWEEN INTERVAL 1 day PRECEDING AND 2 FOLLOWING) from t",
+		output: "select a, avg(b) over (partition by c, d order by e asc range between interval 1 day preceding and 2 following) from t",
+	}, {
+		input: "select a, count(*) over (rows unbounded preceding), count(*) over () from t",
+	}, {
+		input: "select /* rows and row are not reserved */ `rows`, `row` from t where `rows` > 70",
+	}, {
+		input:  "select rows, row from t where Rows > 70",
+		output: "select `rows`, `row` from t where `Rows` > 70",
+	}, {
+		input:  "select a, rank() over w, sum(b) over (w rows :n preceding) from t where a = 1 window w as (partition by c order by d)",
+		output: "select a, rank() over w, sum(b) over (w rows :n preceding) from t where a = 1 window w as (partition by c order by d asc)",
+	}, {
+		input:  "select a, lag(b, 1) over w1 from t group by a, b having a > 1 window w1 as (order by a), w2 as (w1 rows current row) order by a",
+		output: "select a, lag(b, 1) over w1 from t group by a, b having a > 1 window w1 as (order by a asc), w2 as (w1 rows current row) order by a asc",
 	}, {
 		input: "select a from (select 1 as a from tbl1 union select 2 from tbl2) as t",
 	}, {
@@ -2867,6 +2904,15 @@ var (
 	}{{
 		input:  "select : from t",
 		output: "syntax error at position 9 near ':'",
+	}, {
+		input:  "select a, sum(b) over (rows between current row) from t",
+		output: "syntax error at position 49",
+	}, {
+		input:  "select a, row_number() over w from t window w",
+		output: "syntax error at position 46",
+	}, {
+		input:  "with t as select 1 from dual select * from t",
+		output: "syntax error at position 17 near 'select'",
 	}, {
 		input:  "select 0xH from t",
 		output: "syntax error at position 10 near '0x'",
@@ -234,6 +234,18 @@ func (r *replaceColumnsItems) inc() {
 	*r++
 }
 
+func replaceCommonTableExprColumns(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Columns = newNode.(Columns)
+}
+
+func replaceCommonTableExprName(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Name = newNode.(TableIdent)
+}
+
+func replaceCommonTableExprSubquery(newNode, parent SQLNode) {
+	parent.(*CommonTableExpr).Subquery = newNode.(*Subquery)
+}
+
 func replaceComparisonExprEscape(newNode, parent SQLNode) {
 	parent.(*ComparisonExpr).Escape = newNode.(Expr)
 }
@@ -416,6 +428,18 @@ func replaceForeignKeyDefinitionSource(newNode, parent SQLNode) {
 	parent.(*ForeignKeyDefinition).Source = newNode.(Columns)
 }
 
+func replaceFrameClauseEnd(newNode, parent SQLNode) {
+	parent.(*FrameClause).End = newNode.(*FramePoint)
+}
+
+func replaceFrameClauseStart(newNode, parent SQLNode) {
+	parent.(*FrameClause).Start = newNode.(*FramePoint)
+}
+
+func replaceFramePointExpr(newNode, parent SQLNode) {
+	parent.(*FramePoint).Expr = newNode.(Expr)
+}
+
 func replaceFuncExprExprs(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Exprs = newNode.(SelectExprs)
 }
@@ -424,6 +448,10 @@ func replaceFuncExprName(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Name = newNode.(ColIdent)
 }
 
+func replaceFuncExprOver(newNode, parent SQLNode) {
+	parent.(*FuncExpr).Over = newNode.(*OverClause)
+}
+
 func replaceFuncExprQualifier(newNode, parent SQLNode) {
 	parent.(*FuncExpr).Qualifier = newNode.(TableIdent)
 }
@@ -603,6 +631,14 @@ func replaceOrderByOptionCols(newNode, parent SQLNode) {
 	parent.(*OrderByOption).Cols = newNode.(Columns)
 }
 
+func replaceOverClauseWindowName(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowName = newNode.(ColIdent)
+}
+
+func replaceOverClauseWindowSpec(newNode, parent SQLNode) {
+	parent.(*OverClause).WindowSpec = newNode.(*WindowSpec)
+}
+
 func replaceParenSelectSelect(newNode, parent SQLNode) {
 	parent.(*ParenSelect).Select = newNode.(SelectStatement)
 }
@@ -715,6 +751,14 @@ func replaceSelectWhere(newNode, parent SQLNode) {
 	parent.(*Select).Where = newNode.(*Where)
 }
 
+func replaceSelectWindows(newNode, parent SQLNode) {
+	parent.(*Select).Windows = newNode.(WindowDefinitions)
+}
+
+func replaceSelectWith(newNode, parent SQLNode) {
+	parent.(*Select).With = newNode.(*With)
+}
+
 type replaceSelectExprsItems int
 
 func (r *replaceSelectExprsItems) replace(newNode, container SQLNode) {
@@ -935,6 +979,10 @@ func (r *replaceUnionUnionSelects) inc() {
 	*r++
 }
 
+func replaceUnionWith(newNode, parent SQLNode) {
+	parent.(*Union).With = newNode.(*With)
+}
+
 func replaceUnionSelectStatement(newNode, parent SQLNode) {
 	parent.(*UnionSelect).Statement = newNode.(SelectStatement)
 }
@@ -1064,6 +1112,50 @@ func replaceWhereExpr(newNode, parent SQLNode) {
 	parent.(*Where).Expr = newNode.(Expr)
 }
 
+func replaceWindowDefinitionName(newNode, parent SQLNode) {
+	parent.(*WindowDefinition).Name = newNode.(ColIdent)
+}
+
+func replaceWindowDefinitionWindowSpec(newNode, parent SQLNode) {
+	parent.(*WindowDefinition).WindowSpec = newNode.(*WindowSpec)
+}
+
+type replaceWindowDefinitionsItems int
+
+func (r *replaceWindowDefinitionsItems) replace(newNode, container SQLNode) {
+	container.(WindowDefinitions)[int(*r)] = newNode.(*WindowDefinition)
+}
+
+func (r *replaceWindowDefinitionsItems) inc() {
+	*r++
+}
+
+func replaceWindowSpecFrame(newNode, parent SQLNode) {
+	parent.(*WindowSpec).Frame = newNode.(*FrameClause)
+}
+
+func replaceWindowSpecName(newNode, parent SQLNode) {
+	parent.(*WindowSpec).Name = newNode.(ColIdent)
+}
+
+func replaceWindowSpecOrderBy(newNode, parent SQLNode) {
+	parent.(*WindowSpec).OrderBy = newNode.(OrderBy)
+}
+
+func replaceWindowSpecPartitionBy(newNode, parent SQLNode) {
+	parent.(*WindowSpec).PartitionBy = newNode.(Exprs)
+}
+
+type replaceWithCTEs int
+
+func (r *replaceWithCTEs) replace(newNode, container SQLNode) {
+	container.(*With).CTEs[int(*r)] = newNode.(*CommonTableExpr)
+}
+
+func (r *replaceWithCTEs) inc() {
+	*r++
+}
+
 func replaceXorExprLeft(newNode, parent SQLNode) {
 	parent.(*XorExpr).Left = newNode.(Expr)
 }
@@ -1226,6 +1318,11 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *Commit:
 
+	case *CommonTableExpr:
+		a.apply(node, n.Columns, replaceCommonTableExprColumns)
+		a.apply(node, n.Name, replaceCommonTableExprName)
+		a.apply(node, n.Subquery, replaceCommonTableExprSubquery)
+
 	case *ComparisonExpr:
 		a.apply(node, n.Escape, replaceComparisonExprEscape)
 		a.apply(node, n.Left, replaceComparisonExprLeft)
@@ -1323,9 +1420,17 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.ReferencedTable, replaceForeignKeyDefinitionReferencedTable)
 		a.apply(node, n.Source, replaceForeignKeyDefinitionSource)
 
+	case *FrameClause:
+		a.apply(node, n.End, replaceFrameClauseEnd)
+		a.apply(node, n.Start, replaceFrameClauseStart)
+
+	case *FramePoint:
+		a.apply(node, n.Expr, replaceFramePointExpr)
+
 	case *FuncExpr:
 		a.apply(node, n.Exprs, replaceFuncExprExprs)
 		a.apply(node, n.Name, replaceFuncExprName)
+		a.apply(node, n.Over, replaceFuncExprOver)
 		a.apply(node, n.Qualifier, replaceFuncExprQualifier)
 
 	case GroupBy:
@@ -1447,6 +1552,10 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 
 	case *OtherRead:
 
+	case *OverClause:
+		a.apply(node, n.WindowName, replaceOverClauseWindowName)
+		a.apply(node, n.WindowSpec, replaceOverClauseWindowSpec)
+
 	case *ParenSelect:
 		a.apply(node, n.Select, replaceParenSelectSelect)
 
@@ -1509,6 +1618,8 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 		a.apply(node, n.OrderBy, replaceSelectOrderBy)
 		a.apply(node, n.SelectExprs, replaceSelectSelectExprs)
 		a.apply(node, n.Where, replaceSelectWhere)
+		a.apply(node, n.Windows, replaceSelectWindows)
+		a.apply(node, n.With, replaceSelectWith)
 
 	case SelectExprs:
 		replacer := replaceSelectExprsItems(0)
@@ -1647,6 +1758,7 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 			a.apply(node, item, replacerUnionSelectsB.replace)
 			replacerUnionSelectsB.inc()
 		}
+		a.apply(node, n.With, replaceUnionWith)
 
 	case *UnionSelect:
 		a.apply(node, n.Statement, replaceUnionSelectStatement)
@@ -1724,6 +1836,32 @@ func (a *application) apply(parent, node SQLNode, replacer replacerFunc) {
 	case *Where:
 		a.apply(node, n.Expr, replaceWhereExpr)
 
+	case *WindowDefinition:
+		a.apply(node, n.Name, replaceWindowDefinitionName)
+		a.apply(node, n.WindowSpec, replaceWindowDefinitionWindowSpec)
+
+	case WindowDefinitions:
+		replacer := replaceWindowDefinitionsItems(0)
+		replacerRef := &replacer
+		for _, item := range n {
+			a.apply(node, item, replacerRef.replace)
+			replacerRef.inc()
+		}
+
+	case *WindowSpec:
+		a.apply(node, n.Frame, replaceWindowSpecFrame)
+		a.apply(node, n.Name, replaceWindowSpecName)
+		a.apply(node, n.OrderBy, replaceWindowSpecOrderBy)
+		a.apply(node, n.PartitionBy, replaceWindowSpecPartitionBy)
+
+	case *With:
+		replacerCTEs := replaceWithCTEs(0)
+		replacerCTEsB := &replacerCTEs
+		for _, item := range n.CTEs {
+			a.apply(node, item, replacerCTEsB.replace)
+			replacerCTEsB.inc()
+		}
+
 	case *XorExpr:
 		a.apply(node, n.Left, replaceXorExprLeft)
 		a.apply(node, n.Right, replaceXorExprRight)
@@ -44,6 +44,15 @@ type yySymType struct {
 	empty                  struct{}
 	statement              Statement
 	selStmt                SelectStatement
+	with                   *With
+	cte                    *CommonTableExpr
+	overClause             *OverClause
+	windowSpec             *WindowSpec
+	frameClause            *FrameClause
+	frameUnit              FrameUnitType
+	framePoint             *FramePoint
+	windowDefinition       *WindowDefinition
+	windowDefinitions      WindowDefinitions
 	ddl                    *DDL
 	ins                    *Insert
 	byt                    byte
@@ -140,441 +149,446 @@ type yySymType struct {
 }
 
 const LEX_ERROR = 57346
-const UNION = 57347
-const SELECT = 57348
-const STREAM = 57349
-const VSTREAM = 57350
-const INSERT = 57351
-const UPDATE = 57352
-const DELETE = 57353
-const FROM = 57354
-const WHERE = 57355
-const GROUP = 57356
-const HAVING = 57357
-const ORDER = 57358
-const BY = 57359
-const LIMIT = 57360
-const OFFSET = 57361
-const FOR = 57362
-const ALL = 57363
-const DISTINCT = 57364
-const AS = 57365
-const EXISTS = 57366
-const ASC = 57367
-const DESC = 57368
-const INTO = 57369
-const DUPLICATE = 57370
-const KEY = 57371
-const DEFAULT = 57372
-const SET = 57373
-const LOCK = 57374
-const UNLOCK = 57375
-const KEYS = 57376
-const DO = 57377
-const DISTINCTROW = 57378
-const PARSER = 57379
-const OUTFILE = 57380
-const S3 = 57381
-const DATA = 57382
-const LOAD = 57383
-const LINES = 57384
-const TERMINATED = 57385
-const ESCAPED = 57386
-const ENCLOSED = 57387
-const DUMPFILE = 57388
-const CSV = 57389
-const HEADER = 57390
-const MANIFEST = 57391
-const OVERWRITE = 57392
-const STARTING = 57393
-const OPTIONALLY = 57394
-const VALUES = 57395
-const LAST_INSERT_ID = 57396
-const NEXT = 57397
-const VALUE = 57398
-const SHARE = 57399
-const MODE = 57400
-const SQL_NO_CACHE = 57401
-const SQL_CACHE = 57402
-const SQL_CALC_FOUND_ROWS = 57403
-const JOIN = 57404
-const STRAIGHT_JOIN = 57405
-const LEFT = 57406
-const RIGHT = 57407
-const INNER = 57408
-const OUTER = 57409
-const CROSS = 57410
-const NATURAL = 57411
-const USE = 57412
-const FORCE = 57413
-const ON = 57414
-const USING = 57415
-const INPLACE = 57416
-const COPY = 57417
-const ALGORITHM = 57418
-const NONE = 57419
-const SHARED = 57420
-const EXCLUSIVE = 57421
-const ID = 57422
-const AT_ID = 57423
-const AT_AT_ID = 57424
-const HEX = 57425
-const STRING = 57426
-const INTEGRAL = 57427
-const FLOAT = 57428
-const HEXNUM = 57429
-const VALUE_ARG = 57430
-const LIST_ARG = 57431
-const COMMENT = 57432
-const COMMENT_KEYWORD = 57433
-const BIT_LITERAL = 57434
-const COMPRESSION = 57435
-const NULL = 57436
-const TRUE = 57437
-const FALSE = 57438
-const OFF = 57439
-const DISCARD = 57440
-const IMPORT = 57441
-const ENABLE = 57442
-const DISABLE = 57443
-const TABLESPACE = 57444
-const OR = 57445
-const XOR = 57446
-const AND = 57447
-const NOT = 57448
-const BETWEEN = 57449
-const CASE = 57450
-const WHEN = 57451
-const THEN = 57452
-const ELSE = 57453
-const END = 57454
-const LE = 57455
-const GE = 57456
-const NE = 57457
-const NULL_SAFE_EQUAL = 57458
-const IS = 57459
-const LIKE = 57460
-const REGEXP = 57461
-const IN = 57462
-const SHIFT_LEFT = 57463
-const SHIFT_RIGHT = 57464
-const DIV = 57465
-const MOD = 57466
-const UNARY = 57467
-const COLLATE = 57468
-const BINARY = 57469
-const UNDERSCORE_BINARY = 57470
-const UNDERSCORE_UTF8MB4 = 57471
-const UNDERSCORE_UTF8 = 57472
-const UNDERSCORE_LATIN1 = 57473
-const INTERVAL = 57474
-const JSON_EXTRACT_OP = 57475
-const JSON_UNQUOTE_EXTRACT_OP = 57476
-const CREATE = 57477
-const ALTER = 57478
-const DROP = 57479
-const RENAME = 57480
-const ANALYZE = 57481
-const ADD = 57482
-const FLUSH = 57483
-const CHANGE = 57484
-const MODIFY = 57485
-const SCHEMA = 57486
-const TABLE = 57487
-const INDEX = 57488
-const VIEW = 57489
-const TO = 57490
-const IGNORE = 57491
-const IF = 57492
-const UNIQUE = 57493
-const PRIMARY = 57494
-const COLUMN = 57495
-const SPATIAL = 57496
-const FULLTEXT = 57497
-const KEY_BLOCK_SIZE = 57498
-const CHECK = 57499
-const INDEXES = 57500
-const ACTION = 57501
-const CASCADE = 57502
-const CONSTRAINT = 57503
-const FOREIGN = 57504
-const NO = 57505
-const REFERENCES = 57506
-const RESTRICT = 57507
-const SHOW = 57508
-const DESCRIBE = 57509
-const EXPLAIN = 57510
-const DATE = 57511
-const ESCAPE = 57512
-const REPAIR = 57513
-const OPTIMIZE = 57514
-const TRUNCATE = 57515
-const COALESCE = 57516
-const EXCHANGE = 57517
-const REBUILD = 57518
-const PARTITIONING = 57519
-const REMOVE = 57520
-const MAXVALUE = 57521
-const PARTITION = 57522
-const REORGANIZE = 57523
-const LESS = 57524
-const THAN = 57525
-const PROCEDURE = 57526
-const TRIGGER = 57527
-const VINDEX = 57528
-const VINDEXES = 57529
-const DIRECTORY = 57530
-const NAME = 57531
-const UPGRADE = 57532
-const STATUS = 57533
-const VARIABLES = 57534
-const WARNINGS = 57535
-const CASCADED = 57536
-const DEFINER = 57537
-const OPTION = 57538
-const SQL = 57539
-const UNDEFINED = 57540
-const SEQUENCE = 57541
-const MERGE = 57542
-const TEMPTABLE = 57543
-const INVOKER = 57544
-const SECURITY = 57545
-const FIRST = 57546
-const AFTER = 57547
-const LAST = 57548
-const BEGIN = 57549
-const START = 57550
-const TRANSACTION = 57551
-const COMMIT = 57552
-const ROLLBACK = 57553
-const SAVEPOINT = 57554
-const RELEASE = 57555
-const WORK = 57556
-const BIT = 57557
-const TINYINT = 57558
-const SMALLINT = 57559
-const MEDIUMINT = 57560
-const INT = 57561
-const INTEGER = 57562
-const BIGINT = 57563
-const INTNUM = 57564
-const REAL = 57565
-const DOUBLE = 57566
-const FLOAT_TYPE = 57567
-const DECIMAL = 57568
-const NUMERIC = 57569
-const TIME = 57570
-const TIMESTAMP = 57571
-const DATETIME = 57572
-const YEAR = 57573
-const CHAR = 57574
-const VARCHAR = 57575
-const BOOL = 57576
-const CHARACTER = 57577
-const VARBINARY = 57578
-const NCHAR = 57579
-const TEXT = 57580
-const TINYTEXT = 57581
-const MEDIUMTEXT = 57582
-const LONGTEXT = 57583
-const BLOB = 57584
-const TINYBLOB = 57585
-const MEDIUMBLOB = 57586
-const LONGBLOB = 57587
-const JSON = 57588
-const ENUM = 57589
-const GEOMETRY = 57590
-const POINT = 57591
-const LINESTRING = 57592
-const POLYGON = 57593
-const GEOMETRYCOLLECTION = 57594
-const MULTIPOINT = 57595
-const MULTILINESTRING = 57596
-const MULTIPOLYGON = 57597
-const NULLX = 57598
-const AUTO_INCREMENT = 57599
-const APPROXNUM = 57600
-const SIGNED = 57601
-const UNSIGNED = 57602
-const ZEROFILL = 57603
-const COLLATION = 57604
-const DATABASES = 57605
-const SCHEMAS = 57606
-const TABLES = 57607
-const VITESS_METADATA = 57608
-const VSCHEMA = 57609
-const FULL = 57610
-const PROCESSLIST = 57611
-const COLUMNS = 57612
-const FIELDS = 57613
-const ENGINES = 57614
-const PLUGINS = 57615
-const EXTENDED = 57616
-const KEYSPACES = 57617
-const VITESS_KEYSPACES = 57618
-const VITESS_SHARDS = 57619
-const VITESS_TABLETS = 57620
-const CODE = 57621
-const PRIVILEGES = 57622
-const FUNCTION = 57623
-const NAMES = 57624
-const CHARSET = 57625
-const GLOBAL = 57626
-const SESSION = 57627
-const ISOLATION = 57628
-const LEVEL = 57629
-const READ = 57630
-const WRITE = 57631
-const ONLY = 57632
-const REPEATABLE = 57633
-const COMMITTED = 57634
-const UNCOMMITTED = 57635
-const SERIALIZABLE = 57636
-const CURRENT_TIMESTAMP = 57637
-const DATABASE = 57638
-const CURRENT_DATE = 57639
-const CURRENT_TIME = 57640
-const LOCALTIME = 57641
-const LOCALTIMESTAMP = 57642
-const CURRENT_USER = 57643
-const UTC_DATE = 57644
-const UTC_TIME = 57645
-const UTC_TIMESTAMP = 57646
-const REPLACE = 57647
-const CONVERT = 57648
-const CAST = 57649
-const SUBSTR = 57650
-const SUBSTRING = 57651
-const GROUP_CONCAT = 57652
-const SEPARATOR = 57653
-const TIMESTAMPADD = 57654
-const TIMESTAMPDIFF = 57655
-const MATCH = 57656
-const AGAINST = 57657
-const BOOLEAN = 57658
-const LANGUAGE = 57659
-const WITH = 57660
-const QUERY = 57661
-const EXPANSION = 57662
-const WITHOUT = 57663
-const VALIDATION = 57664
-const UNUSED = 57665
-const ARRAY = 57666
-const CUME_DIST = 57667
-const DESCRIPTION = 57668
-const DENSE_RANK = 57669
-const EMPTY = 57670
-const EXCEPT = 57671
-const FIRST_VALUE = 57672
-const GROUPING = 57673
-const GROUPS = 57674
-const JSON_TABLE = 57675
-const LAG = 57676
-const LAST_VALUE = 57677
-const LATERAL = 57678
-const LEAD = 57679
-const MEMBER = 57680
-const NTH_VALUE = 57681
-const NTILE = 57682
-const OF = 57683
-const OVER = 57684
-const PERCENT_RANK = 57685
-const RANK = 57686
-const RECURSIVE = 57687
-const ROW_NUMBER = 57688
-const SYSTEM = 57689
-const WINDOW = 57690
-const ACTIVE = 57691
-const ADMIN = 57692
-const BUCKETS = 57693
-const CLONE = 57694
-const COMPONENT = 57695
-const DEFINITION = 57696
-const ENFORCED = 57697
-const EXCLUDE = 57698
-const FOLLOWING = 57699
-const GEOMCOLLECTION = 57700
-const GET_MASTER_PUBLIC_KEY = 57701
-const HISTOGRAM = 57702
-const HISTORY = 57703
-const INACTIVE = 57704
-const INVISIBLE = 57705
-const LOCKED = 57706
-const MASTER_COMPRESSION_ALGORITHMS = 57707
-const MASTER_PUBLIC_KEY_PATH = 57708
-const MASTER_TLS_CIPHERSUITES = 57709
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57710
-const NESTED = 57711
-const NETWORK_NAMESPACE = 57712
-const NOWAIT = 57713
-const NULLS = 57714
-const OJ = 57715
-const OLD = 57716
-const OPTIONAL = 57717
-const ORDINALITY = 57718
-const ORGANIZATION = 57719
-const OTHERS = 57720
-const PATH = 57721
-const PERSIST = 57722
-const PERSIST_ONLY = 57723
-const PRECEDING = 57724
-const PRIVILEGE_CHECKS_USER = 57725
-const PROCESS = 57726
-const RANDOM = 57727
-const REFERENCE = 57728
-const REQUIRE_ROW_FORMAT = 57729
-const RESOURCE = 57730
-const RESPECT = 57731
-const RESTART = 57732
-const RETAIN = 57733
-const REUSE = 57734
-const ROLE = 57735
-const SECONDARY = 57736
-const SECONDARY_ENGINE = 57737
-const SECONDARY_LOAD = 57738
-const SECONDARY_UNLOAD = 57739
-const SKIP = 57740
-const SRID = 57741
-const THREAD_PRIORITY = 57742
-const TIES = 57743
-const UNBOUNDED = 57744
-const VCPU = 57745
-const VISIBLE = 57746
-const FORMAT = 57747
-const TREE = 57748
-const VITESS = 57749
-const TRADITIONAL = 57750
-const LOCAL = 57751
-const LOW_PRIORITY = 57752
-const AVG_ROW_LENGTH = 57753
-const CONNECTION = 57754
-const CHECKSUM = 57755
-const DELAY_KEY_WRITE = 57756
-const ENCRYPTION = 57757
-const ENGINE = 57758
-const INSERT_METHOD = 57759
-const MAX_ROWS = 57760
-const MIN_ROWS = 57761
-const PACK_KEYS = 57762
-const PASSWORD = 57763
-const FIXED = 57764
-const DYNAMIC = 57765
-const COMPRESSED = 57766
-const REDUNDANT = 57767
-const COMPACT = 57768
-const ROW_FORMAT = 57769
-const STATS_AUTO_RECALC = 57770
-const STATS_PERSISTENT = 57771
-const STATS_SAMPLE_PAGES = 57772
-const STORAGE = 57773
-const MEMORY = 57774
-const DISK = 57775
+const ROWS = 57347
+const UNION = 57348
+const SELECT = 57349
+const STREAM = 57350
+const VSTREAM = 57351
+const INSERT = 57352
+const UPDATE = 57353
+const DELETE = 57354
+const FROM = 57355
+const WHERE = 57356
+const GROUP = 57357
+const HAVING = 57358
+const ORDER = 57359
+const BY = 57360
+const LIMIT = 57361
+const OFFSET = 57362
+const FOR = 57363
+const ALL = 57364
+const DISTINCT = 57365
+const AS = 57366
+const EXISTS = 57367
+const ASC = 57368
+const DESC = 57369
+const INTO = 57370
+const DUPLICATE = 57371
+const KEY = 57372
+const DEFAULT = 57373
+const SET = 57374
+const LOCK = 57375
+const UNLOCK = 57376
+const KEYS = 57377
+const DO = 57378
+const DISTINCTROW = 57379
+const PARSER = 57380
+const OUTFILE = 57381
+const S3 = 57382
+const DATA = 57383
+const LOAD = 57384
+const LINES = 57385
+const TERMINATED = 57386
+const ESCAPED = 57387
+const ENCLOSED = 57388
+const DUMPFILE = 57389
+const CSV = 57390
+const HEADER = 57391
+const MANIFEST = 57392
+const OVERWRITE = 57393
+const STARTING = 57394
+const OPTIONALLY = 57395
+const VALUES = 57396
+const LAST_INSERT_ID = 57397
+const NEXT = 57398
+const VALUE = 57399
+const SHARE = 57400
+const MODE = 57401
+const SQL_NO_CACHE = 57402
+const SQL_CACHE = 57403
+const SQL_CALC_FOUND_ROWS = 57404
+const JOIN = 57405
+const STRAIGHT_JOIN = 57406
+const LEFT = 57407
+const RIGHT = 57408
+const INNER = 57409
+const OUTER = 57410
+const CROSS = 57411
+const NATURAL = 57412
+const USE = 57413
+const FORCE = 57414
+const ON = 57415
+const USING = 57416
+const INPLACE = 57417
+const COPY = 57418
+const ALGORITHM = 57419
+const NONE = 57420
+const SHARED = 57421
+const EXCLUSIVE = 57422
+const ID = 57423
+const AT_ID = 57424
+const AT_AT_ID = 57425
+const HEX = 57426
+const STRING = 57427
+const INTEGRAL = 57428
+const FLOAT = 57429
+const HEXNUM = 57430
+const VALUE_ARG = 57431
+const LIST_ARG = 57432
+const COMMENT = 57433
+const COMMENT_KEYWORD = 57434
+const BIT_LITERAL = 57435
+const COMPRESSION = 57436
+const NULL = 57437
+const TRUE = 57438
+const FALSE = 57439
+const OFF = 57440
+const DISCARD = 57441
+const IMPORT = 57442
+const ENABLE = 57443
+const DISABLE = 57444
+const TABLESPACE = 57445
+const OR = 57446
+const XOR = 57447
+const AND = 57448
+const NOT = 57449
+const BETWEEN = 57450
+const CASE = 57451
+const WHEN = 57452
+const THEN = 57453
+const ELSE = 57454
+const END = 57455
+const LE = 57456
+const GE = 57457
+const NE = 57458
+const NULL_SAFE_EQUAL = 57459
+const IS = 57460
+const LIKE = 57461
+const REGEXP = 57462
+const IN = 57463
+const SHIFT_LEFT = 57464
+const SHIFT_RIGHT = 57465
+const DIV = 57466
+const MOD = 57467
+const UNARY = 57468
+const COLLATE = 57469
+const BINARY = 57470
+const UNDERSCORE_BINARY = 57471
+const UNDERSCORE_UTF8MB4 = 57472
+const UNDERSCORE_UTF8 = 57473
+const UNDERSCORE_LATIN1 = 57474
+const INTERVAL = 57475
+const JSON_EXTRACT_OP = 57476
+const JSON_UNQUOTE_EXTRACT_OP = 57477
+const CREATE = 57478
+const ALTER = 57479
+const DROP = 57480
+const RENAME = 57481
+const ANALYZE = 57482
+const ADD = 57483
+const FLUSH = 57484
+const CHANGE = 57485
+const MODIFY = 57486
+const SCHEMA = 57487
+const TABLE = 57488
+const INDEX = 57489
+const VIEW = 57490
+const TO = 57491
+const IGNORE = 57492
+const IF = 57493
+const UNIQUE = 57494
+const PRIMARY = 57495
+const COLUMN = 57496
+const SPATIAL = 57497
+const FULLTEXT = 57498
+const KEY_BLOCK_SIZE = 57499
+const CHECK = 57500
+const INDEXES = 57501
+const ACTION = 57502
+const CASCADE = 57503
+const CONSTRAINT = 57504
+const FOREIGN = 57505
+const NO = 57506
+const REFERENCES = 57507
+const RESTRICT = 57508
+const SHOW = 57509
+const DESCRIBE = 57510
+const EXPLAIN = 57511
+const DATE = 57512
+const ESCAPE = 57513
+const REPAIR = 57514
+const OPTIMIZE = 57515
+const TRUNCATE = 57516
+const COALESCE = 57517
+const EXCHANGE = 57518
+const REBUILD = 57519
+const PARTITIONING = 57520
+const REMOVE = 57521
+const MAXVALUE = 57522
+const PARTITION = 57523
+const REORGANIZE = 57524
+const LESS = 57525
+const THAN = 57526
+const PROCEDURE = 57527
+const TRIGGER = 57528
+const VINDEX = 57529
+const VINDEXES = 57530
+const DIRECTORY = 57531
+const NAME = 57532
+const UPGRADE = 57533
+const STATUS = 57534
+const VARIABLES = 57535
+const WARNINGS = 57536
+const CASCADED = 57537
+const DEFINER = 57538
+const OPTION = 57539
+const SQL = 57540
+const UNDEFINED = 57541
+const SEQUENCE = 57542
+const MERGE = 57543
+const TEMPTABLE = 57544
+const INVOKER = 57545
+const SECURITY = 57546
+const FIRST = 57547
+const AFTER = 57548
+const LAST = 57549
+const BEGIN = 57550
+const START = 57551
+const TRANSACTION = 57552
+const COMMIT = 57553
+const ROLLBACK = 57554
+const SAVEPOINT = 57555
+const RELEASE = 57556
+const WORK = 57557
+const BIT = 57558
+const TINYINT = 57559
+const SMALLINT = 57560
+const MEDIUMINT = 57561
+const INT = 57562
+const INTEGER = 57563
+const BIGINT = 57564
+const INTNUM = 57565
+const REAL = 57566
+const DOUBLE = 57567
+const FLOAT_TYPE = 57568
+const DECIMAL = 57569
+const NUMERIC = 57570
+const TIME = 57571
+const TIMESTAMP = 57572
+const DATETIME = 57573
+const YEAR = 57574
+const CHAR = 57575
+const VARCHAR = 57576
+const BOOL = 57577
+const CHARACTER = 57578
+const VARBINARY = 57579
+const NCHAR = 57580
+const TEXT = 57581
+const TINYTEXT = 57582
+const MEDIUMTEXT = 57583
+const LONGTEXT = 57584
+const BLOB = 57585
+const TINYBLOB = 57586
+const MEDIUMBLOB = 57587
+const LONGBLOB = 57588
+const JSON = 57589
+const ENUM = 57590
+const GEOMETRY = 57591
+const POINT = 57592
+const LINESTRING = 57593
+const POLYGON = 57594
+const GEOMETRYCOLLECTION = 57595
+const MULTIPOINT = 57596
+const MULTILINESTRING = 57597
+const MULTIPOLYGON = 57598
+const NULLX = 57599
+const AUTO_INCREMENT = 57600
+const APPROXNUM = 57601
+const SIGNED = 57602
+const UNSIGNED = 57603
+const ZEROFILL = 57604
+const COLLATION = 57605
+const DATABASES = 57606
+const SCHEMAS = 57607
+const TABLES = 57608
+const VITESS_METADATA = 57609
+const VSCHEMA = 57610
+const FULL = 57611
+const PROCESSLIST = 57612
+const COLUMNS = 57613
+const FIELDS = 57614
+const ENGINES = 57615
+const PLUGINS = 57616
+const EXTENDED = 57617
+const KEYSPACES = 57618
+const VITESS_KEYSPACES = 57619
+const VITESS_SHARDS = 57620
+const VITESS_TABLETS = 57621
+const CODE = 57622
+const PRIVILEGES = 57623
+const FUNCTION = 57624
+const NAMES = 57625
+const CHARSET = 57626
+const GLOBAL = 57627
+const SESSION = 57628
+const ISOLATION = 57629
+const LEVEL = 57630
+const READ = 57631
+const WRITE = 57632
+const ONLY = 57633
+const REPEATABLE = 57634
+const COMMITTED = 57635
+const UNCOMMITTED = 57636
+const SERIALIZABLE = 57637
+const CURRENT_TIMESTAMP = 57638
+const DATABASE = 57639
+const CURRENT_DATE = 57640
+const CURRENT_TIME = 57641
+const LOCALTIME = 57642
+const LOCALTIMESTAMP = 57643
+const CURRENT_USER = 57644
+const UTC_DATE = 57645
+const UTC_TIME = 57646
+const UTC_TIMESTAMP = 57647
+const REPLACE = 57648
+const CONVERT = 57649
+const CAST = 57650
+const SUBSTR = 57651
+const SUBSTRING = 57652
+const GROUP_CONCAT = 57653
+const SEPARATOR = 57654
+const TIMESTAMPADD = 57655
+const TIMESTAMPDIFF = 57656
+const MATCH = 57657
+const AGAINST = 57658
+const BOOLEAN = 57659
+const LANGUAGE = 57660
+const WITH = 57661
+const QUERY = 57662
+const EXPANSION = 57663
+const WITHOUT = 57664
+const VALIDATION = 57665
+const UNUSED = 57666
+const ARRAY = 57667
+const CUME_DIST = 57668
+const DESCRIPTION = 57669
+const DENSE_RANK = 57670
+const EMPTY = 57671
+const EXCEPT = 57672
+const FIRST_VALUE = 57673
+const GROUPING = 57674
+const GROUPS = 57675
+const JSON_TABLE = 57676
+const LAG = 57677
+const LAST_VALUE = 57678
+const LATERAL = 57679
+const LEAD = 57680
+const MEMBER = 57681
+const NTH_VALUE = 57682
+const NTILE = 57683
+const OF = 57684
+const OVER = 57685
+const PERCENT_RANK = 57686
+const RANK = 57687
+const RECURSIVE = 57688
+const ROW_NUMBER = 57689
+const SYSTEM = 57690
+const WINDOW = 57691
+const ACTIVE = 57692
+const ADMIN = 57693
+const BUCKETS = 57694
+const CLONE = 57695
+const COMPONENT = 57696
+const DEFINITION = 57697
+const ENFORCED = 57698
+const EXCLUDE = 57699
+const FOLLOWING = 57700
+const GEOMCOLLECTION = 57701
+const GET_MASTER_PUBLIC_KEY = 57702
+const HISTOGRAM = 57703
+const HISTORY = 57704
+const INACTIVE = 57705
+const INVISIBLE = 57706
+const LOCKED = 57707
+const MASTER_COMPRESSION_ALGORITHMS = 57708
+const MASTER_PUBLIC_KEY_PATH = 57709
+const MASTER_TLS_CIPHERSUITES = 57710
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57711
+const NESTED = 57712
+const NETWORK_NAMESPACE = 57713
+const NOWAIT = 57714
+const NULLS = 57715
+const OJ = 57716
+const OLD = 57717
+const OPTIONAL = 57718
+const ORDINALITY = 57719
+const ORGANIZATION = 57720
+const OTHERS = 57721
+const PATH = 57722
+const PERSIST = 57723
+const PERSIST_ONLY = 57724
+const PRECEDING = 57725
+const PRIVILEGE_CHECKS_USER = 57726
+const PROCESS = 57727
+const RANDOM = 57728
+const REFERENCE = 57729
+const REQUIRE_ROW_FORMAT = 57730
+const RESOURCE = 57731
+const RESPECT = 57732
+const RESTART = 57733
+const RETAIN = 57734
+const REUSE = 57735
+const ROLE = 57736
+const SECONDARY = 57737
+const SECONDARY_ENGINE = 57738
+const SECONDARY_LOAD = 57739
+const SECONDARY_UNLOAD = 57740
+const SKIP = 57741
+const SRID = 57742
+const THREAD_PRIORITY = 57743
+const TIES = 57744
+const UNBOUNDED = 57745
+const VCPU = 57746
+const VISIBLE = 57747
+const CURRENT = 57748
+const ROW = 57749
+const RANGE = 57750
+const FORMAT = 57751
+const TREE = 57752
+const VITESS = 57753
+const TRADITIONAL = 57754
+const LOCAL = 57755
+const LOW_PRIORITY = 57756
+const AVG_ROW_LENGTH = 57757
+const CONNECTION = 57758
+const CHECKSUM = 57759
+const DELAY_KEY_WRITE = 57760
+const ENCRYPTION = 57761
+const ENGINE = 57762
+const INSERT_METHOD = 57763
+const MAX_ROWS = 57764
+const MIN_ROWS = 57765
+const PACK_KEYS = 57766
+const PASSWORD = 57767
+const FIXED = 57768
+const DYNAMIC = 57769
+const COMPRESSED = 57770
+const REDUNDANT = 57771
+const COMPACT = 57772
+const ROW_FORMAT = 57773
+const STATS_AUTO_RECALC = 57774
+const STATS_PERSISTENT = 57775
+const STATS_SAMPLE_PAGES = 57776
+const STORAGE = 57777
+const MEMORY = 57778
+const DISK = 57779
 
 var yyToknames = [...]string{
 	"$end",
 	"error",
 	"$unk",
 	"LEX_ERROR",
+	"ROWS",
 	"UNION",
 	"SELECT",
 	"STREAM",
@@ -992,6 +1006,9 @@ var yyToknames = [...]string{
 	"UNBOUNDED",
 	"VCPU",
 	"VISIBLE",
+	"CURRENT",
+	"ROW",
+	"RANGE",
 	"FORMAT",
 	"TREE",
 	"VITESS",
@@ -1035,2836 +1052,2997 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 42,
-	163, 911,
-	-2, 89,
-	-1, 43,
-	1, 107,
-	451, 107,
-	-2, 113,
 	-1, 44,
-	142, 113,
-	252, 113,
-	300, 113,
-	-2, 325,
-	-1, 51,
-	34, 463,
-	163, 463,
-	175, 463,
-	208, 477,
-	209, 477,
-	-2, 465,
-	-1, 56,
-	165, 487,
-	-2, 485,
-	-1, 80,
-	55, 530,
-	-2, 538,
-	-1, 104,
-	1, 108,
-	451, 108,
-	-2, 113,
-	-1, 114,
-	168, 230,
-	169, 230,
-	-2, 319,
-	-1, 133,
-	142, 113,
-	252, 113,
-	300, 113,
-	-2, 334,
-	-1, 550,
-	149, 922,
-	-2, 918,
-	-1, 551,
-	149, 923,
-	-2, 919,
-	-1, 568,
-	55, 531,
-	-2, 543,
-	-1, 569,
-	55, 532,
+	164, 947,
+	-2, 95,
+	-1, 45,
+	1, 113,
+	455, 113,
+	-2, 119,
+	-1, 46,
+	143, 119,
+	253, 119,
+	301, 119,
+	-2, 331,
+	-1, 53,
+	35, 469,
+	164, 469,
+	176, 469,
+	209, 483,
+	210, 483,
+	-2, 471,
+	-1, 58,
+	166, 493,
+	-2, 491,
+	-1, 84,
+	56, 536,
 	-2, 544,
-	-1, 589,
-	117, 1249,
-	-2, 82,
-	-1, 590,
-	117, 1136,
-	-2, 83,
-	-1, 596,
-	117, 1184,
-	-2, 896,
-	-1, 730,
-	117, 1078,
-	-2, 893,
-	-1, 762,
-	174, 36,
-	179, 36,
-	-2, 241,
-	-1, 841,
-	1, 372,
-	451, 372,
-	-2, 113,
-	-1, 1057,
-	1, 268,
-	451, 268,
-	-2, 113,
-	-1, 1130,
-	168, 230,
-	169, 230,
-	-2, 319,
-	-1, 1139,
-	174, 37,
-	179, 37,
-	-2, 242,
-	-1, 1333,
-	149, 925,
-	-2, 921,
-	-1, 1423,
-	73, 64,
-	81, 64,
-	-2, 68,
+	-1, 110,
+	1, 114,
+	455, 114,
+	-2, 119,
+	-1, 120,
+	169, 236,
+	170, 236,
+	-2, 325,
+	-1, 139,
+	143, 119,
+	253, 119,
+	301, 119,
+	-2, 340,
+	-1, 559,
+	150, 958,
+	-2, 954,
+	-1, 560,
+	150, 959,
+	-2, 955,
+	-1, 579,
+	56, 537,
+	-2, 549,
+	-1, 580,
+	56, 538,
+	-2, 550,
+	-1, 601,
+	118, 1289,
+	-2, 88,
+	-1, 602,
+	118, 1174,
+	-2, 89,
+	-1, 608,
+	118, 1222,
+	-2, 932,
+	-1, 743,
+	118, 1115,
+	-2, 929,
+	-1, 775,
+	175, 37,
+	180, 37,
+	-2, 247,
+	-1, 854,
+	1, 378,
+	455, 378,
+	-2, 119,
+	-1, 1073,
+	1, 274,
+	455, 274,
+	-2, 119,
+	-1, 1146,
+	169, 236,
+	170, 236,
+	-2, 325,
+	-1, 1155,
+	175, 38,
+	180, 38,
+	-2, 248,
+	-1, 1349,
+	150, 961,
+	-2, 957,
 	-1, 1444,
-	1, 269,
-	451, 269,
-	-2, 113,
-	-1, 1831,
-	5, 790,
-	18, 790,
-	20, 790,
-	32, 790,
-	82, 790,
-	-2, 569,
-	-1, 2046,
-	45, 864,
-	-2, 862,
+	74, 70,
+	82, 70,
+	-2, 74,
+	-1, 1465,
+	1, 275,
+	455, 275,
+	-2, 119,
+	-1, 1862,
+	6, 824,
+	19, 824,
+	21, 824,
+	33, 824,
+	83, 824,
+	-2, 575,
+	-1, 2083,
+	46, 898,
+	-2, 896,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 26967
+const yyLast = 28579
 
 var yyAct = [...]int{
-	550, 2134, 2121, 1878, 2046, 1749, 2098, 2059, 493, 1718,
-	1991, 1967, 1647, 79, 3, 1506, 1441, 1615, 1812, 522,
-	1811, 508, 1370, 1875, 561, 960, 1005, 1648, 1356, 1808,
-	1476, 491, 1722, 1012, 1461, 1481, 734, 1705, 1983, 1706,
-	1823, 142, 1420, 1770, 853, 894, 1575, 173, 1327, 892,
-	185, 1319, 458, 185, 1504, 1634, 792, 1248, 474, 1261,
-	185, 128, 757, 1483, 594, 77, 1698, 1042, 1049, 1409,
-	1137, 1402, 570, 1010, 1372, 1015, 1035, 495, 1032, 999,
-	1353, 1296, 555, 1553, 741, 1144, 31, 738, 474, 484,
-	1227, 474, 185, 474, 1039, 1472, 1033, 763, 1484, 1385,
-	758, 759, 742, 1048, 1022, 1046, 75, 1425, 564, 172,
-	1129, 1155, 1264, 111, 760, 112, 834, 770, 973, 145,
-	1462, 105, 106, 481, 74, 976, 8, 7, 6, 1741,
-	1740, 1993, 1535, 591, 1367, 1368, 1214, 2090, 1282, 1613,
-	2043, 1946, 2020, 2019, 1854, 1962, 796, 795, 1963, 2140,
-	2095, 2133, 1488, 735, 2070, 113, 2124, 76, 576, 580,
-	1879, 1523, 556, 107, 2094, 2069, 1787, 1908, 185, 749,
-	1614, 1678, 436, 1486, 1677, 797, 1837, 1679, 185, 863,
-	847, 1838, 1839, 185, 1435, 482, 483, 794, 1542, 1436,
-	1437, 890, 1541, 588, 174, 175, 176, 811, 462, 80,
-	808, 809, 554, 812, 813, 814, 815, 553, 595, 818,
-	819, 820, 821, 822, 823, 824, 825, 826, 827, 828,
-	829, 830, 831, 832, 107, 753, 751, 750, 752, 1689,
-	773, 861, 1455, 774, 82, 83, 84, 85, 86, 87,
-	33, 872, 873, 68, 37, 38, 461, 1939, 1114, 798,
-	799, 800, 1485, 1050, 449, 1051, 1369, 171, 2074, 805,
-	472, 1899, 1897, 450, 470, 1281, 810, 864, 476, 889,
-	166, 1723, 535, 447, 541, 542, 539, 540, 1505, 538,
-	537, 536, 1538, 97, 1745, 1751, 1330, 1228, 2123, 543,
-	544, 1746, 107, 835, 888, 108, 1233, 130, 1283, 1284,
-	1285, 174, 175, 176, 842, 150, 869, 462, 174, 175,
-	176, 1753, 444, 1754, 67, 102, 178, 179, 180, 862,
-	1550, 456, 2032, 922, 921, 931, 932, 924, 925, 926,
-	927, 928, 929, 930, 923, 462, 140, 933, 102, 94,
-	817, 129, 102, 167, 1236, 98, 1237, 1238, 99, 100,
-	1234, 816, 2091, 867, 868, 461, 1752, 865, 866, 147,
-	1230, 148, 2016, 462, 1957, 772, 117, 118, 139, 138,
-	165, 874, 781, 1507, 1204, 875, 872, 873, 2138, 779,
-	1403, 790, 789, 461, 788, 787, 786, 881, 785, 883,
-	437, 438, 439, 1232, 454, 455, 465, 784, 783, 778,
-	451, 453, 466, 440, 441, 468, 467, 1853, 443, 442,
-	754, 461, 446, 463, 1487, 1205, 1123, 1206, 134, 115,
-	141, 122, 114, 1540, 135, 136, 880, 882, 151, 2068,
-	791, 845, 1958, 886, 1231, 1557, 185, 739, 156, 123,
-	739, 765, 766, 846, 737, 2141, 2110, 578, 746, 772,
-	876, 879, 739, 126, 124, 119, 120, 121, 125, 474,
-	474, 474, 1426, 116, 782, 170, 2075, 101, 104, 2008,
-	462, 780, 127, 1143, 1142, 582, 1755, 474, 474, 1616,
-	1618, 772, 856, 857, 858, 859, 860, 2060, 1529, 1241,
-	101, 898, 904, 801, 101, 772, 1715, 1537, 1796, 771,
-	1795, 772, 891, 1794, 747, 775, 765, 1733, 435, 895,
-	896, 485, 177, 1549, 1771, 776, 1548, 2050, 461, 1525,
-	945, 946, 1928, 878, 2136, 1836, 1639, 2137, 1583, 2135,
-	2033, 1555, 1594, 777, 1515, 1431, 1554, 464, 877, 143,
-	1026, 958, 851, 1442, 459, 1216, 1215, 1217, 1218, 1219,
-	923, 1591, 933, 933, 185, 1674, 69, 1773, 1555, 460,
-	1262, 1381, 885, 1554, 807, 913, 90, 1278, 943, 1789,
-	772, 1003, 2024, 793, 887, 1617, 1821, 1265, 1229, 474,
-	870, 1303, 185, 771, 185, 185, 1052, 474, 137, 1002,
-	765, 768, 769, 474, 739, 1301, 1302, 1300, 762, 766,
-	131, 908, 855, 132, 840, 907, 905, 906, 2009, 2007,
-	961, 91, 848, 849, 1775, 771, 1779, 761, 1774, 1354,
-	1772, 775, 765, 910, 1687, 1777, 1031, 1703, 1118, 771,
-	1000, 776, 591, 839, 1776, 771, 765, 768, 769, 913,
-	739, 841, 1524, 1016, 762, 766, 2053, 1778, 1780, 1522,
-	1019, 911, 912, 910, 975, 978, 980, 982, 983, 985,
-	987, 988, 979, 981, 2142, 984, 986, 1520, 989, 913,
-	945, 946, 1354, 565, 1601, 1263, 997, 922, 921, 931,
-	932, 924, 925, 926, 927, 928, 929, 930, 923, 945,
-	946, 933, 1266, 144, 149, 146, 152, 153, 154, 155,
-	157, 158, 159, 160, 771, 1452, 806, 595, 781, 161,
-	162, 163, 164, 836, 854, 837, 779, 1453, 838, 922,
-	921, 931, 932, 924, 925, 926, 927, 928, 929, 930,
-	923, 2143, 1590, 933, 185, 1517, 1576, 1945, 1110, 926,
-	927, 928, 929, 930, 923, 2125, 185, 933, 1119, 1120,
-	924, 925, 926, 927, 928, 929, 930, 923, 1004, 1521,
-	933, 474, 745, 1139, 1291, 1293, 1294, 911, 912, 910,
-	169, 1148, 1517, 2126, 1944, 1152, 1292, 1223, 474, 474,
-	67, 474, 1149, 474, 474, 913, 474, 474, 474, 474,
-	474, 474, 1299, 174, 175, 176, 1519, 1135, 174, 175,
-	176, 474, 1321, 912, 910, 185, 1188, 1183, 1184, 1121,
-	1122, 1568, 1569, 1570, 2115, 911, 912, 910, 1128, 1859,
-	913, 1201, 931, 932, 924, 925, 926, 927, 928, 929,
-	930, 923, 474, 913, 933, 1222, 1702, 1185, 1386, 1387,
-	185, 1147, 2116, 1798, 1701, 1491, 185, 1109, 1224, 185,
-	1247, 1221, 185, 1694, 1014, 1146, 1211, 1209, 1322, 748,
-	1208, 1116, 1207, 185, 744, 185, 1125, 1191, 1192, 1126,
-	1199, 1124, 1138, 1197, 1198, 1193, 1190, 474, 474, 185,
-	474, 474, 185, 474, 474, 1189, 1145, 1145, 1164, 2128,
-	1157, 1799, 1158, 2127, 1160, 1162, 1047, 1911, 1166, 1168,
-	1170, 1172, 1174, 174, 175, 176, 1253, 1681, 1255, 1220,
-	1257, 1258, 1259, 1260, 1210, 2117, 1250, 2106, 2084, 911,
-	912, 910, 1186, 1980, 1942, 1916, 1268, 1269, 1800, 1271,
-	1272, 1267, 1274, 1275, 1320, 914, 1297, 913, 1711, 581,
-	1242, 1699, 1565, 1323, 922, 921, 931, 932, 924, 925,
-	926, 927, 928, 929, 930, 923, 1533, 474, 933, 1532,
-	1251, 107, 1212, 751, 750, 1200, 1589, 1196, 174, 175,
-	176, 485, 1499, 1195, 1588, 1342, 1345, 1324, 1325, 1194,
-	971, 1355, 1383, 1748, 586, 911, 912, 910, 1335, 1336,
-	565, 474, 474, 1791, 1298, 1337, 2014, 1276, 2013, 911,
-	912, 910, 185, 913, 1331, 174, 175, 176, 76, 1497,
-	1008, 1011, 1864, 2109, 474, 1877, 1332, 913, 174, 175,
-	176, 185, 1202, 1333, 474, 583, 584, 1376, 185, 1725,
-	185, 961, 1377, 174, 175, 176, 1714, 1388, 185, 185,
-	1864, 2066, 1361, 1362, 1382, 474, 1864, 2051, 474, 2037,
-	565, 1421, 511, 510, 513, 514, 515, 516, 1450, 474,
-	1820, 512, 1923, 517, 1864, 2022, 1334, 1960, 565, 911,
-	912, 910, 1331, 921, 931, 932, 924, 925, 926, 927,
-	928, 929, 930, 923, 1400, 565, 933, 913, 1635, 1396,
-	591, 1333, 78, 591, 1635, 1446, 1517, 565, 33, 1445,
-	1926, 565, 1864, 1869, 1851, 1850, 33, 1463, 1464, 1465,
-	1847, 1848, 1847, 1846, 474, 1394, 565, 551, 1426, 1742,
-	1427, 1449, 1496, 1498, 1113, 1727, 1720, 1721, 1398, 1406,
-	565, 1642, 33, 1424, 1427, 474, 909, 565, 1668, 1478,
-	1518, 474, 1113, 1112, 909, 1148, 1426, 1148, 1429, 1433,
-	1432, 1058, 1057, 1643, 1809, 1516, 1406, 1394, 2023, 1448,
-	1447, 1395, 1820, 1820, 1947, 595, 1864, 186, 595, 1405,
-	186, 1503, 67, 558, 1849, 475, 1406, 186, 1434, 1998,
-	67, 1428, 1606, 1605, 1394, 474, 1517, 1320, 1500, 1430,
-	1384, 1365, 1320, 1320, 1517, 1428, 1240, 1479, 1044, 756,
-	755, 1474, 1475, 1426, 2058, 475, 67, 1492, 475, 186,
-	475, 1948, 1949, 1950, 1490, 1513, 1489, 1514, 1495, 1406,
-	1179, 1526, 67, 1969, 1876, 1509, 1479, 185, 1934, 1512,
-	1394, 185, 185, 185, 185, 185, 1508, 1115, 1528, 1477,
-	1527, 185, 185, 1530, 1531, 185, 1747, 67, 773, 1338,
-	1339, 774, 1510, 1344, 1347, 1348, 1473, 1467, 1466, 1226,
-	1140, 1145, 1136, 185, 185, 185, 1111, 1180, 1181, 1182,
-	1708, 92, 171, 1824, 1825, 1750, 1970, 185, 1951, 1360,
-	185, 474, 1363, 1364, 1707, 186, 488, 1488, 2130, 1910,
-	1411, 1414, 1415, 1416, 1412, 186, 1413, 1417, 2122, 1842,
-	186, 1827, 1809, 1252, 1176, 1716, 1559, 1279, 1244, 1830,
-	1013, 1829, 1563, 1656, 1456, 1655, 1457, 1458, 1459, 1460,
-	1297, 1536, 1952, 1953, 2112, 1659, 1657, 2093, 1708, 1558,
-	1660, 1658, 1468, 1469, 1470, 1471, 922, 921, 931, 932,
-	924, 925, 926, 927, 928, 929, 930, 923, 1177, 1178,
-	933, 1661, 1578, 1415, 1416, 1801, 1579, 1286, 1287, 1288,
-	1289, 571, 1585, 1624, 1927, 1867, 185, 1586, 1587, 1633,
-	1632, 2080, 2077, 1593, 185, 572, 1596, 1597, 1298, 2114,
-	2097, 2099, 1571, 2105, 1603, 2104, 1604, 1622, 96, 1607,
-	1608, 1609, 1610, 1611, 2047, 1623, 185, 2045, 1017, 1018,
-	574, 1239, 573, 1621, 552, 1712, 1350, 185, 185, 185,
-	185, 185, 1340, 1341, 1649, 1628, 1644, 1584, 1707, 185,
-	1351, 556, 803, 185, 802, 897, 185, 185, 1006, 1735,
-	185, 185, 185, 1600, 168, 1734, 1666, 181, 1637, 1686,
-	1007, 108, 1000, 1680, 2055, 1612, 2054, 1996, 1620, 1664,
-	1665, 1511, 1154, 1153, 1141, 1921, 1379, 1640, 1627, 1386,
-	1387, 1493, 1243, 1693, 2015, 1964, 1419, 1631, 1669, 1638,
-	1636, 562, 1671, 559, 560, 1630, 1919, 2119, 1692, 2118,
-	1695, 1696, 1697, 2102, 2081, 1650, 1683, 1662, 1653, 474,
-	1920, 1863, 1690, 1691, 1501, 1667, 1250, 563, 1672, 78,
-	1440, 1804, 474, 1675, 1651, 1652, 1635, 1654, 474, 1684,
-	1595, 474, 1592, 1148, 1724, 2132, 2131, 558, 474, 1027,
-	1728, 1411, 1414, 1415, 1416, 1412, 571, 1413, 1417, 1020,
-	1739, 1824, 1825, 2132, 1700, 2048, 1940, 1380, 185, 76,
-	572, 81, 1710, 73, 1, 1738, 1709, 445, 1366, 998,
-	457, 2120, 1213, 186, 1203, 1880, 1966, 474, 185, 1480,
-	1737, 1730, 1128, 568, 569, 574, 1870, 573, 1494, 1704,
-	1482, 764, 133, 1332, 1443, 1444, 475, 475, 475, 2062,
-	1333, 1736, 89, 1729, 474, 732, 88, 767, 884, 1502,
-	1320, 2006, 1961, 1688, 475, 475, 1454, 1938, 1841, 1685,
-	2052, 1064, 1062, 1063, 1061, 1066, 1756, 1065, 1060, 1764,
-	1765, 1280, 1769, 471, 1418, 1580, 1581, 1053, 1768, 1760,
-	474, 1021, 1758, 804, 1759, 1852, 1451, 1277, 1534, 1767,
-	185, 1766, 1788, 452, 1782, 871, 1598, 448, 941, 1629,
-	474, 1781, 1676, 592, 585, 1815, 474, 474, 95, 2103,
-	2078, 1649, 2076, 2044, 1992, 1810, 2079, 2042, 2113, 2096,
-	1378, 1009, 1918, 1813, 1803, 1807, 1599, 970, 1352, 185,
-	1036, 186, 494, 1290, 1816, 509, 506, 507, 1389, 1641,
-	915, 492, 486, 1028, 1410, 1767, 1408, 1407, 1245, 521,
-	1040, 1826, 1822, 1034, 1828, 1831, 475, 1393, 1539, 186,
-	1744, 186, 186, 1819, 475, 1905, 567, 1844, 1845, 93,
-	475, 1833, 1349, 1860, 2031, 1907, 185, 185, 566, 59,
-	36, 474, 478, 1832, 1840, 1834, 2089, 1835, 900, 575,
-	30, 1797, 1866, 29, 185, 28, 23, 22, 21, 184,
-	20, 19, 469, 25, 1856, 1855, 18, 17, 1871, 184,
-	16, 1881, 474, 474, 474, 103, 185, 1865, 1818, 46,
-	43, 41, 110, 1868, 1857, 1858, 109, 1873, 44, 1874,
-	40, 843, 579, 579, 27, 26, 15, 14, 13, 12,
-	11, 184, 10, 947, 948, 949, 950, 951, 952, 953,
-	954, 955, 956, 1890, 9, 1889, 5, 4, 903, 1891,
-	24, 959, 1602, 1886, 1887, 2, 1895, 0, 0, 0,
-	1900, 1901, 0, 922, 921, 931, 932, 924, 925, 926,
-	927, 928, 929, 930, 923, 0, 1915, 933, 0, 1649,
-	1625, 1626, 1011, 0, 1917, 0, 0, 0, 0, 0,
-	1922, 0, 0, 1924, 1925, 0, 0, 1929, 1931, 0,
-	0, 186, 0, 1930, 0, 0, 0, 184, 0, 0,
-	0, 0, 0, 186, 474, 474, 1936, 184, 0, 0,
-	0, 0, 184, 1955, 0, 0, 0, 474, 475, 0,
-	474, 1941, 0, 1943, 166, 1954, 1965, 0, 0, 1937,
-	0, 0, 0, 0, 1973, 475, 475, 0, 475, 1959,
-	475, 475, 0, 475, 475, 475, 475, 475, 475, 108,
-	0, 0, 0, 474, 474, 474, 185, 1971, 475, 150,
-	0, 0, 186, 0, 0, 1968, 0, 474, 1972, 474,
-	0, 0, 1979, 0, 0, 474, 0, 0, 1989, 1999,
-	0, 1984, 1997, 1813, 2004, 0, 1995, 1813, 2001, 475,
-	0, 1990, 1987, 1988, 0, 2003, 0, 186, 2011, 185,
-	2012, 2005, 2010, 186, 0, 0, 186, 0, 0, 186,
-	474, 185, 0, 147, 0, 148, 2018, 0, 2021, 2025,
-	186, 0, 186, 0, 165, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 475, 475, 186, 475, 475, 186,
-	475, 475, 0, 2027, 2028, 2029, 2030, 2041, 2034, 0,
-	2035, 2036, 2038, 0, 0, 0, 2039, 2040, 1813, 2049,
-	1892, 1893, 0, 1894, 474, 474, 1896, 0, 1898, 2056,
-	0, 0, 0, 0, 0, 2061, 0, 0, 474, 0,
-	0, 0, 151, 0, 0, 0, 0, 0, 0, 1790,
-	0, 474, 156, 2073, 0, 0, 0, 1649, 0, 474,
-	2067, 2082, 0, 0, 2085, 0, 0, 0, 2088, 1968,
-	2063, 0, 2092, 0, 475, 0, 0, 0, 0, 0,
-	0, 2101, 2100, 1805, 922, 921, 931, 932, 924, 925,
-	926, 927, 928, 929, 930, 923, 2111, 0, 933, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 475, 475,
-	0, 0, 0, 0, 0, 2107, 2108, 0, 0, 186,
-	0, 0, 0, 2129, 0, 184, 0, 0, 0, 0,
-	0, 475, 0, 0, 2139, 0, 0, 0, 186, 0,
-	0, 475, 0, 0, 0, 186, 0, 186, 0, 0,
-	0, 0, 0, 143, 0, 186, 186, 0, 0, 0,
-	0, 917, 475, 920, 0, 475, 0, 0, 1904, 934,
-	935, 936, 937, 938, 939, 940, 475, 918, 919, 916,
-	922, 921, 931, 932, 924, 925, 926, 927, 928, 929,
-	930, 923, 0, 0, 933, 0, 0, 0, 0, 0,
-	0, 0, 1295, 0, 0, 1304, 1305, 1306, 1307, 1308,
-	1309, 1310, 1311, 1312, 1313, 1314, 1315, 1316, 1317, 1318,
-	1903, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 475, 0, 0, 0, 0, 0, 0, 0, 1909,
-	0, 0, 0, 184, 0, 0, 0, 0, 0, 0,
-	0, 0, 475, 0, 0, 0, 0, 579, 475, 0,
-	0, 0, 485, 0, 1357, 0, 0, 0, 0, 1932,
-	0, 184, 1933, 184, 1043, 1935, 922, 921, 931, 932,
-	924, 925, 926, 927, 928, 929, 930, 923, 0, 0,
-	933, 0, 1902, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 475, 0, 0, 0, 0, 144, 149, 146,
-	152, 153, 154, 155, 157, 158, 159, 160, 0, 0,
-	0, 0, 0, 161, 162, 163, 164, 0, 922, 921,
-	931, 932, 924, 925, 926, 927, 928, 929, 930, 923,
-	0, 0, 933, 0, 186, 0, 0, 0, 186, 186,
-	186, 186, 186, 0, 0, 0, 0, 0, 186, 186,
-	0, 0, 186, 0, 166, 0, 0, 1994, 485, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	186, 186, 186, 520, 0, 0, 0, 0, 0, 108,
-	0, 0, 0, 0, 186, 0, 0, 186, 475, 150,
-	922, 921, 931, 932, 924, 925, 926, 927, 928, 929,
-	930, 923, 0, 0, 933, 0, 0, 0, 0, 0,
-	0, 0, 0, 184, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 184, 0, 0, 0, 0,
-	1682, 473, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 147, 0, 148, 0, 0, 0, 0,
-	0, 0, 0, 1151, 165, 0, 0, 0, 0, 0,
-	0, 593, 0, 0, 736, 1761, 743, 0, 0, 0,
-	0, 0, 0, 186, 0, 0, 0, 0, 1151, 1151,
-	0, 186, 0, 0, 184, 922, 921, 931, 932, 924,
-	925, 926, 927, 928, 929, 930, 923, 0, 0, 933,
-	0, 0, 0, 186, 0, 0, 0, 0, 0, 1001,
-	0, 0, 151, 0, 186, 186, 186, 186, 186, 184,
-	0, 0, 156, 0, 0, 184, 186, 0, 184, 0,
-	186, 1249, 0, 186, 186, 0, 0, 186, 186, 186,
-	0, 0, 184, 0, 184, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 184, 183,
-	0, 184, 0, 1577, 0, 0, 0, 0, 0, 477,
-	0, 0, 0, 0, 0, 0, 0, 0, 1572, 1573,
-	1574, 0, 0, 922, 921, 931, 932, 924, 925, 926,
-	927, 928, 929, 930, 923, 0, 475, 933, 0, 0,
-	0, 740, 0, 0, 0, 0, 0, 0, 0, 475,
-	0, 0, 0, 0, 0, 475, 0, 0, 475, 0,
-	0, 0, 0, 143, 0, 475, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 579, 1249, 0,
-	0, 0, 579, 579, 0, 186, 579, 579, 579, 0,
-	0, 0, 1151, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 475, 186, 0, 0, 0, 0,
-	0, 0, 579, 579, 579, 579, 579, 833, 0, 0,
-	0, 1374, 0, 0, 0, 0, 0, 844, 0, 0,
-	0, 475, 850, 0, 0, 0, 0, 0, 0, 0,
-	184, 0, 0, 0, 0, 0, 1249, 184, 0, 184,
-	0, 0, 0, 0, 0, 0, 0, 184, 184, 0,
-	0, 0, 0, 0, 0, 0, 0, 475, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 186, 0, 523,
-	32, 0, 0, 0, 0, 0, 0, 475, 0, 0,
-	0, 0, 0, 475, 475, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 32, 0, 0, 0, 186, 144, 149, 146,
-	152, 153, 154, 155, 157, 158, 159, 160, 0, 0,
-	0, 0, 0, 161, 162, 163, 164, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 557, 0, 0, 0, 0,
-	0, 0, 0, 186, 186, 0, 0, 0, 475, 0,
-	0, 0, 593, 593, 593, 0, 0, 0, 0, 0,
-	0, 186, 0, 0, 0, 0, 0, 0, 0, 0,
-	899, 901, 0, 1762, 1763, 0, 0, 0, 0, 475,
-	475, 475, 0, 186, 0, 0, 0, 0, 1783, 1784,
-	0, 1785, 1786, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1792, 1793, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 184, 0, 0, 0,
-	184, 184, 184, 184, 184, 0, 0, 0, 0, 0,
-	184, 184, 0, 0, 184, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1560, 1561, 184, 852, 0, 0, 0, 0,
-	0, 0, 1024, 0, 0, 0, 184, 0, 0, 184,
-	593, 0, 0, 1843, 0, 0, 1054, 0, 0, 0,
-	0, 475, 475, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 475, 0, 0, 475, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 579, 579,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	475, 475, 475, 186, 0, 0, 0, 0, 0, 579,
-	0, 0, 0, 0, 475, 0, 475, 0, 1888, 0,
-	0, 0, 475, 0, 0, 184, 0, 0, 0, 0,
-	0, 0, 0, 1374, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 186, 0, 0, 0,
-	0, 0, 0, 0, 579, 184, 0, 475, 186, 0,
-	0, 0, 0, 0, 0, 1151, 184, 184, 184, 184,
-	184, 1030, 0, 0, 1041, 0, 0, 0, 1663, 0,
-	0, 0, 184, 0, 0, 184, 184, 0, 0, 184,
-	1673, 1249, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 475, 475, 0, 736, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 475, 0, 1150, 0, 0,
-	0, 1156, 1156, 0, 1156, 0, 1156, 1156, 475, 1165,
-	1156, 1156, 1156, 1156, 1156, 0, 475, 0, 0, 0,
-	0, 0, 1150, 1150, 736, 0, 0, 0, 0, 1974,
-	1975, 1976, 1977, 1978, 0, 1151, 0, 1981, 1982, 0,
-	0, 0, 0, 0, 0, 1249, 0, 0, 893, 893,
-	893, 0, 0, 0, 0, 1225, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 184, 32, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 942,
-	944, 0, 0, 0, 0, 0, 0, 184, 0, 0,
-	0, 0, 0, 1059, 0, 0, 0, 0, 0, 0,
-	593, 593, 0, 593, 593, 1117, 593, 593, 0, 0,
-	957, 0, 579, 0, 962, 963, 964, 965, 966, 967,
-	968, 969, 0, 972, 974, 977, 977, 977, 974, 977,
-	977, 974, 977, 990, 991, 992, 993, 994, 995, 996,
-	0, 0, 0, 0, 0, 0, 0, 32, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 184,
-	0, 0, 0, 0, 1187, 0, 0, 0, 0, 0,
-	0, 0, 1151, 1037, 0, 0, 0, 0, 0, 0,
-	1326, 0, 593, 0, 2086, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1150, 0, 184, 1235,
-	0, 0, 0, 0, 0, 1041, 0, 0, 1246, 0,
-	0, 0, 0, 0, 1358, 1359, 0, 166, 0, 0,
-	0, 0, 1254, 0, 1256, 0, 0, 0, 1717, 0,
-	0, 0, 0, 0, 0, 0, 0, 1390, 1270, 0,
-	0, 1273, 108, 0, 130, 184, 184, 1024, 0, 0,
-	593, 0, 150, 1151, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 184, 0, 0, 0, 0, 593, 0,
-	0, 593, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 736, 140, 0, 184, 0, 0, 129, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 147, 0, 148, 0,
-	0, 0, 0, 1131, 1132, 139, 138, 165, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 743, 0, 0,
-	0, 0, 0, 166, 0, 0, 0, 0, 0, 0,
-	1151, 0, 0, 0, 1127, 0, 0, 0, 736, 0,
-	0, 0, 0, 0, 743, 134, 1133, 141, 108, 1130,
-	130, 135, 136, 0, 0, 151, 0, 0, 150, 0,
-	1397, 0, 0, 0, 0, 156, 0, 1401, 0, 1404,
-	0, 0, 0, 0, 0, 0, 0, 0, 1423, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 736, 140,
-	0, 0, 0, 0, 129, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 147, 0, 148, 0, 0, 0, 0, 1131,
-	1132, 139, 138, 165, 0, 1374, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 893, 893, 0, 893,
-	893, 0, 893, 893, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 143, 0, 184, 0,
-	0, 134, 1133, 141, 0, 1130, 0, 135, 136, 0,
-	184, 151, 0, 0, 1567, 0, 0, 0, 0, 0,
-	0, 156, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 137, 0, 0, 0, 33,
-	34, 35, 68, 37, 38, 0, 0, 131, 0, 0,
-	132, 0, 0, 0, 0, 0, 0, 0, 0, 72,
-	0, 0, 0, 0, 39, 65, 66, 0, 63, 0,
-	0, 0, 0, 0, 64, 0, 0, 0, 1151, 0,
-	0, 0, 0, 0, 0, 0, 1041, 0, 0, 0,
-	1543, 1544, 1545, 1546, 1547, 0, 0, 0, 0, 1081,
-	1551, 1552, 0, 52, 1556, 0, 0, 0, 0, 0,
-	0, 0, 143, 67, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1562, 0, 0, 1422, 0, 1150,
-	0, 0, 0, 0, 0, 0, 1564, 0, 0, 1566,
-	144, 149, 146, 152, 153, 154, 155, 157, 158, 159,
-	160, 0, 0, 0, 0, 0, 161, 162, 163, 164,
-	0, 137, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 131, 0, 0, 132, 0, 0, 0,
-	0, 0, 0, 0, 0, 42, 45, 48, 47, 50,
-	0, 62, 0, 0, 0, 0, 0, 0, 0, 0,
+	559, 2182, 2086, 2164, 2000, 2205, 2148, 2083, 1775, 2100,
+	974, 2023, 1673, 1396, 1996, 1028, 531, 1637, 1840, 517,
+	1019, 1841, 1907, 1387, 572, 1674, 1527, 2015, 1837, 1497,
+	1732, 973, 4, 1748, 1733, 500, 148, 1502, 1798, 1171,
+	1130, 866, 1343, 1525, 1596, 1277, 83, 3, 81, 179,
+	1853, 79, 191, 747, 467, 191, 1021, 1440, 805, 1335,
+	483, 905, 191, 1153, 1504, 1065, 1058, 134, 1725, 1462,
+	1422, 606, 770, 907, 1051, 1389, 493, 1659, 1429, 1048,
+	1264, 191, 1026, 1031, 581, 1013, 1370, 191, 1312, 504,
+	77, 1049, 1574, 1505, 483, 564, 1160, 483, 191, 483,
+	502, 1055, 754, 1243, 1405, 751, 1064, 1482, 776, 590,
+	32, 755, 1062, 771, 773, 772, 783, 1493, 1446, 1038,
+	972, 111, 1280, 847, 151, 2025, 112, 490, 987, 178,
+	9, 1145, 117, 118, 8, 7, 1767, 1766, 1556, 76,
+	1230, 990, 1384, 1385, 1298, 2135, 1483, 1635, 2140, 2080,
+	2169, 2186, 2190, 2170, 1885, 2188, 1977, 2027, 87, 1791,
+	2055, 2054, 1992, 809, 808, 1993, 2212, 603, 2145, 748,
+	113, 2204, 588, 592, 191, 494, 810, 2189, 119, 2169,
+	2187, 78, 2170, 2113, 191, 567, 860, 34, 565, 191,
+	2192, 491, 492, 1908, 1544, 807, 2144, 1815, 2112, 1939,
+	445, 762, 2165, 177, 1346, 84, 600, 1636, 821, 822,
+	2171, 825, 826, 827, 828, 1868, 34, 831, 832, 833,
+	834, 835, 836, 837, 838, 839, 840, 841, 842, 843,
+	844, 845, 764, 1509, 2032, 113, 786, 763, 787, 2171,
+	607, 1667, 88, 89, 90, 91, 92, 93, 34, 1869,
+	1870, 70, 39, 40, 1507, 811, 812, 813, 1563, 1456,
+	765, 69, 1562, 1668, 818, 1705, 876, 1386, 1704, 103,
+	172, 1706, 2069, 935, 934, 944, 945, 937, 938, 939,
+	940, 941, 942, 943, 936, 1457, 1458, 946, 108, 173,
+	69, 824, 1066, 172, 1067, 114, 903, 136, 823, 563,
+	471, 874, 562, 113, 1716, 156, 766, 1299, 1300, 1301,
+	885, 886, 544, 1476, 550, 551, 548, 549, 114, 547,
+	546, 545, 69, 1777, 108, 100, 1970, 2117, 156, 552,
+	553, 104, 1930, 1506, 105, 106, 146, 1928, 481, 1526,
+	1297, 135, 485, 479, 108, 184, 185, 186, 470, 180,
+	181, 182, 1749, 1771, 877, 180, 181, 182, 1559, 153,
+	1772, 154, 1220, 2136, 1244, 2184, 123, 124, 145, 144,
+	171, 887, 855, 1249, 902, 888, 885, 886, 848, 880,
+	881, 901, 153, 1252, 154, 1253, 1254, 1987, 882, 875,
+	1780, 1571, 1248, 171, 1778, 878, 879, 830, 829, 785,
+	1779, 471, 1246, 1221, 2051, 1222, 794, 792, 1139, 2063,
+	1528, 176, 1423, 803, 802, 801, 471, 1884, 140, 121,
+	147, 128, 120, 800, 141, 142, 799, 1250, 157, 798,
+	797, 796, 791, 1247, 894, 767, 896, 804, 162, 129,
+	107, 858, 1578, 1988, 752, 191, 110, 2213, 750, 470,
+	1447, 157, 785, 132, 130, 125, 126, 127, 131, 889,
+	892, 162, 2111, 122, 470, 2209, 752, 2162, 483, 483,
+	483, 779, 133, 893, 895, 778, 107, 752, 1159, 1158,
+	2070, 2167, 859, 899, 2166, 759, 483, 483, 869, 870,
+	871, 872, 873, 1561, 594, 1508, 107, 1781, 795, 793,
+	785, 1550, 1638, 1640, 1257, 2043, 911, 814, 904, 1742,
+	2167, 2101, 1558, 2166, 35, 1799, 35, 820, 471, 1824,
+	785, 785, 1823, 785, 1546, 1822, 760, 1759, 444, 183,
+	908, 909, 2091, 784, 917, 2118, 958, 959, 1576, 149,
+	778, 781, 782, 1575, 752, 35, 1576, 1570, 775, 779,
+	1569, 1575, 1232, 1231, 1233, 1234, 1235, 1959, 1801, 1867,
+	1664, 1604, 149, 191, 71, 1536, 470, 774, 2141, 1616,
+	891, 1613, 937, 938, 939, 940, 941, 942, 943, 936,
+	1016, 1463, 946, 883, 1452, 890, 784, 1042, 143, 956,
+	971, 483, 788, 778, 191, 864, 191, 191, 1639, 483,
+	137, 936, 789, 138, 946, 483, 927, 180, 181, 182,
+	946, 2207, 898, 1017, 2208, 1803, 2206, 1807, 920, 1802,
+	790, 1800, 918, 919, 900, 854, 1805, 1278, 861, 862,
+	1701, 1401, 1294, 1281, 784, 1804, 96, 1014, 868, 926,
+	788, 778, 494, 975, 2044, 2042, 2058, 1545, 1806, 1808,
+	789, 985, 923, 1047, 784, 784, 806, 784, 1851, 819,
+	1245, 778, 781, 782, 852, 752, 1032, 1721, 926, 775,
+	779, 1068, 603, 989, 992, 994, 996, 997, 999, 1001,
+	1002, 97, 921, 1024, 1027, 853, 1817, 993, 995, 1011,
+	998, 1000, 1371, 1003, 925, 923, 150, 155, 152, 158,
+	159, 160, 161, 163, 164, 165, 166, 958, 959, 958,
+	959, 926, 167, 168, 169, 170, 1371, 1134, 1623, 150,
+	155, 152, 158, 159, 160, 161, 163, 164, 165, 166,
+	180, 181, 182, 1543, 1337, 167, 168, 169, 170, 1406,
+	1407, 1541, 1279, 2202, 849, 607, 850, 191, 1282, 851,
+	867, 1126, 939, 940, 941, 942, 943, 936, 794, 191,
+	946, 1135, 1136, 944, 945, 937, 938, 939, 940, 941,
+	942, 943, 936, 1018, 483, 946, 1155, 1611, 792, 2094,
+	924, 925, 923, 1030, 1164, 1610, 175, 2193, 1168, 1714,
+	1338, 483, 483, 1319, 483, 2175, 483, 483, 926, 483,
+	483, 483, 483, 483, 483, 1473, 1538, 1317, 1318, 1316,
+	924, 925, 923, 1035, 483, 2194, 758, 1474, 191, 1204,
+	924, 925, 923, 2176, 1137, 1138, 1976, 1151, 926, 1975,
+	1542, 1173, 1890, 1174, 1217, 1176, 1178, 1144, 926, 1182,
+	1184, 1186, 1188, 1190, 1403, 483, 1538, 1163, 924, 925,
+	923, 1063, 69, 191, 1729, 1827, 1819, 2214, 1612, 191,
+	576, 1728, 191, 1263, 1315, 191, 926, 1201, 1512, 1240,
+	1540, 1225, 1224, 1307, 1309, 1310, 191, 1239, 191, 1162,
+	1125, 1132, 1207, 1208, 1730, 1308, 757, 1165, 1213, 1214,
+	483, 483, 191, 483, 483, 191, 483, 483, 1161, 1161,
+	1141, 1142, 1140, 1828, 593, 1154, 1402, 1223, 924, 925,
+	923, 1237, 1199, 1200, 1227, 2196, 1269, 1215, 1271, 761,
+	1273, 1274, 1275, 1276, 2215, 1209, 926, 1589, 1590, 1591,
+	1283, 924, 925, 923, 1206, 1238, 1266, 1205, 1180, 2195,
+	2177, 924, 925, 923, 2156, 598, 1313, 1336, 2129, 926,
+	2012, 1284, 1285, 1973, 1287, 1288, 1339, 1290, 1291, 926,
+	1258, 1202, 520, 519, 522, 523, 524, 525, 2049, 1236,
+	483, 521, 1226, 526, 1947, 1829, 764, 1738, 1268, 113,
+	1726, 763, 180, 181, 182, 1586, 1708, 1554, 180, 181,
+	182, 1354, 1520, 1553, 1340, 1341, 595, 596, 180, 181,
+	182, 1267, 1518, 1228, 1216, 483, 483, 180, 181, 182,
+	560, 1218, 180, 181, 182, 1212, 191, 1211, 1210, 1314,
+	1292, 483, 1774, 2048, 570, 1646, 2161, 1646, 2107, 1906,
+	483, 34, 1302, 1303, 1304, 1305, 78, 191, 1646, 2092,
+	483, 1393, 1751, 1349, 191, 1347, 191, 2074, 576, 1741,
+	1378, 1379, 1348, 1408, 191, 191, 1990, 576, 1538, 576,
+	1838, 483, 192, 1350, 483, 192, 1957, 576, 975, 1850,
+	484, 1471, 192, 1646, 1899, 483, 1882, 1881, 1878, 1879,
+	922, 1359, 1362, 1448, 975, 1442, 1415, 1372, 1357, 1358,
+	1660, 192, 1878, 1877, 1352, 1414, 576, 192, 1447, 1768,
+	1441, 1129, 1753, 1448, 484, 69, 80, 484, 192, 484,
+	1466, 587, 1660, 576, 1349, 1425, 1347, 1416, 1746, 1747,
+	1426, 576, 1477, 1420, 1478, 1479, 1480, 1481, 1467, 1539,
+	483, 1646, 1645, 1850, 603, 922, 576, 603, 1517, 1519,
+	1489, 1490, 1491, 1492, 1449, 1129, 1128, 1470, 1074, 1073,
+	1954, 483, 1451, 567, 1499, 1414, 1445, 483, 1426, 1418,
+	82, 1164, 1695, 1164, 1449, 1426, 2125, 2057, 1454, 1978,
+	1447, 1537, 1447, 1453, 1646, 1450, 1880, 1426, 1461, 34,
+	1850, 1355, 1356, 1538, 192, 1361, 1364, 1365, 1469, 1455,
+	1468, 1628, 1627, 1414, 192, 1538, 1484, 1485, 1486, 192,
+	567, 483, 1521, 1336, 1524, 1404, 1382, 607, 1336, 1336,
+	607, 1377, 497, 1256, 1380, 1381, 1979, 1980, 1981, 1060,
+	2199, 769, 1195, 768, 1534, 1414, 1535, 1735, 80, 1516,
+	1373, 1500, 69, 177, 2099, 1511, 1513, 1501, 1998, 1495,
+	1496, 1510, 1020, 191, 1965, 1548, 1131, 191, 191, 191,
+	191, 191, 1498, 69, 1547, 1773, 1533, 191, 191, 1530,
+	1500, 191, 1549, 1529, 786, 1531, 787, 1551, 1552, 1196,
+	1197, 1198, 1776, 1494, 69, 1488, 1161, 1487, 1242, 191,
+	191, 191, 934, 944, 945, 937, 938, 939, 940, 941,
+	942, 943, 936, 191, 1156, 946, 191, 483, 935, 934,
+	944, 945, 937, 938, 939, 940, 941, 942, 943, 936,
+	1152, 1982, 946, 576, 575, 1127, 98, 1854, 1855, 1580,
+	1431, 1434, 1435, 1436, 1432, 1584, 1433, 1437, 1734, 1999,
+	1854, 1855, 1192, 1313, 1431, 1434, 1435, 1436, 1432, 1509,
+	1433, 1437, 2183, 1873, 1557, 1857, 1942, 1838, 1743, 1295,
+	1260, 1860, 1859, 1685, 1579, 1983, 1984, 1597, 1686, 935,
+	934, 944, 945, 937, 938, 939, 940, 941, 942, 943,
+	936, 582, 1735, 946, 1682, 1683, 1193, 1194, 1681, 1607,
+	1684, 2172, 2143, 191, 1687, 583, 1435, 1436, 1830, 1649,
+	1029, 191, 1958, 935, 934, 944, 945, 937, 938, 939,
+	940, 941, 942, 943, 936, 1592, 1314, 946, 1033, 1034,
+	585, 1897, 584, 1658, 1657, 2123, 191, 2120, 2174, 102,
+	2147, 582, 2149, 2155, 1647, 2154, 2084, 191, 191, 191,
+	191, 191, 1648, 2082, 1255, 583, 561, 1739, 816, 191,
+	815, 1734, 910, 1350, 191, 1761, 1605, 191, 191, 1367,
+	1643, 191, 191, 191, 1670, 192, 1622, 1014, 579, 580,
+	585, 565, 584, 1368, 1707, 1653, 1642, 174, 1634, 1669,
+	187, 1022, 1760, 1662, 1713, 1442, 114, 2096, 484, 484,
+	484, 1624, 2095, 1023, 1720, 1644, 2030, 1652, 1532, 1692,
+	1693, 1170, 1169, 1157, 1952, 1399, 484, 484, 1661, 1665,
+	1514, 1663, 1710, 1259, 1696, 2126, 1676, 2050, 1698, 1679,
+	483, 1994, 1650, 1651, 1027, 1694, 1688, 1439, 1699, 1677,
+	1678, 1702, 1680, 483, 1266, 1656, 1675, 1406, 1407, 483,
+	1395, 2179, 483, 1655, 1164, 573, 1745, 82, 529, 483,
+	1711, 568, 569, 2178, 2152, 1754, 2124, 2109, 1951, 1601,
+	1602, 1765, 1719, 1894, 1722, 1723, 1724, 1727, 1522, 191,
+	574, 1950, 1833, 1660, 1617, 1737, 2201, 2200, 1736, 1614,
+	1043, 1620, 1036, 192, 2201, 2088, 1971, 1400, 483, 191,
+	78, 85, 1763, 75, 1, 454, 1717, 1718, 1383, 1144,
+	1012, 1762, 466, 2181, 1229, 1219, 1909, 1755, 482, 1995,
+	1900, 484, 1515, 1731, 192, 483, 192, 192, 1349, 484,
+	1756, 1503, 1336, 777, 139, 484, 1464, 1348, 1465, 1764,
+	2103, 1796, 95, 745, 94, 780, 897, 1750, 1523, 1784,
+	2041, 1785, 605, 1991, 1715, 749, 1816, 756, 1786, 1782,
+	1475, 1969, 483, 1872, 1712, 2093, 1794, 483, 1080, 1078,
+	1941, 1810, 1079, 1077, 1082, 191, 1081, 1076, 1296, 480,
+	1438, 1069, 1037, 817, 1797, 483, 1883, 1472, 1293, 1555,
+	461, 1809, 483, 483, 884, 457, 954, 1654, 1795, 1703,
+	1839, 1825, 604, 597, 1845, 1842, 101, 2153, 2121, 2119,
+	1836, 2081, 2024, 2122, 2079, 2173, 191, 935, 934, 944,
+	945, 937, 938, 939, 940, 941, 942, 943, 936, 2146,
+	1398, 946, 1848, 1025, 1949, 1832, 1621, 1844, 960, 961,
+	962, 963, 964, 965, 966, 967, 968, 969, 984, 1369,
+	1858, 1052, 503, 1818, 1306, 518, 515, 516, 1795, 1409,
+	1891, 1666, 928, 191, 191, 501, 1871, 483, 495, 1044,
+	1430, 1849, 1428, 1864, 1427, 1261, 1056, 192, 1856, 1852,
+	191, 1050, 1413, 1560, 1887, 1770, 578, 1886, 1675, 192,
+	1834, 99, 1863, 1366, 1865, 2068, 1866, 1910, 483, 483,
+	483, 1938, 191, 577, 484, 1901, 61, 1875, 1876, 38,
+	1905, 1898, 483, 1888, 1889, 487, 1904, 1903, 2134, 913,
+	1895, 484, 484, 586, 484, 31, 484, 484, 30, 484,
+	484, 484, 484, 484, 484, 29, 24, 23, 22, 21,
+	20, 1921, 26, 19, 484, 18, 1915, 1916, 192, 17,
+	109, 48, 45, 1926, 43, 116, 115, 46, 42, 856,
+	1923, 1924, 28, 1925, 27, 16, 1927, 15, 1929, 14,
+	1896, 13, 12, 11, 10, 484, 6, 5, 916, 25,
+	86, 2085, 2026, 192, 2001, 1961, 2062, 2168, 1953, 192,
+	2139, 2138, 192, 1790, 2, 192, 1962, 0, 0, 1967,
+	0, 0, 0, 0, 0, 0, 192, 0, 192, 0,
+	0, 0, 0, 0, 0, 483, 0, 0, 0, 0,
+	484, 484, 192, 484, 484, 192, 484, 484, 1986, 483,
+	0, 0, 0, 1985, 0, 0, 0, 0, 0, 483,
+	0, 0, 0, 0, 0, 2005, 0, 0, 0, 1940,
+	0, 0, 0, 0, 0, 0, 1948, 0, 0, 0,
+	0, 0, 1968, 0, 483, 483, 483, 191, 0, 0,
+	0, 1675, 0, 494, 0, 0, 0, 0, 483, 0,
+	483, 1963, 2029, 2011, 1964, 0, 483, 1966, 0, 0,
+	2021, 1842, 2019, 2020, 0, 1842, 2031, 2036, 2003, 2039,
+	484, 0, 1997, 0, 0, 1972, 2038, 1974, 2034, 191,
+	0, 2045, 2040, 0, 0, 0, 0, 0, 0, 483,
+	191, 0, 0, 2033, 0, 0, 605, 605, 605, 0,
+	0, 0, 2059, 0, 0, 484, 484, 2053, 0, 0,
+	0, 0, 0, 0, 912, 914, 192, 0, 483, 0,
+	0, 484, 2004, 0, 0, 0, 0, 0, 0, 2078,
+	484, 2056, 2046, 0, 2047, 0, 0, 192, 0, 1842,
+	484, 2089, 0, 0, 192, 2022, 192, 0, 483, 483,
+	2028, 494, 1936, 0, 192, 192, 0, 0, 0, 0,
+	0, 484, 2102, 0, 484, 483, 0, 0, 2108, 0,
+	0, 2090, 0, 0, 0, 484, 0, 0, 0, 0,
+	0, 2116, 483, 0, 0, 0, 0, 2097, 0, 0,
+	483, 2127, 0, 0, 0, 0, 0, 2130, 0, 0,
+	0, 0, 0, 2133, 0, 0, 2137, 0, 0, 0,
+	2142, 0, 0, 0, 0, 2150, 483, 2151, 2157, 1040,
+	0, 1997, 2104, 0, 0, 0, 0, 605, 0, 0,
+	484, 1311, 0, 1070, 1320, 1321, 1322, 1323, 1324, 1325,
+	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 483,
+	2163, 484, 0, 2180, 0, 0, 0, 484, 0, 2185,
+	935, 934, 944, 945, 937, 938, 939, 940, 941, 942,
+	943, 936, 0, 2198, 946, 0, 0, 0, 0, 1675,
+	0, 0, 483, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1351, 1374, 1353, 2211, 2210, 0, 0,
+	0, 484, 930, 0, 933, 0, 0, 0, 0, 494,
+	947, 948, 949, 950, 951, 952, 953, 0, 931, 932,
+	929, 935, 934, 944, 945, 937, 938, 939, 940, 941,
+	942, 943, 936, 0, 0, 946, 0, 0, 1394, 1935,
+	0, 0, 0, 192, 0, 0, 0, 192, 192, 192,
+	192, 192, 0, 0, 0, 0, 0, 192, 192, 0,
+	0, 192, 0, 0, 0, 0, 180, 181, 182, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 192,
+	192, 192, 0, 1787, 0, 0, 1934, 0, 0, 0,
+	0, 0, 0, 192, 0, 0, 192, 484, 0, 0,
+	0, 0, 749, 935, 934, 944, 945, 937, 938, 939,
+	940, 941, 942, 943, 936, 1166, 0, 946, 0, 1172,
+	1172, 0, 1172, 0, 1172, 1172, 458, 1181, 1172, 1172,
+	1172, 1172, 1172, 0, 0, 459, 0, 0, 0, 0,
+	1166, 1166, 749, 0, 0, 456, 0, 935, 934, 944,
+	945, 937, 938, 939, 940, 941, 942, 943, 936, 0,
+	0, 946, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1241, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 192, 453, 0, 0, 0, 0, 0,
+	0, 192, 0, 465, 935, 934, 944, 945, 937, 938,
+	939, 940, 941, 942, 943, 936, 0, 0, 946, 0,
+	0, 0, 0, 0, 0, 0, 192, 0, 605, 605,
+	0, 605, 605, 0, 605, 605, 0, 192, 192, 192,
+	192, 192, 0, 0, 0, 471, 172, 0, 0, 192,
+	0, 0, 0, 0, 192, 0, 0, 192, 192, 0,
+	0, 192, 192, 192, 0, 0, 0, 0, 0, 0,
+	0, 114, 446, 447, 448, 0, 463, 464, 474, 0,
+	0, 156, 460, 462, 475, 449, 450, 477, 476, 0,
+	452, 451, 0, 470, 455, 472, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1342, 0,
+	605, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	484, 0, 1709, 1933, 0, 1166, 0, 0, 0, 0,
+	1593, 1594, 1595, 484, 0, 153, 0, 154, 0, 484,
+	0, 0, 484, 1375, 1376, 0, 171, 0, 0, 484,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1397,
+	0, 0, 0, 0, 0, 0, 0, 0, 1410, 192,
+	1599, 0, 0, 0, 1600, 0, 0, 0, 1040, 0,
+	0, 605, 0, 1606, 0, 0, 1608, 1609, 484, 192,
+	0, 0, 1615, 0, 0, 1618, 1619, 0, 0, 605,
+	0, 0, 605, 1625, 157, 1626, 0, 0, 1629, 1630,
+	1631, 1632, 1633, 749, 162, 484, 0, 0, 0, 0,
+	0, 0, 473, 0, 0, 0, 0, 0, 0, 468,
+	0, 935, 934, 944, 945, 937, 938, 939, 940, 941,
+	942, 943, 936, 0, 469, 946, 0, 0, 0, 0,
+	0, 0, 484, 0, 0, 0, 0, 484, 0, 0,
+	0, 0, 0, 0, 0, 192, 0, 0, 756, 0,
+	0, 1690, 1691, 0, 0, 484, 0, 0, 0, 0,
+	0, 0, 484, 484, 0, 0, 0, 0, 0, 749,
+	0, 0, 0, 0, 0, 756, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 192, 0, 0, 0,
+	0, 0, 0, 0, 0, 149, 0, 34, 36, 37,
+	70, 39, 40, 0, 0, 532, 33, 0, 0, 0,
+	33, 0, 0, 0, 0, 0, 0, 74, 0, 749,
+	0, 0, 41, 67, 68, 0, 65, 0, 0, 0,
+	0, 0, 66, 192, 192, 0, 0, 484, 0, 33,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 54, 0, 0, 0, 0, 0, 0, 484, 484,
+	484, 69, 192, 0, 1598, 0, 0, 0, 0, 0,
+	0, 0, 484, 566, 0, 0, 0, 0, 0, 0,
+	1788, 1789, 0, 0, 935, 934, 944, 945, 937, 938,
+	939, 940, 941, 942, 943, 936, 1811, 1812, 946, 1813,
+	1814, 0, 1792, 1793, 0, 1588, 0, 0, 0, 0,
+	1820, 1821, 935, 934, 944, 945, 937, 938, 939, 940,
+	941, 942, 943, 936, 0, 0, 946, 0, 0, 0,
+	0, 0, 0, 44, 47, 50, 49, 52, 0, 64,
+	0, 0, 150, 155, 152, 158, 159, 160, 161, 163,
+	164, 165, 166, 0, 0, 0, 0, 0, 167, 168,
+	169, 170, 0, 0, 53, 73, 72, 0, 0, 62,
+	63, 51, 1846, 0, 0, 484, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 484,
+	0, 0, 0, 1861, 1862, 0, 1874, 0, 0, 484,
+	0, 0, 0, 0, 0, 55, 56, 0, 57, 58,
+	59, 60, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 484, 484, 484, 192, 0, 0,
+	0, 0, 0, 0, 1166, 0, 0, 0, 484, 0,
+	484, 0, 0, 0, 0, 0, 484, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 530, 0, 192,
+	1917, 0, 0, 0, 0, 0, 0, 0, 0, 484,
+	192, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1920, 0, 0, 0, 1922,
+	0, 0, 0, 71, 0, 0, 0, 0, 484, 0,
+	1931, 1932, 0, 0, 0, 0, 35, 0, 1740, 190,
+	0, 0, 478, 0, 0, 0, 1946, 0, 0, 190,
+	0, 1397, 0, 0, 0, 1166, 0, 1752, 484, 484,
+	1397, 0, 0, 0, 1955, 605, 1956, 1757, 571, 1960,
+	0, 0, 0, 0, 571, 484, 591, 591, 0, 0,
+	0, 0, 0, 0, 0, 190, 0, 0, 0, 0,
+	0, 0, 484, 0, 0, 0, 0, 0, 0, 0,
+	484, 0, 0, 0, 0, 0, 605, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1989, 0, 0, 0, 0, 484, 0, 0, 0,
+	0, 0, 0, 605, 0, 0, 2006, 2007, 2008, 2009,
+	2010, 0, 0, 0, 2013, 2014, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 484,
+	0, 190, 0, 0, 2016, 0, 0, 0, 0, 0,
+	1172, 190, 0, 0, 0, 1826, 190, 0, 0, 0,
+	0, 0, 0, 906, 906, 906, 0, 0, 0, 0,
+	0, 0, 484, 605, 0, 0, 1166, 0, 0, 0,
+	1847, 1172, 0, 33, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 955, 957, 0, 0, 0, 0,
+	0, 2061, 0, 0, 0, 0, 0, 2064, 2065, 2066,
+	2067, 0, 2071, 0, 2072, 2073, 2075, 0, 0, 0,
+	2076, 2077, 0, 0, 0, 970, 0, 0, 0, 976,
+	977, 978, 979, 980, 981, 982, 983, 0, 986, 988,
+	991, 991, 991, 988, 991, 991, 988, 991, 1004, 1005,
+	1006, 1007, 1008, 1009, 1010, 749, 0, 0, 1166, 0,
+	0, 0, 33, 0, 0, 0, 0, 0, 0, 2110,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 2131, 0, 0, 0, 0, 1911, 1912, 1913, 0,
+	0, 1053, 0, 0, 0, 0, 0, 0, 0, 0,
+	1919, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1015, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2159, 2160, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 2191, 0, 0, 0, 0, 1166,
+	172, 0, 0, 0, 0, 0, 0, 0, 189, 0,
+	0, 1744, 0, 0, 0, 0, 0, 0, 486, 0,
+	0, 2197, 0, 0, 0, 114, 0, 136, 0, 0,
+	0, 0, 0, 0, 0, 156, 0, 0, 0, 0,
+	0, 0, 0, 1397, 0, 0, 0, 0, 0, 0,
+	0, 0, 190, 0, 753, 0, 0, 605, 0, 0,
+	0, 0, 0, 0, 0, 0, 146, 2002, 0, 0,
+	0, 135, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 153,
+	0, 154, 2017, 2017, 2017, 0, 1147, 1148, 145, 144,
+	171, 0, 0, 0, 0, 0, 2035, 0, 2037, 0,
+	0, 0, 0, 0, 1397, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	846, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	857, 0, 0, 0, 0, 863, 0, 1397, 140, 1149,
+	147, 0, 1146, 0, 141, 142, 0, 0, 157, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 162, 0,
+	190, 0, 0, 0, 0, 0, 2087, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 591, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 190, 0, 190, 1059, 0, 605, 605, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 2114, 0, 906, 906, 0, 906, 906,
+	0, 906, 906, 0, 0, 0, 0, 1166, 0, 0,
+	2128, 0, 0, 0, 0, 0, 0, 0, 1397, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 149,
+	0, 0, 0, 0, 2087, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1097, 0, 0, 0, 0, 2002, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 143, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	137, 0, 0, 138, 0, 0, 0, 0, 0, 0,
+	2203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 190, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 190, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1443,
+	0, 0, 0, 0, 1167, 0, 0, 0, 0, 0,
+	0, 865, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1085, 0, 0, 0, 0, 1167,
+	1167, 0, 0, 0, 0, 190, 150, 155, 152, 158,
+	159, 160, 161, 163, 164, 165, 166, 0, 0, 0,
+	0, 0, 167, 168, 169, 170, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1098, 0, 0,
+	190, 0, 0, 0, 0, 0, 190, 0, 0, 190,
+	0, 0, 1265, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 190, 0, 190, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 190,
+	0, 0, 190, 1111, 1114, 1115, 1116, 1117, 1118, 1119,
+	0, 1120, 1121, 1122, 1123, 1124, 1099, 1100, 1101, 1102,
+	1083, 1084, 1112, 0, 1086, 0, 1087, 1088, 1089, 1090,
+	1091, 1092, 1093, 1094, 1095, 1096, 1103, 1104, 1105, 1106,
+	1107, 1108, 1109, 1110, 0, 0, 0, 0, 0, 0,
+	1046, 0, 0, 1057, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 591, 1265,
+	0, 0, 0, 0, 591, 591, 0, 0, 591, 591,
+	591, 0, 0, 0, 1167, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1113, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 591, 591, 591, 591, 591, 0,
+	0, 0, 0, 1391, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 172, 190, 0, 0, 0, 0, 0,
+	1265, 190, 0, 190, 1143, 0, 0, 0, 0, 0,
+	0, 190, 190, 0, 0, 0, 0, 0, 114, 0,
+	136, 0, 0, 0, 0, 0, 0, 0, 156, 0,
+	0, 0, 0, 1603, 0, 0, 566, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1075, 0, 0, 0, 0, 0, 146,
+	0, 0, 0, 0, 135, 1133, 0, 0, 0, 0,
+	0, 0, 0, 0, 1641, 0, 0, 0, 0, 0,
+	0, 957, 153, 0, 154, 0, 0, 0, 0, 1147,
+	1148, 145, 144, 171, 0, 0, 0, 0, 0, 0,
+	0, 1053, 0, 0, 0, 0, 0, 0, 1671, 1672,
+	0, 0, 1053, 1053, 1053, 1053, 1053, 0, 0, 0,
+	0, 0, 0, 0, 1203, 0, 0, 0, 33, 1443,
+	0, 0, 1053, 0, 0, 0, 1053, 0, 0, 0,
+	0, 140, 1149, 147, 0, 1146, 0, 141, 142, 0,
+	0, 157, 0, 0, 0, 0, 0, 0, 0, 1251,
+	0, 162, 0, 0, 0, 1057, 0, 0, 1262, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1270, 0, 1272, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1286, 0,
+	190, 1289, 0, 0, 190, 190, 190, 190, 190, 0,
+	0, 0, 0, 0, 190, 190, 0, 0, 190, 0,
+	0, 0, 0, 0, 0, 1758, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1581, 1582, 190, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	190, 0, 0, 190, 0, 0, 0, 0, 0, 0,
+	0, 0, 149, 906, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 591, 591, 0, 0, 0, 0, 0, 0,
+	0, 143, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 137, 591, 0, 138, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	190, 0, 0, 1417, 0, 0, 0, 0, 1391, 0,
+	1421, 0, 1424, 0, 1843, 0, 33, 33, 0, 0,
+	0, 1444, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 591, 190, 0, 0, 0, 0, 0, 0,
+	0, 1053, 0, 1167, 190, 190, 190, 190, 190, 0,
+	0, 0, 0, 0, 0, 0, 1689, 0, 0, 0,
+	0, 190, 0, 0, 190, 190, 0, 0, 190, 1700,
+	1265, 0, 0, 0, 0, 0, 0, 0, 0, 150,
+	155, 152, 158, 159, 160, 161, 163, 164, 165, 166,
+	0, 0, 0, 0, 0, 167, 168, 169, 170, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1069, 1713, 0, 0, 0, 51, 71, 70, 0,
-	0, 60, 61, 49, 0, 1719, 0, 0, 0, 1150,
-	0, 1726, 0, 0, 1719, 0, 0, 0, 0, 593,
-	0, 1731, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1082, 0, 0, 53, 54, 0,
-	55, 56, 57, 58, 0, 0, 144, 149, 146, 152,
-	153, 154, 155, 157, 158, 159, 160, 0, 0, 0,
-	593, 0, 161, 162, 163, 164, 1670, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1095, 1098, 1099, 1100, 1101, 1102, 1103, 593, 1104, 1105,
-	1106, 1107, 1108, 1083, 1084, 1085, 1086, 1067, 1068, 1096,
-	0, 1070, 0, 1071, 1072, 1073, 1074, 1075, 1076, 1077,
-	1078, 1079, 1080, 1087, 1088, 1089, 1090, 1091, 1092, 1093,
-	1094, 0, 0, 1156, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 69, 0, 0, 0, 0,
-	0, 0, 0, 593, 0, 0, 1150, 0, 0, 1817,
-	1156, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1743, 0, 0,
-	0, 1097, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1757, 0, 0,
-	0, 1582, 0, 0, 557, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 736, 0, 0, 1150, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1619, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1882, 1883, 1884, 0, 0,
-	0, 0, 0, 0, 0, 1037, 0, 0, 0, 1802,
-	0, 0, 1645, 1646, 0, 0, 1037, 1037, 1037, 1037,
-	1037, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1422, 0, 0, 1037, 0, 0, 0, 1037,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1150, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1861, 1862, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1719, 1956, 0,
-	0, 0, 0, 1872, 0, 0, 0, 0, 0, 0,
-	1719, 0, 0, 593, 0, 0, 0, 0, 1732, 0,
-	0, 0, 0, 0, 0, 1885, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1985, 1985, 1985, 0,
-	0, 0, 0, 0, 0, 0, 893, 0, 0, 0,
-	2000, 0, 2002, 0, 0, 0, 0, 0, 1719, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1719, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1814, 0, 32, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 593, 593, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1037, 0,
-	0, 2071, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1150, 0, 2083, 0, 0, 0, 0, 0,
-	0, 0, 1719, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 2017, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	2026, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1906, 0, 0,
-	0, 0, 0, 0, 1912, 1913, 1914, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1814, 0, 32, 0, 1814, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 32, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1814, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 714, 701, 32, 2057, 652, 717, 623,
-	641, 726, 643, 646, 684, 604, 665, 321, 638, 0,
-	627, 600, 634, 601, 625, 654, 237, 658, 622, 703,
-	668, 716, 280, 0, 628, 334, 686, 370, 223, 289,
-	287, 396, 246, 240, 236, 222, 265, 294, 332, 387,
-	326, 723, 284, 675, 0, 379, 306, 0, 0, 0,
-	656, 706, 663, 697, 651, 685, 612, 674, 718, 639,
-	682, 719, 270, 221, 192, 318, 380, 249, 0, 0,
-	0, 174, 175, 176, 0, 2064, 2065, 0, 0, 0,
-	0, 0, 213, 0, 219, 679, 713, 636, 681, 233,
-	268, 239, 232, 394, 683, 729, 599, 676, 0, 602,
-	605, 725, 709, 631, 632, 0, 0, 0, 0, 0,
-	0, 0, 655, 664, 694, 649, 0, 0, 0, 0,
-	0, 0, 0, 0, 629, 0, 673, 0, 0, 0,
-	608, 603, 0, 0, 0, 0, 653, 0, 0, 0,
-	611, 0, 630, 695, 0, 597, 256, 606, 307, 699,
-	708, 650, 421, 712, 648, 647, 715, 690, 609, 705,
-	642, 279, 607, 276, 188, 202, 0, 640, 317, 355,
-	360, 704, 626, 635, 224, 633, 358, 330, 409, 209,
-	247, 352, 335, 356, 672, 688, 357, 285, 398, 347,
-	408, 422, 423, 231, 311, 415, 391, 419, 431, 203,
-	228, 324, 384, 412, 376, 304, 395, 275, 375, 254,
-	191, 283, 195, 386, 406, 214, 368, 0, 0, 0,
-	197, 404, 383, 301, 272, 273, 196, 0, 351, 235,
-	252, 226, 320, 401, 402, 225, 433, 204, 418, 199,
-	205, 417, 313, 397, 405, 302, 293, 198, 403, 300,
-	292, 278, 245, 261, 345, 288, 346, 262, 309, 308,
-	310, 0, 193, 0, 381, 413, 434, 211, 621, 700,
-	393, 427, 430, 0, 348, 212, 253, 244, 344, 251,
-	281, 426, 428, 429, 210, 342, 259, 312, 206, 264,
-	377, 277, 286, 692, 728, 329, 359, 215, 411, 378,
-	616, 620, 614, 615, 666, 667, 617, 720, 721, 722,
-	696, 610, 0, 618, 619, 0, 702, 710, 711, 671,
-	187, 200, 282, 724, 349, 250, 432, 416, 414, 598,
-	613, 230, 624, 0, 0, 637, 644, 645, 657, 659,
-	660, 661, 662, 670, 677, 678, 680, 687, 689, 691,
-	693, 698, 707, 727, 189, 190, 201, 208, 217, 229,
-	242, 248, 257, 260, 263, 266, 267, 269, 274, 291,
-	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
-	325, 327, 328, 331, 337, 338, 339, 340, 341, 343,
-	350, 354, 361, 362, 363, 364, 365, 366, 367, 371,
-	372, 373, 374, 382, 385, 399, 400, 410, 420, 424,
-	258, 407, 425, 0, 290, 669, 194, 220, 207, 227,
-	241, 243, 271, 299, 305, 333, 336, 255, 238, 218,
-	353, 216, 369, 388, 389, 390, 392, 303, 234, 714,
-	701, 0, 0, 652, 717, 623, 641, 726, 643, 646,
-	684, 604, 665, 321, 638, 0, 627, 600, 634, 601,
-	625, 654, 237, 658, 622, 703, 668, 716, 280, 0,
-	628, 334, 686, 370, 223, 289, 287, 396, 246, 240,
-	236, 222, 265, 294, 332, 387, 326, 723, 284, 675,
-	0, 379, 306, 0, 0, 0, 656, 706, 663, 697,
-	651, 685, 612, 674, 718, 639, 682, 719, 270, 221,
-	192, 318, 380, 249, 0, 0, 0, 174, 175, 176,
-	0, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	219, 679, 713, 636, 681, 233, 268, 239, 232, 394,
-	683, 729, 599, 676, 0, 602, 605, 725, 709, 631,
-	632, 0, 0, 0, 0, 0, 0, 0, 655, 664,
-	694, 649, 0, 0, 0, 0, 0, 0, 1806, 0,
-	629, 0, 673, 0, 0, 0, 608, 603, 0, 0,
-	0, 0, 653, 0, 0, 0, 611, 0, 630, 695,
-	0, 597, 256, 606, 307, 699, 708, 650, 421, 712,
-	648, 647, 715, 690, 609, 705, 642, 279, 607, 276,
-	188, 202, 0, 640, 317, 355, 360, 704, 626, 635,
-	224, 633, 358, 330, 409, 209, 247, 352, 335, 356,
-	672, 688, 357, 285, 398, 347, 408, 422, 423, 231,
-	311, 415, 391, 419, 431, 203, 228, 324, 384, 412,
-	376, 304, 395, 275, 375, 254, 191, 283, 195, 386,
-	406, 214, 368, 0, 0, 0, 197, 404, 383, 301,
-	272, 273, 196, 0, 351, 235, 252, 226, 320, 401,
-	402, 225, 433, 204, 418, 199, 205, 417, 313, 397,
-	405, 302, 293, 198, 403, 300, 292, 278, 245, 261,
-	345, 288, 346, 262, 309, 308, 310, 0, 193, 0,
-	381, 413, 434, 211, 621, 700, 393, 427, 430, 0,
-	348, 212, 253, 244, 344, 251, 281, 426, 428, 429,
-	210, 342, 259, 312, 206, 264, 377, 277, 286, 692,
-	728, 329, 359, 215, 411, 378, 616, 620, 614, 615,
-	666, 667, 617, 720, 721, 722, 696, 610, 0, 618,
-	619, 0, 702, 710, 711, 671, 187, 200, 282, 724,
-	349, 250, 432, 416, 414, 598, 613, 230, 624, 0,
-	0, 637, 644, 645, 657, 659, 660, 661, 662, 670,
-	677, 678, 680, 687, 689, 691, 693, 698, 707, 727,
-	189, 190, 201, 208, 217, 229, 242, 248, 257, 260,
-	263, 266, 267, 269, 274, 291, 295, 296, 297, 298,
-	314, 315, 316, 319, 322, 323, 325, 327, 328, 331,
-	337, 338, 339, 340, 341, 343, 350, 354, 361, 362,
-	363, 364, 365, 366, 367, 371, 372, 373, 374, 382,
-	385, 399, 400, 410, 420, 424, 258, 407, 425, 0,
-	290, 669, 194, 220, 207, 227, 241, 243, 271, 299,
-	305, 333, 336, 255, 238, 218, 353, 216, 369, 388,
-	389, 390, 392, 303, 234, 714, 701, 0, 0, 652,
-	717, 623, 641, 726, 643, 646, 684, 604, 665, 321,
-	638, 0, 627, 600, 634, 601, 625, 654, 237, 658,
-	622, 703, 668, 716, 280, 0, 628, 334, 686, 370,
-	223, 289, 287, 396, 246, 240, 236, 222, 265, 294,
-	332, 387, 326, 723, 284, 675, 0, 379, 306, 0,
-	0, 0, 656, 706, 663, 697, 651, 685, 612, 674,
-	718, 639, 682, 719, 270, 221, 192, 318, 380, 249,
-	67, 0, 0, 174, 175, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 219, 679, 713, 636,
-	681, 233, 268, 239, 232, 394, 683, 729, 599, 676,
-	0, 602, 605, 725, 709, 631, 632, 0, 0, 0,
-	0, 0, 0, 0, 655, 664, 694, 649, 0, 0,
-	0, 0, 0, 0, 0, 0, 629, 0, 673, 0,
-	0, 0, 608, 603, 0, 0, 0, 0, 653, 0,
-	0, 0, 611, 0, 630, 695, 0, 597, 256, 606,
-	307, 699, 708, 650, 421, 712, 648, 647, 715, 690,
-	609, 705, 642, 279, 607, 276, 188, 202, 0, 640,
-	317, 355, 360, 704, 626, 635, 224, 633, 358, 330,
-	409, 209, 247, 352, 335, 356, 672, 688, 357, 285,
-	398, 347, 408, 422, 423, 231, 311, 415, 391, 419,
-	431, 203, 228, 324, 384, 412, 376, 304, 395, 275,
-	375, 254, 191, 283, 195, 386, 406, 214, 368, 0,
-	0, 0, 197, 404, 383, 301, 272, 273, 196, 0,
-	351, 235, 252, 226, 320, 401, 402, 225, 433, 204,
-	418, 199, 205, 417, 313, 397, 405, 302, 293, 198,
-	403, 300, 292, 278, 245, 261, 345, 288, 346, 262,
-	309, 308, 310, 0, 193, 0, 381, 413, 434, 211,
-	621, 700, 393, 427, 430, 0, 348, 212, 253, 244,
-	344, 251, 281, 426, 428, 429, 210, 342, 259, 312,
-	206, 264, 377, 277, 286, 692, 728, 329, 359, 215,
-	411, 378, 616, 620, 614, 615, 666, 667, 617, 720,
-	721, 722, 696, 610, 0, 618, 619, 0, 702, 710,
-	711, 671, 187, 200, 282, 724, 349, 250, 432, 416,
-	414, 598, 613, 230, 624, 0, 0, 637, 644, 645,
-	657, 659, 660, 661, 662, 670, 677, 678, 680, 687,
-	689, 691, 693, 698, 707, 727, 189, 190, 201, 208,
-	217, 229, 242, 248, 257, 260, 263, 266, 267, 269,
-	274, 291, 295, 296, 297, 298, 314, 315, 316, 319,
-	322, 323, 325, 327, 328, 331, 337, 338, 339, 340,
-	341, 343, 350, 354, 361, 362, 363, 364, 365, 366,
-	367, 371, 372, 373, 374, 382, 385, 399, 400, 410,
-	420, 424, 258, 407, 425, 0, 290, 669, 194, 220,
-	207, 227, 241, 243, 271, 299, 305, 333, 336, 255,
-	238, 218, 353, 216, 369, 388, 389, 390, 392, 303,
-	234, 714, 701, 0, 0, 652, 717, 623, 641, 726,
-	643, 646, 684, 604, 665, 321, 638, 0, 627, 600,
-	634, 601, 625, 654, 237, 658, 622, 703, 668, 716,
-	280, 0, 628, 334, 686, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 723,
-	284, 675, 0, 379, 306, 0, 0, 0, 656, 706,
-	663, 697, 651, 685, 612, 674, 718, 639, 682, 719,
-	270, 221, 192, 318, 380, 249, 0, 0, 0, 174,
-	175, 176, 0, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 219, 679, 713, 636, 681, 233, 268, 239,
-	232, 394, 683, 729, 599, 676, 0, 602, 605, 725,
-	709, 631, 632, 0, 0, 0, 0, 0, 0, 0,
-	655, 664, 694, 649, 0, 0, 0, 0, 0, 0,
-	1674, 0, 629, 0, 673, 0, 0, 0, 608, 603,
-	0, 0, 0, 0, 653, 0, 0, 0, 611, 0,
-	630, 695, 0, 597, 256, 606, 307, 699, 708, 650,
-	421, 712, 648, 647, 715, 690, 609, 705, 642, 279,
-	607, 276, 188, 202, 0, 640, 317, 355, 360, 704,
-	626, 635, 224, 633, 358, 330, 409, 209, 247, 352,
-	335, 356, 672, 688, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 406, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 205, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 621, 700, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 312, 206, 264, 377, 277,
-	286, 692, 728, 329, 359, 215, 411, 378, 616, 620,
-	614, 615, 666, 667, 617, 720, 721, 722, 696, 610,
-	0, 618, 619, 0, 702, 710, 711, 671, 187, 200,
-	282, 724, 349, 250, 432, 416, 414, 598, 613, 230,
-	624, 0, 0, 637, 644, 645, 657, 659, 660, 661,
-	662, 670, 677, 678, 680, 687, 689, 691, 693, 698,
-	707, 727, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 258, 407,
-	425, 0, 290, 669, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234, 714, 701, 0,
-	0, 652, 717, 623, 641, 726, 643, 646, 684, 604,
-	665, 321, 638, 0, 627, 600, 634, 601, 625, 654,
-	237, 658, 622, 703, 668, 716, 280, 0, 628, 334,
-	686, 370, 223, 289, 287, 396, 246, 240, 236, 222,
-	265, 294, 332, 387, 326, 723, 284, 675, 0, 379,
-	306, 0, 0, 0, 656, 706, 663, 697, 651, 685,
-	612, 674, 718, 639, 682, 719, 270, 221, 192, 318,
-	380, 249, 0, 0, 0, 174, 175, 176, 0, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 219, 679,
-	713, 636, 681, 233, 268, 239, 232, 394, 683, 729,
-	599, 676, 0, 602, 605, 725, 709, 631, 632, 0,
-	0, 0, 0, 0, 0, 0, 655, 664, 694, 649,
-	0, 0, 0, 0, 0, 0, 1399, 0, 629, 0,
-	673, 0, 0, 0, 608, 603, 0, 0, 0, 0,
-	653, 0, 0, 0, 611, 0, 630, 695, 0, 597,
-	256, 606, 307, 699, 708, 650, 421, 712, 648, 647,
-	715, 690, 609, 705, 642, 279, 607, 276, 188, 202,
-	0, 640, 317, 355, 360, 704, 626, 635, 224, 633,
-	358, 330, 409, 209, 247, 352, 335, 356, 672, 688,
-	357, 285, 398, 347, 408, 422, 423, 231, 311, 415,
-	391, 419, 431, 203, 228, 324, 384, 412, 376, 304,
-	395, 275, 375, 254, 191, 283, 195, 386, 406, 214,
-	368, 0, 0, 0, 197, 404, 383, 301, 272, 273,
-	196, 0, 351, 235, 252, 226, 320, 401, 402, 225,
-	433, 204, 418, 199, 205, 417, 313, 397, 405, 302,
-	293, 198, 403, 300, 292, 278, 245, 261, 345, 288,
-	346, 262, 309, 308, 310, 0, 193, 0, 381, 413,
-	434, 211, 621, 700, 393, 427, 430, 0, 348, 212,
-	253, 244, 344, 251, 281, 426, 428, 429, 210, 342,
-	259, 312, 206, 264, 377, 277, 286, 692, 728, 329,
-	359, 215, 411, 378, 616, 620, 614, 615, 666, 667,
-	617, 720, 721, 722, 696, 610, 0, 618, 619, 0,
-	702, 710, 711, 671, 187, 200, 282, 724, 349, 250,
-	432, 416, 414, 598, 613, 230, 624, 0, 0, 637,
-	644, 645, 657, 659, 660, 661, 662, 670, 677, 678,
-	680, 687, 689, 691, 693, 698, 707, 727, 189, 190,
-	201, 208, 217, 229, 242, 248, 257, 260, 263, 266,
-	267, 269, 274, 291, 295, 296, 297, 298, 314, 315,
-	316, 319, 322, 323, 325, 327, 328, 331, 337, 338,
-	339, 340, 341, 343, 350, 354, 361, 362, 363, 364,
-	365, 366, 367, 371, 372, 373, 374, 382, 385, 399,
-	400, 410, 420, 424, 258, 407, 425, 0, 290, 669,
-	194, 220, 207, 227, 241, 243, 271, 299, 305, 333,
-	336, 255, 238, 218, 353, 216, 369, 388, 389, 390,
-	392, 303, 234, 714, 701, 0, 0, 652, 717, 623,
-	641, 726, 643, 646, 684, 604, 665, 321, 638, 0,
-	627, 600, 634, 601, 625, 654, 237, 658, 622, 703,
-	668, 716, 280, 0, 628, 334, 686, 370, 223, 289,
-	287, 396, 246, 240, 236, 222, 265, 294, 332, 387,
-	326, 723, 284, 675, 0, 379, 306, 0, 0, 0,
-	656, 706, 663, 697, 651, 685, 612, 674, 718, 639,
-	682, 719, 270, 221, 192, 318, 380, 249, 0, 0,
-	0, 174, 175, 176, 0, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 219, 679, 713, 636, 681, 233,
-	268, 239, 232, 394, 683, 729, 599, 676, 0, 602,
-	605, 725, 709, 631, 632, 0, 0, 0, 0, 0,
-	0, 0, 655, 664, 694, 649, 0, 0, 0, 0,
-	0, 0, 0, 0, 629, 0, 673, 0, 0, 0,
-	608, 603, 0, 0, 0, 0, 653, 0, 0, 0,
-	611, 0, 630, 695, 0, 597, 256, 606, 307, 699,
-	708, 650, 421, 712, 648, 647, 715, 690, 609, 705,
-	642, 279, 607, 276, 188, 202, 0, 640, 317, 355,
-	360, 704, 626, 635, 224, 633, 358, 330, 409, 209,
-	247, 352, 335, 356, 672, 688, 357, 285, 398, 347,
-	408, 422, 423, 231, 311, 415, 391, 419, 431, 203,
-	228, 324, 384, 412, 376, 304, 395, 275, 375, 254,
-	191, 283, 195, 386, 406, 214, 368, 0, 0, 0,
-	197, 404, 383, 301, 272, 273, 196, 0, 351, 235,
-	252, 226, 320, 401, 402, 225, 433, 204, 418, 199,
-	205, 417, 313, 397, 405, 302, 293, 198, 403, 300,
-	292, 278, 245, 261, 345, 288, 346, 262, 309, 308,
-	310, 0, 193, 0, 381, 413, 434, 211, 621, 700,
-	393, 427, 430, 0, 348, 212, 253, 244, 344, 251,
-	281, 426, 428, 429, 210, 342, 259, 312, 206, 264,
-	377, 277, 286, 692, 728, 329, 359, 215, 411, 378,
-	616, 620, 614, 615, 666, 667, 617, 720, 721, 722,
-	696, 610, 0, 618, 619, 0, 702, 710, 711, 671,
-	187, 200, 282, 724, 349, 250, 432, 416, 414, 598,
-	613, 230, 624, 0, 0, 637, 644, 645, 657, 659,
-	660, 661, 662, 670, 677, 678, 680, 687, 689, 691,
-	693, 698, 707, 727, 189, 190, 201, 208, 217, 229,
-	242, 248, 257, 260, 263, 266, 267, 269, 274, 291,
-	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
-	325, 327, 328, 331, 337, 338, 339, 340, 341, 343,
-	350, 354, 361, 362, 363, 364, 365, 366, 367, 371,
-	372, 373, 374, 382, 385, 399, 400, 410, 420, 424,
-	258, 407, 425, 0, 290, 669, 194, 220, 207, 227,
-	241, 243, 271, 299, 305, 333, 336, 255, 238, 218,
-	353, 216, 369, 388, 389, 390, 392, 303, 234, 714,
-	701, 0, 0, 652, 717, 623, 641, 726, 643, 646,
-	684, 604, 665, 321, 638, 0, 627, 600, 634, 601,
-	625, 654, 237, 658, 622, 703, 668, 716, 280, 0,
-	628, 334, 686, 370, 223, 289, 287, 396, 246, 240,
-	236, 222, 265, 294, 332, 387, 326, 723, 284, 675,
-	0, 379, 306, 0, 0, 0, 656, 706, 663, 697,
-	651, 685, 612, 674, 718, 639, 682, 719, 270, 221,
-	192, 318, 380, 249, 0, 0, 0, 174, 175, 176,
-	0, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	219, 679, 713, 636, 681, 233, 268, 239, 232, 394,
-	683, 729, 599, 676, 0, 602, 605, 725, 709, 631,
-	632, 0, 0, 0, 0, 0, 0, 0, 655, 664,
-	694, 649, 0, 0, 0, 0, 0, 0, 0, 0,
-	629, 0, 673, 0, 0, 0, 608, 603, 0, 0,
-	0, 0, 653, 0, 0, 0, 611, 0, 630, 695,
-	0, 597, 256, 606, 307, 699, 708, 650, 421, 712,
-	648, 647, 715, 690, 609, 705, 642, 279, 607, 276,
-	188, 202, 0, 640, 317, 355, 360, 704, 626, 635,
-	224, 633, 358, 330, 409, 209, 247, 352, 335, 356,
-	672, 688, 357, 285, 398, 347, 408, 422, 423, 231,
-	311, 415, 391, 419, 431, 203, 228, 324, 384, 412,
-	376, 304, 395, 275, 375, 254, 191, 283, 195, 386,
-	406, 214, 368, 0, 0, 0, 197, 404, 383, 301,
-	272, 273, 196, 0, 351, 235, 252, 226, 320, 401,
-	402, 225, 433, 204, 418, 199, 731, 417, 313, 397,
-	405, 302, 293, 198, 403, 300, 292, 278, 245, 261,
-	345, 288, 346, 262, 309, 308, 310, 0, 193, 0,
-	381, 413, 434, 211, 621, 700, 393, 427, 430, 0,
-	348, 212, 253, 244, 344, 251, 281, 426, 428, 429,
-	210, 342, 259, 596, 730, 590, 589, 277, 286, 692,
-	728, 329, 359, 215, 411, 378, 616, 620, 614, 615,
-	666, 667, 617, 720, 721, 722, 696, 610, 0, 618,
-	619, 0, 702, 710, 711, 671, 187, 200, 282, 724,
-	349, 250, 432, 416, 414, 598, 613, 230, 624, 0,
-	0, 637, 644, 645, 657, 659, 660, 661, 662, 670,
-	677, 678, 680, 687, 689, 691, 693, 698, 707, 727,
-	189, 190, 201, 208, 217, 229, 242, 248, 257, 260,
-	263, 266, 267, 269, 274, 291, 295, 296, 297, 298,
-	314, 315, 316, 319, 322, 323, 325, 327, 328, 331,
-	337, 338, 339, 340, 341, 343, 350, 354, 361, 362,
-	363, 364, 365, 366, 367, 371, 372, 373, 374, 382,
-	385, 399, 400, 410, 420, 424, 258, 407, 425, 0,
-	290, 669, 194, 220, 207, 227, 241, 243, 271, 299,
-	305, 333, 336, 255, 238, 218, 353, 216, 369, 388,
-	389, 390, 392, 303, 234, 714, 701, 0, 0, 652,
-	717, 623, 641, 726, 643, 646, 684, 604, 665, 321,
-	638, 0, 627, 600, 634, 601, 625, 654, 237, 658,
-	622, 703, 668, 716, 280, 0, 628, 334, 686, 370,
-	223, 289, 287, 396, 246, 240, 236, 222, 265, 294,
-	332, 387, 326, 723, 284, 675, 0, 379, 306, 0,
-	0, 0, 656, 706, 663, 697, 651, 685, 612, 674,
-	718, 639, 682, 719, 270, 221, 192, 318, 380, 249,
-	0, 0, 0, 174, 175, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 219, 679, 713, 636,
-	681, 233, 268, 239, 232, 394, 683, 729, 599, 676,
-	0, 602, 605, 725, 709, 631, 632, 0, 0, 0,
-	0, 0, 0, 0, 655, 664, 694, 649, 0, 0,
-	0, 0, 0, 0, 0, 0, 629, 0, 673, 0,
-	0, 0, 608, 603, 0, 0, 0, 0, 653, 0,
-	0, 0, 611, 0, 630, 695, 0, 597, 256, 606,
-	307, 699, 708, 650, 421, 712, 648, 647, 715, 690,
-	609, 705, 642, 279, 607, 276, 188, 202, 0, 640,
-	317, 355, 360, 704, 626, 635, 224, 633, 358, 330,
-	409, 209, 247, 352, 335, 356, 672, 688, 357, 285,
-	398, 347, 408, 422, 423, 231, 311, 415, 391, 419,
-	431, 203, 228, 324, 384, 412, 376, 304, 395, 275,
-	375, 254, 191, 283, 195, 386, 1045, 214, 368, 0,
-	0, 0, 197, 404, 383, 301, 272, 273, 196, 0,
-	351, 235, 252, 226, 320, 401, 402, 225, 433, 204,
-	418, 199, 731, 417, 313, 397, 405, 302, 293, 198,
-	403, 300, 292, 278, 245, 261, 345, 288, 346, 262,
-	309, 308, 310, 0, 193, 0, 381, 413, 434, 211,
-	621, 700, 393, 427, 430, 0, 348, 212, 253, 244,
-	344, 251, 281, 426, 428, 429, 210, 342, 259, 596,
-	730, 590, 589, 277, 286, 692, 728, 329, 359, 215,
-	411, 378, 616, 620, 614, 615, 666, 667, 617, 720,
-	721, 722, 696, 610, 0, 618, 619, 0, 702, 710,
-	711, 671, 187, 200, 282, 724, 349, 250, 432, 416,
-	414, 598, 613, 230, 624, 0, 0, 637, 644, 645,
-	657, 659, 660, 661, 662, 670, 677, 678, 680, 687,
-	689, 691, 693, 698, 707, 727, 189, 190, 201, 208,
-	217, 229, 242, 248, 257, 260, 263, 266, 267, 269,
-	274, 291, 295, 296, 297, 298, 314, 315, 316, 319,
-	322, 323, 325, 327, 328, 331, 337, 338, 339, 340,
-	341, 343, 350, 354, 361, 362, 363, 364, 365, 366,
-	367, 371, 372, 373, 374, 382, 385, 399, 400, 410,
-	420, 424, 258, 407, 425, 0, 290, 669, 194, 220,
-	207, 227, 241, 243, 271, 299, 305, 333, 336, 255,
-	238, 218, 353, 216, 369, 388, 389, 390, 392, 303,
-	234, 714, 701, 0, 0, 652, 717, 623, 641, 726,
-	643, 646, 684, 604, 665, 321, 638, 0, 627, 600,
-	634, 601, 625, 654, 237, 658, 622, 703, 668, 716,
-	280, 0, 628, 334, 686, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 723,
-	284, 675, 0, 379, 306, 0, 0, 0, 656, 706,
-	663, 697, 651, 685, 612, 674, 718, 639, 682, 719,
-	270, 221, 192, 318, 380, 249, 0, 0, 0, 174,
-	175, 176, 0, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 219, 679, 713, 636, 681, 233, 268, 239,
-	232, 394, 683, 729, 599, 676, 0, 602, 605, 725,
-	709, 631, 632, 0, 0, 0, 0, 0, 0, 0,
-	655, 664, 694, 649, 0, 0, 0, 0, 0, 0,
-	0, 0, 629, 0, 673, 0, 0, 0, 608, 603,
-	0, 0, 0, 0, 653, 0, 0, 0, 611, 0,
-	630, 695, 0, 597, 256, 606, 307, 699, 708, 650,
-	421, 712, 648, 647, 715, 690, 609, 705, 642, 279,
-	607, 276, 188, 202, 0, 640, 317, 355, 360, 704,
-	626, 635, 224, 633, 358, 330, 409, 209, 247, 352,
-	335, 356, 672, 688, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 587, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 731, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 621, 700, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 596, 730, 590, 589, 277,
-	286, 692, 728, 329, 359, 215, 411, 378, 616, 620,
-	614, 615, 666, 667, 617, 720, 721, 722, 696, 610,
-	0, 618, 619, 0, 702, 710, 711, 671, 187, 200,
-	282, 724, 349, 250, 432, 416, 414, 598, 613, 230,
-	624, 0, 0, 637, 644, 645, 657, 659, 660, 661,
-	662, 670, 677, 678, 680, 687, 689, 691, 693, 698,
-	707, 727, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 258, 407,
-	425, 0, 290, 669, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234, 321, 0, 0,
-	1328, 0, 490, 0, 0, 0, 237, 0, 489, 0,
-	0, 0, 280, 0, 1329, 334, 0, 370, 223, 289,
-	287, 396, 246, 240, 236, 222, 265, 294, 332, 387,
-	326, 533, 284, 0, 0, 379, 306, 0, 0, 0,
-	0, 0, 524, 525, 0, 0, 0, 0, 0, 0,
-	0, 0, 270, 221, 192, 318, 380, 249, 67, 0,
-	0, 174, 175, 176, 511, 510, 513, 514, 515, 516,
-	0, 0, 213, 512, 219, 517, 518, 519, 0, 233,
-	268, 239, 232, 394, 0, 0, 0, 487, 504, 0,
-	532, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	501, 502, 577, 0, 0, 0, 548, 0, 503, 0,
-	0, 496, 497, 499, 498, 500, 505, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 0, 307, 547,
-	0, 0, 421, 0, 0, 545, 0, 0, 0, 0,
-	0, 279, 0, 276, 188, 202, 0, 0, 317, 355,
-	360, 0, 0, 0, 224, 0, 358, 330, 409, 209,
-	247, 352, 335, 356, 0, 0, 357, 285, 398, 347,
-	408, 422, 423, 231, 311, 415, 391, 419, 431, 203,
-	228, 324, 384, 412, 376, 304, 395, 275, 375, 254,
-	191, 283, 195, 386, 406, 214, 368, 0, 0, 0,
-	197, 404, 383, 301, 272, 273, 196, 0, 351, 235,
-	252, 226, 320, 401, 402, 225, 433, 204, 418, 199,
-	205, 417, 313, 397, 405, 302, 293, 198, 403, 300,
-	292, 278, 245, 261, 345, 288, 346, 262, 309, 308,
-	310, 0, 193, 0, 381, 413, 434, 211, 0, 0,
-	393, 427, 430, 0, 348, 212, 253, 244, 344, 251,
-	281, 426, 428, 429, 210, 342, 259, 312, 206, 264,
-	377, 277, 286, 0, 0, 329, 359, 215, 411, 378,
-	535, 546, 541, 542, 539, 540, 534, 538, 537, 536,
-	549, 526, 527, 528, 529, 531, 0, 543, 544, 530,
-	187, 200, 282, 0, 349, 250, 432, 416, 414, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 201, 208, 217, 229,
-	242, 248, 257, 260, 263, 266, 267, 269, 274, 291,
-	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
-	325, 327, 328, 331, 337, 338, 339, 340, 341, 343,
-	350, 354, 361, 362, 363, 364, 365, 366, 367, 371,
-	372, 373, 374, 382, 385, 399, 400, 410, 420, 424,
-	258, 407, 425, 0, 290, 0, 194, 220, 207, 227,
-	241, 243, 271, 299, 305, 333, 336, 255, 238, 218,
-	353, 216, 369, 388, 389, 390, 392, 303, 234, 321,
-	0, 0, 0, 0, 490, 0, 0, 0, 237, 0,
-	489, 0, 0, 0, 280, 0, 0, 334, 0, 370,
-	223, 289, 287, 396, 246, 240, 236, 222, 265, 294,
-	332, 387, 326, 533, 284, 0, 0, 379, 306, 0,
-	0, 0, 0, 0, 524, 525, 0, 0, 0, 0,
-	0, 0, 1438, 0, 270, 221, 192, 318, 380, 249,
-	67, 0, 0, 174, 175, 176, 511, 510, 513, 514,
-	515, 516, 0, 0, 213, 512, 219, 517, 518, 519,
-	1439, 233, 268, 239, 232, 394, 0, 0, 0, 487,
-	504, 0, 532, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 501, 502, 0, 0, 0, 0, 548, 0,
-	503, 0, 0, 496, 497, 499, 498, 500, 505, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 256, 0,
-	307, 547, 0, 0, 421, 0, 0, 545, 0, 0,
-	0, 0, 0, 279, 0, 276, 188, 202, 0, 0,
-	317, 355, 360, 0, 0, 0, 224, 0, 358, 330,
-	409, 209, 247, 352, 335, 356, 0, 0, 357, 285,
-	398, 347, 408, 422, 423, 231, 311, 415, 391, 419,
-	431, 203, 228, 324, 384, 412, 376, 304, 395, 275,
-	375, 254, 191, 283, 195, 386, 406, 214, 368, 0,
-	0, 0, 197, 404, 383, 301, 272, 273, 196, 0,
-	351, 235, 252, 226, 320, 401, 402, 225, 433, 204,
-	418, 199, 205, 417, 313, 397, 405, 302, 293, 198,
-	403, 300, 292, 278, 245, 261, 345, 288, 346, 262,
-	309, 308, 310, 0, 193, 0, 381, 413, 434, 211,
-	0, 0, 393, 427, 430, 0, 348, 212, 253, 244,
-	344, 251, 281, 426, 428, 429, 210, 342, 259, 312,
-	206, 264, 377, 277, 286, 0, 0, 329, 359, 215,
-	411, 378, 535, 546, 541, 542, 539, 540, 534, 538,
-	537, 536, 549, 526, 527, 528, 529, 531, 0, 543,
-	544, 530, 187, 200, 282, 0, 349, 250, 432, 416,
-	414, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 201, 208,
-	217, 229, 242, 248, 257, 260, 263, 266, 267, 269,
-	274, 291, 295, 296, 297, 298, 314, 315, 316, 319,
-	322, 323, 325, 327, 328, 331, 337, 338, 339, 340,
-	341, 343, 350, 354, 361, 362, 363, 364, 365, 366,
-	367, 371, 372, 373, 374, 382, 385, 399, 400, 410,
-	420, 424, 258, 407, 425, 0, 290, 0, 194, 220,
-	207, 227, 241, 243, 271, 299, 305, 333, 336, 255,
-	238, 218, 353, 216, 369, 388, 389, 390, 392, 303,
-	234, 321, 0, 0, 0, 0, 490, 0, 0, 0,
-	237, 0, 489, 0, 0, 0, 280, 0, 0, 334,
-	0, 370, 223, 289, 287, 396, 246, 240, 236, 222,
-	265, 294, 332, 387, 326, 533, 284, 0, 0, 379,
-	306, 0, 0, 0, 0, 0, 524, 525, 0, 0,
-	0, 0, 0, 0, 0, 0, 270, 221, 192, 318,
-	380, 249, 67, 0, 565, 174, 175, 176, 511, 510,
-	513, 514, 515, 516, 0, 0, 213, 512, 219, 517,
-	518, 519, 0, 233, 268, 239, 232, 394, 0, 0,
-	0, 487, 504, 0, 532, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 501, 502, 0, 0, 0, 0,
-	548, 0, 503, 0, 0, 496, 497, 499, 498, 500,
-	505, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	256, 0, 307, 547, 0, 0, 421, 0, 0, 545,
-	0, 0, 0, 0, 0, 279, 0, 276, 188, 202,
-	0, 0, 317, 355, 360, 0, 0, 0, 224, 0,
-	358, 330, 409, 209, 247, 352, 335, 356, 0, 0,
-	357, 285, 398, 347, 408, 422, 423, 231, 311, 415,
-	391, 419, 431, 203, 228, 324, 384, 412, 376, 304,
-	395, 275, 375, 254, 191, 283, 195, 386, 406, 214,
-	368, 0, 0, 0, 197, 404, 383, 301, 272, 273,
-	196, 0, 351, 235, 252, 226, 320, 401, 402, 225,
-	433, 204, 418, 199, 205, 417, 313, 397, 405, 302,
-	293, 198, 403, 300, 292, 278, 245, 261, 345, 288,
-	346, 262, 309, 308, 310, 0, 193, 0, 381, 413,
-	434, 211, 0, 0, 393, 427, 430, 0, 348, 212,
-	253, 244, 344, 251, 281, 426, 428, 429, 210, 342,
-	259, 312, 206, 264, 377, 277, 286, 0, 0, 329,
-	359, 215, 411, 378, 535, 546, 541, 542, 539, 540,
-	534, 538, 537, 536, 549, 526, 527, 528, 529, 531,
-	0, 543, 544, 530, 187, 200, 282, 0, 349, 250,
-	432, 416, 414, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	201, 208, 217, 229, 242, 248, 257, 260, 263, 266,
-	267, 269, 274, 291, 295, 296, 297, 298, 314, 315,
-	316, 319, 322, 323, 325, 327, 328, 331, 337, 338,
-	339, 340, 341, 343, 350, 354, 361, 362, 363, 364,
-	365, 366, 367, 371, 372, 373, 374, 382, 385, 399,
-	400, 410, 420, 424, 258, 407, 425, 0, 290, 0,
-	194, 220, 207, 227, 241, 243, 271, 299, 305, 333,
-	336, 255, 238, 218, 353, 216, 369, 388, 389, 390,
-	392, 303, 234, 321, 0, 0, 0, 0, 490, 0,
-	0, 0, 237, 0, 489, 0, 0, 0, 280, 0,
-	0, 334, 0, 370, 223, 289, 287, 396, 246, 240,
-	236, 222, 265, 294, 332, 387, 326, 533, 284, 0,
-	0, 379, 306, 0, 0, 0, 0, 0, 524, 525,
-	0, 0, 0, 0, 0, 0, 0, 0, 270, 221,
-	192, 318, 380, 249, 67, 0, 0, 174, 175, 176,
-	511, 510, 513, 514, 515, 516, 0, 0, 213, 512,
-	219, 517, 518, 519, 0, 233, 268, 239, 232, 394,
-	0, 0, 0, 487, 504, 0, 532, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 501, 502, 577, 0,
-	0, 0, 548, 0, 503, 0, 0, 496, 497, 499,
-	498, 500, 505, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 0, 307, 547, 0, 0, 421, 0,
-	0, 545, 0, 0, 0, 0, 0, 279, 0, 276,
-	188, 202, 0, 0, 317, 355, 360, 0, 0, 0,
-	224, 0, 358, 330, 409, 209, 247, 352, 335, 356,
-	0, 0, 357, 285, 398, 347, 408, 422, 423, 231,
-	311, 415, 391, 419, 431, 203, 228, 324, 384, 412,
-	376, 304, 395, 275, 375, 254, 191, 283, 195, 386,
-	406, 214, 368, 0, 0, 0, 197, 404, 383, 301,
-	272, 273, 196, 0, 351, 235, 252, 226, 320, 401,
-	402, 225, 433, 204, 418, 199, 205, 417, 313, 397,
-	405, 302, 293, 198, 403, 300, 292, 278, 245, 261,
-	345, 288, 346, 262, 309, 308, 310, 0, 193, 0,
-	381, 413, 434, 211, 0, 0, 393, 427, 430, 0,
-	348, 212, 253, 244, 344, 251, 281, 426, 428, 429,
-	210, 342, 259, 312, 206, 264, 377, 277, 286, 0,
-	0, 329, 359, 215, 411, 378, 535, 546, 541, 542,
-	539, 540, 534, 538, 537, 536, 549, 526, 527, 528,
-	529, 531, 0, 543, 544, 530, 187, 200, 282, 0,
-	349, 250, 432, 416, 414, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 201, 208, 217, 229, 242, 248, 257, 260,
-	263, 266, 267, 269, 274, 291, 295, 296, 297, 298,
-	314, 315, 316, 319, 322, 323, 325, 327, 328, 331,
-	337, 338, 339, 340, 341, 343, 350, 354, 361, 362,
-	363, 364, 365, 366, 367, 371, 372, 373, 374, 382,
-	385, 399, 400, 410, 420, 424, 258, 407, 425, 0,
-	290, 0, 194, 220, 207, 227, 241, 243, 271, 299,
-	305, 333, 336, 255, 238, 218, 353, 216, 369, 388,
-	389, 390, 392, 303, 234, 321, 0, 0, 0, 0,
-	490, 0, 0, 0, 237, 0, 489, 0, 0, 0,
-	280, 0, 0, 334, 0, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 533,
-	284, 0, 0, 379, 306, 0, 0, 0, 0, 0,
-	524, 525, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 221, 192, 318, 380, 249, 67, 0, 0, 174,
-	175, 176, 511, 1346, 513, 514, 515, 516, 0, 0,
-	213, 512, 219, 517, 518, 519, 0, 233, 268, 239,
-	232, 394, 0, 0, 0, 487, 504, 0, 532, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 501, 502,
-	577, 0, 0, 0, 548, 0, 503, 0, 0, 496,
-	497, 499, 498, 500, 505, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 256, 0, 307, 547, 0, 0,
-	421, 0, 0, 545, 0, 0, 0, 0, 0, 279,
-	0, 276, 188, 202, 0, 0, 317, 355, 360, 0,
-	0, 0, 224, 0, 358, 330, 409, 209, 247, 352,
-	335, 356, 0, 0, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 406, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 205, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 0, 0, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 312, 206, 264, 377, 277,
-	286, 0, 0, 329, 359, 215, 411, 378, 535, 546,
-	541, 542, 539, 540, 534, 538, 537, 536, 549, 526,
-	527, 528, 529, 531, 0, 543, 544, 530, 187, 200,
-	282, 0, 349, 250, 432, 416, 414, 0, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 258, 407,
-	425, 0, 290, 0, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234, 321, 0, 0,
-	0, 0, 490, 0, 0, 0, 237, 0, 489, 0,
-	0, 0, 280, 0, 0, 334, 0, 370, 223, 289,
-	287, 396, 246, 240, 236, 222, 265, 294, 332, 387,
-	326, 533, 284, 0, 0, 379, 306, 0, 0, 0,
-	0, 0, 524, 525, 0, 0, 0, 0, 0, 0,
-	0, 0, 270, 221, 192, 318, 380, 249, 67, 0,
-	0, 174, 175, 176, 511, 1343, 513, 514, 515, 516,
-	0, 0, 213, 512, 219, 517, 518, 519, 0, 233,
-	268, 239, 232, 394, 0, 0, 0, 487, 504, 0,
-	532, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	501, 502, 577, 0, 0, 0, 548, 0, 503, 0,
-	0, 496, 497, 499, 498, 500, 505, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 0, 307, 547,
-	0, 0, 421, 0, 0, 545, 0, 0, 0, 0,
-	0, 279, 0, 276, 188, 202, 0, 0, 317, 355,
-	360, 0, 0, 0, 224, 0, 358, 330, 409, 209,
-	247, 352, 335, 356, 0, 0, 357, 285, 398, 347,
-	408, 422, 423, 231, 311, 415, 391, 419, 431, 203,
-	228, 324, 384, 412, 376, 304, 395, 275, 375, 254,
-	191, 283, 195, 386, 406, 214, 368, 0, 0, 0,
-	197, 404, 383, 301, 272, 273, 196, 0, 351, 235,
-	252, 226, 320, 401, 402, 225, 433, 204, 418, 199,
-	205, 417, 313, 397, 405, 302, 293, 198, 403, 300,
-	292, 278, 245, 261, 345, 288, 346, 262, 309, 308,
-	310, 0, 193, 0, 381, 413, 434, 211, 0, 0,
-	393, 427, 430, 0, 348, 212, 253, 244, 344, 251,
-	281, 426, 428, 429, 210, 342, 259, 312, 206, 264,
-	377, 277, 286, 0, 0, 329, 359, 215, 411, 378,
-	535, 546, 541, 542, 539, 540, 534, 538, 537, 536,
-	549, 526, 527, 528, 529, 531, 0, 543, 544, 530,
-	187, 200, 282, 0, 349, 250, 432, 416, 414, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 201, 208, 217, 229,
-	242, 248, 257, 260, 263, 266, 267, 269, 274, 291,
-	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
-	325, 327, 328, 331, 337, 338, 339, 340, 341, 343,
-	350, 354, 361, 362, 363, 364, 365, 366, 367, 371,
-	372, 373, 374, 382, 385, 399, 400, 410, 420, 424,
-	258, 407, 425, 0, 290, 0, 194, 220, 207, 227,
-	241, 243, 271, 299, 305, 333, 336, 255, 238, 218,
-	353, 216, 369, 388, 389, 390, 392, 303, 234, 558,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 321, 0, 0, 0, 0, 490, 0, 0,
-	0, 237, 0, 489, 0, 0, 0, 280, 0, 0,
-	334, 0, 370, 223, 289, 287, 396, 246, 240, 236,
-	222, 265, 294, 332, 387, 326, 533, 284, 0, 0,
-	379, 306, 0, 0, 0, 0, 0, 524, 525, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 221, 192,
-	318, 380, 249, 67, 0, 0, 174, 175, 176, 511,
-	510, 513, 514, 515, 516, 0, 0, 213, 512, 219,
-	517, 518, 519, 0, 233, 268, 239, 232, 394, 0,
-	0, 0, 487, 504, 0, 532, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 501, 502, 0, 0, 0,
-	0, 548, 0, 503, 0, 0, 496, 497, 499, 498,
-	500, 505, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 307, 547, 0, 0, 421, 0, 0,
-	545, 0, 0, 0, 0, 0, 279, 0, 276, 188,
-	202, 0, 0, 317, 355, 360, 0, 0, 0, 224,
-	0, 358, 330, 409, 209, 247, 352, 335, 356, 0,
-	0, 357, 285, 398, 347, 408, 422, 423, 231, 311,
-	415, 391, 419, 431, 203, 228, 324, 384, 412, 376,
-	304, 395, 275, 375, 254, 191, 283, 195, 386, 406,
-	214, 368, 0, 0, 0, 197, 404, 383, 301, 272,
-	273, 196, 0, 351, 235, 252, 226, 320, 401, 402,
-	225, 433, 204, 418, 199, 205, 417, 313, 397, 405,
-	302, 293, 198, 403, 300, 292, 278, 245, 261, 345,
-	288, 346, 262, 309, 308, 310, 0, 193, 0, 381,
-	413, 434, 211, 0, 0, 393, 427, 430, 0, 348,
-	212, 253, 244, 344, 251, 281, 426, 428, 429, 210,
-	342, 259, 312, 206, 264, 377, 277, 286, 0, 0,
-	329, 359, 215, 411, 378, 535, 546, 541, 542, 539,
-	540, 534, 538, 537, 536, 549, 526, 527, 528, 529,
-	531, 0, 543, 544, 530, 187, 200, 282, 0, 349,
-	250, 432, 416, 414, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 201, 208, 217, 229, 242, 248, 257, 260, 263,
-	266, 267, 269, 274, 291, 295, 296, 297, 298, 314,
-	315, 316, 319, 322, 323, 325, 327, 328, 331, 337,
-	338, 339, 340, 341, 343, 350, 354, 361, 362, 363,
-	364, 365, 366, 367, 371, 372, 373, 374, 382, 385,
-	399, 400, 410, 420, 424, 258, 407, 425, 0, 290,
-	0, 194, 220, 207, 227, 241, 243, 271, 299, 305,
-	333, 336, 255, 238, 218, 353, 216, 369, 388, 389,
-	390, 392, 303, 234, 321, 0, 0, 0, 0, 490,
-	0, 0, 0, 237, 0, 489, 0, 0, 0, 280,
-	0, 0, 334, 0, 370, 223, 289, 287, 396, 246,
-	240, 236, 222, 265, 294, 332, 387, 326, 533, 284,
-	0, 0, 379, 306, 0, 0, 0, 0, 0, 524,
-	525, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	221, 192, 318, 380, 249, 67, 0, 0, 174, 175,
-	176, 511, 510, 513, 514, 515, 516, 0, 0, 213,
-	512, 219, 517, 518, 519, 0, 233, 268, 239, 232,
-	394, 0, 0, 0, 487, 504, 0, 532, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 501, 502, 0,
-	0, 0, 0, 548, 0, 503, 0, 0, 496, 497,
-	499, 498, 500, 505, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 307, 547, 0, 0, 421,
-	0, 0, 545, 0, 0, 0, 0, 0, 279, 0,
-	276, 188, 202, 0, 0, 317, 355, 360, 0, 0,
-	0, 224, 0, 358, 330, 409, 209, 247, 352, 335,
-	356, 0, 0, 357, 285, 398, 347, 408, 422, 423,
-	231, 311, 415, 391, 419, 431, 203, 228, 324, 384,
-	412, 376, 304, 395, 275, 375, 254, 191, 283, 195,
-	386, 406, 214, 368, 0, 0, 0, 197, 404, 383,
-	301, 272, 273, 196, 0, 351, 235, 252, 226, 320,
-	401, 402, 225, 433, 204, 418, 199, 205, 417, 313,
-	397, 405, 302, 293, 198, 403, 300, 292, 278, 245,
-	261, 345, 288, 346, 262, 309, 308, 310, 0, 193,
-	0, 381, 413, 434, 211, 0, 0, 393, 427, 430,
-	0, 348, 212, 253, 244, 344, 251, 281, 426, 428,
-	429, 210, 342, 259, 312, 206, 264, 377, 277, 286,
-	0, 0, 329, 359, 215, 411, 378, 535, 546, 541,
-	542, 539, 540, 534, 538, 537, 536, 549, 526, 527,
-	528, 529, 531, 0, 543, 544, 530, 187, 200, 282,
-	0, 349, 250, 432, 416, 414, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 201, 208, 217, 229, 242, 248, 257,
-	260, 263, 266, 267, 269, 274, 291, 295, 296, 297,
-	298, 314, 315, 316, 319, 322, 323, 325, 327, 328,
-	331, 337, 338, 339, 340, 341, 343, 350, 354, 361,
-	362, 363, 364, 365, 366, 367, 371, 372, 373, 374,
-	382, 385, 399, 400, 410, 420, 424, 258, 407, 425,
-	0, 290, 0, 194, 220, 207, 227, 241, 243, 271,
-	299, 305, 333, 336, 255, 238, 218, 353, 216, 369,
-	388, 389, 390, 392, 303, 234, 321, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	0, 280, 0, 0, 334, 0, 370, 223, 289, 287,
-	396, 246, 240, 236, 222, 265, 294, 332, 387, 326,
-	533, 284, 0, 0, 379, 306, 0, 0, 0, 0,
-	0, 524, 525, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 221, 192, 318, 380, 249, 67, 0, 0,
-	174, 175, 176, 511, 510, 513, 514, 515, 516, 0,
-	0, 213, 512, 219, 517, 518, 519, 0, 233, 268,
-	239, 232, 394, 0, 0, 0, 0, 504, 0, 532,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 501,
-	502, 0, 0, 0, 0, 548, 0, 503, 0, 0,
-	496, 497, 499, 498, 500, 505, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 307, 547, 0,
-	0, 421, 0, 0, 545, 0, 0, 0, 0, 0,
-	279, 0, 276, 188, 202, 0, 0, 317, 355, 360,
-	0, 0, 0, 224, 0, 358, 330, 409, 209, 247,
-	352, 335, 356, 2087, 0, 357, 285, 398, 347, 408,
-	422, 423, 231, 311, 415, 391, 419, 431, 203, 228,
-	324, 384, 412, 376, 304, 395, 275, 375, 254, 191,
-	283, 195, 386, 406, 214, 368, 0, 0, 0, 197,
-	404, 383, 301, 272, 273, 196, 0, 351, 235, 252,
-	226, 320, 401, 402, 225, 433, 204, 418, 199, 205,
-	417, 313, 397, 405, 302, 293, 198, 403, 300, 292,
-	278, 245, 261, 345, 288, 346, 262, 309, 308, 310,
-	0, 193, 0, 381, 413, 434, 211, 0, 0, 393,
-	427, 430, 0, 348, 212, 253, 244, 344, 251, 281,
-	426, 428, 429, 210, 342, 259, 312, 206, 264, 377,
-	277, 286, 0, 0, 329, 359, 215, 411, 378, 535,
-	546, 541, 542, 539, 540, 534, 538, 537, 536, 549,
-	526, 527, 528, 529, 531, 0, 543, 544, 530, 187,
-	200, 282, 0, 349, 250, 432, 416, 414, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 201, 208, 217, 229, 242,
-	248, 257, 260, 263, 266, 267, 269, 274, 291, 295,
-	296, 297, 298, 314, 315, 316, 319, 322, 323, 325,
-	327, 328, 331, 337, 338, 339, 340, 341, 343, 350,
-	354, 361, 362, 363, 364, 365, 366, 367, 371, 372,
-	373, 374, 382, 385, 399, 400, 410, 420, 424, 258,
-	407, 425, 0, 290, 0, 194, 220, 207, 227, 241,
-	243, 271, 299, 305, 333, 336, 255, 238, 218, 353,
-	216, 369, 388, 389, 390, 392, 303, 234, 321, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 0, 280, 0, 0, 334, 0, 370, 223,
-	289, 287, 396, 246, 240, 236, 222, 265, 294, 332,
-	387, 326, 533, 284, 0, 0, 379, 306, 0, 0,
-	0, 0, 0, 524, 525, 0, 0, 0, 0, 0,
-	0, 0, 0, 270, 221, 192, 318, 380, 249, 67,
-	0, 565, 174, 175, 176, 511, 510, 513, 514, 515,
-	516, 0, 0, 213, 512, 219, 517, 518, 519, 0,
-	233, 268, 239, 232, 394, 0, 0, 0, 0, 504,
-	0, 532, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 501, 502, 0, 0, 0, 0, 548, 0, 503,
-	0, 0, 496, 497, 499, 498, 500, 505, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 307,
-	547, 0, 0, 421, 0, 0, 545, 0, 0, 0,
-	0, 0, 279, 0, 276, 188, 202, 0, 0, 317,
-	355, 360, 0, 0, 0, 224, 0, 358, 330, 409,
-	209, 247, 352, 335, 356, 0, 0, 357, 285, 398,
-	347, 408, 422, 423, 231, 311, 415, 391, 419, 431,
-	203, 228, 324, 384, 412, 376, 304, 395, 275, 375,
-	254, 191, 283, 195, 386, 406, 214, 368, 0, 0,
-	0, 197, 404, 383, 301, 272, 273, 196, 0, 351,
-	235, 252, 226, 320, 401, 402, 225, 433, 204, 418,
-	199, 205, 417, 313, 397, 405, 302, 293, 198, 403,
-	300, 292, 278, 245, 261, 345, 288, 346, 262, 309,
-	308, 310, 0, 193, 0, 381, 413, 434, 211, 0,
-	0, 393, 427, 430, 0, 348, 212, 253, 244, 344,
-	251, 281, 426, 428, 429, 210, 342, 259, 312, 206,
-	264, 377, 277, 286, 0, 0, 329, 359, 215, 411,
-	378, 535, 546, 541, 542, 539, 540, 534, 538, 537,
-	536, 549, 526, 527, 528, 529, 531, 0, 543, 544,
-	530, 187, 200, 282, 0, 349, 250, 432, 416, 414,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 201, 208, 217,
-	229, 242, 248, 257, 260, 263, 266, 267, 269, 274,
-	291, 295, 296, 297, 298, 314, 315, 316, 319, 322,
-	323, 325, 327, 328, 331, 337, 338, 339, 340, 341,
-	343, 350, 354, 361, 362, 363, 364, 365, 366, 367,
-	371, 372, 373, 374, 382, 385, 399, 400, 410, 420,
-	424, 258, 407, 425, 0, 290, 0, 194, 220, 207,
-	227, 241, 243, 271, 299, 305, 333, 336, 255, 238,
-	218, 353, 216, 369, 388, 389, 390, 392, 303, 234,
-	321, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 280, 0, 0, 334, 0,
-	370, 223, 289, 287, 396, 246, 240, 236, 222, 265,
-	294, 332, 387, 326, 533, 284, 0, 0, 379, 306,
-	0, 0, 0, 0, 0, 524, 525, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 221, 192, 318, 380,
-	249, 67, 0, 0, 174, 175, 176, 511, 510, 513,
-	514, 515, 516, 0, 0, 213, 512, 219, 517, 518,
-	519, 0, 233, 268, 239, 232, 394, 0, 0, 0,
-	0, 504, 0, 532, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 501, 502, 0, 0, 0, 0, 548,
-	0, 503, 0, 0, 496, 497, 499, 498, 500, 505,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 307, 547, 0, 0, 421, 0, 0, 545, 0,
-	0, 0, 0, 0, 279, 0, 276, 188, 202, 0,
-	0, 317, 355, 360, 0, 0, 0, 224, 0, 358,
-	330, 409, 209, 247, 352, 335, 356, 0, 0, 357,
-	285, 398, 347, 408, 422, 423, 231, 311, 415, 391,
-	419, 431, 203, 228, 324, 384, 412, 376, 304, 395,
-	275, 375, 254, 191, 283, 195, 386, 406, 214, 368,
-	0, 0, 0, 197, 404, 383, 301, 272, 273, 196,
-	0, 351, 235, 252, 226, 320, 401, 402, 225, 433,
-	204, 418, 199, 205, 417, 313, 397, 405, 302, 293,
-	198, 403, 300, 292, 278, 245, 261, 345, 288, 346,
-	262, 309, 308, 310, 0, 193, 0, 381, 413, 434,
-	211, 0, 0, 393, 427, 430, 0, 348, 212, 253,
-	244, 344, 251, 281, 426, 428, 429, 210, 342, 259,
-	312, 206, 264, 377, 277, 286, 0, 0, 329, 359,
-	215, 411, 378, 535, 546, 541, 542, 539, 540, 534,
-	538, 537, 536, 549, 526, 527, 528, 529, 531, 0,
-	543, 544, 530, 187, 200, 282, 0, 349, 250, 432,
-	416, 414, 0, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
-	208, 217, 229, 242, 248, 257, 260, 263, 266, 267,
-	269, 274, 291, 295, 296, 297, 298, 314, 315, 316,
-	319, 322, 323, 325, 327, 328, 331, 337, 338, 339,
-	340, 341, 343, 350, 354, 361, 362, 363, 364, 365,
-	366, 367, 371, 372, 373, 374, 382, 385, 399, 400,
-	410, 420, 424, 258, 407, 425, 0, 290, 0, 194,
-	220, 207, 227, 241, 243, 271, 299, 305, 333, 336,
-	255, 238, 218, 353, 216, 369, 388, 389, 390, 392,
-	303, 234, 321, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 280, 0, 0,
-	334, 0, 370, 223, 289, 287, 396, 246, 240, 236,
-	222, 265, 294, 332, 387, 326, 0, 284, 0, 0,
-	379, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 221, 192,
-	318, 380, 249, 0, 0, 0, 174, 175, 176, 0,
-	0, 0, 0, 0, 0, 0, 0, 213, 0, 219,
-	0, 0, 0, 0, 233, 268, 239, 232, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 922, 921, 931, 932, 924, 925, 926, 927, 928,
-	929, 930, 923, 0, 0, 933, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 307, 0, 0, 0, 421, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 0, 276, 188,
-	202, 0, 0, 317, 355, 360, 0, 0, 0, 224,
-	0, 358, 330, 409, 209, 247, 352, 335, 356, 0,
-	0, 357, 285, 398, 347, 408, 422, 423, 231, 311,
-	415, 391, 419, 431, 203, 228, 324, 384, 412, 376,
-	304, 395, 275, 375, 254, 191, 283, 195, 386, 406,
-	214, 368, 0, 0, 0, 197, 404, 383, 301, 272,
-	273, 196, 0, 351, 235, 252, 226, 320, 401, 402,
-	225, 433, 204, 418, 199, 205, 417, 313, 397, 405,
-	302, 293, 198, 403, 300, 292, 278, 245, 261, 345,
-	288, 346, 262, 309, 308, 310, 0, 193, 0, 381,
-	413, 434, 211, 0, 0, 393, 427, 430, 0, 348,
-	212, 253, 244, 344, 251, 281, 426, 428, 429, 210,
-	342, 259, 312, 206, 264, 377, 277, 286, 0, 0,
-	329, 359, 215, 411, 378, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 200, 282, 0, 349,
-	250, 432, 416, 414, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 201, 208, 217, 229, 242, 248, 257, 260, 263,
-	266, 267, 269, 274, 291, 295, 296, 297, 298, 314,
-	315, 316, 319, 322, 323, 325, 327, 328, 331, 337,
-	338, 339, 340, 341, 343, 350, 354, 361, 362, 363,
-	364, 365, 366, 367, 371, 372, 373, 374, 382, 385,
-	399, 400, 410, 420, 424, 258, 407, 425, 0, 290,
-	0, 194, 220, 207, 227, 241, 243, 271, 299, 305,
-	333, 336, 255, 238, 218, 353, 216, 369, 388, 389,
-	390, 392, 303, 234, 321, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 772, 0, 0, 0, 0, 280,
-	0, 0, 334, 0, 370, 223, 289, 287, 396, 246,
-	240, 236, 222, 265, 294, 332, 387, 326, 0, 284,
-	0, 0, 379, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	221, 192, 318, 380, 249, 0, 0, 0, 174, 175,
-	176, 0, 0, 0, 0, 0, 0, 0, 0, 213,
-	0, 219, 0, 0, 0, 0, 233, 268, 239, 232,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 307, 0, 0, 771, 421,
-	0, 0, 0, 0, 0, 0, 768, 769, 279, 739,
-	276, 188, 202, 762, 766, 317, 355, 360, 0, 0,
-	0, 224, 0, 358, 330, 409, 209, 247, 352, 335,
-	356, 0, 0, 357, 285, 398, 347, 408, 422, 423,
-	231, 311, 415, 391, 419, 431, 203, 228, 324, 384,
-	412, 376, 304, 395, 275, 375, 254, 191, 283, 195,
-	386, 406, 214, 368, 0, 0, 0, 197, 404, 383,
-	301, 272, 273, 196, 0, 351, 235, 252, 226, 320,
-	401, 402, 225, 433, 204, 418, 199, 205, 417, 313,
-	397, 405, 302, 293, 198, 403, 300, 292, 278, 245,
-	261, 345, 288, 346, 262, 309, 308, 310, 0, 193,
-	0, 381, 413, 434, 211, 0, 0, 393, 427, 430,
-	0, 348, 212, 253, 244, 344, 251, 281, 426, 428,
-	429, 210, 342, 259, 312, 206, 264, 377, 277, 286,
-	0, 0, 329, 359, 215, 411, 378, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 200, 282,
-	0, 349, 250, 432, 416, 414, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 201, 208, 217, 229, 242, 248, 257,
-	260, 263, 266, 267, 269, 274, 291, 295, 296, 297,
-	298, 314, 315, 316, 319, 322, 323, 325, 327, 328,
-	331, 337, 338, 339, 340, 341, 343, 350, 354, 361,
-	362, 363, 364, 365, 366, 367, 371, 372, 373, 374,
-	382, 385, 399, 400, 410, 420, 424, 258, 407, 425,
-	0, 290, 0, 194, 220, 207, 227, 241, 243, 271,
-	299, 305, 333, 336, 255, 238, 218, 353, 216, 369,
-	388, 389, 390, 392, 303, 234, 321, 0, 0, 0,
-	1023, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	0, 280, 0, 0, 334, 0, 370, 223, 289, 287,
-	396, 246, 240, 236, 222, 265, 294, 332, 387, 326,
-	0, 284, 0, 0, 379, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 221, 192, 318, 380, 249, 0, 0, 0,
-	174, 175, 176, 0, 1025, 0, 0, 0, 0, 0,
-	0, 213, 0, 219, 0, 0, 0, 0, 233, 268,
-	239, 232, 394, 911, 912, 910, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 913, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 307, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 0, 276, 188, 202, 0, 0, 317, 355, 360,
-	0, 0, 0, 224, 0, 358, 330, 409, 209, 247,
-	352, 335, 356, 0, 0, 357, 285, 398, 347, 408,
-	422, 423, 231, 311, 415, 391, 419, 431, 203, 228,
-	324, 384, 412, 376, 304, 395, 275, 375, 254, 191,
-	283, 195, 386, 406, 214, 368, 0, 0, 0, 197,
-	404, 383, 301, 272, 273, 196, 0, 351, 235, 252,
-	226, 320, 401, 402, 225, 433, 204, 418, 199, 205,
-	417, 313, 397, 405, 302, 293, 198, 403, 300, 292,
-	278, 245, 261, 345, 288, 346, 262, 309, 308, 310,
-	0, 193, 0, 381, 413, 434, 211, 0, 0, 393,
-	427, 430, 0, 348, 212, 253, 244, 344, 251, 281,
-	426, 428, 429, 210, 342, 259, 312, 206, 264, 377,
-	277, 286, 0, 0, 329, 359, 215, 411, 378, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	200, 282, 0, 349, 250, 432, 416, 414, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 201, 208, 217, 229, 242,
-	248, 257, 260, 263, 266, 267, 269, 274, 291, 295,
-	296, 297, 298, 314, 315, 316, 319, 322, 323, 325,
-	327, 328, 331, 337, 338, 339, 340, 341, 343, 350,
-	354, 361, 362, 363, 364, 365, 366, 367, 371, 372,
-	373, 374, 382, 385, 399, 400, 410, 420, 424, 258,
-	407, 425, 0, 290, 0, 194, 220, 207, 227, 241,
-	243, 271, 299, 305, 333, 336, 255, 238, 218, 353,
-	216, 369, 388, 389, 390, 392, 303, 234, 33, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 321, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 0, 0, 0, 0, 0, 280, 0, 0, 334,
-	0, 370, 223, 289, 287, 396, 246, 240, 236, 222,
-	265, 294, 332, 387, 326, 0, 284, 0, 0, 379,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 270, 221, 192, 318,
-	380, 249, 67, 0, 565, 174, 175, 176, 0, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 219, 0,
-	0, 0, 0, 233, 268, 239, 232, 394, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	256, 0, 307, 0, 0, 0, 421, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 0, 276, 188, 202,
-	0, 0, 317, 355, 360, 0, 0, 0, 224, 0,
-	358, 330, 409, 209, 247, 352, 335, 356, 0, 0,
-	357, 285, 398, 347, 408, 422, 423, 231, 311, 415,
-	391, 419, 431, 203, 228, 324, 384, 412, 376, 304,
-	395, 275, 375, 254, 191, 283, 195, 386, 406, 214,
-	368, 0, 0, 0, 197, 404, 383, 301, 272, 273,
-	196, 0, 351, 235, 252, 226, 320, 401, 402, 225,
-	433, 204, 418, 199, 205, 417, 313, 397, 405, 302,
-	293, 198, 403, 300, 292, 278, 245, 261, 345, 288,
-	346, 262, 309, 308, 310, 0, 193, 0, 381, 413,
-	434, 211, 0, 0, 393, 427, 430, 0, 348, 212,
-	253, 244, 344, 251, 281, 426, 428, 429, 210, 342,
-	259, 312, 206, 264, 377, 277, 286, 0, 0, 329,
-	359, 215, 411, 378, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 200, 282, 0, 349, 250,
-	432, 416, 414, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	201, 208, 217, 229, 242, 248, 257, 260, 263, 266,
-	267, 269, 274, 291, 295, 296, 297, 298, 314, 315,
-	316, 319, 322, 323, 325, 327, 328, 331, 337, 338,
-	339, 340, 341, 343, 350, 354, 361, 362, 363, 364,
-	365, 366, 367, 371, 372, 373, 374, 382, 385, 399,
-	400, 410, 420, 424, 258, 407, 425, 0, 290, 0,
-	194, 220, 207, 227, 241, 243, 271, 299, 305, 333,
-	336, 255, 238, 218, 353, 216, 369, 388, 389, 390,
-	392, 303, 234, 321, 0, 0, 0, 1373, 0, 0,
-	0, 0, 237, 0, 0, 0, 0, 0, 280, 0,
-	0, 334, 0, 370, 223, 289, 287, 396, 246, 240,
-	236, 222, 265, 294, 332, 387, 326, 0, 284, 0,
-	0, 379, 306, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 270, 221,
-	192, 318, 380, 249, 0, 0, 0, 174, 175, 176,
-	0, 1375, 0, 0, 0, 0, 0, 0, 213, 0,
-	219, 0, 0, 0, 0, 233, 268, 239, 232, 394,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 0, 307, 0, 0, 0, 421, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 0, 276,
-	188, 202, 0, 0, 317, 355, 360, 0, 0, 0,
-	224, 0, 358, 330, 409, 209, 247, 352, 335, 356,
-	0, 1371, 357, 285, 398, 347, 408, 422, 423, 231,
-	311, 415, 391, 419, 431, 203, 228, 324, 384, 412,
-	376, 304, 395, 275, 375, 254, 191, 283, 195, 386,
-	406, 214, 368, 0, 0, 0, 197, 404, 383, 301,
-	272, 273, 196, 0, 351, 235, 252, 226, 320, 401,
-	402, 225, 433, 204, 418, 199, 205, 417, 313, 397,
-	405, 302, 293, 198, 403, 300, 292, 278, 245, 261,
-	345, 288, 346, 262, 309, 308, 310, 0, 193, 0,
-	381, 413, 434, 211, 0, 0, 393, 427, 430, 0,
-	348, 212, 253, 244, 344, 251, 281, 426, 428, 429,
-	210, 342, 259, 312, 206, 264, 377, 277, 286, 0,
-	0, 329, 359, 215, 411, 378, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 200, 282, 0,
-	349, 250, 432, 416, 414, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 201, 208, 217, 229, 242, 248, 257, 260,
-	263, 266, 267, 269, 274, 291, 295, 296, 297, 298,
-	314, 315, 316, 319, 322, 323, 325, 327, 328, 331,
-	337, 338, 339, 340, 341, 343, 350, 354, 361, 362,
-	363, 364, 365, 366, 367, 371, 372, 373, 374, 382,
-	385, 399, 400, 410, 420, 424, 258, 407, 425, 0,
-	290, 0, 194, 220, 207, 227, 241, 243, 271, 299,
-	305, 333, 336, 255, 238, 218, 353, 216, 369, 388,
-	389, 390, 392, 303, 234, 321, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	280, 0, 0, 334, 0, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 0,
-	284, 0, 0, 379, 306, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 221, 192, 318, 380, 249, 0, 0, 0, 174,
-	175, 176, 0, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 219, 0, 0, 0, 0, 233, 268, 239,
-	232, 394, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 733, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 256, 0, 307, 0, 0, 0,
-	421, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	739, 276, 188, 202, 737, 0, 317, 355, 360, 0,
-	0, 0, 224, 0, 358, 330, 409, 209, 247, 352,
-	335, 356, 0, 0, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 406, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 205, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 0, 0, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 312, 206, 264, 377, 277,
-	286, 0, 0, 329, 359, 215, 411, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 200,
-	282, 0, 349, 250, 432, 416, 414, 0, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 258, 407,
-	425, 0, 290, 0, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234, 321, 0, 0,
-	0, 1373, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 280, 0, 0, 334, 0, 370, 223, 289,
-	287, 396, 246, 240, 236, 222, 265, 294, 332, 387,
-	326, 0, 284, 0, 0, 379, 306, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 270, 221, 192, 318, 380, 249, 0, 0,
-	0, 174, 175, 176, 0, 1375, 0, 0, 0, 0,
-	0, 0, 213, 0, 219, 0, 0, 0, 0, 233,
-	268, 239, 232, 394, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 0, 307, 0,
-	0, 0, 421, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 0, 276, 188, 202, 0, 0, 317, 355,
-	360, 0, 0, 0, 224, 0, 358, 330, 409, 209,
-	247, 352, 335, 356, 0, 0, 357, 285, 398, 347,
-	408, 422, 423, 231, 311, 415, 391, 419, 431, 203,
-	228, 324, 384, 412, 376, 304, 395, 275, 375, 254,
-	191, 283, 195, 386, 406, 214, 368, 0, 0, 0,
-	197, 404, 383, 301, 272, 273, 196, 0, 351, 235,
-	252, 226, 320, 401, 402, 225, 433, 204, 418, 199,
-	205, 417, 313, 397, 405, 302, 293, 198, 403, 300,
-	292, 278, 245, 261, 345, 288, 346, 262, 309, 308,
-	310, 0, 193, 0, 381, 413, 434, 211, 0, 0,
-	393, 427, 430, 0, 348, 212, 253, 244, 344, 251,
-	281, 426, 428, 429, 210, 342, 259, 312, 206, 264,
-	377, 277, 286, 0, 0, 329, 359, 215, 411, 378,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 200, 282, 0, 349, 250, 432, 416, 414, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 201, 208, 217, 229,
-	242, 248, 257, 260, 263, 266, 267, 269, 274, 291,
-	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
-	325, 327, 328, 331, 337, 338, 339, 340, 341, 343,
-	350, 354, 361, 362, 363, 364, 365, 366, 367, 371,
-	372, 373, 374, 382, 385, 399, 400, 410, 420, 424,
-	258, 407, 425, 0, 290, 0, 194, 220, 207, 227,
-	241, 243, 271, 299, 305, 333, 336, 255, 238, 218,
-	353, 216, 369, 388, 389, 390, 392, 303, 234, 321,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
-	0, 0, 0, 0, 280, 0, 0, 334, 0, 370,
-	223, 289, 287, 396, 246, 240, 236, 222, 265, 294,
-	332, 387, 326, 0, 284, 0, 0, 379, 306, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 270, 221, 192, 318, 380, 249,
-	0, 0, 565, 174, 175, 176, 0, 0, 0, 0,
-	0, 0, 0, 0, 213, 0, 219, 0, 0, 0,
-	0, 233, 268, 239, 232, 394, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 256, 0,
-	307, 0, 0, 0, 421, 0, 0, 0, 0, 1986,
-	0, 0, 0, 279, 0, 276, 188, 202, 0, 0,
-	317, 355, 360, 0, 0, 0, 224, 0, 358, 330,
-	409, 209, 247, 352, 335, 356, 0, 0, 357, 285,
-	398, 347, 408, 422, 423, 231, 311, 415, 391, 419,
-	431, 203, 228, 324, 384, 412, 376, 304, 395, 275,
-	375, 254, 191, 283, 195, 386, 406, 214, 368, 0,
-	0, 0, 197, 404, 383, 301, 272, 273, 196, 0,
-	351, 235, 252, 226, 320, 401, 402, 225, 433, 204,
-	418, 199, 205, 417, 313, 397, 405, 302, 293, 198,
-	403, 300, 292, 278, 245, 261, 345, 288, 346, 262,
-	309, 308, 310, 0, 193, 0, 381, 413, 434, 211,
-	0, 0, 393, 427, 430, 0, 348, 212, 253, 244,
-	344, 251, 281, 426, 428, 429, 210, 342, 259, 312,
-	206, 264, 377, 277, 286, 0, 0, 329, 359, 215,
-	411, 378, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 200, 282, 0, 349, 250, 432, 416,
-	414, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 201, 208,
-	217, 229, 242, 248, 257, 260, 263, 266, 267, 269,
-	274, 291, 295, 296, 297, 298, 314, 315, 316, 319,
-	322, 323, 325, 327, 328, 331, 337, 338, 339, 340,
-	341, 343, 350, 354, 361, 362, 363, 364, 365, 366,
-	367, 371, 372, 373, 374, 382, 385, 399, 400, 410,
-	420, 424, 258, 407, 425, 0, 290, 0, 194, 220,
-	207, 227, 241, 243, 271, 299, 305, 333, 336, 255,
-	238, 218, 353, 216, 369, 388, 389, 390, 392, 303,
-	234, 33, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 321, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 280,
-	0, 0, 334, 0, 370, 223, 289, 287, 396, 246,
-	240, 236, 222, 265, 294, 332, 387, 326, 0, 284,
-	0, 0, 379, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	221, 192, 318, 380, 249, 67, 0, 0, 174, 175,
-	176, 0, 0, 0, 0, 0, 0, 0, 0, 213,
-	0, 219, 0, 0, 0, 0, 233, 268, 239, 232,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 307, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
-	276, 188, 202, 0, 0, 317, 355, 360, 0, 0,
-	0, 224, 0, 358, 330, 409, 209, 247, 352, 335,
-	356, 0, 0, 357, 285, 398, 347, 408, 422, 423,
-	231, 311, 415, 391, 419, 431, 203, 228, 324, 384,
-	412, 376, 304, 395, 275, 375, 254, 191, 283, 195,
-	386, 406, 214, 368, 0, 0, 0, 197, 404, 383,
-	301, 272, 273, 196, 0, 351, 235, 252, 226, 320,
-	401, 402, 225, 433, 204, 418, 199, 205, 417, 313,
-	397, 405, 302, 293, 198, 403, 300, 292, 278, 245,
-	261, 345, 288, 346, 262, 309, 308, 310, 0, 193,
-	0, 381, 413, 434, 211, 0, 0, 393, 427, 430,
-	0, 348, 212, 253, 244, 344, 251, 281, 426, 428,
-	429, 210, 342, 259, 312, 206, 264, 377, 277, 286,
-	0, 0, 329, 359, 215, 411, 378, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 200, 282,
-	0, 349, 250, 432, 416, 414, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 201, 208, 217, 229, 242, 248, 257,
-	260, 263, 266, 267, 269, 274, 291, 295, 296, 297,
-	298, 314, 315, 316, 319, 322, 323, 325, 327, 328,
-	331, 337, 338, 339, 340, 341, 343, 350, 354, 361,
-	362, 363, 364, 365, 366, 367, 371, 372, 373, 374,
-	382, 385, 399, 400, 410, 420, 424, 258, 407, 425,
-	0, 290, 0, 194, 220, 207, 227, 241, 243, 271,
-	299, 305, 333, 336, 255, 238, 218, 353, 216, 369,
-	388, 389, 390, 392, 303, 234, 321, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	0, 280, 0, 0, 334, 0, 370, 223, 289, 287,
-	396, 246, 240, 236, 222, 265, 294, 332, 387, 326,
-	0, 284, 0, 0, 379, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 221, 192, 318, 380, 249, 0, 0, 0,
-	174, 175, 176, 0, 0, 1391, 0, 0, 1392, 0,
-	0, 213, 0, 219, 0, 0, 0, 0, 233, 268,
-	239, 232, 394, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 307, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 0, 276, 188, 202, 0, 0, 317, 355, 360,
-	0, 0, 0, 224, 0, 358, 330, 409, 209, 247,
-	352, 335, 356, 0, 0, 357, 285, 398, 347, 408,
-	422, 423, 231, 311, 415, 391, 419, 431, 203, 228,
-	324, 384, 412, 376, 304, 395, 275, 375, 254, 191,
-	283, 195, 386, 406, 214, 368, 0, 0, 0, 197,
-	404, 383, 301, 272, 273, 196, 0, 351, 235, 252,
-	226, 320, 401, 402, 225, 433, 204, 418, 199, 205,
-	417, 313, 397, 405, 302, 293, 198, 403, 300, 292,
-	278, 245, 261, 345, 288, 346, 262, 309, 308, 310,
-	0, 193, 0, 381, 413, 434, 211, 0, 0, 393,
-	427, 430, 0, 348, 212, 253, 244, 344, 251, 281,
-	426, 428, 429, 210, 342, 259, 312, 206, 264, 377,
-	277, 286, 0, 0, 329, 359, 215, 411, 378, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	200, 282, 0, 349, 250, 432, 416, 414, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 201, 208, 217, 229, 242,
-	248, 257, 260, 263, 266, 267, 269, 274, 291, 295,
-	296, 297, 298, 314, 315, 316, 319, 322, 323, 325,
-	327, 328, 331, 337, 338, 339, 340, 341, 343, 350,
-	354, 361, 362, 363, 364, 365, 366, 367, 371, 372,
-	373, 374, 382, 385, 399, 400, 410, 420, 424, 258,
-	407, 425, 0, 290, 0, 194, 220, 207, 227, 241,
-	243, 271, 299, 305, 333, 336, 255, 238, 218, 353,
-	216, 369, 388, 389, 390, 392, 303, 234, 321, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 0, 1056,
-	0, 0, 0, 280, 0, 0, 334, 0, 370, 223,
-	289, 287, 396, 246, 240, 236, 222, 265, 294, 332,
-	387, 326, 0, 284, 0, 0, 379, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 270, 221, 192, 318, 380, 249, 0,
-	0, 0, 174, 175, 176, 0, 1055, 0, 0, 0,
-	0, 0, 0, 213, 0, 219, 0, 0, 0, 0,
-	233, 268, 239, 232, 394, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 307,
-	0, 0, 0, 421, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 0, 276, 188, 202, 0, 0, 317,
-	355, 360, 0, 0, 0, 224, 0, 358, 330, 409,
-	209, 247, 352, 335, 356, 0, 0, 357, 285, 398,
-	347, 408, 422, 423, 231, 311, 415, 391, 419, 431,
-	203, 228, 324, 384, 412, 376, 304, 395, 275, 375,
-	254, 191, 283, 195, 386, 406, 214, 368, 0, 0,
-	0, 197, 404, 383, 301, 272, 273, 196, 0, 351,
-	235, 252, 226, 320, 401, 402, 225, 433, 204, 418,
-	199, 205, 417, 313, 397, 405, 302, 293, 198, 403,
-	300, 292, 278, 245, 261, 345, 288, 346, 262, 309,
-	308, 310, 0, 193, 0, 381, 413, 434, 211, 0,
-	0, 393, 427, 430, 0, 348, 212, 253, 244, 344,
-	251, 281, 426, 428, 429, 210, 342, 259, 312, 206,
-	264, 377, 277, 286, 0, 0, 329, 359, 215, 411,
-	378, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 200, 282, 0, 349, 250, 432, 416, 414,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 201, 208, 217,
-	229, 242, 248, 257, 260, 263, 266, 267, 269, 274,
-	291, 295, 296, 297, 298, 314, 315, 316, 319, 322,
-	323, 325, 327, 328, 331, 337, 338, 339, 340, 341,
-	343, 350, 354, 361, 362, 363, 364, 365, 366, 367,
-	371, 372, 373, 374, 382, 385, 399, 400, 410, 420,
-	424, 258, 407, 425, 0, 290, 0, 194, 220, 207,
-	227, 241, 243, 271, 299, 305, 333, 336, 255, 238,
-	218, 353, 216, 369, 388, 389, 390, 392, 303, 234,
-	321, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 280, 0, 0, 334, 0,
-	370, 223, 289, 287, 396, 246, 240, 236, 222, 265,
-	294, 332, 387, 326, 0, 284, 0, 0, 379, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 221, 192, 318, 380,
-	249, 0, 0, 0, 174, 175, 176, 0, 0, 0,
-	0, 0, 0, 0, 0, 213, 0, 219, 0, 0,
-	0, 0, 233, 268, 239, 232, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 307, 0, 0, 0, 421, 0, 0, 0, 0,
-	2072, 0, 0, 0, 279, 0, 276, 188, 202, 0,
-	0, 317, 355, 360, 0, 0, 0, 224, 0, 358,
-	330, 409, 209, 247, 352, 335, 356, 0, 0, 357,
-	285, 398, 347, 408, 422, 423, 231, 311, 415, 391,
-	419, 431, 203, 228, 324, 384, 412, 376, 304, 395,
-	275, 375, 254, 191, 283, 195, 386, 406, 214, 368,
-	0, 0, 0, 197, 404, 383, 301, 272, 273, 196,
-	0, 351, 235, 252, 226, 320, 401, 402, 225, 433,
-	204, 418, 199, 205, 417, 313, 397, 405, 302, 293,
-	198, 403, 300, 292, 278, 245, 261, 345, 288, 346,
-	262, 309, 308, 310, 0, 193, 0, 381, 413, 434,
-	211, 0, 0, 393, 427, 430, 0, 348, 212, 253,
-	244, 344, 251, 281, 426, 428, 429, 210, 342, 259,
-	312, 206, 264, 377, 277, 286, 0, 0, 329, 359,
-	215, 411, 378, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 200, 282, 0, 349, 250, 432,
-	416, 414, 0, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
-	208, 217, 229, 242, 248, 257, 260, 263, 266, 267,
-	269, 274, 291, 295, 296, 297, 298, 314, 315, 316,
-	319, 322, 323, 325, 327, 328, 331, 337, 338, 339,
-	340, 341, 343, 350, 354, 361, 362, 363, 364, 365,
-	366, 367, 371, 372, 373, 374, 382, 385, 399, 400,
-	410, 420, 424, 258, 407, 425, 0, 290, 0, 194,
-	220, 207, 227, 241, 243, 271, 299, 305, 333, 336,
-	255, 238, 218, 353, 216, 369, 388, 389, 390, 392,
-	303, 234, 321, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 280, 0, 0,
-	334, 0, 370, 223, 289, 287, 396, 246, 240, 236,
-	222, 265, 294, 332, 387, 326, 0, 284, 0, 0,
-	379, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 221, 192,
-	318, 380, 249, 0, 0, 0, 174, 175, 176, 0,
-	0, 0, 0, 0, 0, 0, 0, 213, 0, 219,
-	0, 0, 0, 0, 233, 268, 239, 232, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 307, 0, 0, 0, 421, 0, 0,
-	0, 0, 1986, 0, 0, 0, 279, 0, 276, 188,
-	202, 0, 0, 317, 355, 360, 0, 0, 0, 224,
-	0, 358, 330, 409, 209, 247, 352, 335, 356, 0,
-	0, 357, 285, 398, 347, 408, 422, 423, 231, 311,
-	415, 391, 419, 431, 203, 228, 324, 384, 412, 376,
-	304, 395, 275, 375, 254, 191, 283, 195, 386, 406,
-	214, 368, 0, 0, 0, 197, 404, 383, 301, 272,
-	273, 196, 0, 351, 235, 252, 226, 320, 401, 402,
-	225, 433, 204, 418, 199, 205, 417, 313, 397, 405,
-	302, 293, 198, 403, 300, 292, 278, 245, 261, 345,
-	288, 346, 262, 309, 308, 310, 0, 193, 0, 381,
-	413, 434, 211, 0, 0, 393, 427, 430, 0, 348,
-	212, 253, 244, 344, 251, 281, 426, 428, 429, 210,
-	342, 259, 312, 206, 264, 377, 277, 286, 0, 0,
-	329, 359, 215, 411, 378, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 200, 282, 0, 349,
-	250, 432, 416, 414, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 201, 208, 217, 229, 242, 248, 257, 260, 263,
-	266, 267, 269, 274, 291, 295, 296, 297, 298, 314,
-	315, 316, 319, 322, 323, 325, 327, 328, 331, 337,
-	338, 339, 340, 341, 343, 350, 354, 361, 362, 363,
-	364, 365, 366, 367, 371, 372, 373, 374, 382, 385,
-	399, 400, 410, 420, 424, 258, 407, 425, 0, 290,
-	0, 194, 220, 207, 227, 241, 243, 271, 299, 305,
-	333, 336, 255, 238, 218, 353, 216, 369, 388, 389,
-	390, 392, 303, 234, 321, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 280,
-	0, 0, 334, 0, 370, 223, 289, 287, 396, 246,
-	240, 236, 222, 265, 294, 332, 387, 326, 0, 284,
-	0, 0, 379, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	221, 192, 318, 380, 249, 67, 0, 0, 174, 175,
-	176, 0, 0, 0, 0, 0, 0, 0, 0, 213,
-	0, 219, 0, 0, 0, 0, 233, 268, 239, 232,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 307, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
-	276, 188, 202, 0, 0, 317, 355, 360, 0, 0,
-	0, 224, 0, 358, 330, 409, 209, 247, 352, 335,
-	356, 0, 0, 357, 285, 398, 347, 408, 422, 423,
-	231, 311, 415, 391, 419, 431, 203, 228, 324, 384,
-	412, 376, 304, 395, 275, 375, 254, 191, 283, 195,
-	386, 406, 214, 368, 0, 0, 0, 197, 404, 383,
-	301, 272, 273, 196, 0, 351, 235, 252, 226, 320,
-	401, 402, 225, 433, 204, 418, 199, 205, 417, 313,
-	397, 405, 302, 293, 198, 403, 300, 292, 278, 245,
-	261, 345, 288, 346, 262, 309, 308, 310, 0, 193,
-	0, 381, 413, 434, 211, 0, 0, 393, 427, 430,
-	0, 348, 212, 253, 244, 344, 251, 281, 426, 428,
-	429, 210, 342, 259, 312, 206, 264, 377, 277, 286,
-	0, 0, 329, 359, 215, 411, 378, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 200, 282,
-	0, 349, 250, 432, 416, 414, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 201, 208, 217, 229, 242, 248, 257,
-	260, 263, 266, 267, 269, 274, 291, 295, 296, 297,
-	298, 314, 315, 316, 319, 322, 323, 325, 327, 328,
-	331, 337, 338, 339, 340, 341, 343, 350, 354, 361,
-	362, 363, 364, 365, 366, 367, 371, 372, 373, 374,
-	382, 385, 399, 400, 410, 420, 424, 258, 407, 425,
-	0, 290, 0, 194, 220, 207, 227, 241, 243, 271,
-	299, 305, 333, 336, 255, 238, 218, 353, 216, 369,
-	388, 389, 390, 392, 303, 234, 321, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	0, 280, 0, 0, 334, 0, 370, 223, 289, 287,
-	396, 246, 240, 236, 222, 265, 294, 332, 387, 326,
-	0, 284, 0, 0, 379, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 221, 192, 318, 380, 249, 0, 0, 0,
-	174, 175, 176, 0, 1375, 0, 0, 0, 0, 0,
-	0, 213, 0, 219, 0, 0, 0, 0, 233, 268,
-	239, 232, 394, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 307, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 0, 276, 188, 202, 0, 0, 317, 355, 360,
-	0, 0, 0, 224, 0, 358, 330, 409, 209, 247,
-	352, 335, 356, 0, 0, 357, 285, 398, 347, 408,
-	422, 423, 231, 311, 415, 391, 419, 431, 203, 228,
-	324, 384, 412, 376, 304, 395, 275, 375, 254, 191,
-	283, 195, 386, 406, 214, 368, 0, 0, 0, 197,
-	404, 383, 301, 272, 273, 196, 0, 351, 235, 252,
-	226, 320, 401, 402, 225, 433, 204, 418, 199, 205,
-	417, 313, 397, 405, 302, 293, 198, 403, 300, 292,
-	278, 245, 261, 345, 288, 346, 262, 309, 308, 310,
-	0, 193, 0, 381, 413, 434, 211, 0, 0, 393,
-	427, 430, 0, 348, 212, 253, 244, 344, 251, 281,
-	426, 428, 429, 210, 342, 259, 312, 206, 264, 377,
-	277, 286, 0, 0, 329, 359, 215, 411, 378, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	200, 282, 0, 349, 250, 432, 416, 414, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 201, 208, 217, 229, 242,
-	248, 257, 260, 263, 266, 267, 269, 274, 291, 295,
-	296, 297, 298, 314, 315, 316, 319, 322, 323, 325,
-	327, 328, 331, 337, 338, 339, 340, 341, 343, 350,
-	354, 361, 362, 363, 364, 365, 366, 367, 371, 372,
-	373, 374, 382, 385, 399, 400, 410, 420, 424, 258,
-	407, 425, 0, 290, 0, 194, 220, 207, 227, 241,
-	243, 271, 299, 305, 333, 336, 255, 238, 218, 353,
-	216, 369, 388, 389, 390, 392, 303, 234, 321, 0,
-	0, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 0, 280, 0, 0, 334, 0, 370, 223,
-	289, 287, 396, 246, 240, 236, 222, 265, 294, 332,
-	387, 326, 0, 284, 0, 0, 379, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 270, 221, 192, 318, 380, 249, 0,
-	0, 0, 174, 175, 176, 0, 1025, 0, 0, 0,
-	0, 0, 0, 213, 0, 219, 0, 0, 0, 0,
-	233, 268, 239, 232, 394, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 307,
-	0, 0, 0, 421, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 0, 276, 188, 202, 0, 0, 317,
-	355, 360, 0, 0, 0, 224, 0, 358, 330, 409,
-	209, 247, 352, 335, 356, 0, 0, 357, 285, 398,
-	347, 408, 422, 423, 231, 311, 415, 391, 419, 431,
-	203, 228, 324, 384, 412, 376, 304, 395, 275, 375,
-	254, 191, 283, 195, 386, 406, 214, 368, 0, 0,
-	0, 197, 404, 383, 301, 272, 273, 196, 0, 351,
-	235, 252, 226, 320, 401, 402, 225, 433, 204, 418,
-	199, 205, 417, 313, 397, 405, 302, 293, 198, 403,
-	300, 292, 278, 245, 261, 345, 288, 346, 262, 309,
-	308, 310, 0, 193, 0, 381, 413, 434, 211, 0,
-	0, 393, 427, 430, 0, 348, 212, 253, 244, 344,
-	251, 281, 426, 428, 429, 210, 342, 259, 312, 206,
-	264, 377, 277, 286, 0, 0, 329, 359, 215, 411,
-	378, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 200, 282, 0, 349, 250, 432, 416, 414,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 201, 208, 217,
-	229, 242, 248, 257, 260, 263, 266, 267, 269, 274,
-	291, 295, 296, 297, 298, 314, 315, 316, 319, 322,
-	323, 325, 327, 328, 331, 337, 338, 339, 340, 341,
-	343, 350, 354, 361, 362, 363, 364, 365, 366, 367,
-	371, 372, 373, 374, 382, 385, 399, 400, 410, 420,
-	424, 258, 407, 425, 0, 290, 0, 194, 220, 207,
-	227, 241, 243, 271, 299, 305, 333, 336, 255, 238,
-	218, 353, 216, 369, 388, 389, 390, 392, 303, 234,
-	321, 0, 1175, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 280, 0, 0, 334, 0,
-	370, 223, 289, 287, 396, 246, 240, 236, 222, 265,
-	294, 332, 387, 326, 0, 284, 0, 0, 379, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 221, 192, 318, 380,
-	249, 0, 0, 0, 174, 175, 176, 0, 0, 0,
-	0, 0, 0, 0, 0, 213, 0, 219, 0, 0,
-	0, 0, 233, 268, 239, 232, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 307, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 0, 276, 188, 202, 0,
-	0, 317, 355, 360, 0, 0, 0, 224, 0, 358,
-	330, 409, 209, 247, 352, 335, 356, 0, 0, 357,
-	285, 398, 347, 408, 422, 423, 231, 311, 415, 391,
-	419, 431, 203, 228, 324, 384, 412, 376, 304, 395,
-	275, 375, 254, 191, 283, 195, 386, 406, 214, 368,
-	0, 0, 0, 197, 404, 383, 301, 272, 273, 196,
-	0, 351, 235, 252, 226, 320, 401, 402, 225, 433,
-	204, 418, 199, 205, 417, 313, 397, 405, 302, 293,
-	198, 403, 300, 292, 278, 245, 261, 345, 288, 346,
-	262, 309, 308, 310, 0, 193, 0, 381, 413, 434,
-	211, 0, 0, 393, 427, 430, 0, 348, 212, 253,
-	244, 344, 251, 281, 426, 428, 429, 210, 342, 259,
-	312, 206, 264, 377, 277, 286, 0, 0, 329, 359,
-	215, 411, 378, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 200, 282, 0, 349, 250, 432,
-	416, 414, 0, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
-	208, 217, 229, 242, 248, 257, 260, 263, 266, 267,
-	269, 274, 291, 295, 296, 297, 298, 314, 315, 316,
-	319, 322, 323, 325, 327, 328, 331, 337, 338, 339,
-	340, 341, 343, 350, 354, 361, 362, 363, 364, 365,
-	366, 367, 371, 372, 373, 374, 382, 385, 399, 400,
-	410, 420, 424, 258, 407, 425, 0, 290, 0, 194,
-	220, 207, 227, 241, 243, 271, 299, 305, 333, 336,
-	255, 238, 218, 353, 216, 369, 388, 389, 390, 392,
-	303, 234, 321, 0, 1173, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 280, 0, 0,
-	334, 0, 370, 223, 289, 287, 396, 246, 240, 236,
-	222, 265, 294, 332, 387, 326, 0, 284, 0, 0,
-	379, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 221, 192,
-	318, 380, 249, 0, 0, 0, 174, 175, 176, 0,
-	0, 0, 0, 0, 0, 0, 0, 213, 0, 219,
-	0, 0, 0, 0, 233, 268, 239, 232, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 307, 0, 0, 0, 421, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 0, 276, 188,
-	202, 0, 0, 317, 355, 360, 0, 0, 0, 224,
-	0, 358, 330, 409, 209, 247, 352, 335, 356, 0,
-	0, 357, 285, 398, 347, 408, 422, 423, 231, 311,
-	415, 391, 419, 431, 203, 228, 324, 384, 412, 376,
-	304, 395, 275, 375, 254, 191, 283, 195, 386, 406,
-	214, 368, 0, 0, 0, 197, 404, 383, 301, 272,
-	273, 196, 0, 351, 235, 252, 226, 320, 401, 402,
-	225, 433, 204, 418, 199, 205, 417, 313, 397, 405,
-	302, 293, 198, 403, 300, 292, 278, 245, 261, 345,
-	288, 346, 262, 309, 308, 310, 0, 193, 0, 381,
-	413, 434, 211, 0, 0, 393, 427, 430, 0, 348,
-	212, 253, 244, 344, 251, 281, 426, 428, 429, 210,
-	342, 259, 312, 206, 264, 377, 277, 286, 0, 0,
-	329, 359, 215, 411, 378, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 200, 282, 0, 349,
-	250, 432, 416, 414, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 201, 208, 217, 229, 242, 248, 257, 260, 263,
-	266, 267, 269, 274, 291, 295, 296, 297, 298, 314,
-	315, 316, 319, 322, 323, 325, 327, 328, 331, 337,
-	338, 339, 340, 341, 343, 350, 354, 361, 362, 363,
-	364, 365, 366, 367, 371, 372, 373, 374, 382, 385,
-	399, 400, 410, 420, 424, 258, 407, 425, 0, 290,
-	0, 194, 220, 207, 227, 241, 243, 271, 299, 305,
-	333, 336, 255, 238, 218, 353, 216, 369, 388, 389,
-	390, 392, 303, 234, 321, 0, 1171, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 280,
-	0, 0, 334, 0, 370, 223, 289, 287, 396, 246,
-	240, 236, 222, 265, 294, 332, 387, 326, 0, 284,
-	0, 0, 379, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	221, 192, 318, 380, 249, 0, 0, 0, 174, 175,
-	176, 0, 0, 0, 0, 0, 0, 0, 0, 213,
-	0, 219, 0, 0, 0, 0, 233, 268, 239, 232,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 307, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
-	276, 188, 202, 0, 0, 317, 355, 360, 0, 0,
-	0, 224, 0, 358, 330, 409, 209, 247, 352, 335,
-	356, 0, 0, 357, 285, 398, 347, 408, 422, 423,
-	231, 311, 415, 391, 419, 431, 203, 228, 324, 384,
-	412, 376, 304, 395, 275, 375, 254, 191, 283, 195,
-	386, 406, 214, 368, 0, 0, 0, 197, 404, 383,
-	301, 272, 273, 196, 0, 351, 235, 252, 226, 320,
-	401, 402, 225, 433, 204, 418, 199, 205, 417, 313,
-	397, 405, 302, 293, 198, 403, 300, 292, 278, 245,
-	261, 345, 288, 346, 262, 309, 308, 310, 0, 193,
-	0, 381, 413, 434, 211, 0, 0, 393, 427, 430,
-	0, 348, 212, 253, 244, 344, 251, 281, 426, 428,
-	429, 210, 342, 259, 312, 206, 264, 377, 277, 286,
-	0, 0, 329, 359, 215, 411, 378, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 200, 282,
-	0, 349, 250, 432, 416, 414, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 201, 208, 217, 229, 242, 248, 257,
-	260, 263, 266, 267, 269, 274, 291, 295, 296, 297,
-	298, 314, 315, 316, 319, 322, 323, 325, 327, 328,
-	331, 337, 338, 339, 340, 341, 343, 350, 354, 361,
-	362, 363, 364, 365, 366, 367, 371, 372, 373, 374,
-	382, 385, 399, 400, 410, 420, 424, 258, 407, 425,
-	0, 290, 0, 194, 220, 207, 227, 241, 243, 271,
-	299, 305, 333, 336, 255, 238, 218, 353, 216, 369,
-	388, 389, 390, 392, 303, 234, 321, 0, 1169, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	0, 280, 0, 0, 334, 0, 370, 223, 289, 287,
-	396, 246, 240, 236, 222, 265, 294, 332, 387, 326,
-	0, 284, 0, 0, 379, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 221, 192, 318, 380, 249, 0, 0, 0,
-	174, 175, 176, 0, 0, 0, 0, 0, 0, 0,
-	0, 213, 0, 219, 0, 0, 0, 0, 233, 268,
-	239, 232, 394, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 307, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 0, 276, 188, 202, 0, 0, 317, 355, 360,
-	0, 0, 0, 224, 0, 358, 330, 409, 209, 247,
-	352, 335, 356, 0, 0, 357, 285, 398, 347, 408,
-	422, 423, 231, 311, 415, 391, 419, 431, 203, 228,
-	324, 384, 412, 376, 304, 395, 275, 375, 254, 191,
-	283, 195, 386, 406, 214, 368, 0, 0, 0, 197,
-	404, 383, 301, 272, 273, 196, 0, 351, 235, 252,
-	226, 320, 401, 402, 225, 433, 204, 418, 199, 205,
-	417, 313, 397, 405, 302, 293, 198, 403, 300, 292,
-	278, 245, 261, 345, 288, 346, 262, 309, 308, 310,
-	0, 193, 0, 381, 413, 434, 211, 0, 0, 393,
-	427, 430, 0, 348, 212, 253, 244, 344, 251, 281,
-	426, 428, 429, 210, 342, 259, 312, 206, 264, 377,
-	277, 286, 0, 0, 329, 359, 215, 411, 378, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	200, 282, 0, 349, 250, 432, 416, 414, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 201, 208, 217, 229, 242,
-	248, 257, 260, 263, 266, 267, 269, 274, 291, 295,
-	296, 297, 298, 314, 315, 316, 319, 322, 323, 325,
-	327, 328, 331, 337, 338, 339, 340, 341, 343, 350,
-	354, 361, 362, 363, 364, 365, 366, 367, 371, 372,
-	373, 374, 382, 385, 399, 400, 410, 420, 424, 258,
-	407, 425, 0, 290, 0, 194, 220, 207, 227, 241,
-	243, 271, 299, 305, 333, 336, 255, 238, 218, 353,
-	216, 369, 388, 389, 390, 392, 303, 234, 321, 0,
-	1167, 0, 0, 0, 0, 0, 0, 237, 0, 0,
-	0, 0, 0, 280, 0, 0, 334, 0, 370, 223,
-	289, 287, 396, 246, 240, 236, 222, 265, 294, 332,
-	387, 326, 0, 284, 0, 0, 379, 306, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 270, 221, 192, 318, 380, 249, 0,
-	0, 0, 174, 175, 176, 0, 0, 0, 0, 0,
-	0, 0, 0, 213, 0, 219, 0, 0, 0, 0,
-	233, 268, 239, 232, 394, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 256, 0, 307,
-	0, 0, 0, 421, 0, 0, 0, 0, 0, 0,
-	0, 0, 279, 0, 276, 188, 202, 0, 0, 317,
-	355, 360, 0, 0, 0, 224, 0, 358, 330, 409,
-	209, 247, 352, 335, 356, 0, 0, 357, 285, 398,
-	347, 408, 422, 423, 231, 311, 415, 391, 419, 431,
-	203, 228, 324, 384, 412, 376, 304, 395, 275, 375,
-	254, 191, 283, 195, 386, 406, 214, 368, 0, 0,
-	0, 197, 404, 383, 301, 272, 273, 196, 0, 351,
-	235, 252, 226, 320, 401, 402, 225, 433, 204, 418,
-	199, 205, 417, 313, 397, 405, 302, 293, 198, 403,
-	300, 292, 278, 245, 261, 345, 288, 346, 262, 309,
-	308, 310, 0, 193, 0, 381, 413, 434, 211, 0,
-	0, 393, 427, 430, 0, 348, 212, 253, 244, 344,
-	251, 281, 426, 428, 429, 210, 342, 259, 312, 206,
-	264, 377, 277, 286, 0, 0, 329, 359, 215, 411,
-	378, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 200, 282, 0, 349, 250, 432, 416, 414,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 189, 190, 201, 208, 217,
-	229, 242, 248, 257, 260, 263, 266, 267, 269, 274,
-	291, 295, 296, 297, 298, 314, 315, 316, 319, 322,
-	323, 325, 327, 328, 331, 337, 338, 339, 340, 341,
-	343, 350, 354, 361, 362, 363, 364, 365, 366, 367,
-	371, 372, 373, 374, 382, 385, 399, 400, 410, 420,
-	424, 258, 407, 425, 0, 290, 0, 194, 220, 207,
-	227, 241, 243, 271, 299, 305, 333, 336, 255, 238,
-	218, 353, 216, 369, 388, 389, 390, 392, 303, 234,
-	321, 0, 1163, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 280, 0, 0, 334, 0,
-	370, 223, 289, 287, 396, 246, 240, 236, 222, 265,
-	294, 332, 387, 326, 0, 284, 0, 0, 379, 306,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 270, 221, 192, 318, 380,
-	249, 0, 0, 0, 174, 175, 176, 0, 0, 0,
-	0, 0, 0, 0, 0, 213, 0, 219, 0, 0,
-	0, 0, 233, 268, 239, 232, 394, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 256,
-	0, 307, 0, 0, 0, 421, 0, 0, 0, 0,
-	0, 0, 0, 0, 279, 0, 276, 188, 202, 0,
-	0, 317, 355, 360, 0, 0, 0, 224, 0, 358,
-	330, 409, 209, 247, 352, 335, 356, 0, 0, 357,
-	285, 398, 347, 408, 422, 423, 231, 311, 415, 391,
-	419, 431, 203, 228, 324, 384, 412, 376, 304, 395,
-	275, 375, 254, 191, 283, 195, 386, 406, 214, 368,
-	0, 0, 0, 197, 404, 383, 301, 272, 273, 196,
-	0, 351, 235, 252, 226, 320, 401, 402, 225, 433,
-	204, 418, 199, 205, 417, 313, 397, 405, 302, 293,
-	198, 403, 300, 292, 278, 245, 261, 345, 288, 346,
-	262, 309, 308, 310, 0, 193, 0, 381, 413, 434,
-	211, 0, 0, 393, 427, 430, 0, 348, 212, 253,
-	244, 344, 251, 281, 426, 428, 429, 210, 342, 259,
-	312, 206, 264, 377, 277, 286, 0, 0, 329, 359,
-	215, 411, 378, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 200, 282, 0, 349, 250, 432,
-	416, 414, 0, 0, 230, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
-	208, 217, 229, 242, 248, 257, 260, 263, 266, 267,
-	269, 274, 291, 295, 296, 297, 298, 314, 315, 316,
-	319, 322, 323, 325, 327, 328, 331, 337, 338, 339,
-	340, 341, 343, 350, 354, 361, 362, 363, 364, 365,
-	366, 367, 371, 372, 373, 374, 382, 385, 399, 400,
-	410, 420, 424, 258, 407, 425, 0, 290, 0, 194,
-	220, 207, 227, 241, 243, 271, 299, 305, 333, 336,
-	255, 238, 218, 353, 216, 369, 388, 389, 390, 392,
-	303, 234, 321, 0, 1161, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 280, 0, 0,
-	334, 0, 370, 223, 289, 287, 396, 246, 240, 236,
-	222, 265, 294, 332, 387, 326, 0, 284, 0, 0,
-	379, 306, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 270, 221, 192,
-	318, 380, 249, 0, 0, 0, 174, 175, 176, 0,
-	0, 0, 0, 0, 0, 0, 0, 213, 0, 219,
-	0, 0, 0, 0, 233, 268, 239, 232, 394, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 256, 0, 307, 0, 0, 0, 421, 0, 0,
-	0, 0, 0, 0, 0, 0, 279, 0, 276, 188,
-	202, 0, 0, 317, 355, 360, 0, 0, 0, 224,
-	0, 358, 330, 409, 209, 247, 352, 335, 356, 0,
-	0, 357, 285, 398, 347, 408, 422, 423, 231, 311,
-	415, 391, 419, 431, 203, 228, 324, 384, 412, 376,
-	304, 395, 275, 375, 254, 191, 283, 195, 386, 406,
-	214, 368, 0, 0, 0, 197, 404, 383, 301, 272,
-	273, 196, 0, 351, 235, 252, 226, 320, 401, 402,
-	225, 433, 204, 418, 199, 205, 417, 313, 397, 405,
-	302, 293, 198, 403, 300, 292, 278, 245, 261, 345,
-	288, 346, 262, 309, 308, 310, 0, 193, 0, 381,
-	413, 434, 211, 0, 0, 393, 427, 430, 0, 348,
-	212, 253, 244, 344, 251, 281, 426, 428, 429, 210,
-	342, 259, 312, 206, 264, 377, 277, 286, 0, 0,
-	329, 359, 215, 411, 378, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 200, 282, 0, 349,
-	250, 432, 416, 414, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
-	190, 201, 208, 217, 229, 242, 248, 257, 260, 263,
-	266, 267, 269, 274, 291, 295, 296, 297, 298, 314,
-	315, 316, 319, 322, 323, 325, 327, 328, 331, 337,
-	338, 339, 340, 341, 343, 350, 354, 361, 362, 363,
-	364, 365, 366, 367, 371, 372, 373, 374, 382, 385,
-	399, 400, 410, 420, 424, 258, 407, 425, 0, 290,
-	0, 194, 220, 207, 227, 241, 243, 271, 299, 305,
-	333, 336, 255, 238, 218, 353, 216, 369, 388, 389,
-	390, 392, 303, 234, 321, 0, 1159, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 280,
-	0, 0, 334, 0, 370, 223, 289, 287, 396, 246,
-	240, 236, 222, 265, 294, 332, 387, 326, 0, 284,
-	0, 0, 379, 306, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 270,
-	221, 192, 318, 380, 249, 0, 0, 0, 174, 175,
-	176, 0, 0, 0, 0, 0, 0, 0, 0, 213,
-	0, 219, 0, 0, 0, 0, 233, 268, 239, 232,
-	394, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 256, 0, 307, 0, 0, 0, 421,
-	0, 0, 0, 0, 0, 0, 0, 0, 279, 0,
-	276, 188, 202, 0, 0, 317, 355, 360, 0, 0,
-	0, 224, 0, 358, 330, 409, 209, 247, 352, 335,
-	356, 0, 0, 357, 285, 398, 347, 408, 422, 423,
-	231, 311, 415, 391, 419, 431, 203, 228, 324, 384,
-	412, 376, 304, 395, 275, 375, 254, 191, 283, 195,
-	386, 406, 214, 368, 0, 0, 0, 197, 404, 383,
-	301, 272, 273, 196, 0, 351, 235, 252, 226, 320,
-	401, 402, 225, 433, 204, 418, 199, 205, 417, 313,
-	397, 405, 302, 293, 198, 403, 300, 292, 278, 245,
-	261, 345, 288, 346, 262, 309, 308, 310, 0, 193,
-	0, 381, 413, 434, 211, 0, 0, 393, 427, 430,
-	0, 348, 212, 253, 244, 344, 251, 281, 426, 428,
-	429, 210, 342, 259, 312, 206, 264, 377, 277, 286,
-	0, 0, 329, 359, 215, 411, 378, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 187, 200, 282,
-	0, 349, 250, 432, 416, 414, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 189, 190, 201, 208, 217, 229, 242, 248, 257,
-	260, 263, 266, 267, 269, 274, 291, 295, 296, 297,
-	298, 314, 315, 316, 319, 322, 323, 325, 327, 328,
-	331, 337, 338, 339, 340, 341, 343, 350, 354, 361,
-	362, 363, 364, 365, 366, 367, 371, 372, 373, 374,
-	382, 385, 399, 400, 410, 420, 424, 258, 407, 425,
-	0, 290, 0, 194, 220, 207, 227, 241, 243, 271,
-	299, 305, 333, 336, 255, 238, 218, 353, 216, 369,
-	388, 389, 390, 392, 303, 234, 321, 0, 0, 0,
-	0, 0, 0, 0, 0, 237, 0, 0, 0, 0,
-	0, 280, 0, 0, 334, 0, 370, 223, 289, 287,
-	396, 246, 240, 236, 222, 265, 294, 332, 387, 326,
-	0, 284, 0, 0, 379, 306, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 270, 221, 192, 318, 380, 249, 1134, 0, 0,
-	174, 175, 176, 0, 0, 0, 0, 0, 0, 0,
-	0, 213, 0, 219, 0, 0, 0, 0, 233, 268,
-	239, 232, 394, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 256, 0, 307, 0, 0,
-	0, 421, 0, 0, 0, 0, 0, 0, 0, 0,
-	279, 0, 276, 188, 202, 0, 0, 317, 355, 360,
-	0, 0, 0, 224, 0, 358, 330, 409, 209, 247,
-	352, 335, 356, 0, 0, 357, 285, 398, 347, 408,
-	422, 423, 231, 311, 415, 391, 419, 431, 203, 228,
-	324, 384, 412, 376, 304, 395, 275, 375, 254, 191,
-	283, 195, 386, 406, 214, 368, 0, 0, 0, 197,
-	404, 383, 301, 272, 273, 196, 0, 351, 235, 252,
-	226, 320, 401, 402, 225, 433, 204, 418, 199, 205,
-	417, 313, 397, 405, 302, 293, 198, 403, 300, 292,
-	278, 245, 261, 345, 288, 346, 262, 309, 308, 310,
-	0, 193, 0, 381, 413, 434, 211, 0, 0, 393,
-	427, 430, 0, 348, 212, 253, 244, 344, 251, 281,
-	426, 428, 429, 210, 342, 259, 312, 206, 264, 377,
-	277, 286, 0, 0, 329, 359, 215, 411, 378, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	200, 282, 0, 349, 250, 432, 416, 414, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 189, 190, 201, 208, 217, 229, 242,
-	248, 257, 260, 263, 266, 267, 269, 274, 291, 295,
-	296, 297, 298, 314, 315, 316, 319, 322, 323, 325,
-	327, 328, 331, 337, 338, 339, 340, 341, 343, 350,
-	354, 361, 362, 363, 364, 365, 366, 367, 371, 372,
-	373, 374, 382, 385, 399, 400, 410, 420, 424, 258,
-	407, 425, 0, 290, 0, 194, 220, 207, 227, 241,
-	243, 271, 299, 305, 333, 336, 255, 238, 218, 353,
-	216, 369, 388, 389, 390, 392, 303, 234, 1038, 0,
-	0, 0, 0, 0, 0, 321, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	280, 0, 0, 334, 0, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 0,
-	284, 0, 0, 379, 306, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 221, 192, 318, 380, 249, 0, 0, 0, 174,
-	175, 176, 0, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 219, 0, 0, 0, 0, 233, 268, 239,
-	232, 394, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 256, 0, 307, 0, 0, 0,
-	421, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	0, 276, 188, 202, 0, 0, 317, 355, 360, 0,
-	0, 0, 224, 0, 358, 330, 409, 209, 247, 352,
-	335, 356, 0, 0, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 406, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 205, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 0, 0, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 312, 206, 264, 377, 277,
-	286, 0, 0, 329, 359, 215, 411, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 200,
-	282, 0, 349, 250, 432, 416, 414, 0, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 258, 407,
-	425, 0, 290, 0, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234, 321, 0, 0,
-	0, 0, 0, 0, 0, 1029, 237, 0, 0, 0,
-	0, 0, 280, 0, 0, 334, 0, 370, 223, 289,
-	287, 396, 246, 240, 236, 222, 265, 294, 332, 387,
-	326, 0, 284, 0, 0, 379, 306, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 270, 221, 192, 318, 380, 249, 0, 0,
-	0, 174, 175, 176, 0, 0, 0, 0, 0, 0,
-	0, 0, 213, 0, 219, 0, 0, 0, 0, 233,
-	268, 239, 232, 394, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 256, 0, 307, 0,
-	0, 0, 421, 0, 0, 0, 0, 0, 0, 0,
-	0, 279, 0, 276, 188, 202, 0, 0, 317, 355,
-	360, 0, 0, 0, 224, 0, 358, 330, 409, 209,
-	247, 352, 335, 356, 0, 0, 357, 285, 398, 347,
-	408, 422, 423, 231, 311, 415, 391, 419, 431, 203,
-	228, 324, 384, 412, 376, 304, 395, 275, 375, 254,
-	191, 283, 195, 386, 406, 214, 368, 0, 0, 0,
-	197, 404, 383, 301, 272, 273, 196, 0, 351, 235,
-	252, 226, 320, 401, 402, 225, 433, 204, 418, 199,
-	205, 417, 313, 397, 405, 302, 293, 198, 403, 300,
-	292, 278, 245, 261, 345, 288, 346, 262, 309, 308,
-	310, 0, 193, 0, 381, 413, 434, 211, 0, 0,
-	393, 427, 430, 0, 348, 212, 253, 244, 344, 251,
-	281, 426, 428, 429, 210, 342, 259, 312, 206, 264,
-	377, 277, 286, 0, 0, 329, 359, 215, 411, 378,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 200, 282, 0, 349, 250, 432, 416, 414, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 189, 190, 201, 208, 217, 229,
-	242, 248, 257, 260, 263, 266, 267, 269, 274, 291,
-	295, 296, 297, 298, 314, 315, 316, 319, 322, 323,
-	325, 327, 328, 331, 337, 338, 339, 340, 341, 343,
-	350, 354, 361, 362, 363, 364, 365, 366, 367, 371,
-	372, 373, 374, 382, 385, 399, 400, 410, 420, 424,
-	258, 407, 425, 0, 290, 0, 194, 220, 207, 227,
-	241, 243, 271, 299, 305, 333, 336, 255, 238, 218,
-	353, 216, 369, 388, 389, 390, 392, 303, 234, 321,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
-	0, 0, 0, 0, 280, 0, 0, 334, 0, 370,
-	223, 289, 287, 396, 246, 240, 236, 222, 265, 294,
-	332, 387, 326, 0, 284, 0, 0, 379, 306, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 270, 221, 192, 318, 380, 249,
-	0, 0, 0, 174, 175, 176, 0, 902, 0, 0,
-	0, 0, 0, 0, 213, 0, 219, 0, 0, 0,
-	0, 233, 268, 239, 232, 394, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 256, 0,
-	307, 0, 0, 0, 421, 0, 0, 0, 0, 0,
-	0, 0, 0, 279, 0, 276, 188, 202, 0, 0,
-	317, 355, 360, 0, 0, 0, 224, 0, 358, 330,
-	409, 209, 247, 352, 335, 356, 0, 0, 357, 285,
-	398, 347, 408, 422, 423, 231, 311, 415, 391, 419,
-	431, 203, 228, 324, 384, 412, 376, 304, 395, 275,
-	375, 254, 191, 283, 195, 386, 406, 214, 368, 0,
-	0, 0, 197, 404, 383, 301, 272, 273, 196, 0,
-	351, 235, 252, 226, 320, 401, 402, 225, 433, 204,
-	418, 199, 205, 417, 313, 397, 405, 302, 293, 198,
-	403, 300, 292, 278, 245, 261, 345, 288, 346, 262,
-	309, 308, 310, 0, 193, 0, 381, 413, 434, 211,
-	0, 0, 393, 427, 430, 0, 348, 212, 253, 244,
-	344, 251, 281, 426, 428, 429, 210, 342, 259, 312,
-	206, 264, 377, 277, 286, 0, 0, 329, 359, 215,
-	411, 378, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 187, 200, 282, 0, 349, 250, 432, 416,
-	414, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 189, 190, 201, 208,
-	217, 229, 242, 248, 257, 260, 263, 266, 267, 269,
-	274, 291, 295, 296, 297, 298, 314, 315, 316, 319,
-	322, 323, 325, 327, 328, 331, 337, 338, 339, 340,
-	341, 343, 350, 354, 361, 362, 363, 364, 365, 366,
-	367, 371, 372, 373, 374, 382, 385, 399, 400, 410,
-	420, 424, 258, 407, 425, 0, 290, 0, 194, 220,
-	207, 227, 241, 243, 271, 299, 305, 333, 336, 255,
-	238, 218, 353, 216, 369, 388, 389, 390, 392, 303,
-	234, 321, 0, 0, 0, 0, 0, 0, 0, 0,
-	237, 0, 0, 0, 0, 0, 280, 0, 0, 334,
-	0, 370, 223, 289, 287, 396, 246, 240, 236, 222,
-	265, 294, 332, 387, 326, 0, 284, 0, 0, 379,
-	306, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 270, 221, 192, 318,
-	380, 249, 0, 0, 0, 174, 175, 176, 0, 0,
-	0, 0, 0, 0, 0, 0, 213, 0, 219, 0,
-	0, 0, 0, 233, 268, 239, 232, 394, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 480, 0,
-	256, 0, 307, 0, 0, 0, 421, 0, 0, 0,
-	0, 0, 0, 0, 0, 279, 0, 276, 188, 202,
-	0, 0, 317, 355, 360, 0, 0, 0, 224, 0,
-	358, 330, 409, 209, 247, 352, 335, 356, 0, 0,
-	357, 285, 398, 347, 408, 422, 423, 231, 311, 415,
-	391, 419, 431, 203, 228, 324, 384, 412, 376, 304,
-	395, 275, 375, 254, 191, 283, 195, 386, 406, 214,
-	368, 0, 0, 0, 197, 404, 383, 301, 272, 273,
-	196, 0, 351, 235, 252, 226, 320, 401, 402, 225,
-	433, 204, 418, 199, 205, 417, 313, 397, 405, 302,
-	293, 198, 403, 300, 292, 278, 245, 261, 345, 288,
-	346, 262, 309, 308, 310, 0, 193, 0, 381, 413,
-	434, 211, 0, 0, 393, 427, 430, 0, 348, 212,
-	253, 244, 344, 251, 281, 426, 428, 429, 210, 342,
-	259, 312, 206, 264, 377, 277, 286, 0, 0, 329,
-	359, 215, 411, 378, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 200, 282, 0, 349, 250,
-	432, 416, 414, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
-	201, 208, 217, 229, 242, 248, 257, 260, 263, 266,
-	267, 269, 274, 291, 295, 296, 297, 298, 314, 315,
-	316, 319, 322, 323, 325, 327, 328, 331, 337, 338,
-	339, 340, 341, 343, 350, 354, 361, 362, 363, 364,
-	365, 366, 367, 371, 372, 373, 374, 382, 385, 399,
-	400, 410, 420, 424, 479, 407, 425, 0, 290, 0,
-	194, 220, 207, 227, 241, 243, 271, 299, 305, 333,
-	336, 255, 238, 218, 353, 216, 369, 388, 389, 390,
-	392, 303, 234, 321, 0, 0, 0, 0, 0, 0,
-	0, 0, 237, 0, 0, 0, 0, 0, 280, 0,
-	0, 334, 0, 370, 223, 289, 287, 396, 246, 240,
-	236, 222, 265, 294, 332, 387, 326, 0, 284, 0,
-	0, 379, 306, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 270, 221,
-	192, 318, 380, 249, 0, 0, 0, 174, 175, 176,
-	0, 0, 0, 0, 0, 0, 0, 0, 213, 0,
-	219, 0, 0, 0, 0, 233, 268, 239, 232, 394,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 256, 0, 307, 0, 182, 0, 421, 0,
-	0, 0, 0, 0, 0, 0, 0, 279, 0, 276,
-	188, 202, 0, 0, 317, 355, 360, 0, 0, 0,
-	224, 0, 358, 330, 409, 209, 247, 352, 335, 356,
-	0, 0, 357, 285, 398, 347, 408, 422, 423, 231,
-	311, 415, 391, 419, 431, 203, 228, 324, 384, 412,
-	376, 304, 395, 275, 375, 254, 191, 283, 195, 386,
-	406, 214, 368, 0, 0, 0, 197, 404, 383, 301,
-	272, 273, 196, 0, 351, 235, 252, 226, 320, 401,
-	402, 225, 433, 204, 418, 199, 205, 417, 313, 397,
-	405, 302, 293, 198, 403, 300, 292, 278, 245, 261,
-	345, 288, 346, 262, 309, 308, 310, 0, 193, 0,
-	381, 413, 434, 211, 0, 0, 393, 427, 430, 0,
-	348, 212, 253, 244, 344, 251, 281, 426, 428, 429,
-	210, 342, 259, 312, 206, 264, 377, 277, 286, 0,
-	0, 329, 359, 215, 411, 378, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 200, 282, 0,
-	349, 250, 432, 416, 414, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	189, 190, 201, 208, 217, 229, 242, 248, 257, 260,
-	263, 266, 267, 269, 274, 291, 295, 296, 297, 298,
-	314, 315, 316, 319, 322, 323, 325, 327, 328, 331,
-	337, 338, 339, 340, 341, 343, 350, 354, 361, 362,
-	363, 364, 365, 366, 367, 371, 372, 373, 374, 382,
-	385, 399, 400, 410, 420, 424, 258, 407, 425, 0,
-	290, 0, 194, 220, 207, 227, 241, 243, 271, 299,
-	305, 333, 336, 255, 238, 218, 353, 216, 369, 388,
-	389, 390, 392, 303, 234, 321, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	280, 0, 0, 334, 0, 370, 223, 289, 287, 396,
-	246, 240, 236, 222, 265, 294, 332, 387, 326, 0,
-	284, 0, 0, 379, 306, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	270, 221, 192, 318, 380, 249, 0, 0, 0, 174,
-	175, 176, 0, 0, 0, 0, 0, 0, 0, 0,
-	213, 0, 219, 0, 0, 0, 0, 233, 268, 239,
-	232, 394, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 256, 0, 307, 0, 0, 0,
-	421, 0, 0, 0, 0, 0, 0, 0, 0, 279,
-	0, 276, 188, 202, 0, 0, 317, 355, 360, 0,
-	0, 0, 224, 0, 358, 330, 409, 209, 247, 352,
-	335, 356, 0, 0, 357, 285, 398, 347, 408, 422,
-	423, 231, 311, 415, 391, 419, 431, 203, 228, 324,
-	384, 412, 376, 304, 395, 275, 375, 254, 191, 283,
-	195, 386, 406, 214, 368, 0, 0, 0, 197, 404,
-	383, 301, 272, 273, 196, 0, 351, 235, 252, 226,
-	320, 401, 402, 225, 433, 204, 418, 199, 205, 417,
-	313, 397, 405, 302, 293, 198, 403, 300, 292, 278,
-	245, 261, 345, 288, 346, 262, 309, 308, 310, 0,
-	193, 0, 381, 413, 434, 211, 0, 0, 393, 427,
-	430, 0, 348, 212, 253, 244, 344, 251, 281, 426,
-	428, 429, 210, 342, 259, 312, 206, 264, 377, 277,
-	286, 0, 0, 329, 359, 215, 411, 378, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 200,
-	282, 0, 349, 250, 432, 416, 414, 0, 0, 230,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 189, 190, 201, 208, 217, 229, 242, 248,
-	257, 260, 263, 266, 267, 269, 274, 291, 295, 296,
-	297, 298, 314, 315, 316, 319, 322, 323, 325, 327,
-	328, 331, 337, 338, 339, 340, 341, 343, 350, 354,
-	361, 362, 363, 364, 365, 366, 367, 371, 372, 373,
-	374, 382, 385, 399, 400, 410, 420, 424, 258, 407,
-	425, 0, 290, 0, 194, 220, 207, 227, 241, 243,
-	271, 299, 305, 333, 336, 255, 238, 218, 353, 216,
-	369, 388, 389, 390, 392, 303, 234,
+	0, 0, 0, 0, 0, 0, 0, 1918, 0, 0,
+	0, 0, 0, 0, 1167, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1265, 0, 0, 0, 0, 0,
+	0, 1937, 0, 0, 0, 0, 0, 0, 1943, 1944,
+	1945, 0, 0, 0, 0, 0, 190, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 190, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1057,
+	0, 0, 0, 1564, 1565, 1566, 1567, 1568, 0, 0,
+	0, 591, 0, 1572, 1573, 0, 0, 1577, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1583, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1585,
+	0, 0, 1587, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 190, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1167, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1843, 0, 33, 0, 1843, 0, 0, 0, 0, 0,
+	0, 0, 0, 190, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	33, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	190, 190, 0, 0, 0, 0, 0, 1167, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 190, 1843, 0,
+	33, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 33, 2098, 0, 190,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1697, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1167, 0,
+	0, 0, 2158, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1769, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1783, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1391, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 190, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 190, 0, 0,
+	0, 1831, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1167, 0, 0, 1892,
+	1893, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1902, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1914, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 376, 727, 714, 0, 0, 664,
+	730, 635, 653, 739, 655, 658, 696, 616, 677, 328,
+	650, 0, 639, 612, 646, 613, 637, 666, 244, 670,
+	634, 716, 680, 729, 287, 0, 640, 341, 698, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 736, 291, 687, 0, 388, 313, 0,
+	0, 0, 668, 719, 675, 710, 663, 697, 624, 686,
+	731, 651, 694, 732, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 2105, 2106, 0,
+	0, 0, 0, 0, 219, 0, 225, 691, 726, 648,
+	693, 240, 275, 246, 239, 403, 695, 742, 611, 688,
+	0, 614, 617, 738, 722, 643, 644, 0, 0, 0,
+	0, 0, 0, 0, 667, 676, 706, 661, 0, 0,
+	0, 0, 0, 0, 0, 0, 641, 0, 685, 0,
+	0, 0, 620, 615, 0, 2052, 0, 0, 665, 0,
+	0, 0, 623, 0, 642, 707, 2060, 609, 263, 618,
+	314, 712, 721, 662, 430, 725, 660, 659, 728, 702,
+	621, 718, 654, 286, 619, 283, 194, 208, 0, 652,
+	324, 362, 367, 717, 638, 647, 231, 645, 365, 337,
+	418, 215, 254, 359, 342, 363, 684, 700, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	633, 713, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 704, 741, 336, 366, 221,
+	420, 387, 628, 632, 626, 627, 678, 679, 629, 733,
+	734, 735, 708, 622, 0, 630, 631, 0, 715, 723,
+	724, 683, 193, 206, 289, 737, 356, 257, 441, 425,
+	423, 610, 625, 237, 636, 0, 0, 649, 656, 657,
+	669, 671, 672, 673, 674, 682, 689, 690, 692, 699,
+	701, 703, 705, 711, 720, 740, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 709, 265, 416, 434, 0, 297,
+	681, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 727, 714, 0, 0, 664,
+	730, 635, 653, 739, 655, 658, 696, 616, 677, 328,
+	650, 0, 639, 612, 646, 613, 637, 666, 244, 670,
+	634, 716, 680, 729, 287, 0, 640, 341, 698, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 736, 291, 687, 0, 388, 313, 0,
+	0, 0, 668, 719, 675, 710, 663, 697, 624, 686,
+	731, 651, 694, 732, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 691, 726, 648,
+	693, 240, 275, 246, 239, 403, 695, 742, 611, 688,
+	0, 614, 617, 738, 722, 643, 644, 0, 0, 0,
+	0, 0, 0, 0, 667, 676, 706, 661, 0, 0,
+	0, 0, 0, 0, 1835, 0, 641, 0, 685, 0,
+	0, 0, 620, 615, 0, 0, 0, 0, 665, 0,
+	0, 0, 623, 0, 642, 707, 0, 609, 263, 618,
+	314, 712, 721, 662, 430, 725, 660, 659, 728, 702,
+	621, 718, 654, 286, 619, 283, 194, 208, 0, 652,
+	324, 362, 367, 717, 638, 647, 231, 645, 365, 337,
+	418, 215, 254, 359, 342, 363, 684, 700, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	633, 713, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 704, 741, 336, 366, 221,
+	420, 387, 628, 632, 626, 627, 678, 679, 629, 733,
+	734, 735, 708, 622, 0, 630, 631, 0, 715, 723,
+	724, 683, 193, 206, 289, 737, 356, 257, 441, 425,
+	423, 610, 625, 237, 636, 0, 0, 649, 656, 657,
+	669, 671, 672, 673, 674, 682, 689, 690, 692, 699,
+	701, 703, 705, 711, 720, 740, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 709, 265, 416, 434, 0, 297,
+	681, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 727, 714, 0, 0, 664,
+	730, 635, 653, 739, 655, 658, 696, 616, 677, 328,
+	650, 0, 639, 612, 646, 613, 637, 666, 244, 670,
+	634, 716, 680, 729, 287, 0, 640, 341, 698, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 736, 291, 687, 0, 388, 313, 0,
+	0, 0, 668, 719, 675, 710, 663, 697, 624, 686,
+	731, 651, 694, 732, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 691, 726, 648,
+	693, 240, 275, 246, 239, 403, 695, 742, 611, 688,
+	0, 614, 617, 738, 722, 643, 644, 0, 0, 0,
+	0, 0, 0, 0, 667, 676, 706, 661, 0, 0,
+	0, 0, 0, 0, 0, 0, 641, 0, 685, 0,
+	0, 0, 620, 615, 0, 0, 0, 0, 665, 0,
+	0, 0, 623, 0, 642, 707, 0, 609, 263, 618,
+	314, 712, 721, 662, 430, 725, 660, 659, 728, 702,
+	621, 718, 654, 286, 619, 283, 194, 208, 0, 652,
+	324, 362, 367, 717, 638, 647, 231, 645, 365, 337,
+	418, 215, 254, 359, 342, 363, 684, 700, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	633, 713, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 704, 741, 336, 366, 221,
+	420, 387, 628, 632, 626, 627, 678, 679, 629, 733,
+	734, 735, 708, 622, 0, 630, 631, 0, 715, 723,
+	724, 683, 193, 206, 289, 737, 356, 257, 441, 425,
+	423, 610, 625, 237, 636, 0, 0, 649, 656, 657,
+	669, 671, 672, 673, 674, 682, 689, 690, 692, 699,
+	701, 703, 705, 711, 720, 740, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 709, 265, 416, 434, 0, 297,
+	681, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 727, 714, 0, 0, 664,
+	730, 635, 653, 739, 655, 658, 696, 616, 677, 328,
+	650, 0, 639, 612, 646, 613, 637, 666, 244, 670,
+	634, 716, 680, 729, 287, 0, 640, 341, 698, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 736, 291, 687, 0, 388, 313, 0,
+	0, 0, 668, 719, 675, 710, 663, 697, 624, 686,
+	731, 651, 694, 732, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 691, 726, 648,
+	693, 240, 275, 246, 239, 403, 695, 742, 611, 688,
+	0, 614, 617, 738, 722, 643, 644, 0, 0, 0,
+	0, 0, 0, 0, 667, 676, 706, 661, 0, 0,
+	0, 0, 0, 0, 1701, 0, 641, 0, 685, 0,
+	0, 0, 620, 615, 0, 0, 0, 0, 665, 0,
+	0, 0, 623, 0, 642, 707, 0, 609, 263, 618,
+	314, 712, 721, 662, 430, 725, 660, 659, 728, 702,
+	621, 718, 654, 286, 619, 283, 194, 208, 0, 652,
+	324, 362, 367, 717, 638, 647, 231, 645, 365, 337,
+	418, 215, 254, 359, 342, 363, 684, 700, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	633, 713, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 704, 741, 336, 366, 221,
+	420, 387, 628, 632, 626, 627, 678, 679, 629, 733,
+	734, 735, 708, 622, 0, 630, 631, 0, 715, 723,
+	724, 683, 193, 206, 289, 737, 356, 257, 441, 425,
+	423, 610, 625, 237, 636, 0, 0, 649, 656, 657,
+	669, 671, 672, 673, 674, 682, 689, 690, 692, 699,
+	701, 703, 705, 711, 720, 740, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 709, 265, 416, 434, 0, 297,
+	681, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 727, 714, 0, 0, 664,
+	730, 635, 653, 739, 655, 658, 696, 616, 677, 328,
+	650, 0, 639, 612, 646, 613, 637, 666, 244, 670,
+	634, 716, 680, 729, 287, 0, 640, 341, 698, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 736, 291, 687, 0, 388, 313, 0,
+	0, 0, 668, 719, 675, 710, 663, 697, 624, 686,
+	731, 651, 694, 732, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 691, 726, 648,
+	693, 240, 275, 246, 239, 403, 695, 742, 611, 688,
+	0, 614, 617, 738, 722, 643, 644, 0, 0, 0,
+	0, 0, 0, 0, 667, 676, 706, 661, 0, 0,
+	0, 0, 0, 0, 1419, 0, 641, 0, 685, 0,
+	0, 0, 620, 615, 0, 0, 0, 0, 665, 0,
+	0, 0, 623, 0, 642, 707, 0, 609, 263, 618,
+	314, 712, 721, 662, 430, 725, 660, 659, 728, 702,
+	621, 718, 654, 286, 619, 283, 194, 208, 0, 652,
+	324, 362, 367, 717, 638, 647, 231, 645, 365, 337,
+	418, 215, 254, 359, 342, 363, 684, 700, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	633, 713, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 704, 741, 336, 366, 221,
+	420, 387, 628, 632, 626, 627, 678, 679, 629, 733,
+	734, 735, 708, 622, 0, 630, 631, 0, 715, 723,
+	724, 683, 193, 206, 289, 737, 356, 257, 441, 425,
+	423, 610, 625, 237, 636, 0, 0, 649, 656, 657,
+	669, 671, 672, 673, 674, 682, 689, 690, 692, 699,
+	701, 703, 705, 711, 720, 740, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 709, 265, 416, 434, 0, 297,
+	681, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 727, 714, 0, 0, 664,
+	730, 635, 653, 739, 655, 658, 696, 616, 677, 328,
+	650, 0, 639, 612, 646, 613, 637, 666, 244, 670,
+	634, 716, 680, 729, 287, 0, 640, 341, 698, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 736, 291, 687, 0, 388, 313, 0,
+	0, 0, 668, 719, 675, 710, 663, 697, 624, 686,
+	731, 651, 694, 732, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 691, 726, 648,
+	693, 240, 275, 246, 239, 403, 695, 742, 611, 688,
+	0, 614, 617, 738, 722, 643, 644, 0, 0, 0,
+	0, 0, 0, 0, 667, 676, 706, 661, 0, 0,
+	0, 0, 0, 0, 0, 0, 641, 0, 685, 0,
+	0, 0, 620, 615, 0, 0, 0, 0, 665, 0,
+	0, 0, 623, 0, 642, 707, 0, 609, 263, 618,
+	314, 712, 721, 662, 430, 725, 660, 659, 728, 702,
+	621, 718, 654, 286, 619, 283, 194, 208, 0, 652,
+	324, 362, 367, 717, 638, 647, 231, 645, 365, 337,
+	418, 215, 254, 359, 342, 363, 684, 700, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	633, 713, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 704, 741, 336, 366, 221,
+	420, 387, 628, 632, 626, 627, 678, 679, 629, 733,
+	734, 735, 708, 622, 0, 630, 631, 0, 715, 723,
+	724, 683, 193, 206, 289, 737, 356, 257, 441, 425,
+	423, 610, 625, 237, 636, 0, 0, 649, 656, 657,
+	669, 671, 672, 673, 674, 682, 689, 690, 692, 699,
+	701, 703, 705, 711, 720, 740, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 709, 265, 416, 434, 0, 297,
+	681, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 727, 714, 0, 0, 664,
+	730, 635, 653, 739, 655, 658, 696, 616, 677, 328,
+	650, 0, 639, 612, 646, 613, 637, 666, 244, 670,
+	634, 716, 680, 729, 287, 0, 640, 341, 698, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 736, 291, 687, 0, 388, 313, 0,
+	0, 0, 668, 719, 675, 710, 663, 697, 624, 686,
+	731, 651, 694, 732, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 691, 726, 648,
+	693, 240, 275, 246, 239, 403, 695, 742, 611, 688,
+	0, 614, 617, 738, 722, 643, 644, 0, 0, 0,
+	0, 0, 0, 0, 667, 676, 706, 661, 0, 0,
+	0, 0, 0, 0, 0, 0, 641, 0, 685, 0,
+	0, 0, 620, 615, 0, 0, 0, 0, 665, 0,
+	0, 0, 623, 0, 642, 707, 0, 609, 263, 618,
+	314, 712, 721, 662, 430, 725, 660, 659, 728, 702,
+	621, 718, 654, 286, 619, 283, 194, 208, 0, 652,
+	324, 362, 367, 717, 638, 647, 231, 645, 365, 337,
+	418, 215, 254, 359, 342, 363, 684, 700, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 744, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	633, 713, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 608,
+	743, 602, 601, 284, 293, 704, 741, 336, 366, 221,
+	420, 387, 628, 632, 626, 627, 678, 679, 629, 733,
+	734, 735, 708, 622, 0, 630, 631, 0, 715, 723,
+	724, 683, 193, 206, 289, 737, 356, 257, 441, 425,
+	423, 610, 625, 237, 636, 0, 0, 649, 656, 657,
+	669, 671, 672, 673, 674, 682, 689, 690, 692, 699,
+	701, 703, 705, 711, 720, 740, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 709, 265, 416, 434, 0, 297,
+	681, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 727, 714, 0, 0, 664,
+	730, 635, 653, 739, 655, 658, 696, 616, 677, 328,
+	650, 0, 639, 612, 646, 613, 637, 666, 244, 670,
+	634, 716, 680, 729, 287, 0, 640, 341, 698, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 736, 291, 687, 0, 388, 313, 0,
+	0, 0, 668, 719, 675, 710, 663, 697, 624, 686,
+	731, 651, 694, 732, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 691, 726, 648,
+	693, 240, 275, 246, 239, 403, 695, 742, 611, 688,
+	0, 614, 617, 738, 722, 643, 644, 0, 0, 0,
+	0, 0, 0, 0, 667, 676, 706, 661, 0, 0,
+	0, 0, 0, 0, 0, 0, 641, 0, 685, 0,
+	0, 0, 620, 615, 0, 0, 0, 0, 665, 0,
+	0, 0, 623, 0, 642, 707, 0, 609, 263, 618,
+	314, 712, 721, 662, 430, 725, 660, 659, 728, 702,
+	621, 718, 654, 286, 619, 283, 194, 208, 0, 652,
+	324, 362, 367, 717, 638, 647, 231, 645, 365, 337,
+	418, 215, 254, 359, 342, 363, 684, 700, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 1061, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 744, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	633, 713, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 608,
+	743, 602, 601, 284, 293, 704, 741, 336, 366, 221,
+	420, 387, 628, 632, 626, 627, 678, 679, 629, 733,
+	734, 735, 708, 622, 0, 630, 631, 0, 715, 723,
+	724, 683, 193, 206, 289, 737, 356, 257, 441, 425,
+	423, 610, 625, 237, 636, 0, 0, 649, 656, 657,
+	669, 671, 672, 673, 674, 682, 689, 690, 692, 699,
+	701, 703, 705, 711, 720, 740, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 709, 265, 416, 434, 0, 297,
+	681, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 727, 714, 0, 0, 664,
+	730, 635, 653, 739, 655, 658, 696, 616, 677, 328,
+	650, 0, 639, 612, 646, 613, 637, 666, 244, 670,
+	634, 716, 680, 729, 287, 0, 640, 341, 698, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 736, 291, 687, 0, 388, 313, 0,
+	0, 0, 668, 719, 675, 710, 663, 697, 624, 686,
+	731, 651, 694, 732, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 691, 726, 648,
+	693, 240, 275, 246, 239, 403, 695, 742, 611, 688,
+	0, 614, 617, 738, 722, 643, 644, 0, 0, 0,
+	0, 0, 0, 0, 667, 676, 706, 661, 0, 0,
+	0, 0, 0, 0, 0, 0, 641, 0, 685, 0,
+	0, 0, 620, 615, 0, 0, 0, 0, 665, 0,
+	0, 0, 623, 0, 642, 707, 0, 609, 263, 618,
+	314, 712, 721, 662, 430, 725, 660, 659, 728, 702,
+	621, 718, 654, 286, 619, 283, 194, 208, 0, 652,
+	324, 362, 367, 717, 638, 647, 231, 645, 365, 337,
+	418, 215, 254, 359, 342, 363, 684, 700, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 599, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 744, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	633, 713, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 608,
+	743, 602, 601, 284, 293, 704, 741, 336, 366, 221,
+	420, 387, 628, 632, 626, 627, 678, 679, 629, 733,
+	734, 735, 708, 622, 0, 630, 631, 0, 715, 723,
+	724, 683, 193, 206, 289, 737, 356, 257, 441, 425,
+	423, 610, 625, 237, 636, 0, 0, 649, 656, 657,
+	669, 671, 672, 673, 674, 682, 689, 690, 692, 699,
+	701, 703, 705, 711, 720, 740, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 709, 265, 416, 434, 0, 297,
+	681, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 1344, 0, 499, 0, 0, 0, 244, 0,
+	498, 0, 0, 0, 287, 0, 1345, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 542, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 520, 519, 522, 523,
+	524, 525, 0, 0, 219, 521, 225, 526, 527, 528,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 496,
+	513, 0, 541, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 510, 511, 589, 0, 0, 0, 557, 0,
+	512, 0, 0, 505, 506, 508, 507, 509, 514, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 556, 0, 0, 430, 0, 0, 554, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 544, 555, 550, 551, 548, 549, 543, 547,
+	546, 545, 558, 535, 536, 537, 538, 540, 0, 552,
+	553, 539, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 499, 0, 0, 0, 244, 0,
+	498, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 542, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
+	0, 0, 1459, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 520, 519, 522, 523,
+	524, 525, 0, 0, 219, 521, 225, 526, 527, 528,
+	1460, 240, 275, 246, 239, 403, 0, 0, 0, 496,
+	513, 0, 541, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 510, 511, 0, 0, 0, 0, 557, 0,
+	512, 0, 0, 505, 506, 508, 507, 509, 514, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 556, 0, 0, 430, 0, 0, 554, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 544, 555, 550, 551, 548, 549, 543, 547,
+	546, 545, 558, 535, 536, 537, 538, 540, 0, 552,
+	553, 539, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 567, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 499, 0, 0, 0, 244, 0,
+	498, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 542, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 520, 519, 522, 523,
+	524, 525, 0, 0, 219, 521, 225, 526, 527, 528,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 496,
+	513, 0, 541, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 510, 511, 0, 0, 0, 0, 557, 0,
+	512, 0, 0, 505, 506, 508, 507, 509, 514, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 556, 0, 0, 430, 0, 0, 554, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 544, 555, 550, 551, 548, 549, 543, 547,
+	546, 545, 558, 535, 536, 537, 538, 540, 0, 552,
+	553, 539, 193, 206, 289, 35, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 499, 0, 0, 0, 244, 0,
+	498, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 542, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 576, 180, 181, 182, 520, 519, 522, 523,
+	524, 525, 0, 0, 219, 521, 225, 526, 527, 528,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 496,
+	513, 0, 541, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 510, 511, 0, 0, 0, 0, 557, 0,
+	512, 0, 0, 505, 506, 508, 507, 509, 514, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 556, 0, 0, 430, 0, 0, 554, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 544, 555, 550, 551, 548, 549, 543, 547,
+	546, 545, 558, 535, 536, 537, 538, 540, 0, 552,
+	553, 539, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 499, 0, 0, 0, 244, 0,
+	498, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 542, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 520, 519, 522, 523,
+	524, 525, 0, 0, 219, 521, 225, 526, 527, 528,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 496,
+	513, 0, 541, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 510, 511, 589, 0, 0, 0, 557, 0,
+	512, 0, 0, 505, 506, 508, 507, 509, 514, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 556, 0, 0, 430, 0, 0, 554, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 544, 555, 550, 551, 548, 549, 543, 547,
+	546, 545, 558, 535, 536, 537, 538, 540, 0, 552,
+	553, 539, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 499, 0, 0, 0, 244, 0,
+	498, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 542, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 520, 1363, 522, 523,
+	524, 525, 0, 0, 219, 521, 225, 526, 527, 528,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 496,
+	513, 0, 541, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 510, 511, 589, 0, 0, 0, 557, 0,
+	512, 0, 0, 505, 506, 508, 507, 509, 514, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 556, 0, 0, 430, 0, 0, 554, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 544, 555, 550, 551, 548, 549, 543, 547,
+	546, 545, 558, 535, 536, 537, 538, 540, 0, 552,
+	553, 539, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 499, 0, 0, 0, 244, 0,
+	498, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 542, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 520, 1360, 522, 523,
+	524, 525, 0, 0, 219, 521, 225, 526, 527, 528,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 496,
+	513, 0, 541, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 510, 511, 589, 0, 0, 0, 557, 0,
+	512, 0, 0, 505, 506, 508, 507, 509, 514, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 556, 0, 0, 430, 0, 0, 554, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 544, 555, 550, 551, 548, 549, 543, 547,
+	546, 545, 558, 535, 536, 537, 538, 540, 0, 552,
+	553, 539, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 499, 0, 0, 0, 244, 0,
+	498, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 542, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 520, 519, 522, 523,
+	524, 525, 0, 0, 219, 521, 225, 526, 527, 528,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 496,
+	513, 0, 541, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 510, 511, 0, 0, 0, 0, 557, 0,
+	512, 0, 0, 505, 506, 508, 507, 509, 514, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 556, 0, 0, 430, 0, 0, 554, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 544, 555, 550, 551, 548, 549, 543, 547,
+	546, 545, 558, 535, 536, 537, 538, 540, 0, 552,
+	553, 539, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 542, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 520, 519, 522, 523,
+	524, 525, 0, 0, 219, 521, 225, 526, 527, 528,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	513, 0, 541, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 510, 511, 0, 0, 0, 0, 557, 0,
+	512, 0, 0, 505, 506, 508, 507, 509, 514, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 556, 0, 0, 430, 0, 0, 554, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 2132, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 544, 555, 550, 551, 548, 549, 543, 547,
+	546, 545, 558, 535, 536, 537, 538, 540, 0, 552,
+	553, 539, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 542, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 576, 180, 181, 182, 520, 519, 522, 523,
+	524, 525, 0, 0, 219, 521, 225, 526, 527, 528,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	513, 0, 541, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 510, 511, 0, 0, 0, 0, 557, 0,
+	512, 0, 0, 505, 506, 508, 507, 509, 514, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 556, 0, 0, 430, 0, 0, 554, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 544, 555, 550, 551, 548, 549, 543, 547,
+	546, 545, 558, 535, 536, 537, 538, 540, 0, 552,
+	553, 539, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 542, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 533, 534, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 520, 519, 522, 523,
+	524, 525, 0, 0, 219, 521, 225, 526, 527, 528,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	513, 0, 541, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 510, 511, 0, 0, 0, 0, 557, 0,
+	512, 0, 0, 505, 506, 508, 507, 509, 514, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 556, 0, 0, 430, 0, 0, 554, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 544, 555, 550, 551, 548, 549, 543, 547,
+	546, 545, 558, 535, 536, 537, 538, 540, 0, 552,
+	553, 539, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 935, 934,
+	944, 945, 937, 938, 939, 940, 941, 942, 943, 936,
+	0, 0, 946, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 785,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 784, 430, 0, 0, 0, 0, 0,
+	0, 781, 782, 286, 752, 283, 194, 208, 775, 779,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 1039, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 1041, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 924, 925, 923, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 926, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 34, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 576, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 34, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 35, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 1390, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 1392, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 1388, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 746, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 752, 283, 194, 208, 750, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 1390, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 1392, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 576, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 2018,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 1411, 0,
+	0, 1412, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	1072, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 1071, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 2115,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 2018,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	69, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 1392, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 1041, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 1191, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 1189, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 1187, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 1185, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 1183, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 1179, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 1177, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 1175, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	1150, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 1054, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 1045, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 915, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 489, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 488, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 188, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 376, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 328,
+	0, 0, 0, 0, 0, 0, 0, 0, 244, 0,
+	0, 0, 0, 0, 287, 0, 0, 341, 0, 379,
+	230, 296, 294, 405, 253, 247, 243, 228, 272, 301,
+	339, 396, 333, 0, 291, 0, 0, 388, 313, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 227, 198, 325, 389, 256,
+	0, 0, 0, 180, 181, 182, 0, 0, 0, 0,
+	0, 0, 0, 0, 219, 0, 225, 0, 0, 0,
+	0, 240, 275, 246, 239, 403, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 263, 0,
+	314, 0, 0, 0, 430, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 194, 208, 0, 0,
+	324, 362, 367, 0, 0, 0, 231, 0, 365, 337,
+	418, 215, 254, 359, 342, 363, 0, 0, 364, 292,
+	407, 354, 417, 431, 432, 238, 318, 424, 400, 428,
+	440, 209, 235, 331, 393, 421, 385, 311, 404, 282,
+	384, 261, 197, 290, 201, 395, 415, 220, 377, 0,
+	0, 0, 203, 413, 392, 308, 279, 280, 202, 0,
+	358, 242, 259, 233, 327, 410, 411, 232, 442, 210,
+	427, 205, 211, 426, 320, 406, 414, 309, 300, 204,
+	412, 307, 299, 285, 252, 268, 352, 295, 353, 269,
+	316, 315, 317, 0, 199, 0, 390, 422, 443, 217,
+	0, 0, 402, 436, 439, 0, 355, 218, 260, 251,
+	351, 258, 288, 435, 437, 438, 216, 349, 266, 319,
+	212, 271, 386, 284, 293, 0, 0, 336, 366, 221,
+	420, 387, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 193, 206, 289, 0, 356, 257, 441, 425,
+	423, 0, 0, 237, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 195, 196, 207, 214,
+	223, 236, 249, 255, 264, 267, 270, 273, 274, 276,
+	281, 298, 302, 303, 304, 305, 321, 322, 323, 326,
+	329, 330, 332, 334, 335, 338, 344, 345, 346, 347,
+	348, 350, 357, 361, 368, 369, 370, 371, 372, 373,
+	374, 380, 381, 382, 383, 391, 394, 408, 409, 419,
+	429, 433, 229, 375, 0, 265, 416, 434, 0, 297,
+	0, 200, 226, 213, 234, 248, 250, 278, 306, 312,
+	340, 343, 262, 245, 224, 360, 222, 378, 397, 398,
+	399, 401, 310, 241, 328, 0, 0, 0, 0, 0,
+	0, 0, 0, 244, 0, 0, 0, 0, 0, 287,
+	0, 0, 341, 0, 379, 230, 296, 294, 405, 253,
+	247, 243, 228, 272, 301, 339, 396, 333, 0, 291,
+	0, 0, 388, 313, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
+	227, 198, 325, 389, 256, 0, 0, 0, 180, 181,
+	182, 0, 0, 0, 0, 0, 0, 0, 0, 219,
+	0, 225, 0, 0, 0, 0, 240, 275, 246, 239,
+	403, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 263, 0, 314, 0, 0, 0, 430,
+	0, 0, 0, 0, 0, 0, 0, 0, 286, 0,
+	283, 194, 208, 0, 0, 324, 362, 367, 0, 0,
+	0, 231, 0, 365, 337, 418, 215, 254, 359, 342,
+	363, 0, 0, 364, 292, 407, 354, 417, 431, 432,
+	238, 318, 424, 400, 428, 440, 209, 235, 331, 393,
+	421, 385, 311, 404, 282, 384, 261, 197, 290, 201,
+	395, 415, 220, 377, 0, 0, 0, 203, 413, 392,
+	308, 279, 280, 202, 0, 358, 242, 259, 233, 327,
+	410, 411, 232, 442, 210, 427, 205, 211, 426, 320,
+	406, 414, 309, 300, 204, 412, 307, 299, 285, 252,
+	268, 352, 295, 353, 269, 316, 315, 317, 0, 199,
+	0, 390, 422, 443, 217, 0, 0, 402, 436, 439,
+	0, 355, 218, 260, 251, 351, 258, 288, 435, 437,
+	438, 216, 349, 266, 319, 212, 271, 386, 284, 293,
+	0, 0, 336, 366, 221, 420, 387, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 193, 206, 289,
+	0, 356, 257, 441, 425, 423, 0, 0, 237, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 195, 196, 207, 214, 223, 236, 249, 255, 264,
+	267, 270, 273, 274, 276, 281, 298, 302, 303, 304,
+	305, 321, 322, 323, 326, 329, 330, 332, 334, 335,
+	338, 344, 345, 346, 347, 348, 350, 357, 361, 368,
+	369, 370, 371, 372, 373, 374, 380, 381, 382, 383,
+	391, 394, 408, 409, 419, 429, 433, 229, 375, 0,
+	265, 416, 434, 0, 297, 0, 200, 226, 213, 234,
+	248, 250, 278, 306, 312, 340, 343, 262, 245, 224,
+	360, 222, 378, 397, 398, 399, 401, 310, 241,
 }
 
 var yyPact = [...]int{
-	3693, -1000, -327, 1534, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	2710, -1000, -316, 1574, 1024, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1483, 1092, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	486, 1191, 177, 1411, 265, 181, 950, 350, 154, 26084,
-	346, 111, 26516, -1000, 38, -1000, 29, 26516, 39, 25652,
-	-1000, -1000, -1000, 11795, 1364, -75, -80, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 1167, 1452, 1453, 1480, 1003,
-	1504, -1000, 10054, 10054, 309, 309, 309, 8326, -1000, -1000,
-	15696, 26516, 26516, 1196, 281, 341, 281, -153, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1411, -1000, -1000, 83, -1000, 215,
-	1119, -1000, 1118, -1000, 420, 336, 202, 274, 267, 201,
-	200, 191, 189, 188, 187, 185, 184, 236, -1000, 456,
-	456, -192, -193, 1879, 271, 271, 271, 328, 1390, 1388,
-	-1000, 541, -1000, 456, 456, 55, 456, 456, 456, 456,
-	146, 135, 456, 456, 456, 456, 456, 456, 456, 456,
-	456, 456, 456, 456, 456, 456, 456, 26516, -1000, 81,
-	561, 487, 1411, 97, -1000, -1000, -1000, 26516, 276, 950,
-	276, 276, 26516, -1000, 393, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1520, 1172, -1000, -205, -1000, -1000, -1000, -1000,
+	-1000, -1000, 555, 1235, 162, 1445, 264, 126, 928, 366,
+	182, 27239, 365, 2192, 27689, -1000, 116, -1000, 106, 27689,
+	112, 26789, -1000, -1000, -1000, 12389, 1395, 19, 16, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1193, 1519, 1574,
+	27689, 1516, 1542, 1030, 1398, -1000, 27689, -1000, 11039, 11039,
+	327, 327, 327, 8789, -1000, -1000, 16889, 27689, 27689, 1156,
+	317, 362, 317, -122, -1000, -1000, -1000, -1000, -1000, -1000,
+	1445, -1000, -1000, 163, -1000, 239, 1141, -1000, 1139, -1000,
+	369, 422, 234, 301, 300, 233, 232, 231, 228, 225,
+	217, 216, 215, 242, -1000, 538, 538, -176, -177, 287,
+	304, 304, 304, 341, 1405, 1403, -1000, 493, -1000, 538,
+	538, 148, 538, 538, 538, 538, 192, 191, 538, 538,
+	538, 538, 538, 538, 538, 538, 538, 538, 538, 538,
+	538, 538, 538, 27689, -1000, 165, 591, 567, 1445, 164,
+	-1000, -1000, -1000, 27689, 314, 928, 314, 314, 27689, -1000,
+	445, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3888,23 +4066,24 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 26516, 589, 589, 589, 589,
-	589, 589, 23, -1000, -29, 149, 145, 98, 89, 950,
-	225, -1000, 399, -1000, 85, -13, -1000, 589, 5650, 5650,
-	5650, -1000, 1394, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 326, -1000, -1000, -1000, -1000, 26516, 25220, 234, 484,
-	-1000, -1000, -1000, -1000, 1063, 661, -1000, 11795, 2052, 1142,
-	1142, -1000, -1000, 370, -1000, -1000, 13091, 13091, 13091, 13091,
-	13091, 13091, 13091, 13091, 13091, 13091, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1142, 392, -1000, 11363, 1142, 1142, 1142, 1142, 1142, 1142,
-	1142, 1142, 11795, 1142, 1142, 1142, 1142, 1142, 1142, 1142,
-	1142, 1142, 1142, 1142, 1142, 1142, 1142, 1142, 1142, 1142,
-	-1000, -1000, -1000, 26516, -1000, 1483, -1000, 1092, -1000, -1000,
-	-1000, 1408, 11795, 11795, 1483, -1000, 1255, 10054, -1000, -1000,
-	1339, -1000, -1000, -1000, -1000, 557, 1517, -1000, 14387, 391,
-	1507, 24788, -1000, 19165, 24356, 1117, 7880, -50, -1000, -1000,
-	-1000, 469, 17869, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 27689, 624, 624, 624, 624, 624,
+	624, 92, -1000, 57, 186, 170, 179, 88, 928, 271,
+	-1000, 448, -1000, 171, 91, -1000, 624, 6089, 6089, 6089,
+	-1000, 1410, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	340, -1000, -1000, -1000, -1000, 27689, 26339, 241, 564, -1000,
+	-1000, -1000, -1000, 998, 673, -1000, 12389, 2102, 1151, 1151,
+	-1000, -1000, 385, -1000, -1000, 13739, 13739, 13739, 13739, 13739,
+	13739, 13739, 13739, 13739, 13739, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1151,
+	440, -1000, 10139, 1151, 1151, 1151, 1151, 1151, 1151, 1151,
+	1151, 12389, 1151, 1151, 1151, 1151, 1151, 1151, 1151, 1151,
+	1151, 1151, 1151, 1151, 1151, 1151, 1151, 1151, 1151, -1000,
+	-1000, -1000, 27689, -1000, 1520, -1000, 1172, -1000, -1000, -1000,
+	-1000, 1161, 1450, 12389, 12389, 1520, -1000, 1334, 11039, -1000,
+	-1000, 1348, -1000, -1000, -1000, -1000, 719, -1000, 1559, -1000,
+	15089, 437, 1557, 25889, -1000, 20039, 25439, 1137, 8339, -12,
+	-1000, -1000, -1000, 553, 18689, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3918,410 +4097,425 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1394, 1070, 26516, -1000, -1000, 3728, 950, -1000, 1186,
-	-1000, 1061, -1000, 1157, 81, 26516, 519, 950, 950, -1000,
-	-1000, -1000, 456, 456, 222, 265, 3498, -1000, -1000, -1000,
-	23917, 1182, 950, -1000, 1180, -1000, 1425, 305, 472, 472,
-	950, -1000, -1000, 26516, 950, 1424, 1423, 26516, 26516, -1000,
-	23485, -1000, 23053, 22621, 800, 26516, 22189, 21757, 21325, 20893,
-	20461, -1000, 1274, -1000, 1190, -1000, -1000, -1000, 26516, 26516,
-	26516, -54, -1000, -1000, 26516, 950, -1000, -1000, 797, 788,
-	456, 456, 787, 892, 886, 880, 456, 456, 782, 878,
-	935, 194, 774, 772, 769, 826, 875, 106, 821, 747,
-	760, 26516, 1179, -1000, 73, 461, 157, 231, 134, 26516,
-	129, 1411, 1361, 1115, 324, 26516, 1438, 1236, 26516, 950,
-	-1000, 6988, -1000, -1000, 873, 11795, -1000, -1000, -1000, -1000,
-	-1000, 589, 26516, 589, 26516, 589, 589, 589, 589, 548,
-	565, 548, -1000, -1000, -1000, -1000, 5650, 5650, 26516, 5650,
-	5650, 26516, 5650, 5650, 565, -1000, -1000, -1000, 442, -1000,
-	1235, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 36, -1000,
-	-1000, -1000, -1000, -1000, 1534, -1000, -1000, -1000, -125, 11795,
-	11795, 11795, 11795, 667, 441, 13091, 700, 470, 13091, 13091,
-	13091, 13091, 13091, 13091, 13091, 13091, 13091, 13091, 13091, 13091,
-	13091, 13091, 13091, 715, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 950, -1000, 1511, 966, 966, 410, 410, 410,
-	410, 410, 410, 410, 410, 410, 13523, 8758, 6988, 1003,
-	1055, 1483, 10054, 10054, 11795, 11795, 10918, 10486, 10054, 1384,
-	506, 661, 26516, -1000, 908, -1000, -1000, 12659, -1000, -1000,
+	-1000, -1000, -1000, -1000, 1410, 1066, 27689, -1000, -1000, 3660,
+	928, -1000, 1234, -1000, 1063, -1000, 1165, 165, 27689, 607,
+	928, 928, -1000, -1000, -1000, 538, 538, 213, 264, 4027,
+	-1000, -1000, -1000, 24989, 1229, 928, -1000, 1213, -1000, 1463,
+	309, 491, 491, 928, -1000, -1000, 27689, 928, 1462, 1461,
+	27689, 27689, -1000, 24539, -1000, 24089, 23639, 849, 27689, 23189,
+	22739, 22289, 21839, 21389, -1000, 1301, -1000, 1191, -1000, -1000,
+	-1000, 27689, 27689, 27689, 47, -1000, -1000, 27689, 928, -1000,
+	-1000, 848, 845, 538, 538, 836, 930, 929, 927, 538,
+	538, 828, 916, 923, 181, 818, 783, 782, 883, 915,
+	109, 880, 846, 780, 27689, 1197, -1000, 149, 542, 198,
+	229, 210, 27689, 167, 1445, 1393, 1131, 338, 27689, 1478,
+	1277, 27689, 928, -1000, 7439, -1000, -1000, 913, 12389, -1000,
+	-1000, -1000, -1000, -1000, 624, 27689, 624, 27689, 624, 624,
+	624, 624, 614, 620, 614, -1000, -1000, -1000, -1000, 6089,
+	6089, 27689, 6089, 6089, 27689, 6089, 6089, 620, -1000, -1000,
+	-1000, 506, -1000, 1276, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 110, -1000, -1000, -1000, -1000, -1000, 1574, -1000, -1000,
+	-1000, -120, 12389, 12389, 12389, 12389, 775, 514, 13739, 771,
+	681, 13739, 13739, 13739, 13739, 13739, 13739, 13739, 13739, 13739,
+	13739, 13739, 13739, 13739, 13739, 13739, 646, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 928, -1000, 178, 875, 875,
+	467, 467, 467, 467, 467, 467, 467, 467, 467, 14189,
+	9239, 7439, 1030, 1146, 1053, 1520, 11039, 11039, 12389, 12389,
+	11939, 11489, 11039, 1426, 578, 673, 27689, -1000, 777, -1000,
+	-1000, 13289, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 27689, 27689, 11039, 11039, 11039, 11039,
+	11039, -1000, 1124, -1000, -164, 16439, 1516, 1030, 1348, 1506,
+	27689, 1467, 1566, 503, 824, 1123, -1000, 713, 1516, 18239,
+	1073, -1000, 1348, -1000, -1000, -1000, 27689, -1000, -1000, 20939,
+	-1000, -1000, 6989, 27689, 214, 27689, -1000, 1083, 1271, -1000,
+	-1000, -1000, 1493, 15989, 27689, 1090, 1070, -1000, -1000, 434,
+	7889, -12, -1000, 7889, 1107, -1000, -46, -22, 9689, 438,
+	-1000, -1000, -1000, 287, 14639, 988, 707, 35, -1000, -1000,
+	-1000, 1165, -1000, 1165, 1165, 1165, 1165, 47, 47, 47,
+	47, -1000, -1000, -1000, -1000, -1000, 1196, 1194, -1000, 1165,
+	1165, 1165, 1165, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	26516, 26516, 10054, 10054, 10054, 10054, 10054, -1000, 1110, -1000,
-	-171, 15264, 1453, 1003, 1339, 1429, 1527, 434, 963, 1109,
-	-1000, 813, 1453, 17437, 1149, -1000, 1339, -1000, -1000, -1000,
-	26516, -1000, -1000, 20029, -1000, -1000, 6542, 26516, 183, 26516,
-	-1000, 1138, 1228, -1000, -1000, -1000, 1443, 17005, 26516, 1122,
-	1108, -1000, -1000, 386, 7434, -50, -1000, 7434, 1097, -1000,
-	-120, -117, 9190, 401, -1000, -1000, -1000, 1879, 13955, 976,
-	608, -45, -1000, -1000, -1000, 1157, -1000, 1157, 1157, 1157,
-	1157, -54, -54, -54, -54, -1000, -1000, -1000, -1000, -1000,
-	1178, 1177, -1000, 1157, 1157, 1157, 1157, -1000, -1000, -1000,
+	1192, 1192, 1192, 1171, 1171, 302, -1000, 12389, 159, 27689,
+	1501, 779, 149, -1000, 1475, 1265, -1000, 914, 904, -1000,
+	1120, -1000, -1000, 1540, -1000, -1000, 490, 672, 652, 470,
+	27689, 117, 212, -1000, 291, -1000, 27689, 1184, 1458, 491,
+	928, -1000, 928, -1000, -1000, -1000, -1000, 415, -1000, -1000,
+	928, 1113, -1000, 1101, 764, 635, 724, 627, 1113, -1000,
+	-1000, -142, 1113, -1000, 1113, -1000, 1113, -1000, 1113, -1000,
+	1113, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 492,
+	27689, 117, 646, -1000, 335, -1000, -1000, 646, 646, -1000,
+	-1000, -1000, -1000, 905, 899, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1176, 1176, 1176, 1159, 1159, 278,
-	-1000, 11795, 79, 26516, 1434, 757, 73, -1000, 1437, 1214,
-	-1000, 922, 885, -1000, 1107, -1000, -1000, 1477, -1000, -1000,
-	466, 611, 603, 452, 26516, 57, 176, -1000, 263, -1000,
-	26516, 1172, 1422, 472, 950, -1000, 950, -1000, -1000, -1000,
-	-1000, 385, -1000, -1000, 950, 1105, -1000, 1113, 691, 562,
-	654, 544, 1105, -1000, -1000, -174, 1105, -1000, 1105, -1000,
-	1105, -1000, 1105, -1000, 1105, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 488, 26516, 57, 715, -1000, 323, -1000,
-	-1000, 715, 715, -1000, -1000, -1000, -1000, 872, 869, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -316, 26516, 333, 62, 105,
-	26516, 26516, 26516, 26516, 26516, 359, -1000, -1000, -1000, 115,
-	26516, 26516, 381, -1000, 26516, 354, -1000, -1000, -1000, -1000,
-	-1000, -1000, 661, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 589, 26516, 26516, 26516, -1000, -1000, 589, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 26516, -1000, 855, 26516,
-	26516, -1000, -1000, -1000, -1000, -1000, 661, 441, 696, 515,
-	-1000, -1000, 714, -1000, -1000, 1956, -1000, -1000, -1000, -1000,
-	700, 13091, 13091, 13091, 549, 1956, 2465, 692, 944, 410,
-	605, 605, 411, 411, 411, 411, 411, 618, 618, -1000,
-	-1000, -1000, -1000, 908, -1000, -1000, -1000, 908, 10054, 10054,
-	1103, 1142, 379, -1000, 1167, -1000, -1000, 1453, 1034, 1034,
-	893, 709, 539, 1500, 1034, 520, 1498, 1034, 1034, 10054,
-	-1000, -1000, 559, -1000, 11795, 908, -1000, 591, 1102, 1101,
-	1034, 908, 908, 1034, 1034, 26516, -1000, -287, -1000, -136,
-	409, 1142, -1000, 19597, -1000, -1000, 1408, -1000, -1000, 1349,
-	-1000, 1306, 11795, 11795, 11795, -1000, -1000, -1000, 1408, 1455,
-	-1000, 1317, 1316, 1493, 10054, 19165, 1339, -1000, -1000, -1000,
-	377, 1493, 1100, 1142, -1000, 26516, 19165, 19165, 19165, 19165,
-	19165, -1000, 1253, 1251, -1000, 1264, 1263, 1289, 26516, -1000,
-	1048, 1003, 17005, 183, 1065, 19165, 26516, -1000, -1000, 19165,
-	26516, 6096, -1000, 1097, -50, -134, -1000, -1000, -1000, -1000,
-	661, -1000, 820, -1000, 2359, -1000, 266, -1000, -1000, -1000,
-	-1000, 1409, -1000, 527, -49, -1000, -1000, -54, -54, -1000,
-	-1000, 401, 710, 401, 401, 401, 854, 854, -1000, -1000,
-	-1000, -1000, -1000, 756, -1000, -1000, -1000, 748, -1000, -1000,
-	545, 1252, 79, -1000, -1000, 456, 851, 1368, 26516, -1000,
-	-1000, 954, 332, -1000, 1233, -1000, -1000, -1000, -1000, -1000,
-	3372, 26516, 1045, -1000, 49, 26516, 947, 26516, -1000, 1043,
-	26516, -1000, 950, -1000, -1000, 6988, -1000, 26516, 1142, -1000,
-	-1000, -1000, -1000, 345, 1405, 1399, 57, 49, 401, 950,
-	-1000, -1000, -1000, -1000, -1000, -320, 1037, 26516, 72, -1000,
-	1166, 899, -1000, 1202, -1000, -1000, -1000, -1000, 82, 108,
-	107, 311, -1000, -1000, -1000, -1000, 5650, 26516, -1000, -1000,
-	-1000, -1000, 548, -1000, 548, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 549, 1956, 2367, -1000, 13091, 13091, -1000, -1000,
-	1034, 1034, 10054, 6988, 1483, 1408, -1000, -1000, 371, 715,
-	371, 13091, 13091, -1000, 13091, 13091, -1000, -166, 1076, 453,
-	-1000, 11795, 879, -1000, -1000, 13091, 13091, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, 340, 337, 335, 26516,
-	-1000, -1000, 804, 841, 1297, 661, 661, -1000, -1000, 26516,
-	-1000, -1000, -1000, -1000, 1487, 11795, -1000, 1095, -1000, 5204,
-	1453, 1230, 26516, 1142, 1534, 14832, 26516, 1081, -1000, 459,
-	1228, 1201, 1229, 1459, -1000, -1000, -1000, -1000, 1249, -1000,
-	1247, -1000, -1000, -1000, -1000, -1000, 1003, 1493, 19165, 1075,
-	-1000, 1075, -1000, 376, -1000, -1000, -1000, -129, -128, -1000,
-	-1000, -1000, 1879, -1000, -1000, 1227, 13091, -1000, -1000, -1000,
-	401, 401, -1000, -1000, -1000, -1000, -1000, -1000, 1031, -1000,
-	1029, 1093, 1023, 35, -1000, 1194, 1386, 456, 456, -1000,
-	731, -1000, 950, -1000, -1000, 26516, 26516, 1474, 1085, -1000,
-	26516, -1000, -1000, 26516, -1000, -1000, 1312, 79, 1021, -1000,
-	-1000, -1000, 176, 26516, -1000, 966, 49, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1144, -1000, -1000, -1000, 933, -1000, -175,
-	950, 26516, 26516, 26516, -1000, 26516, -1000, -1000, 589, 589,
-	-1000, 13091, 1956, 1956, -1000, -1000, 908, -1000, 1453, -1000,
-	908, 1157, 1157, -1000, 1157, 1159, -1000, 1157, 25, 1157,
-	24, 908, 908, 2272, 2200, 2148, 1685, 1142, -161, -1000,
-	661, 11795, 1208, 816, 1142, 1142, 1142, 1015, 838, -54,
-	-1000, -1000, -1000, 1461, 1473, 661, -1000, -1000, -1000, 1427,
-	1082, 981, -1000, -1000, 9622, 1019, 1311, 373, 1015, 1483,
-	26516, 11795, -1000, -1000, 11795, 1148, -1000, 11795, -1000, -1000,
-	-1000, 1483, 1483, 1075, -1000, -1000, 421, -1000, -1000, -1000,
-	-1000, -27, 1526, 1956, -1000, -1000, -54, 837, -54, 686,
-	-1000, 649, -1000, -1000, -231, -1000, -1000, 1134, 1248, -1000,
-	-1000, 1144, -1000, 26516, 26516, -1000, -1000, 165, -1000, 251,
-	986, -1000, -190, -1000, -1000, 1442, 26516, -1000, -1000, 6988,
-	-1000, -1000, 1143, 1203, -1000, -1000, -1000, -1000, 1956, -1000,
-	1408, -1000, -1000, 218, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 13091, 13091, 13091, 13091, 13091, 1453, 836, 661,
-	13091, 13091, 16560, 18733, 18733, 16128, -54, -46, -1000, 11795,
-	11795, 1418, -1000, 1142, -1000, 1126, 26516, 1142, 26516, -1000,
-	1453, -1000, 661, 661, 26516, 661, 1453, -1000, 440, -1000,
-	-40, 401, -1000, 401, 916, 914, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1441, 1085, -1000, 162, 26516, -1000,
-	176, -1000, -196, -197, 1092, 983, 1077, -1000, 455, 26516,
-	26516, -1000, -1000, -1000, 591, 591, 591, 591, 195, 908,
-	-1000, 591, 591, 968, -1000, -1000, -1000, 968, 968, 409,
-	-282, -1000, 1355, 1351, 661, 1063, 1525, -1000, 1142, 1534,
-	368, 981, -1000, -1000, 965, -1000, 552, 1417, -1000, 1415,
-	-1000, -1000, -1000, -1000, -1000, 1092, 1142, 1124, -1000, -1000,
-	-1000, 152, -1000, 6988, 4758, 959, -1000, -1000, -1000, -1000,
-	-1000, 908, 96, -182, -1000, -1000, -1000, 18301, -1000, -1000,
-	-1000, -1000, -46, 211, -1000, 1321, 1319, 1467, 26516, 981,
-	26516, -1000, -1000, 831, -1000, -1000, 152, 12227, 26516, -1000,
-	-74, -1000, -1000, -1000, -1000, -1000, 1202, -1000, 1269, -170,
-	-187, -1000, -1000, 1331, 1333, 1333, 1351, 1466, 1341, 1338,
-	-1000, 830, 979, -1000, -1000, -1000, 591, 908, 931, 272,
-	-1000, -1000, -175, -1000, 1266, -1000, 1329, 742, -1000, -1000,
-	-1000, -1000, 828, -1000, 1462, 1460, -1000, -1000, -1000, 1226,
-	75, -1000, -179, -1000, 673, -1000, -1000, -1000, 806, 802,
-	1216, -1000, 1505, -1000, -185, -1000, -1000, -1000, -1000, -1000,
-	1523, 347, 347, -188, -1000, -1000, -1000, 269, 634, -1000,
-	-1000, -1000, -1000, -1000,
+	-1000, -314, 27689, 347, 137, 174, 27689, 27689, 27689, 27689,
+	27689, 392, -1000, -1000, -1000, 185, 27689, 27689, 368, -1000,
+	27689, 360, -1000, -1000, -1000, -1000, -1000, -1000, 673, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 624, 27689, 27689,
+	27689, -1000, -1000, 624, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 27689, -1000, 897, 27689, 27689, -1000, -1000, -1000,
+	-1000, -1000, 673, 514, 586, 543, -1000, -1000, 829, -1000,
+	-1000, 2713, -1000, -1000, -1000, -1000, 771, 13739, 13739, 13739,
+	1169, 2713, 2685, 632, 1152, 467, 617, 617, 461, 461,
+	461, 461, 461, 439, 439, -1000, -1000, -1000, -1000, 777,
+	-1000, -1000, -1000, 777, 11039, 11039, 1111, 1151, 411, -1000,
+	1193, -1000, 1030, -1000, 1516, 1013, 1013, 703, 834, 558,
+	1556, 1013, 556, 1551, 1013, 1013, 11039, -1000, -1000, 602,
+	-1000, 12389, 777, -1000, 1230, 1110, 1109, 1013, 777, 777,
+	1013, 1013, 27689, -1000, -283, -1000, -100, 431, 1151, -1000,
+	20489, -1000, -1000, 1450, -1000, 1151, 1049, -1000, -1000, 1385,
+	-1000, 1331, 12389, 12389, 12389, -1000, -1000, -1000, 1450, 1512,
+	-1000, 1360, 1359, 1549, 11039, 20039, 1348, -1000, -1000, -1000,
+	410, 1549, 209, 1151, -1000, 27689, 20039, 20039, 20039, 20039,
+	20039, -1000, 1315, 1311, -1000, 1312, 1290, 1321, 27689, -1000,
+	1038, 1030, 1024, 15989, 214, 1088, 20039, 27689, -1000, -1000,
+	20039, 27689, 6539, -1000, 1107, -12, -41, -1000, -1000, -1000,
+	-1000, 673, -1000, 898, -1000, 2440, -1000, 269, -1000, -1000,
+	-1000, -1000, 1443, -1000, 691, 25, -1000, -1000, 47, 47,
+	-1000, -1000, 438, 523, 438, 438, 438, 892, 892, -1000,
+	-1000, -1000, -1000, -1000, 772, -1000, -1000, -1000, 765, -1000,
+	-1000, 801, 1295, 159, -1000, -1000, 538, 889, 1399, 27689,
+	-1000, -1000, 966, 344, -1000, 1275, -1000, -1000, -1000, -1000,
+	-1000, 3384, 27689, 1036, -1000, 129, 27689, 959, 27689, -1000,
+	1019, 27689, -1000, 928, -1000, -1000, 7439, -1000, 27689, 1151,
+	-1000, -1000, -1000, -1000, 364, 1441, 1414, 117, 129, 438,
+	928, -1000, -1000, -1000, -1000, -1000, -317, 1016, 27689, 140,
+	-1000, 1174, 937, -1000, 1198, -1000, -1000, -1000, -1000, 119,
+	196, 183, 331, -1000, -1000, -1000, -1000, 6089, 27689, -1000,
+	-1000, -1000, -1000, 614, -1000, 614, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1169, 2713, 2184, -1000, 13739, 13739, -1000,
+	-201, 1013, 1013, 11039, 7439, 1520, -1000, 1450, -1000, -1000,
+	371, 646, 371, 13739, 13739, -1000, 13739, 13739, -1000, -136,
+	1143, 569, -1000, 12389, 741, -1000, -1000, 13739, 13739, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 361, 358,
+	355, 27689, -1000, -1000, -1000, -1000, 27689, 815, 887, 1329,
+	673, 673, -1000, -1000, 27689, -1000, -1000, -1000, -1000, 1547,
+	12389, -1000, 1095, -1000, 5639, 1516, 1274, 27689, 1151, 1574,
+	1024, 15539, 27689, 1098, -1000, 540, 1271, 1244, 1272, 1257,
+	-1000, -1000, -1000, -1000, 1289, -1000, 1288, -1000, -1000, -1000,
+	-1000, -1000, 1030, 1030, 1549, 20039, 1076, -1000, 1076, -1000,
+	409, -1000, -1000, -1000, -91, -61, -1000, -1000, -1000, 287,
+	-1000, -1000, 1270, 13739, -1000, -1000, -1000, 438, 438, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1010, -1000, 996, 1094, 994,
+	44, -1000, 1150, 1408, 538, 538, -1000, 743, -1000, 928,
+	-1000, -1000, 27689, 27689, 1535, 1092, 27689, -1000, -1000, 27689,
+	-1000, -1000, 1357, 159, 991, -1000, -1000, -1000, 212, 27689,
+	-1000, 875, 129, -1000, -1000, -1000, -1000, -1000, -1000, 1161,
+	-1000, -1000, -1000, 946, -1000, -143, 928, 27689, 27689, 27689,
+	-1000, 27689, -1000, -1000, 624, 624, -1000, 13739, 2713, 2713,
+	-1000, 20039, -1000, -1000, 777, -1000, 1516, -1000, 777, 1165,
+	1165, -1000, 1165, 1171, -1000, 1165, 99, 1165, 94, 777,
+	777, 2502, 2275, 2228, 2041, 1151, -130, -1000, 673, 12389,
+	1568, 1264, 1151, 1151, 1151, 976, -1000, 886, 47, -1000,
+	-1000, -1000, 1545, 1530, 673, -1000, -1000, -1000, 1465, 987,
+	1068, -1000, -1000, 10589, 1574, 984, 1338, 407, 976, 1520,
+	27689, 12389, -1000, -1000, 12389, 1163, -1000, 12389, -1000, -1000,
+	-1000, -1000, 1520, 1520, 1076, -1000, -1000, 495, -1000, -1000,
+	-1000, -1000, 51, 1565, 2713, -1000, -1000, 47, 865, 47,
+	740, -1000, 737, -1000, -1000, -217, -1000, -1000, 1138, 1280,
+	-1000, -1000, 1161, -1000, 27689, -1000, -1000, 187, -1000, 261,
+	974, -1000, -174, -1000, -1000, 1487, -1000, -1000, 7439, -1000,
+	-1000, 1157, 1255, -1000, -1000, -1000, -1000, 2713, 28124, -1000,
+	-1000, 1450, -1000, -1000, 265, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 13739, 13739, 13739, 13739, 13739, 1516, 862,
+	673, 13739, 13739, 17789, 19589, 19589, 17339, 47, 22, -209,
+	12389, 12389, 1456, -1000, 1151, -1000, 180, 27689, 1151, 27689,
+	-1000, 1516, -1000, 673, 673, 27689, 673, 1516, -1000, 475,
+	-1000, -1, 438, -1000, 438, 940, 885, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1483, 1092, 203, 27689, -1000,
+	212, -1000, -179, -180, 1172, 1085, -1000, 528, 27689, 27689,
+	777, 211, -1000, -1000, -1000, -1000, 1230, 1230, 1230, 1230,
+	144, 777, -1000, 1230, 1230, 965, -1000, -1000, -1000, 965,
+	965, 431, -277, -1000, 1390, 1382, -1000, 27689, 673, 998,
+	1564, -1000, 1151, 1574, 1024, 382, 1068, -1000, -1000, 956,
+	-1000, 684, 1452, -1000, 1447, -1000, -1000, -1000, -1000, -1000,
+	1172, 1151, 1153, -1000, -1000, -1000, 175, 7439, 5189, 945,
+	-1000, -1000, 1520, 1529, -1000, -1000, -1000, -1000, 777, 128,
+	-154, -1000, -1000, -1000, 19139, -1000, -1000, -1000, -1000, 22,
+	279, -1000, 1365, 1362, 1528, 1084, -1000, 1481, 27689, 1068,
+	1574, 27689, -1000, -1000, 860, -1000, -1000, 175, 12839, 27689,
+	-1000, -67, -1000, -1000, -1000, -1000, -1000, 1198, 143, 12389,
+	-1000, 1323, -139, -170, -1000, -1000, 1370, 1373, 1373, 1382,
+	1526, 1380, 1377, -1000, 856, 27689, 1151, 1051, -1000, -1000,
+	-1000, 1230, 777, 943, 292, -1000, -1000, -143, -1000, 90,
+	-1000, -1000, 998, -1000, 1322, -1000, 1367, 722, -1000, -1000,
+	-1000, -1000, 852, -1000, 1525, 1513, -1000, -1000, 28124, -1000,
+	-1000, 1269, 151, -1000, -1000, 61, -273, -220, -223, -1000,
+	-1000, 13739, -146, -1000, 714, -1000, -1000, -1000, 851, 827,
+	777, 1147, -1000, 1555, -1000, 634, -1000, -1000, -1000, -1000,
+	-1000, 14189, -166, -1000, -1000, -1000, -1000, -1000, -1000, 1563,
+	433, 433, 61, -1000, -172, -1000, -1000, -1000, 270, 826,
+	-1000, -1000, -1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1805, 1801, 13, 86, 82, 1800, 1798, 1797, 1796,
-	128, 127, 126, 1794, 1782, 1780, 1779, 1778, 1777, 1776,
-	1775, 1774, 1771, 1770, 1768, 61, 110, 115, 1766, 1762,
-	1761, 1760, 1759, 122, 121, 468, 1755, 119, 1750, 1747,
-	1746, 1743, 1741, 1740, 1738, 1737, 1736, 1735, 1733, 1730,
-	199, 1729, 1728, 7, 1726, 83, 1722, 1720, 1719, 1718,
-	1715, 1714, 1712, 106, 1709, 46, 286, 48, 75, 1706,
-	72, 770, 1700, 90, 116, 1698, 447, 1697, 42, 78,
-	96, 1693, 40, 1692, 1691, 94, 1690, 1688, 1687, 69,
-	1686, 1684, 2519, 1683, 67, 76, 17, 55, 1682, 1681,
-	1680, 1679, 31, 1286, 1678, 1677, 21, 1676, 1675, 125,
-	1673, 81, 25, 20, 19, 18, 1672, 77, 1670, 8,
-	54, 32, 1668, 80, 1667, 1666, 1664, 1662, 33, 1661,
-	73, 99, 24, 1660, 6, 10, 1659, 1658, 1657, 1656,
-	1654, 1653, 4, 1652, 1650, 1649, 1648, 26, 1645, 9,
-	23, 38, 71, 111, 29, 12, 1644, 120, 1643, 27,
-	105, 68, 103, 1642, 1639, 1638, 939, 1637, 59, 1635,
-	131, 1633, 1628, 44, 1627, 431, 762, 1626, 1625, 1623,
-	64, 1117, 2383, 45, 104, 1621, 1617, 1689, 57, 74,
-	22, 1614, 1613, 1611, 123, 49, 51, 896, 43, 1608,
-	1607, 1605, 1604, 1603, 1602, 1601, 248, 1600, 1599, 1598,
-	34, 16, 95, 30, 1597, 1596, 1593, 1592, 1591, 66,
-	36, 1589, 101, 100, 62, 117, 1588, 112, 85, 70,
-	1587, 56, 1586, 1585, 1582, 1579, 41, 1575, 1574, 1572,
-	1571, 102, 84, 63, 37, 39, 98, 1570, 35, 1569,
-	1568, 97, 87, 1566, 15, 113, 11, 1556, 3, 0,
-	1555, 5, 109, 1388, 114, 1554, 1552, 1, 1551, 2,
-	1550, 1549, 79, 1548, 1547, 1544, 1543, 2749, 28, 107,
-	1541, 118,
+	0, 1874, 120, 46, 110, 95, 31, 1024, 1873, 4,
+	1871, 1870, 3, 1867, 1866, 1864, 2, 1862, 1861, 1860,
+	1859, 1858, 1857, 1856, 135, 134, 130, 1854, 1853, 1852,
+	1851, 1849, 1847, 1845, 1844, 1842, 1839, 1838, 1837, 67,
+	131, 133, 1836, 1835, 1834, 1832, 1831, 126, 121, 446,
+	1830, 124, 1829, 1825, 1823, 1822, 1820, 1819, 1818, 1817,
+	1816, 1815, 1808, 1805, 205, 1803, 1799, 9, 1798, 92,
+	1795, 1789, 1786, 1783, 1781, 1775, 1773, 90, 1771, 44,
+	204, 42, 83, 1766, 84, 786, 1765, 103, 123, 1763,
+	109, 1762, 57, 79, 91, 1761, 50, 1759, 1758, 101,
+	1756, 1755, 1754, 78, 1752, 1750, 3346, 1749, 66, 74,
+	17, 77, 1748, 1745, 1742, 1741, 35, 1212, 1739, 1737,
+	19, 1736, 1735, 141, 1734, 88, 10, 18, 16, 21,
+	1732, 89, 1731, 100, 43, 33, 1729, 86, 1728, 1716,
+	1715, 1714, 15, 1713, 82, 104, 24, 1710, 6, 11,
+	1709, 1695, 1694, 1693, 1692, 1691, 7, 1689, 1688, 1687,
+	1686, 56, 1684, 13, 20, 27, 70, 39, 28, 12,
+	1683, 146, 1682, 25, 112, 65, 106, 1679, 1677, 1676,
+	904, 1675, 45, 1674, 125, 1670, 1669, 41, 1668, 441,
+	816, 1667, 1666, 1663, 71, 1010, 1538, 73, 119, 1662,
+	1661, 2997, 80, 75, 23, 1660, 1659, 1658, 127, 61,
+	59, 851, 38, 1657, 1656, 1654, 1653, 1652, 1649, 1648,
+	40, 1645, 1644, 1643, 107, 69, 117, 29, 1641, 1640,
+	1634, 1633, 1630, 68, 53, 1628, 115, 113, 72, 116,
+	1626, 122, 96, 63, 1625, 58, 1624, 1623, 1622, 1620,
+	36, 1618, 1616, 1614, 1613, 111, 102, 64, 30, 34,
+	93, 1611, 37, 1603, 1602, 108, 105, 1600, 26, 132,
+	14, 1599, 22, 0, 1596, 8, 129, 1419, 114, 1595,
+	1594, 5, 1593, 1, 1592, 1590, 85, 1588, 1585, 1584,
+	1583, 2725, 1230, 118, 1581, 128,
 }
 
 var yyR1 = [...]int{
-	0, 275, 276, 276, 1, 1, 1, 1, 1, 1,
+	0, 289, 290, 290, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 259, 259, 259, 262, 262, 21, 47,
-	3, 3, 3, 3, 2, 2, 8, 9, 4, 5,
-	5, 10, 10, 57, 57, 11, 12, 12, 12, 12,
-	279, 279, 87, 87, 85, 85, 86, 86, 152, 152,
-	13, 14, 14, 162, 162, 161, 161, 161, 163, 163,
-	163, 163, 197, 197, 15, 15, 15, 15, 15, 64,
-	64, 261, 261, 260, 258, 258, 257, 257, 256, 23,
-	24, 30, 31, 32, 263, 263, 232, 36, 36, 35,
-	35, 35, 35, 37, 37, 34, 34, 33, 33, 234,
-	234, 221, 221, 233, 233, 233, 233, 233, 233, 233,
-	220, 199, 199, 199, 199, 202, 202, 200, 200, 200,
-	200, 200, 200, 200, 200, 200, 201, 201, 201, 201,
-	201, 203, 203, 203, 203, 203, 204, 204, 204, 204,
-	204, 204, 204, 204, 204, 204, 204, 204, 204, 204,
-	204, 205, 205, 205, 205, 205, 205, 205, 205, 219,
-	219, 206, 206, 212, 212, 213, 213, 213, 215, 215,
-	216, 216, 177, 177, 177, 208, 208, 209, 209, 214,
-	214, 210, 210, 210, 211, 211, 211, 218, 218, 218,
-	218, 218, 207, 207, 222, 248, 248, 247, 247, 243,
-	243, 243, 243, 231, 231, 240, 240, 240, 240, 240,
-	230, 230, 226, 226, 226, 227, 227, 228, 228, 225,
-	225, 229, 229, 242, 242, 241, 223, 223, 224, 224,
-	251, 251, 251, 251, 252, 268, 269, 267, 267, 267,
-	267, 267, 55, 55, 55, 178, 178, 178, 238, 238,
-	237, 237, 237, 239, 239, 236, 236, 236, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
-	236, 236, 236, 236, 236, 236, 236, 236, 236, 236,
-	236, 236, 236, 236, 172, 172, 172, 266, 266, 266,
-	266, 266, 266, 265, 265, 265, 235, 235, 235, 264,
-	264, 120, 120, 121, 121, 28, 28, 28, 28, 28,
-	28, 27, 27, 27, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 29, 29, 26, 26,
-	26, 26, 26, 26, 26, 26, 26, 16, 16, 16,
-	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 255, 255, 255, 255, 255, 255, 255, 255,
-	255, 255, 255, 255, 255, 255, 255, 255, 255, 255,
-	255, 255, 255, 255, 217, 217, 217, 253, 253, 254,
-	254, 17, 22, 22, 18, 18, 18, 18, 19, 19,
-	38, 39, 39, 39, 39, 39, 39, 39, 39, 39,
+	1, 1, 1, 1, 273, 273, 273, 276, 276, 35,
+	61, 3, 3, 3, 3, 6, 6, 19, 19, 7,
+	2, 2, 22, 23, 4, 5, 5, 24, 24, 71,
+	71, 25, 26, 26, 26, 26, 293, 293, 101, 101,
+	99, 99, 100, 100, 166, 166, 27, 28, 28, 176,
+	176, 175, 175, 175, 177, 177, 177, 177, 211, 211,
+	29, 29, 29, 29, 29, 78, 78, 275, 275, 274,
+	272, 272, 271, 271, 270, 37, 38, 44, 45, 46,
+	277, 277, 246, 50, 50, 49, 49, 49, 49, 51,
+	51, 48, 48, 47, 47, 248, 248, 235, 235, 247,
+	247, 247, 247, 247, 247, 247, 234, 213, 213, 213,
+	213, 216, 216, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 215, 215, 215, 215, 215, 217, 217, 217,
+	217, 217, 218, 218, 218, 218, 218, 218, 218, 218,
+	218, 218, 218, 218, 218, 218, 218, 219, 219, 219,
+	219, 219, 219, 219, 219, 233, 233, 220, 220, 226,
+	226, 227, 227, 227, 229, 229, 230, 230, 191, 191,
+	191, 222, 222, 223, 223, 228, 228, 224, 224, 224,
+	225, 225, 225, 232, 232, 232, 232, 232, 221, 221,
+	236, 262, 262, 261, 261, 257, 257, 257, 257, 245,
+	245, 254, 254, 254, 254, 254, 244, 244, 240, 240,
+	240, 241, 241, 242, 242, 239, 239, 243, 243, 256,
+	256, 255, 237, 237, 238, 238, 265, 265, 265, 265,
+	266, 282, 283, 281, 281, 281, 281, 281, 69, 69,
+	69, 192, 192, 192, 252, 252, 251, 251, 251, 253,
+	253, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	250, 250, 250, 250, 250, 250, 250, 250, 250, 250,
+	186, 186, 186, 280, 280, 280, 280, 280, 280, 279,
+	279, 279, 249, 249, 249, 278, 278, 134, 134, 135,
+	135, 42, 42, 42, 42, 42, 42, 41, 41, 41,
 	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
 	39, 39, 39, 39, 39, 39, 39, 39, 39, 39,
-	39, 39, 39, 39, 39, 39, 39, 39, 39, 169,
-	169, 270, 270, 171, 171, 167, 167, 170, 170, 168,
-	168, 168, 173, 173, 173, 174, 174, 274, 274, 274,
-	40, 40, 42, 42, 43, 44, 44, 192, 192, 193,
-	193, 45, 46, 56, 56, 56, 56, 56, 56, 58,
-	58, 58, 7, 7, 7, 7, 52, 52, 52, 6,
-	6, 41, 41, 48, 271, 271, 272, 273, 273, 273,
-	273, 49, 20, 280, 50, 51, 51, 63, 63, 63,
-	59, 59, 59, 62, 62, 62, 67, 67, 69, 69,
-	69, 69, 69, 70, 70, 70, 70, 70, 70, 66,
-	66, 68, 68, 68, 68, 185, 185, 185, 184, 184,
-	77, 77, 78, 78, 79, 79, 80, 80, 80, 118,
-	95, 95, 150, 150, 149, 149, 151, 151, 151, 151,
-	153, 153, 81, 81, 81, 81, 82, 82, 83, 83,
-	84, 84, 191, 191, 190, 190, 190, 189, 189, 88,
-	88, 88, 90, 89, 89, 89, 89, 91, 91, 93,
-	93, 92, 92, 94, 96, 96, 96, 96, 96, 97,
-	97, 76, 76, 76, 76, 76, 76, 76, 76, 165,
-	165, 99, 99, 98, 98, 98, 98, 98, 98, 98,
-	98, 98, 98, 110, 110, 110, 110, 110, 110, 100,
-	100, 100, 100, 100, 100, 100, 65, 65, 111, 111,
-	111, 117, 112, 112, 103, 103, 103, 103, 103, 103,
-	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
-	103, 103, 103, 103, 103, 103, 103, 103, 103, 103,
-	103, 103, 103, 103, 103, 103, 103, 103, 107, 107,
-	107, 107, 105, 105, 105, 105, 105, 105, 105, 105,
-	105, 105, 105, 105, 105, 105, 106, 106, 106, 106,
-	106, 106, 106, 106, 106, 106, 106, 106, 106, 106,
-	106, 106, 281, 281, 109, 108, 108, 108, 108, 108,
-	108, 108, 61, 61, 61, 61, 61, 196, 196, 196,
-	198, 198, 198, 198, 198, 198, 198, 198, 198, 198,
-	198, 198, 198, 124, 124, 60, 60, 122, 122, 123,
-	125, 125, 119, 119, 119, 102, 102, 102, 102, 102,
-	102, 102, 102, 104, 104, 104, 126, 126, 127, 127,
-	128, 128, 129, 129, 130, 131, 131, 131, 132, 132,
-	132, 132, 249, 249, 249, 249, 249, 244, 244, 244,
-	244, 245, 245, 245, 71, 71, 71, 71, 73, 73,
-	72, 72, 53, 53, 54, 54, 54, 74, 74, 75,
-	75, 75, 75, 147, 147, 147, 133, 133, 133, 133,
-	138, 138, 138, 134, 134, 136, 136, 136, 137, 137,
-	137, 135, 141, 141, 143, 143, 142, 142, 140, 140,
-	145, 145, 144, 144, 139, 139, 101, 101, 101, 101,
-	101, 148, 148, 148, 148, 154, 154, 113, 113, 115,
-	115, 114, 116, 155, 155, 159, 156, 156, 160, 160,
-	160, 160, 160, 157, 157, 158, 158, 186, 186, 186,
-	164, 164, 175, 175, 176, 176, 166, 166, 179, 179,
-	179, 146, 146, 146, 146, 250, 250, 246, 182, 182,
-	183, 183, 187, 187, 188, 188, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	180, 180, 180, 180, 180, 180, 180, 180, 180, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 181, 277, 278, 194,
-	195, 195, 195,
+	39, 39, 43, 43, 40, 40, 40, 40, 40, 40,
+	40, 40, 40, 30, 30, 30, 30, 30, 30, 30,
+	30, 30, 30, 30, 30, 30, 30, 30, 269, 269,
+	269, 269, 269, 269, 269, 269, 269, 269, 269, 269,
+	269, 269, 269, 269, 269, 269, 269, 269, 269, 269,
+	231, 231, 231, 267, 267, 268, 268, 31, 36, 36,
+	32, 32, 32, 32, 33, 33, 52, 53, 53, 53,
+	53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	53, 53, 53, 53, 53, 53, 53, 53, 53, 53,
+	53, 53, 53, 53, 53, 183, 183, 284, 284, 185,
+	185, 181, 181, 184, 184, 182, 182, 182, 187, 187,
+	187, 188, 188, 288, 288, 288, 54, 54, 56, 56,
+	57, 58, 58, 206, 206, 207, 207, 59, 60, 70,
+	70, 70, 70, 70, 70, 72, 72, 72, 21, 21,
+	21, 21, 66, 66, 66, 20, 20, 55, 55, 62,
+	285, 285, 286, 287, 287, 287, 287, 63, 34, 294,
+	64, 65, 65, 77, 77, 77, 73, 73, 73, 76,
+	76, 76, 81, 81, 83, 83, 83, 83, 83, 84,
+	84, 84, 84, 84, 84, 80, 80, 82, 82, 82,
+	82, 199, 199, 199, 198, 198, 91, 91, 92, 92,
+	93, 93, 94, 94, 94, 132, 132, 109, 109, 164,
+	164, 163, 163, 165, 165, 165, 165, 167, 167, 95,
+	95, 95, 95, 96, 96, 97, 97, 98, 98, 205,
+	205, 204, 204, 204, 203, 203, 102, 102, 102, 104,
+	103, 103, 103, 103, 105, 105, 107, 107, 106, 106,
+	108, 110, 110, 110, 110, 110, 111, 111, 90, 90,
+	90, 90, 90, 90, 90, 90, 179, 179, 113, 113,
+	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
+	124, 124, 124, 124, 124, 124, 114, 114, 114, 114,
+	114, 114, 114, 79, 79, 125, 125, 125, 131, 131,
+	126, 126, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 117, 117, 117, 117,
+	117, 117, 117, 117, 117, 117, 121, 121, 121, 121,
+	121, 119, 119, 119, 119, 119, 119, 119, 119, 119,
+	119, 119, 119, 119, 119, 120, 120, 120, 120, 120,
+	120, 120, 120, 120, 120, 120, 120, 120, 120, 120,
+	120, 295, 295, 123, 122, 122, 122, 122, 122, 122,
+	122, 75, 75, 75, 75, 75, 210, 210, 210, 212,
+	212, 212, 212, 212, 212, 212, 212, 212, 212, 212,
+	212, 212, 138, 138, 74, 74, 136, 136, 137, 139,
+	139, 133, 133, 133, 116, 116, 116, 116, 116, 116,
+	116, 116, 118, 118, 118, 140, 140, 141, 141, 17,
+	17, 18, 18, 16, 8, 8, 9, 15, 15, 14,
+	14, 10, 10, 10, 11, 11, 12, 12, 12, 12,
+	12, 13, 13, 13, 142, 142, 143, 143, 144, 145,
+	145, 145, 146, 146, 146, 146, 263, 263, 263, 263,
+	263, 258, 258, 258, 258, 259, 259, 259, 85, 85,
+	85, 85, 87, 87, 86, 86, 67, 67, 68, 68,
+	68, 88, 88, 89, 89, 89, 89, 161, 161, 161,
+	147, 147, 147, 147, 152, 152, 152, 148, 148, 150,
+	150, 150, 151, 151, 151, 149, 155, 155, 157, 157,
+	156, 156, 154, 154, 159, 159, 158, 158, 153, 153,
+	115, 115, 115, 115, 115, 115, 115, 162, 162, 162,
+	162, 168, 168, 127, 127, 129, 129, 128, 130, 169,
+	169, 173, 170, 170, 174, 174, 174, 174, 174, 171,
+	171, 172, 172, 200, 200, 200, 178, 178, 189, 189,
+	190, 190, 180, 180, 193, 193, 193, 160, 160, 160,
+	160, 264, 264, 260, 196, 196, 197, 197, 201, 201,
+	202, 202, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 194, 194, 194, 194,
+	194, 194, 194, 194, 194, 194, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 195, 195, 195,
+	195, 195, 195, 195, 195, 195, 195, 291, 292, 208,
+	209, 209, 209,
 }
 
 var yyR2 = [...]int{
-	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
+	0, 2, 0, 1, 1, 2, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 0, 1, 1, 1, 0, 1, 2, 3,
-	5, 6, 6, 7, 4, 6, 5, 7, 8, 1,
-	3, 7, 8, 1, 1, 9, 9, 8, 7, 7,
-	1, 1, 1, 3, 1, 3, 1, 3, 0, 4,
-	3, 5, 4, 1, 3, 3, 2, 2, 2, 2,
-	2, 1, 1, 1, 2, 2, 6, 11, 2, 0,
-	2, 0, 2, 1, 0, 2, 1, 3, 3, 4,
-	3, 7, 4, 2, 1, 1, 4, 0, 1, 1,
-	1, 2, 2, 0, 1, 4, 4, 4, 4, 2,
-	4, 1, 3, 1, 1, 3, 4, 3, 3, 3,
-	8, 3, 1, 1, 1, 2, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 2, 2, 2, 2,
-	2, 1, 2, 2, 2, 2, 4, 4, 2, 2,
-	3, 3, 3, 3, 1, 1, 1, 1, 1, 6,
-	6, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	3, 0, 3, 0, 5, 0, 3, 5, 0, 1,
-	0, 1, 0, 1, 2, 0, 2, 0, 3, 0,
-	1, 0, 2, 2, 0, 2, 2, 0, 2, 1,
-	2, 1, 0, 2, 5, 0, 1, 1, 2, 1,
-	3, 2, 3, 0, 1, 3, 3, 3, 4, 2,
-	0, 2, 1, 1, 1, 1, 1, 0, 1, 1,
-	1, 0, 1, 1, 3, 3, 3, 1, 3, 1,
-	10, 11, 11, 12, 5, 3, 3, 1, 1, 2,
-	2, 2, 0, 1, 1, 0, 1, 2, 0, 1,
-	1, 3, 2, 1, 2, 3, 3, 4, 4, 3,
-	3, 3, 3, 4, 4, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 4, 5, 0, 2, 2, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 1, 0,
-	1, 0, 2, 0, 2, 0, 1, 5, 1, 3,
-	7, 1, 3, 3, 1, 2, 2, 2, 5, 5,
-	5, 6, 6, 5, 5, 2, 2, 2, 2, 3,
-	3, 3, 4, 1, 3, 5, 1, 3, 3, 3,
-	3, 3, 3, 3, 3, 2, 2, 2, 4, 4,
-	2, 10, 3, 6, 7, 5, 5, 5, 12, 7,
-	5, 9, 5, 3, 7, 4, 4, 4, 4, 3,
-	3, 3, 7, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 0, 2, 2, 1, 3, 8,
-	8, 3, 3, 5, 5, 6, 5, 4, 3, 2,
-	3, 3, 3, 3, 3, 3, 3, 4, 2, 4,
-	4, 4, 4, 4, 5, 7, 4, 4, 4, 4,
-	4, 4, 4, 4, 2, 4, 7, 2, 4, 5,
-	4, 3, 3, 5, 2, 3, 3, 3, 3, 1,
-	1, 1, 1, 0, 1, 0, 1, 1, 1, 0,
-	2, 2, 0, 2, 2, 0, 2, 0, 1, 1,
-	2, 1, 1, 2, 1, 1, 5, 0, 1, 0,
-	1, 2, 3, 0, 3, 3, 3, 3, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 1, 1, 3,
-	3, 2, 2, 3, 1, 3, 2, 1, 2, 1,
-	2, 2, 2, 0, 2, 0, 2, 1, 2, 2,
-	0, 1, 1, 0, 1, 1, 0, 1, 0, 1,
-	2, 3, 4, 1, 1, 1, 1, 1, 1, 1,
-	3, 1, 2, 3, 5, 0, 1, 2, 1, 1,
-	0, 2, 1, 3, 1, 1, 1, 3, 3, 3,
-	3, 7, 0, 3, 1, 3, 1, 1, 3, 3,
-	1, 3, 4, 4, 4, 3, 2, 4, 0, 1,
-	0, 2, 0, 1, 0, 1, 2, 1, 1, 1,
-	2, 2, 1, 2, 3, 2, 3, 2, 2, 2,
-	1, 1, 3, 3, 0, 5, 4, 5, 5, 0,
-	2, 1, 3, 3, 3, 2, 3, 1, 2, 0,
-	3, 1, 1, 3, 3, 4, 4, 5, 3, 4,
-	5, 6, 2, 1, 2, 1, 2, 1, 2, 1,
-	1, 1, 1, 1, 1, 1, 0, 2, 1, 1,
-	1, 3, 1, 3, 1, 1, 1, 1, 1, 3,
+	1, 1, 1, 0, 1, 1, 1, 0, 1, 2,
+	3, 5, 6, 6, 7, 3, 3, 0, 1, 4,
+	4, 6, 5, 7, 9, 1, 3, 7, 8, 1,
+	1, 9, 9, 8, 7, 7, 1, 1, 1, 3,
+	1, 3, 1, 3, 0, 4, 3, 5, 4, 1,
+	3, 3, 2, 2, 2, 2, 2, 1, 1, 1,
+	2, 2, 6, 11, 2, 0, 2, 0, 2, 1,
+	0, 2, 1, 3, 3, 4, 3, 7, 4, 2,
+	1, 1, 4, 0, 1, 1, 1, 2, 2, 0,
+	1, 4, 4, 4, 4, 2, 4, 1, 3, 1,
+	1, 3, 4, 3, 3, 3, 8, 3, 1, 1,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 2, 2, 2, 2, 2, 1, 2, 2,
+	2, 2, 4, 4, 2, 2, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 6, 6, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 3, 0, 3, 0,
+	5, 0, 3, 5, 0, 1, 0, 1, 0, 1,
+	2, 0, 2, 0, 3, 0, 1, 0, 2, 2,
+	0, 2, 2, 0, 2, 1, 2, 1, 0, 2,
+	5, 0, 1, 1, 2, 1, 3, 2, 3, 0,
+	1, 3, 3, 3, 4, 2, 0, 2, 1, 1,
+	1, 1, 1, 0, 1, 1, 1, 0, 1, 1,
+	3, 3, 3, 1, 3, 1, 10, 11, 11, 12,
+	5, 3, 3, 1, 1, 2, 2, 2, 0, 1,
+	1, 0, 1, 2, 0, 1, 1, 3, 2, 1,
+	2, 3, 3, 4, 4, 3, 3, 3, 3, 4,
+	4, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 4, 5,
+	0, 2, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 0, 1, 0, 2, 0,
+	2, 0, 1, 5, 1, 3, 7, 1, 3, 3,
+	1, 2, 2, 2, 5, 5, 5, 6, 6, 5,
+	5, 2, 2, 2, 2, 3, 3, 3, 4, 1,
+	3, 5, 1, 3, 3, 3, 3, 3, 3, 3,
+	3, 2, 2, 2, 4, 4, 2, 10, 3, 6,
+	7, 5, 5, 5, 12, 7, 5, 9, 5, 3,
+	7, 4, 4, 4, 4, 3, 3, 3, 7, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	0, 2, 2, 1, 3, 8, 8, 3, 3, 5,
+	5, 6, 5, 4, 3, 2, 3, 3, 3, 3,
+	3, 3, 3, 4, 2, 4, 4, 4, 4, 4,
+	5, 7, 4, 4, 4, 4, 4, 4, 4, 4,
+	2, 4, 7, 2, 4, 5, 4, 3, 3, 5,
+	2, 3, 3, 3, 3, 1, 1, 1, 1, 0,
+	1, 0, 1, 1, 1, 0, 2, 2, 0, 2,
+	2, 0, 2, 0, 1, 1, 2, 1, 1, 2,
+	1, 1, 5, 0, 1, 0, 1, 2, 3, 0,
+	3, 3, 3, 3, 1, 1, 1, 1, 1, 1,
+	1, 1, 0, 1, 1, 3, 3, 2, 2, 3,
+	1, 3, 2, 1, 2, 1, 2, 2, 2, 0,
+	2, 0, 2, 1, 2, 2, 0, 1, 1, 0,
+	1, 1, 0, 1, 0, 1, 2, 3, 4, 1,
+	1, 1, 1, 1, 1, 1, 3, 1, 2, 3,
+	5, 0, 1, 2, 1, 1, 0, 2, 1, 3,
+	1, 1, 1, 3, 3, 3, 4, 3, 7, 0,
+	3, 1, 3, 1, 1, 3, 3, 1, 3, 4,
+	4, 4, 3, 2, 4, 0, 1, 0, 2, 0,
+	1, 0, 1, 2, 1, 1, 1, 2, 2, 1,
+	2, 3, 2, 3, 2, 2, 2, 1, 1, 3,
+	3, 0, 5, 4, 5, 5, 0, 2, 1, 3,
+	3, 3, 2, 3, 1, 2, 0, 3, 1, 1,
+	3, 3, 4, 4, 5, 3, 4, 5, 6, 2,
+	1, 2, 1, 2, 1, 2, 1, 1, 1, 1,
+	1, 1, 1, 0, 2, 1, 1, 1, 3, 4,
+	1, 3, 1, 1, 1, 1, 1, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 2, 2, 2, 2, 2, 2,
-	2, 2, 2, 3, 1, 1, 1, 1, 4, 5,
-	5, 6, 4, 4, 6, 6, 6, 8, 8, 8,
-	8, 9, 8, 5, 4, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	8, 8, 0, 2, 3, 4, 4, 4, 4, 4,
-	4, 4, 0, 3, 4, 7, 3, 1, 1, 1,
-	2, 3, 3, 1, 2, 2, 1, 2, 1, 2,
-	2, 1, 2, 0, 1, 0, 2, 1, 2, 4,
-	0, 2, 1, 3, 5, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 2, 2, 0, 3, 0, 2,
-	0, 3, 1, 3, 2, 0, 1, 1, 0, 2,
-	4, 4, 0, 2, 2, 1, 1, 3, 3, 3,
-	3, 3, 3, 3, 0, 3, 3, 3, 0, 3,
-	1, 1, 0, 4, 0, 1, 1, 0, 3, 1,
-	3, 2, 1, 0, 2, 4, 0, 9, 3, 5,
-	0, 3, 3, 0, 1, 0, 2, 2, 0, 2,
-	2, 2, 0, 3, 0, 3, 0, 3, 0, 4,
-	0, 3, 0, 4, 0, 1, 2, 1, 5, 4,
-	4, 1, 3, 3, 5, 0, 5, 1, 3, 1,
-	2, 3, 1, 1, 3, 3, 1, 3, 3, 3,
-	3, 3, 2, 1, 2, 1, 1, 1, 1, 1,
-	1, 1, 0, 2, 0, 3, 0, 1, 0, 1,
-	1, 0, 1, 1, 1, 0, 1, 2, 1, 1,
+	3, 3, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 3, 1, 1, 1, 1, 4, 5, 5, 5,
+	6, 4, 4, 6, 6, 6, 8, 8, 8, 8,
+	9, 8, 5, 4, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 8,
+	8, 0, 2, 3, 4, 4, 4, 4, 4, 4,
+	4, 0, 3, 4, 7, 3, 1, 1, 1, 2,
+	3, 3, 1, 2, 2, 1, 2, 1, 2, 2,
+	1, 2, 0, 1, 0, 2, 1, 2, 4, 0,
+	2, 1, 3, 5, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 0, 3, 0, 2, 0,
+	2, 1, 3, 5, 4, 2, 4, 0, 1, 0,
+	3, 0, 2, 5, 1, 1, 2, 2, 2, 2,
+	2, 1, 1, 3, 0, 3, 1, 3, 2, 0,
+	1, 1, 0, 2, 4, 4, 0, 2, 2, 1,
+	1, 3, 3, 3, 3, 3, 3, 3, 0, 3,
+	3, 3, 0, 3, 1, 1, 0, 4, 0, 1,
+	1, 0, 3, 1, 3, 2, 1, 0, 2, 4,
+	0, 9, 3, 5, 0, 3, 3, 0, 1, 0,
+	2, 2, 0, 2, 2, 2, 0, 3, 0, 3,
+	0, 3, 0, 4, 0, 3, 0, 4, 0, 1,
+	2, 1, 2, 5, 4, 4, 5, 1, 3, 3,
+	5, 0, 5, 1, 3, 1, 2, 3, 1, 1,
+	3, 3, 1, 3, 3, 3, 3, 3, 2, 1,
+	2, 1, 1, 1, 1, 1, 1, 1, 0, 2,
+	0, 3, 0, 1, 0, 1, 1, 0, 1, 1,
+	1, 0, 1, 2, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4365,455 +4559,469 @@ var yyR2 = [...]int{
 }
 
 var yyChk = [...]int{
-	-1000, -275, -1, -3, -8, -9, -10, -11, -12, -13,
-	-14, -15, -16, -17, -18, -19, -38, -39, -40, -42,
-	-43, -44, -45, -46, -6, -41, -20, -21, -47, -48,
-	-49, -4, -277, 6, 7, 8, -57, 10, 11, 31,
-	-23, -30, 152, -31, -24, 153, -32, 155, 154, 190,
-	156, 183, 70, 224, 225, 227, 228, 229, 230, -58,
-	188, 189, 158, 35, 41, 32, 33, 80, 9, 322,
-	185, 184, 26, -276, 451, -63, 5, -128, 16, -3,
-	-50, -280, -50, -50, -50, -50, -50, -50, -232, -234,
-	80, 125, 80, -64, 162, -146, -263, 106, 168, 171,
-	172, 313, 161, -36, -35, -34, -33, -37, 30, -28,
-	-29, -255, -27, -26, 157, 154, 198, 101, 102, 190,
-	191, 192, 156, 174, 189, 193, 188, 207, -25, 76,
-	32, 335, 338, -239, 153, 159, 160, 323, 104, 103,
-	71, 155, -236, 274, 428, -37, 430, 94, 96, 429,
-	40, 163, 431, 432, 433, 434, 173, 435, 436, 437,
-	438, 444, 445, 446, 447, 105, 5, 162, -263, -71,
-	284, 76, -262, -259, 83, 84, 85, 162, 162, 163,
-	164, -263, 162, -92, -187, -259, -181, 332, 176, 366,
-	367, 222, 76, 274, 428, 224, 238, 232, 259, 251,
-	333, 368, 177, 211, 249, 252, 300, 430, 369, 191,
-	296, 279, 287, 94, 227, 309, 443, 370, 441, 96,
-	429, 75, 47, 40, 186, 247, 243, 431, 212, 371,
-	343, 205, 104, 101, 450, 241, 46, 28, 440, 103,
-	45, 432, 372, 433, 289, 264, 44, 192, 373, 79,
-	337, 291, 242, 288, 221, 439, 158, 374, 422, 298,
-	375, 265, 269, 376, 301, 48, 377, 378, 102, 379,
-	74, 434, 236, 237, 380, 219, 175, 303, 263, 173,
-	34, 292, 334, 223, 54, 199, 304, 42, 267, 41,
-	426, 381, 262, 258, 49, 382, 383, 384, 385, 435,
-	261, 235, 257, 449, 217, 436, 58, 160, 271, 270,
-	272, 206, 299, 254, 386, 387, 388, 180, 77, 389,
-	244, 19, 390, 391, 213, 392, 52, 393, 394, 307,
-	189, 395, 50, 437, 37, 194, 438, 396, 397, 398,
-	399, 400, 297, 401, 290, 266, 268, 201, 286, 336,
-	402, 240, 193, 442, 403, 181, 195, 198, 188, 308,
-	182, 404, 405, 406, 407, 408, 409, 410, 228, 444,
-	39, 411, 412, 413, 414, 220, 216, 302, 311, 57,
-	78, 276, 415, 234, 214, 416, 225, 51, 445, 446,
-	447, 208, 448, 282, 105, 218, 43, 255, 200, 417,
-	418, 245, 246, 260, 233, 256, 226, 423, 202, 190,
-	419, 310, 215, 277, 340, 207, 339, 253, 250, 209,
-	420, 164, 203, 204, 421, 424, 293, 283, 294, 295,
-	284, 210, 338, 248, 278, 162, -157, 279, 280, 281,
-	292, 293, 298, 297, 201, -274, 301, 162, -167, 143,
-	152, 289, -171, 290, 283, 284, 210, -270, -259, 433,
-	448, 300, 252, 302, 426, 285, 291, 295, 294, -187,
-	226, -192, 231, -182, -259, -181, 229, -92, -56, 422,
-	156, -194, -194, -194, -112, -76, -98, 109, -103, 30,
-	24, -102, -99, -119, -116, -117, 143, 144, 146, 145,
-	147, 132, 133, 140, 110, 148, -107, -105, -106, -108,
-	87, 86, 95, 88, 89, 90, 91, 97, 98, 99,
-	-182, -187, -114, -277, 64, 65, 323, 324, 325, 326,
-	331, 327, 112, 53, 318, 312, 321, 320, 319, 316,
-	317, 314, 315, 329, 330, 167, 313, 161, 138, 322,
-	-259, -181, 40, 282, 282, -5, -4, -277, 6, 21,
-	22, -132, 18, 17, -278, 82, -59, -69, 59, 60,
-	-70, 22, 36, 63, 61, -51, -68, 134, -76, -187,
-	-68, -166, 166, -166, -166, -156, -197, 226, -160, 302,
-	301, -183, -158, -182, -180, -157, 299, 157, 341, 108,
-	23, 25, 111, 143, 17, 112, 159, 174, 142, 170,
-	323, 152, 68, 342, 314, 315, 312, 318, 325, 326,
-	313, 280, 30, 11, 344, 26, 184, 22, 36, 136,
-	154, 115, 116, 187, 24, 185, 99, 347, 20, 71,
-	179, 12, 172, 14, 348, 349, 15, 167, 166, 127,
-	163, 66, 9, 148, 27, 124, 62, 350, 29, 351,
-	352, 353, 354, 64, 125, 18, 316, 317, 32, 427,
-	355, 331, 196, 138, 69, 55, 109, 356, 357, 97,
-	358, 100, 72, 106, 16, 67, 38, 359, 197, 360,
-	169, 361, 305, 362, 126, 155, 322, 65, 363, 161,
-	281, 6, 328, 31, 183, 171, 63, 364, 162, 114,
-	329, 330, 165, 98, 5, 168, 33, 10, 70, 73,
-	319, 320, 321, 53, 335, 113, 13, 365, 306, 107,
-	300, 252, -233, 125, -220, -224, -182, 178, -252, 174,
-	-92, -242, -241, -182, -71, -176, 167, 163, -176, 322,
-	-33, -34, -157, 142, 195, 81, 81, -224, -223, -222,
-	-264, 197, 178, -251, -240, 170, 179, -230, 171, 172,
-	-225, 163, 29, -264, -225, 169, 179, 197, 197, 105,
-	197, 105, 197, 197, 197, 197, 197, 197, 197, 197,
-	197, 194, -231, 117, -231, 339, 339, -236, -264, -264,
-	-264, 165, 34, 34, -179, -225, 165, 23, -231, -231,
-	-157, 142, -231, -231, -231, -231, 205, 205, -231, -231,
-	-231, -231, -231, -231, -231, -231, -231, -231, -231, -231,
-	-231, -231, -231, -92, -74, 212, 152, 154, 157, 72,
-	117, -35, 207, -22, -92, -175, 167, -259, -175, -175,
-	-92, 149, -92, -173, 125, 13, -173, -173, -173, -173,
-	-173, 208, 296, 208, 296, 208, 209, 208, 209, 208,
-	-170, -169, 287, 288, 282, 286, -259, 313, 298, -259,
-	201, 162, 202, 164, -226, 163, 34, 175, 209, 282,
-	204, -173, -195, -277, -183, -195, -195, 31, 165, -182,
-	-52, -182, 87, -7, -3, -11, -10, -12, 117, 81,
-	108, 106, 107, 124, -76, -100, 127, 109, 125, 126,
-	111, 129, 128, 139, 132, 133, 134, 135, 136, 137,
-	138, 130, 131, 142, 117, 118, 119, 120, 121, 122,
-	123, -165, -277, -117, -277, 150, 151, -103, -103, -103,
-	-103, -103, -103, -103, -103, -103, -103, -277, 149, -2,
-	-112, -4, -277, -277, -277, -277, -277, -277, -277, -277,
-	-124, -76, -277, -281, -277, -281, -109, -277, -281, -109,
-	-281, -109, -281, -281, -109, -281, -109, -281, -281, -109,
-	-277, -277, -277, -277, -277, -277, -277, -194, -271, -272,
-	-95, -92, -128, -3, -50, -147, 20, 32, -76, -129,
-	-130, -76, -128, 55, -66, -68, -70, 59, 60, 93,
-	12, -185, -184, 23, -182, 87, 149, 12, -93, 27,
-	-92, -78, -79, -80, -81, -95, -118, -277, 12, -85,
-	-86, -92, -94, -187, 81, 226, -160, -197, -162, -161,
-	303, 305, 117, -186, -182, 87, 30, 82, 81, -92,
-	-199, -202, -204, -203, -205, -200, -201, 249, 250, 143,
-	253, 255, 256, 257, 258, 259, 260, 261, 262, 263,
-	264, 31, 186, 245, 246, 247, 248, 265, 266, 267,
-	268, 269, 270, 271, 272, 232, 251, 333, 233, 234,
-	235, 236, 237, 238, 240, 241, 242, 243, 244, -262,
-	-259, 80, 82, 81, -206, 80, -74, -92, 109, -259,
-	-259, -231, -231, 194, -27, -26, -255, 16, -25, -26,
-	157, 101, 102, 154, 80, -220, 80, -229, -262, -259,
-	80, 29, 169, 168, -228, -225, -228, -229, -259, -119,
-	-182, -187, -259, 29, 29, -153, -182, -153, -153, 21,
-	-153, 21, -153, 21, 88, -182, -153, 21, -153, 21,
-	-153, 21, -153, 21, -153, 21, 30, 74, 75, 30,
-	77, 78, 79, -119, -119, -220, -157, -92, -259, 88,
-	88, -231, -231, 88, 87, 87, 87, -231, -231, 88,
-	87, -259, 87, -265, 180, 221, 223, 88, 88, 88,
-	88, 30, 87, -266, 30, 440, 439, 441, 442, 443,
-	88, 30, 88, 30, 88, -182, 80, -73, 214, 117,
-	203, 203, 162, 162, 216, -92, 215, 217, 218, 40,
-	81, 165, -85, 24, 72, -87, -92, -259, -188, -187,
-	-180, 87, -76, -173, -92, -173, -92, -173, -173, -173,
-	-173, -168, 12, 127, -227, 12, 127, -168, -195, -195,
-	-92, -195, -195, -92, -195, -195, -227, -174, 125, 72,
-	-193, 229, 263, 423, 424, 425, -76, -76, -76, -76,
-	-110, 97, 109, 98, 99, -103, -111, -114, -117, 92,
-	127, 125, 126, 111, -103, -103, -103, -103, -103, -103,
-	-103, -103, -103, -103, -103, -103, -103, -103, -103, -196,
-	-259, 87, 143, -259, -102, -102, -182, -67, 22, 36,
-	-66, -183, -188, -180, -63, -278, -278, -128, -66, -66,
-	-76, -76, -119, 87, -66, -119, 87, -66, -66, -62,
-	22, 36, -122, -123, 113, -119, -278, -103, -182, -182,
-	-66, -67, -67, -66, -66, 81, -273, 305, 306, 427,
-	-190, 197, -189, 23, -187, 87, -132, -278, -133, 27,
-	10, 127, 81, 19, 81, -131, 25, 26, -132, -104,
-	-182, 88, 91, -77, 81, 12, -70, -92, -184, 134,
-	-188, -92, -152, 197, -92, 31, 81, -88, -90, -89,
-	-91, 62, 66, 68, 63, 64, 65, 69, -191, 23,
-	-78, -3, -277, -92, -85, -279, 81, 12, 73, -279,
-	81, 149, -160, -162, 81, 304, 306, 307, 72, 100,
-	-76, -211, 142, -238, -237, -236, -220, -222, -223, -224,
-	82, -177, 97, 109, -215, 277, -206, -206, -206, -206,
-	-206, -210, -157, -210, -210, -210, 80, 80, -206, -206,
-	-206, -206, -212, 80, -212, -212, -213, 80, -213, -252,
-	-76, -248, -247, -243, -246, 173, 94, 335, 73, -241,
-	-131, 88, -73, 24, -250, -246, -259, 87, -259, 87,
-	81, 17, -221, -220, -120, 221, -254, 197, -251, -242,
-	80, 29, -228, -229, -229, 149, -259, 81, 27, 105,
-	105, 105, 105, 335, 154, 31, -220, -120, -196, 165,
-	-196, -196, 87, 87, -172, 448, -85, 164, 220, -75,
-	318, 87, 83, -92, -92, -92, -92, -92, 157, 154,
-	205, -92, -92, -55, 182, 177, -92, 81, -55, -173,
-	-187, -187, -92, -173, -92, 87, -92, -182, 97, 98,
-	99, -111, -103, -103, -103, -65, 187, 108, -278, -278,
-	-66, -66, -277, 149, -5, -132, -278, -278, 81, 73,
-	23, 12, 12, -278, 12, 12, -278, -278, -66, -125,
-	-123, 115, -76, -278, -278, 81, 81, -278, -278, -278,
-	-278, -278, -272, 426, 306, -96, 70, 166, 71, -277,
-	-189, -147, 38, 46, 57, -76, -76, -130, -147, -164,
-	20, 12, 53, 53, -97, 13, -68, -78, -70, 149,
-	-97, -101, 31, 53, -3, -277, -277, -155, -159, -119,
-	-79, -80, -80, -79, -80, 62, 62, 62, 67, 62,
-	67, 62, -89, -187, -278, -278, -3, -152, 73, -78,
-	-92, -78, -94, -187, 134, -161, -163, 308, 305, 311,
-	-259, 87, 81, -236, -224, -208, 30, 97, -216, 278,
-	-210, -210, -211, -259, 143, -211, -211, -211, -219, 87,
-	-219, 88, 88, 82, -249, -244, -245, 32, 76, -243,
-	-231, 87, 37, -182, 82, 164, 72, 16, -149, -182,
-	81, 82, -121, 222, -119, 82, -182, 82, -149, -229,
-	-183, -182, -277, 162, 30, 30, -120, -121, -211, -259,
-	450, 449, 82, -92, -72, 212, 219, 80, 84, -261,
-	73, 203, 274, 203, 206, 165, -195, -92, -168, -168,
-	-65, 108, -103, -103, -278, -278, -67, -183, -128, -147,
-	-198, 143, 249, 186, 247, 243, 263, 254, 276, 245,
-	277, -196, -198, -103, -103, -103, -103, 332, -128, 116,
-	-76, 114, -103, -103, 163, 163, 163, -153, 39, 87,
-	87, 58, -92, -126, 14, -76, 134, -132, -154, 72,
-	-155, -113, -115, -114, -277, -148, -278, -182, -153, -97,
-	81, 117, -83, -82, 72, 73, -84, 72, -82, 62,
-	62, -278, -97, -78, -97, -97, 149, 305, 309, 310,
-	-236, -209, 72, -103, -211, -211, 82, 81, 82, 81,
-	82, 81, -178, 372, 109, -245, -244, -231, -231, 88,
-	-259, -92, -92, 17, 81, -220, -119, 53, -248, 82,
-	-253, -254, -92, -102, -121, -150, 80, 82, -258, 335,
-	-260, -259, -182, -182, -182, -92, -173, -173, -103, -278,
-	-132, -278, -206, -206, -206, -213, -206, 237, -206, 237,
-	-278, -278, 20, 20, 20, 20, -277, -60, 328, -76,
-	81, 81, -277, -277, -277, -278, 87, -210, -127, 15,
-	17, 28, -154, 81, -278, -278, 81, 53, 149, -278,
-	-128, -159, -76, -76, 80, -76, -128, -97, -214, 274,
-	10, -210, 87, -210, 88, 88, 372, 30, 77, 78,
-	79, 30, 74, 75, -150, -149, -182, 199, 181, -278,
-	81, -217, 335, 338, 23, -149, -257, -256, -183, 80,
-	73, -147, -210, -259, -103, -103, -103, -103, -103, -132,
-	87, -103, -103, -151, -278, -182, 169, -151, -151, -190,
-	-210, -135, -140, -170, -76, -112, 29, -115, 53, -3,
-	-182, -113, -182, -132, -149, -132, -218, 169, 29, 168,
-	-106, -211, -211, 82, 82, 23, 200, -92, -254, 339,
-	339, -3, 82, 81, 117, -149, -92, -278, -278, -278,
-	-278, -61, 127, 335, -278, -278, -278, 81, -278, -278,
-	-278, -96, -138, 422, -141, 42, -142, 43, 10, -113,
-	149, 82, -207, 94, 29, 29, -3, -277, 80, -53,
-	335, -256, -235, -183, 87, 88, 82, -278, 333, 69,
-	336, -182, 169, -135, 47, 255, -143, 51, -144, -139,
-	52, 17, -155, -182, 87, -53, -103, 196, -149, -54,
-	211, 426, -261, 58, 334, 337, -136, 49, -134, 48,
-	-134, -142, 17, -145, 44, 45, 87, -278, -278, 82,
-	174, -258, 58, -137, 50, 72, 100, 87, 17, 17,
-	-268, -269, 72, 213, 335, 72, 100, 87, 87, -269,
-	72, 11, 10, 336, -267, 182, 177, 180, 31, -267,
-	337, 176, 30, 97,
+	-1000, -289, -1, -3, -6, -22, -23, -24, -25, -26,
+	-27, -28, -29, -30, -31, -32, -33, -52, -53, -54,
+	-56, -57, -58, -59, -60, -20, -55, -34, -35, -61,
+	-62, -63, -4, -291, 7, 336, 8, 9, -71, 11,
+	12, 32, -37, -44, 153, -45, -38, 154, -46, 156,
+	155, 191, 157, 184, 71, 225, 226, 228, 229, 230,
+	231, -72, 189, 190, 159, 36, 42, 33, 34, 81,
+	10, 323, 186, 185, 27, -290, 455, -77, 6, -3,
+	82, -142, 17, -3, -64, -294, -19, 363, -64, -64,
+	-64, -64, -64, -64, -246, -248, 81, 126, 81, -78,
+	163, -160, -277, 107, 169, 172, 173, 314, 162, -50,
+	-49, -48, -47, -51, 31, -42, -43, -269, -41, -40,
+	158, 155, 199, 102, 103, 191, 192, 193, 157, 175,
+	190, 194, 189, 208, -39, 77, 33, 336, 339, -253,
+	154, 160, 161, 324, 105, 104, 72, 156, -250, 275,
+	432, -51, 434, 95, 97, 433, 41, 164, 435, 436,
+	437, 438, 174, 439, 440, 441, 442, 448, 449, 450,
+	451, 106, 6, 163, -277, -85, 285, 77, -276, -273,
+	84, 85, 86, 163, 163, 164, 165, -277, 163, -106,
+	-201, -273, -195, 333, 177, 367, 368, 223, 77, 275,
+	432, 225, 239, 233, 260, 252, 334, 369, 178, 212,
+	250, 253, 301, 434, 370, 192, 297, 280, 288, 95,
+	228, 310, 447, 371, 445, 97, 433, 76, 48, 423,
+	41, 187, 248, 244, 435, 213, 372, 344, 206, 105,
+	102, 454, 242, 47, 29, 444, 104, 46, 436, 373,
+	437, 290, 265, 45, 193, 374, 80, 338, 292, 243,
+	289, 222, 443, 159, 375, 426, 299, 376, 266, 270,
+	377, 302, 49, 378, 379, 103, 380, 75, 438, 237,
+	238, 381, 220, 176, 304, 264, 174, 35, 293, 335,
+	224, 55, 200, 305, 43, 268, 42, 430, 382, 263,
+	259, 50, 383, 384, 385, 386, 439, 262, 236, 258,
+	453, 218, 440, 59, 161, 272, 271, 273, 207, 300,
+	255, 387, 388, 389, 181, 78, 390, 245, 20, 391,
+	392, 214, 393, 53, 394, 395, 308, 190, 396, 51,
+	441, 38, 195, 442, 397, 398, 399, 400, 401, 298,
+	402, 291, 267, 269, 202, 287, 337, 403, 241, 194,
+	446, 404, 182, 196, 199, 189, 309, 183, 405, 406,
+	407, 408, 409, 410, 411, 424, 5, 229, 448, 40,
+	412, 413, 414, 415, 221, 217, 303, 312, 58, 79,
+	277, 416, 235, 215, 417, 226, 52, 449, 450, 451,
+	209, 452, 283, 106, 219, 44, 256, 201, 418, 419,
+	246, 247, 261, 234, 257, 227, 427, 203, 191, 420,
+	311, 216, 278, 341, 208, 340, 254, 251, 210, 421,
+	165, 204, 205, 422, 428, 294, 284, 295, 296, 285,
+	211, 339, 249, 279, 163, -171, 280, 281, 282, 293,
+	294, 299, 298, 202, -288, 302, 163, -181, 144, 153,
+	290, -185, 291, 284, 285, 211, -284, -273, 437, 452,
+	301, 253, 303, 430, 286, 292, 296, 295, -201, 227,
+	-206, 232, -196, -273, -195, 230, -106, -70, 426, 157,
+	-208, -208, -208, -126, -90, -112, 110, -117, 31, 25,
+	-116, -113, -133, -130, -131, 144, 145, 147, 146, 148,
+	133, 134, 141, 111, 149, -121, -119, -120, -122, 88,
+	87, 96, 89, 90, 91, 92, 98, 99, 100, -196,
+	-201, -128, -291, 65, 66, 324, 325, 326, 327, 332,
+	328, 113, 54, 319, 313, 322, 321, 320, 317, 318,
+	315, 316, 330, 331, 168, 314, 162, 139, 323, -273,
+	-195, 41, 283, 283, -5, -4, -291, 7, 22, 23,
+	-7, -201, -146, 19, 18, -292, 83, -73, -83, 60,
+	61, -84, 23, 37, 64, 62, -65, -7, -82, 135,
+	-90, -201, -82, -180, 167, -180, -180, -170, -211, 227,
+	-174, 303, 302, -197, -172, -196, -194, -171, 300, 158,
+	342, 109, 24, 26, 112, 144, 18, 113, 160, 175,
+	143, 171, 324, 153, 69, 343, 315, 316, 313, 319,
+	326, 327, 314, 281, 31, 12, 345, 27, 185, 23,
+	37, 137, 155, 116, 117, 188, 25, 186, 100, 348,
+	21, 72, 180, 13, 173, 15, 349, 350, 16, 168,
+	167, 128, 164, 67, 10, 149, 28, 125, 63, 351,
+	30, 352, 353, 354, 355, 65, 126, 19, 317, 318,
+	33, 431, 356, 332, 197, 139, 70, 56, 110, 357,
+	358, 98, 359, 101, 73, 107, 17, 68, 39, 360,
+	198, 361, 170, 362, 306, 363, 127, 156, 323, 425,
+	66, 364, 162, 282, 7, 329, 32, 184, 172, 64,
+	365, 163, 115, 330, 331, 166, 99, 6, 169, 34,
+	11, 71, 74, 320, 321, 322, 54, 336, 114, 14,
+	366, 307, 108, 301, 253, -247, 126, -234, -238, -196,
+	179, -266, 175, -106, -256, -255, -196, -85, -190, 168,
+	164, -190, 323, -47, -48, -171, 143, 196, 82, 82,
+	-238, -237, -236, -278, 198, 179, -265, -254, 171, 180,
+	-244, 172, 173, -239, 164, 30, -278, -239, 170, 180,
+	198, 198, 106, 198, 106, 198, 198, 198, 198, 198,
+	198, 198, 198, 198, 195, -245, 118, -245, 340, 340,
+	-250, -278, -278, -278, 166, 35, 35, -193, -239, 166,
+	24, -245, -245, -171, 143, -245, -245, -245, -245, 206,
+	206, -245, -245, -245, -245, -245, -245, -245, -245, -245,
+	-245, -245, -245, -245, -245, -245, -106, -88, 213, 153,
+	155, 158, 73, 118, -49, 208, -36, -106, -189, 168,
+	-273, -189, -189, -106, 150, -106, -187, 126, 14, -187,
+	-187, -187, -187, -187, 209, 297, 209, 297, 209, 210,
+	209, 210, 209, -184, -183, 288, 289, 283, 287, -273,
+	314, 299, -273, 202, 163, 203, 165, -240, 164, 35,
+	176, 210, 283, 205, -187, -209, -291, -197, -209, -209,
+	32, 166, -196, -66, -196, 88, -21, -3, -25, -24,
+	-26, 118, 82, 109, 107, 108, 125, -90, -114, 128,
+	110, 126, 127, 112, 130, 129, 140, 133, 134, 135,
+	136, 137, 138, 139, 131, 132, 143, 118, 119, 120,
+	121, 122, 123, 124, -179, -291, -131, -291, 151, 152,
+	-117, -117, -117, -117, -117, -117, -117, -117, -117, -117,
+	-291, 150, -2, -6, -126, -4, -291, -291, -291, -291,
+	-291, -291, -291, -291, -138, -90, -291, -295, -291, -295,
+	-123, -291, -295, -123, -295, -123, -295, -295, -123, -295,
+	-123, -295, -295, -123, -291, -291, -291, -291, -291, -291,
+	-291, -208, -285, -286, -109, -106, -142, -3, -64, -164,
+	81, -161, 21, 33, -90, -143, -144, -90, -142, 56,
+	-80, -82, -84, 60, 61, 94, 13, -199, -198, 24,
+	-196, 88, 150, 13, -107, 28, -106, -92, -93, -94,
+	-95, -109, -132, -291, 13, -99, -100, -106, -108, -201,
+	82, 227, -174, -211, -176, -175, 304, 306, 118, -200,
+	-196, 88, 31, 83, 82, -106, -213, -216, -218, -217,
+	-219, -214, -215, 250, 251, 144, 254, 256, 257, 258,
+	259, 260, 261, 262, 263, 264, 265, 32, 187, 246,
+	247, 248, 249, 266, 267, 268, 269, 270, 271, 272,
+	273, 233, 252, 334, 234, 235, 236, 237, 238, 239,
+	241, 242, 243, 244, 245, -276, -273, 81, 83, 82,
+	-220, 81, -88, -106, 110, -273, -273, -245, -245, 195,
+	-41, -40, -269, 17, -39, -40, 158, 102, 103, 155,
+	81, -234, 81, -243, -276, -273, 81, 30, 170, 169,
+	-242, -239, -242, -243, -273, -133, -196, -201, -273, 30,
+	30, -167, -196, -167, -167, 22, -167, 22, -167, 22,
+	89, -196, -167, 22, -167, 22, -167, 22, -167, 22,
+	-167, 22, 31, 75, 76, 31, 78, 79, 80, -133,
+	-133, -234, -171, -106, -273, 89, 89, -245, -245, 89,
+	88, 88, 88, -245, -245, 89, 88, -273, 88, -279,
+	181, 222, 224, 89, 89, 89, 89, 31, 88, -280,
+	31, 444, 443, 445, 446, 447, 89, 31, 89, 31,
+	89, -196, 81, -87, 215, 118, 204, 204, 163, 163,
+	217, -106, 216, 218, 219, 41, 82, 166, -99, 25,
+	73, -101, -106, -273, -202, -201, -194, 88, -90, -187,
+	-106, -187, -106, -187, -187, -187, -187, -182, 13, 128,
+	-241, 13, 128, -182, -209, -209, -106, -209, -209, -106,
+	-209, -209, -241, -188, 126, 73, -207, 230, 264, 427,
+	428, 429, -90, -90, -90, -90, -124, 98, 110, 99,
+	100, -117, -125, -128, -131, 93, 128, 126, 127, 112,
+	-117, -117, -117, -117, -117, -117, -117, -117, -117, -117,
+	-117, -117, -117, -117, -117, -210, -273, 88, 144, -273,
+	-116, -116, -196, -81, 23, 37, -80, -197, -202, -194,
+	-77, -292, -2, -292, -142, -80, -80, -90, -90, -133,
+	88, -80, -133, 88, -80, -80, -76, 23, 37, -136,
+	-137, 114, -133, -292, -117, -196, -196, -80, -81, -81,
+	-80, -80, 82, -287, 306, 307, 431, -204, 198, -203,
+	24, -201, 88, -146, -292, 24, -163, -196, -147, 28,
+	11, 128, 82, 20, 82, -145, 26, 27, -146, -118,
+	-196, 89, 92, -91, 82, 13, -84, -106, -198, 135,
+	-202, -106, -166, 198, -106, 32, 82, -102, -104, -103,
+	-105, 63, 67, 69, 64, 65, 66, 70, -205, 24,
+	-92, -3, -6, -291, -106, -99, -293, 82, 13, 74,
+	-293, 82, 150, -174, -176, 82, 305, 307, 308, 73,
+	101, -90, -225, 143, -252, -251, -250, -234, -236, -237,
+	-238, 83, -191, 98, 110, -229, 278, -220, -220, -220,
+	-220, -220, -224, -171, -224, -224, -224, 81, 81, -220,
+	-220, -220, -220, -226, 81, -226, -226, -227, 81, -227,
+	-266, -90, -262, -261, -257, -260, 174, 95, 336, 74,
+	-255, -145, 89, -87, 25, -264, -260, -273, 88, -273,
+	88, 82, 18, -235, -234, -134, 222, -268, 198, -265,
+	-256, 81, 30, -242, -243, -243, 150, -273, 82, 28,
+	106, 106, 106, 106, 336, 155, 32, -234, -134, -210,
+	166, -210, -210, 88, 88, -186, 452, -99, 165, 221,
+	-89, 319, 88, 84, -106, -106, -106, -106, -106, 158,
+	155, 206, -106, -106, -69, 183, 178, -106, 82, -69,
+	-187, -201, -201, -106, -187, -106, 88, -106, -196, 98,
+	99, 100, -125, -117, -117, -117, -79, 188, 109, -292,
+	-292, -80, -80, -291, 150, -5, -292, -146, -292, -292,
+	82, 74, 24, 13, 13, -292, 13, 13, -292, -292,
+	-80, -139, -137, 116, -90, -292, -292, 82, 82, -292,
+	-292, -292, -292, -292, -286, 430, 307, -110, 71, 167,
+	72, -291, -203, -161, -131, 83, 82, 39, 47, 58,
+	-90, -90, -144, -161, -178, 21, 13, 54, 54, -111,
+	14, -82, -92, -84, 150, -111, -115, 32, 54, -3,
+	-6, -291, -291, -169, -173, -133, -93, -94, -94, -93,
+	-94, 63, 63, 63, 68, 63, 68, 63, -103, -201,
+	-292, -292, -3, -3, -166, 74, -92, -106, -92, -108,
+	-201, 135, -175, -177, 309, 306, 312, -273, 88, 82,
+	-250, -238, -222, 31, 98, -230, 279, -224, -224, -225,
+	-273, 144, -225, -225, -225, -233, 88, -233, 89, 89,
+	83, -263, -258, -259, 33, 77, -257, -245, 88, 38,
+	-196, 83, 165, 73, 17, -163, 82, 83, -135, 223,
+	-133, 83, -196, 83, -163, -243, -197, -196, -291, 163,
+	31, 31, -134, -135, -225, -273, 454, 453, 83, -106,
+	-86, 213, 220, 81, 85, -275, 74, 204, 275, 204,
+	207, 166, -209, -106, -182, -182, -79, 109, -117, -117,
+	-8, 360, -292, -292, -81, -197, -142, -161, -212, 144,
+	250, 187, 248, 244, 264, 255, 277, 246, 278, -210,
+	-212, -117, -117, -117, -117, 333, -142, 117, -90, 115,
+	-117, -117, 164, 164, 164, -167, -196, 40, 88, 88,
+	59, -106, -140, 15, -90, 135, -146, -168, 73, -169,
+	-127, -129, -128, -291, -3, -162, -292, -196, -167, -111,
+	82, 118, -97, -96, 73, 74, -98, 73, -96, 63,
+	63, -292, -292, -111, -92, -111, -111, 150, 306, 310,
+	311, -250, -223, 73, -117, -225, -225, 83, 82, 83,
+	82, 83, 82, -192, 373, 110, -259, -258, -245, -245,
+	89, -273, -106, -106, 18, -234, -133, 54, -262, 83,
+	-267, -268, -106, -116, -135, -164, 83, -272, 336, -274,
+	-273, -196, -196, -196, -106, -187, -187, -117, -291, -196,
+	-292, -146, -292, -220, -220, -220, -227, -220, 238, -220,
+	238, -292, -292, 21, 21, 21, 21, -291, -74, 329,
+	-90, 82, 82, -291, -291, -291, -292, 88, -224, -141,
+	16, 18, 29, -168, 82, -292, -292, 82, 54, 150,
+	-292, -142, -173, -90, -90, 81, -90, -142, -111, -228,
+	275, 11, -224, 88, -224, 89, 89, 373, 31, 78,
+	79, 80, 31, 75, 76, -164, -163, 200, 182, -292,
+	82, -231, 336, 339, 24, -271, -270, -197, 81, 74,
+	-9, -15, -196, -161, -224, -273, -117, -117, -117, -117,
+	-117, -146, 88, -117, -117, -165, -292, -196, 170, -165,
+	-165, -204, -224, -149, -154, -184, -17, 366, -90, -126,
+	30, -129, 54, -3, -6, -196, -127, -196, -146, -163,
+	-146, -232, 170, 30, 169, -120, -225, -225, 83, 83,
+	24, 201, -106, -268, 340, 340, -3, 82, 118, -163,
+	-106, -292, -14, 198, -292, -292, -292, -292, -75, 128,
+	336, -292, -292, -292, 82, -292, -292, -292, -110, -152,
+	426, -155, 43, -156, 44, -18, -16, -196, 11, -127,
+	-3, 150, 83, -221, 95, 30, 30, -3, -291, 81,
+	-67, 336, -270, -249, -197, 88, 89, 83, -142, 18,
+	-292, 334, 70, 337, -196, 170, -149, 48, 256, -157,
+	52, -158, -153, 53, 18, 82, 24, -169, -196, 88,
+	-67, -117, 197, -163, -68, 212, 430, -275, -10, -11,
+	5, 425, -126, 59, 335, 338, -150, 50, -148, 49,
+	-148, -156, 18, -159, 45, 46, 88, -16, -291, -292,
+	-292, 83, 175, -272, -12, 112, 423, 420, -13, 89,
+	92, 149, 59, -151, 51, 73, 101, 88, 18, 18,
+	-9, -282, -283, 73, 214, -12, 424, 400, 375, 400,
+	375, -117, 336, 73, 101, 88, 88, -292, -283, 73,
+	12, 11, 109, -196, 337, -281, 183, 178, 181, 32,
+	-281, -12, 338, 177, 31, 98,
 }
 
 var yyDef = [...]int{
-	32, -2, 2, 4, 5, 6, 7, 8, 9, 10,
+	33, -2, 2, 4, 0, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 790, 0, 523, 523, 523, 523, 523, 523, 523,
-	0, 0, -2, -2, -2, 814, 36, 0, 0, 0,
-	0, -2, 481, 482, 0, 484, -2, 0, 0, 493,
-	1309, 1309, 1309, 0, 0, 0, 0, 1307, 53, 54,
-	499, 500, 501, 1, 3, 0, 527, 798, 0, 0,
-	-2, 525, 0, 0, 906, 906, 906, 0, 84, 85,
-	0, 0, 0, 814, 904, 0, 904, 0, 912, 913,
-	914, 104, 105, 88, -2, 109, 110, 0, 114, 367,
-	328, 370, 326, 356, -2, 319, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 331, 223,
-	223, 0, 0, -2, 319, 319, 319, 0, 0, 0,
-	353, 908, 273, 223, 223, 0, 223, 223, 223, 223,
-	0, 0, 223, 223, 223, 223, 223, 223, 223, 223,
-	223, 223, 223, 223, 223, 223, 223, 0, 103, 827,
-	0, 0, 113, 37, 33, 34, 35, 0, 902, 0,
-	902, 902, 0, 419, 611, 922, 923, 1059, 1060, 1061,
-	1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071,
-	1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081,
-	1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091,
-	1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101,
-	1102, 1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111,
-	1112, 1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121,
-	1122, 1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131,
-	1132, 1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141,
-	1142, 1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151,
-	1152, 1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161,
-	1162, 1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171,
-	1172, 1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181,
-	1182, 1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191,
-	1192, 1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201,
-	1202, 1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211,
-	1212, 1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221,
-	1222, 1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231,
-	1232, 1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241,
-	1242, 1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251,
-	1252, 1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261,
-	1262, 1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271,
-	1272, 1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281,
-	1282, 1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291,
-	1292, 1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301,
-	1302, 1303, 1304, 1305, 1306, 0, 472, 472, 472, 472,
-	472, 472, 0, 428, 0, 0, 0, 0, 0, 0,
-	0, 444, 0, 447, 0, 0, 454, 472, 1310, 1310,
-	1310, 893, 0, 478, 479, 466, 464, 461, 462, 480,
-	483, 0, 488, 491, 918, 919, 0, 506, 0, 1130,
-	498, 511, 512, 522, 38, 662, 621, 0, 627, 629,
-	0, 664, 665, 666, 667, 668, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 694, 695, 696, 697,
-	775, 776, 777, 778, 779, 780, 781, 782, 631, 632,
-	772, 0, 882, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 763, 0, 732, 732, 732, 732, 732, 732,
-	732, 732, 732, 0, 0, 0, 0, 0, 0, 0,
-	-2, -2, 1309, 0, 521, 790, 49, 0, 523, 528,
-	529, 833, 0, 0, 790, 1308, 0, 0, -2, -2,
-	539, 545, 546, 547, 548, 524, 0, 551, 555, 0,
-	0, 0, 907, 0, 0, 70, 0, 1278, 886, -2,
-	-2, 0, 0, 920, 921, 895, -2, 926, 927, 928,
-	929, 930, 931, 932, 933, 934, 935, 936, 937, 938,
-	939, 940, 941, 942, 943, 944, 945, 946, 947, 948,
-	949, 950, 951, 952, 953, 954, 955, 956, 957, 958,
-	959, 960, 961, 962, 963, 964, 965, 966, 967, 968,
-	969, 970, 971, 972, 973, 974, 975, 976, 977, 978,
-	979, 980, 981, 982, 983, 984, 985, 986, 987, 988,
-	989, 990, 991, 992, 993, 994, 995, 996, 997, 998,
-	999, 1000, 1001, 1002, 1003, 1004, 1005, 1006, 1007, 1008,
-	1009, 1010, 1011, 1012, 1013, 1014, 1015, 1016, 1017, 1018,
-	1019, 1020, 1021, 1022, 1023, 1024, 1025, 1026, 1027, 1028,
-	1029, 1030, 1031, 1032, 1033, 1034, 1035, 1036, 1037, 1038,
-	1039, 1040, 1041, 1042, 1043, 1044, 1045, 1046, 1047, 1048,
-	1049, 1050, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 1058,
-	-2, 1077, 0, 0, 123, 124, 0, 36, 249, 0,
-	119, 0, 243, 181, 827, 0, 0, 0, 0, 90,
-	111, 112, 223, 223, 0, 113, 113, 335, 336, 337,
-	0, 0, -2, 247, 0, 320, 0, 0, 237, 237,
-	241, 239, 240, 0, 0, 0, 0, 0, 0, 347,
-	0, 348, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 403, 0, 224, 0, 365, 366, 274, 0, 0,
-	0, 0, 345, 346, 0, 0, 909, 910, 0, 0,
-	223, 223, 0, 0, 0, 0, 223, 223, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 100, 818, 0, 0, 0, 0, 0,
-	0, -2, 0, 411, 0, 0, 0, 0, 0, 0,
-	418, 0, 420, 421, 0, 0, 422, 423, 424, 425,
-	426, 472, 0, 472, 0, 472, 472, 472, 472, 469,
-	0, 469, 467, 468, 459, 460, 1310, 1310, 0, 1310,
-	1310, 0, 1310, 1310, 0, 232, 233, 234, 475, 451,
-	452, 455, 456, 1311, 1312, 457, 458, 894, 489, 492,
-	509, 507, 508, 510, 502, 503, 504, 505, 0, 0,
-	0, 0, 0, 0, 625, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 649, 650, 651, 652, 653, 654,
-	655, 628, 0, 642, 0, 0, 0, 684, 685, 686,
-	687, 688, 689, 690, 691, 692, 0, 536, 0, 0,
-	0, 790, 0, 0, 0, 0, 0, 0, 0, 533,
-	0, 764, 0, 715, 0, 716, 724, 0, 717, 725,
-	718, 726, 719, 720, 727, 721, 728, 722, 723, 729,
-	0, 0, 0, 536, 536, 0, 0, 39, 513, 514,
-	0, 594, 798, 0, 538, 836, 0, 0, 799, 791,
-	792, 795, 798, 0, 560, 549, 540, 543, 544, 526,
-	0, 552, 556, 0, 558, 559, 0, 0, 68, 0,
-	610, 0, 562, 564, 565, 566, 592, 0, 0, 0,
-	0, 64, 66, 611, 0, 1278, 892, 0, 72, 73,
-	0, 0, 0, 204, 897, 898, 899, -2, 230, 0,
-	192, 188, 132, 133, 134, 181, 136, 181, 181, 181,
-	181, 201, 201, 201, 201, 164, 165, 166, 167, 168,
-	0, 0, 151, 181, 181, 181, 181, 171, 172, 173,
-	174, 175, 176, 177, 178, 137, 138, 139, 140, 141,
-	142, 143, 144, 145, 183, 183, 183, 185, 185, 0,
-	37, 0, 215, 0, 795, 0, 818, 99, 0, 915,
-	102, 0, 0, 368, 329, 357, 369, 0, 332, 333,
-	-2, 0, 0, 319, 0, 321, 0, 231, 0, -2,
-	0, 0, 0, 237, 241, 238, 241, 229, 242, 349,
-	772, 0, 350, 351, 0, 383, 580, 0, 0, 0,
-	0, 0, 389, 390, 391, 0, 393, 394, 395, 396,
-	397, 398, 399, 400, 401, 402, 358, 359, 360, 361,
-	362, 363, 364, 0, 0, 321, 0, 354, 0, 275,
-	276, 0, 0, 279, 280, 281, 282, 0, 0, 285,
-	286, 287, 288, 289, 313, 314, 315, 290, 291, 292,
-	293, 294, 295, 296, 307, 308, 309, 310, 311, 312,
-	297, 298, 299, 300, 301, 304, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 815, 816, 817, 0,
-	0, 0, 262, 903, 0, 262, 62, 417, 612, 924,
-	925, 473, 474, 427, 445, 429, 448, 430, 432, 431,
-	433, 472, 0, 0, 0, 235, 236, 472, 436, 437,
-	438, 439, 440, 441, 442, 443, 0, 450, 0, 0,
-	0, 490, 494, 495, 496, 497, 663, 622, 623, 624,
-	626, 643, 0, 645, 647, 633, 634, 658, 659, 660,
-	0, 0, 0, 0, 656, 638, 0, 669, 670, 671,
-	672, 673, 674, 675, 676, 677, 678, 679, 680, 683,
-	747, 748, 749, 0, 681, 682, 693, 0, 0, 0,
-	537, 773, 0, -2, 0, 661, 881, 798, 0, 0,
-	0, 0, 666, 775, 0, 666, 775, 0, 0, 0,
-	534, 535, 770, 767, 0, 0, 733, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 516, 517, 519, 0,
-	614, 0, 595, 0, 597, 598, 833, 50, 40, 0,
-	834, 0, 0, 0, 0, 794, 796, 797, 833, 0,
-	783, 0, 0, 619, 0, 0, 541, 46, 557, 553,
-	0, 619, 0, 0, 609, 0, 0, 0, 0, 0,
-	0, 599, 0, 0, 602, 0, 0, 0, 0, 593,
-	0, 0, 0, -2, 0, 0, 0, 60, 61, 0,
-	0, 0, 887, 71, 0, 0, 76, 77, 888, 889,
-	890, 891, 0, 106, -2, 270, 125, 127, 128, 129,
-	120, 195, 193, 0, 190, 189, 135, 201, 201, 158,
-	159, 204, 0, 204, 204, 204, 0, 0, 152, 153,
-	154, 155, 146, 0, 147, 148, 149, 0, 150, 248,
-	0, 802, 216, 217, 219, 223, 0, 0, 0, 244,
-	245, 0, 0, 905, 0, 916, 115, 116, 117, 118,
-	113, 0, 0, 121, 323, 0, 0, 0, 246, 0,
-	0, 225, 241, 226, 227, 0, 352, 0, 0, 385,
-	386, 387, 388, 0, 0, 0, 321, 323, 204, 0,
-	277, 278, 283, 284, 302, 0, 0, 0, 0, 828,
-	829, 0, 832, 91, 375, 377, 376, 380, 0, 0,
-	0, 0, 412, 414, 263, 264, 1310, 0, 416, 434,
-	470, 471, 469, 449, 469, 476, 453, 486, 644, 646,
-	648, 635, 656, 639, 0, 636, 0, 0, 630, 698,
-	0, 0, 536, 0, 790, 833, 702, 703, 0, 0,
-	0, 0, 0, 740, 0, 0, 741, 0, 790, 0,
-	768, 0, 0, 714, 734, 0, 0, 735, 736, 737,
-	738, 739, 515, 518, 520, 570, 0, 0, 0, 0,
-	596, 42, 0, 0, 0, 800, 801, 793, 41, 0,
-	900, 901, 784, 785, 786, 0, 550, 561, 542, 0,
-	798, 875, 0, 0, 867, 0, 0, 619, 883, 0,
-	563, 588, 590, 0, 585, 600, 601, 603, 0, 605,
-	0, 607, 608, 567, 568, 569, 0, 619, 0, 619,
-	65, 619, 67, 0, 613, 74, 75, 0, 0, 81,
-	205, 206, 113, 272, 126, 197, 0, 194, 131, 191,
-	204, 204, 160, 202, 203, 161, 162, 163, 0, 179,
-	0, 0, 0, 265, 86, 806, 805, 223, 223, 218,
-	0, 221, 0, 917, 182, 0, 0, 0, 327, 574,
-	0, 338, 339, 0, 322, 382, 0, 215, 0, 228,
-	773, 581, 0, 0, 340, 0, 323, 343, 344, 355,
-	305, 306, 303, 572, 819, 820, 821, 0, 831, 94,
-	0, 0, 0, 0, 373, 0, 415, 63, 472, 472,
-	637, 0, 657, 640, 699, 700, 0, 774, 798, 44,
-	0, 181, 181, 753, 181, 185, 756, 181, 758, 181,
-	761, 0, 0, 0, 0, 0, 0, 0, 765, 713,
-	771, 0, 0, 0, 0, 0, 0, 0, 0, 201,
-	838, 835, 43, 788, 0, 620, 554, 47, 51, 0,
-	875, 866, 877, 879, 0, 0, 0, 871, 0, 790,
-	0, 0, 582, 589, 0, 0, 583, 0, 584, 604,
-	606, -2, 790, 619, 58, 59, 0, 78, 79, 80,
-	271, 199, 0, 196, 156, 157, 201, 0, 201, 0,
-	186, 0, 254, 266, 0, 803, 804, 0, 0, 220,
-	222, 572, 101, 0, 0, 122, 324, 0, 214, 0,
-	0, 407, 404, 341, 342, 0, 0, 830, 374, 0,
-	92, 93, 0, 0, 379, 413, 435, 446, 641, 701,
-	833, 704, 750, 201, 754, 755, 757, 759, 760, 762,
-	706, 705, 0, 0, 0, 0, 0, 798, 0, 769,
-	0, 0, 0, 0, 0, 594, 201, 858, 48, 0,
-	0, 0, 52, 0, 880, 0, 0, 0, 0, 69,
-	798, 884, 885, 586, 0, 591, 798, 57, 207, 200,
-	0, 204, 180, 204, 0, 0, 267, 807, 808, 809,
-	810, 811, 812, 813, 0, 330, 575, 0, 0, 384,
-	0, 392, 0, 0, 0, 0, 95, 96, 0, 0,
-	0, 45, 751, 752, 0, 0, 0, 0, 742, 0,
-	766, 0, 0, 0, 616, 576, 577, 0, 0, 614,
-	840, 839, 852, 856, 789, 787, 0, 878, 0, 870,
-	873, 869, 872, 55, 0, 56, 212, 0, 209, 211,
-	198, 169, 170, 184, 187, 0, 0, 0, 408, 405,
-	406, 822, 573, 0, 0, 0, 381, 707, 709, 708,
-	710, 0, 0, 0, 712, 730, 731, 0, 615, 617,
-	618, 571, 858, 0, 851, 854, -2, 0, 0, 868,
-	0, 587, 130, 0, 208, 210, 822, 0, 0, 371,
-	824, 97, 98, 316, 317, 318, 91, 711, 0, 0,
-	0, 578, 579, 845, 843, 843, 856, 0, 860, 0,
-	865, 0, 876, 874, 213, 87, 0, 0, 0, 0,
-	825, 826, 94, 743, 0, 746, 848, 0, 841, 844,
-	842, 853, 0, 859, 0, 0, 857, 409, 410, 250,
-	0, 378, 744, 837, 0, 846, 847, 855, 0, 0,
-	251, 252, 0, 823, 0, 849, 850, 861, 863, 253,
-	0, 0, 0, 0, 255, 257, 258, 0, 0, 256,
-	745, 259, 260, 261,
+	31, 32, 824, 0, 529, 47, 529, 529, 529, 529,
+	529, 529, 0, 0, -2, -2, -2, 848, 37, 0,
+	0, 0, 0, -2, 487, 488, 0, 490, -2, 0,
+	0, 499, 1349, 1349, 1349, 0, 0, 0, 0, 1347,
+	59, 60, 505, 506, 507, 1, 3, 0, 533, 5,
+	0, 832, 0, 0, -2, 531, 0, 48, 0, 0,
+	942, 942, 942, 0, 90, 91, 0, 0, 0, 848,
+	940, 0, 940, 0, 948, 949, 950, 110, 111, 94,
+	-2, 115, 116, 0, 120, 373, 334, 376, 332, 362,
+	-2, 325, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 337, 229, 229, 0, 0, -2,
+	325, 325, 325, 0, 0, 0, 359, 944, 279, 229,
+	229, 0, 229, 229, 229, 229, 0, 0, 229, 229,
+	229, 229, 229, 229, 229, 229, 229, 229, 229, 229,
+	229, 229, 229, 0, 109, 861, 0, 0, 119, 38,
+	34, 35, 36, 0, 938, 0, 938, 938, 0, 425,
+	618, 958, 959, 1096, 1097, 1098, 1099, 1100, 1101, 1102,
+	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
+	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122,
+	1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132,
+	1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142,
+	1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152,
+	1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162,
+	1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
+	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
+	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
+	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202,
+	1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212,
+	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
+	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
+	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
+	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
+	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
+	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
+	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
+	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
+	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
+	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
+	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
+	1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332,
+	1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342,
+	1343, 1344, 1345, 1346, 0, 478, 478, 478, 478, 478,
+	478, 0, 434, 0, 0, 0, 0, 0, 0, 0,
+	450, 0, 453, 0, 0, 460, 478, 1350, 1350, 1350,
+	929, 0, 484, 485, 472, 470, 467, 468, 486, 489,
+	0, 494, 497, 954, 955, 0, 512, 0, 1168, 504,
+	517, 518, 528, 39, 670, 628, 0, 634, 636, 0,
+	672, 673, 674, 675, 676, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 702, 703, 704, 705, 784,
+	785, 786, 787, 788, 789, 790, 791, 638, 639, 781,
+	0, 918, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 772, 0, 741, 741, 741, 741, 741, 741, 741,
+	741, 741, 0, 0, 0, 0, 0, 0, 0, -2,
+	-2, 1349, 0, 527, 824, 55, 0, 529, 534, 535,
+	46, 579, 867, 0, 0, 824, 1348, 0, 0, -2,
+	-2, 545, 551, 552, 553, 554, 530, 45, 0, 557,
+	561, 0, 0, 0, 943, 0, 0, 76, 0, 1318,
+	922, -2, -2, 0, 0, 956, 957, 931, -2, 962,
+	963, 964, 965, 966, 967, 968, 969, 970, 971, 972,
+	973, 974, 975, 976, 977, 978, 979, 980, 981, 982,
+	983, 984, 985, 986, 987, 988, 989, 990, 991, 992,
+	993, 994, 995, 996, 997, 998, 999, 1000, 1001, 1002,
+	1003, 1004, 1005, 1006, 1007, 1008, 1009, 1010, 1011, 1012,
+	1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020, 1021, 1022,
+	1023, 1024, 1025, 1026, 1027, 1028, 1029, 1030, 1031, 1032,
+	1033, 1034, 1035, 1036, 1037, 1038, 1039, 1040, 1041, 1042,
+	1043, 1044, 1045, 1046, 1047, 1048, 1049, 1050, 1051, 1052,
+	1053, 1054, 1055, 1056, 1057, 1058, 1059, 1060, 1061, 1062,
+	1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071, 1072,
+	1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081, 1082,
+	1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091, 1092,
+	1093, 1094, 1095, -2, 1114, 0, 0, 129, 130, 0,
+	37, 255, 0, 125, 0, 249, 187, 861, 0, 0,
+	0, 0, 96, 117, 118, 229, 229, 0, 119, 119,
+	341, 342, 343, 0, 0, -2, 253, 0, 326, 0,
+	0, 243, 243, 247, 245, 246, 0, 0, 0, 0,
+	0, 0, 353, 0, 354, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 409, 0, 230, 0, 371, 372,
+	280, 0, 0, 0, 0, 351, 352, 0, 0, 945,
+	946, 0, 0, 229, 229, 0, 0, 0, 0, 229,
+	229, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 106, 852, 0, 0,
+	0, 0, 0, 0, -2, 0, 417, 0, 0, 0,
+	0, 0, 0, 424, 0, 426, 427, 0, 0, 428,
+	429, 430, 431, 432, 478, 0, 478, 0, 478, 478,
+	478, 478, 475, 0, 475, 473, 474, 465, 466, 1350,
+	1350, 0, 1350, 1350, 0, 1350, 1350, 0, 238, 239,
+	240, 481, 457, 458, 461, 462, 1351, 1352, 463, 464,
+	930, 495, 498, 515, 513, 514, 516, 508, 509, 510,
+	511, 0, 0, 0, 0, 0, 0, 632, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 656, 657, 658,
+	659, 660, 661, 662, 635, 0, 649, 0, 0, 0,
+	692, 693, 694, 695, 696, 697, 698, 699, 700, 0,
+	542, 0, 0, 0, 0, 824, 0, 0, 0, 0,
+	0, 0, 0, 539, 0, 773, 0, 724, 0, 725,
+	733, 0, 726, 734, 727, 735, 728, 729, 736, 730,
+	737, 731, 732, 738, 0, 0, 0, 542, 542, 0,
+	0, 40, 519, 520, 0, 601, 832, 0, 544, 0,
+	0, 870, 0, 0, 833, 825, 826, 829, 832, 0,
+	566, 555, 546, 549, 550, 532, 0, 558, 562, 0,
+	564, 565, 0, 0, 74, 0, 617, 0, 568, 570,
+	571, 572, 599, 0, 0, 0, 0, 70, 72, 618,
+	0, 1318, 928, 0, 78, 79, 0, 0, 0, 210,
+	933, 934, 935, -2, 236, 0, 198, 194, 138, 139,
+	140, 187, 142, 187, 187, 187, 187, 207, 207, 207,
+	207, 170, 171, 172, 173, 174, 0, 0, 157, 187,
+	187, 187, 187, 177, 178, 179, 180, 181, 182, 183,
+	184, 143, 144, 145, 146, 147, 148, 149, 150, 151,
+	189, 189, 189, 191, 191, 0, 38, 0, 221, 0,
+	829, 0, 852, 105, 0, 951, 108, 0, 0, 374,
+	335, 363, 375, 0, 338, 339, -2, 0, 0, 325,
+	0, 327, 0, 237, 0, -2, 0, 0, 0, 243,
+	247, 244, 247, 235, 248, 355, 781, 0, 356, 357,
+	0, 389, 587, 0, 0, 0, 0, 0, 395, 396,
+	397, 0, 399, 400, 401, 402, 403, 404, 405, 406,
+	407, 408, 364, 365, 366, 367, 368, 369, 370, 0,
+	0, 327, 0, 360, 0, 281, 282, 0, 0, 285,
+	286, 287, 288, 0, 0, 291, 292, 293, 294, 295,
+	319, 320, 321, 296, 297, 298, 299, 300, 301, 302,
+	313, 314, 315, 316, 317, 318, 303, 304, 305, 306,
+	307, 310, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 849, 850, 851, 0, 0, 0, 268, 939,
+	0, 268, 68, 423, 619, 960, 961, 479, 480, 433,
+	451, 435, 454, 436, 438, 437, 439, 478, 0, 0,
+	0, 241, 242, 478, 442, 443, 444, 445, 446, 447,
+	448, 449, 0, 456, 0, 0, 0, 496, 500, 501,
+	502, 503, 671, 629, 630, 631, 633, 650, 0, 652,
+	654, 640, 641, 665, 666, 667, 0, 0, 0, 0,
+	663, 645, 0, 677, 678, 679, 680, 681, 682, 683,
+	684, 685, 686, 687, 688, 691, 756, 757, 758, 0,
+	689, 690, 701, 0, 0, 0, 543, 782, 0, -2,
+	0, 668, 0, 917, 832, 0, 0, 0, 0, 674,
+	784, 0, 674, 784, 0, 0, 0, 540, 541, 779,
+	776, 0, 0, 742, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 522, 523, 525, 0, 621, 0, 602,
+	0, 604, 605, 867, 56, 0, 0, 581, 41, 0,
+	868, 0, 0, 0, 0, 828, 830, 831, 867, 0,
+	792, 0, 0, 626, 0, 0, 547, 52, 563, 559,
+	0, 626, 0, 0, 616, 0, 0, 0, 0, 0,
+	0, 606, 0, 0, 609, 0, 0, 0, 0, 600,
+	0, 0, 0, 0, -2, 0, 0, 0, 66, 67,
+	0, 0, 0, 923, 77, 0, 0, 82, 83, 924,
+	925, 926, 927, 0, 112, -2, 276, 131, 133, 134,
+	135, 126, 201, 199, 0, 196, 195, 141, 207, 207,
+	164, 165, 210, 0, 210, 210, 210, 0, 0, 158,
+	159, 160, 161, 152, 0, 153, 154, 155, 0, 156,
+	254, 0, 836, 222, 223, 225, 229, 0, 0, 0,
+	250, 251, 0, 0, 941, 0, 952, 121, 122, 123,
+	124, 119, 0, 0, 127, 329, 0, 0, 0, 252,
+	0, 0, 231, 247, 232, 233, 0, 358, 0, 0,
+	391, 392, 393, 394, 0, 0, 0, 327, 329, 210,
+	0, 283, 284, 289, 290, 308, 0, 0, 0, 0,
+	862, 863, 0, 866, 97, 381, 383, 382, 386, 0,
+	0, 0, 0, 418, 420, 269, 270, 1350, 0, 422,
+	440, 476, 477, 475, 455, 475, 482, 459, 492, 651,
+	653, 655, 642, 663, 646, 0, 643, 0, 0, 637,
+	706, 0, 0, 542, 0, 824, 669, 867, 711, 712,
+	0, 0, 0, 0, 0, 749, 0, 0, 750, 0,
+	824, 0, 777, 0, 0, 723, 743, 0, 0, 744,
+	745, 746, 747, 748, 521, 524, 526, 577, 0, 0,
+	0, 0, 603, 43, 49, 580, 0, 0, 0, 0,
+	834, 835, 827, 42, 0, 936, 937, 793, 794, 795,
+	0, 556, 567, 548, 0, 832, 911, 0, 0, 901,
+	0, 0, 0, 626, 919, 0, 569, 595, 597, 0,
+	592, 607, 608, 610, 0, 612, 0, 614, 615, 573,
+	574, 575, 0, 0, 626, 0, 626, 71, 626, 73,
+	0, 620, 80, 81, 0, 0, 87, 211, 212, 119,
+	278, 132, 203, 0, 200, 137, 197, 210, 210, 166,
+	208, 209, 167, 168, 169, 0, 185, 0, 0, 0,
+	271, 92, 840, 839, 229, 229, 224, 0, 227, 0,
+	953, 188, 0, 0, 0, 333, 0, 344, 345, 0,
+	328, 388, 0, 221, 0, 234, 782, 588, 0, 0,
+	346, 0, 329, 349, 350, 361, 311, 312, 309, 579,
+	853, 854, 855, 0, 865, 100, 0, 0, 0, 0,
+	379, 0, 421, 69, 478, 478, 644, 0, 664, 647,
+	707, 0, 708, 709, 0, 783, 832, 50, 0, 187,
+	187, 762, 187, 191, 765, 187, 767, 187, 770, 0,
+	0, 0, 0, 0, 0, 0, 774, 722, 780, 0,
+	0, 0, 0, 0, 0, 0, 582, 0, 207, 872,
+	869, 44, 797, 0, 627, 560, 53, 57, 0, 911,
+	900, 913, 915, 0, 902, 0, 0, 907, 0, 824,
+	0, 0, 589, 596, 0, 0, 590, 0, 591, 611,
+	613, 576, -2, 824, 626, 64, 65, 0, 84, 85,
+	86, 277, 205, 0, 202, 162, 163, 207, 0, 207,
+	0, 192, 0, 260, 272, 0, 837, 838, 0, 0,
+	226, 228, 579, 107, 0, 128, 330, 0, 220, 0,
+	0, 413, 410, 347, 348, 0, 864, 380, 0, 98,
+	99, 0, 0, 385, 419, 441, 452, 648, 807, 805,
+	710, 867, 713, 759, 207, 763, 764, 766, 768, 769,
+	771, 715, 714, 0, 0, 0, 0, 0, 832, 0,
+	778, 0, 0, 0, 0, 0, 601, 207, 892, 799,
+	0, 0, 0, 58, 0, 916, 0, 0, 0, 0,
+	75, 832, 920, 921, 593, 0, 598, 832, 63, 213,
+	206, 0, 210, 186, 210, 0, 0, 273, 841, 842,
+	843, 844, 845, 846, 847, 0, 336, 0, 0, 390,
+	0, 398, 0, 0, 0, 101, 102, 0, 0, 0,
+	0, 809, 808, 51, 760, 761, 0, 0, 0, 0,
+	751, 0, 775, 0, 0, 0, 623, 583, 584, 0,
+	0, 621, 874, 873, 886, 890, 54, 0, 798, 796,
+	0, 914, 0, 905, 0, 909, 904, 908, 61, 0,
+	62, 218, 0, 215, 217, 204, 175, 176, 190, 193,
+	0, 0, 0, 414, 411, 412, 856, 0, 0, 0,
+	387, 804, 824, 0, 716, 718, 717, 719, 0, 0,
+	0, 721, 739, 740, 0, 622, 624, 625, 578, 892,
+	0, 885, 888, -2, 0, 800, 801, 0, 0, 903,
+	906, 0, 594, 136, 0, 214, 216, 856, 0, 0,
+	377, 858, 103, 104, 322, 323, 324, 97, 811, 0,
+	720, 0, 0, 0, 585, 586, 879, 877, 877, 890,
+	0, 894, 0, 899, 0, 0, 0, 912, 910, 219,
+	93, 0, 0, 0, 0, 859, 860, 100, 806, 0,
+	814, 815, 810, 752, 0, 755, 882, 0, 875, 878,
+	876, 887, 0, 893, 0, 0, 891, 802, 807, 415,
+	416, 256, 0, 384, 812, 0, 0, 0, 0, 821,
+	822, 0, 753, 871, 0, 880, 881, 889, 0, 0,
+	0, 257, 258, 0, 857, 0, 816, 817, 818, 819,
+	820, 0, 0, 883, 884, 895, 897, 803, 259, 0,
+	0, 0, 0, 823, 0, 261, 263, 264, 0, 0,
+	262, 813, 754, 265, 266, 267,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 110, 3, 3, 3, 137, 129, 3,
-	80, 82, 134, 132, 81, 133, 149, 135, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 451,
-	118, 117, 119, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 111, 3, 3, 3, 138, 130, 3,
+	81, 83, 135, 133, 82, 134, 150, 136, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 455,
+	119, 118, 120, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 139, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 140, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 128, 3, 140,
+	3, 3, 3, 3, 129, 3, 141,
 }
 
 var yyTok2 = [...]int{
@@ -4824,13 +5032,13 @@ var yyTok2 = [...]int{
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
-	72, 73, 74, 75, 76, 77, 78, 79, 83, 84,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 84,
 	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 111, 112, 113, 114, 115,
-	116, 120, 121, 122, 123, 124, 125, 126, 127, 130,
-	131, 136, 138, 141, 142, 143, 144, 145, 146, 147,
-	148, 150, 151, 152, 153, 154, 155, 156, 157, 158,
+	105, 106, 107, 108, 109, 110, 112, 113, 114, 115,
+	116, 117, 121, 122, 123, 124, 125, 126, 127, 128,
+	131, 132, 137, 139, 142, 143, 144, 145, 146, 147,
+	148, 149, 151, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
 	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
@@ -4881,7 +5089,8 @@ var yyTok3 = [...]int{
 	57760, 435, 57761, 436, 57762, 437, 57763, 438, 57764, 439,
 	57765, 440, 57766, 441, 57767, 442, 57768, 443, 57769, 444,
 	57770, 445, 57771, 446, 57772, 447, 57773, 448, 57774, 449,
-	57775, 450, 0,
+	57775, 450, 57776, 451, 57777, 452, 57778, 453, 57779, 454,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5223,77 +5432,83 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:407
+//line sql.y:434
 		{
 			setParseTree(yylex, yyDollar[1].statement)
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:412
+//line sql.y:439
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:413
+//line sql.y:440
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:417
+//line sql.y:444
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 32:
-		yyDollar = yyS[yypt-0 : yypt+1]
+	case 5:
+		yyDollar = yyS[yypt-2 : yypt+1]
 //line sql.y:448
 		{
-			setParseTree(yylex, nil)
+			yyVAL.statement = SetWith(yyDollar[2].selStmt, yyDollar[1].with)
 		}
 	case 33:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:479
+		{
+			setParseTree(yylex, nil)
+		}
+	case 34:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:454
+//line sql.y:485
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), NoAt)
 		}
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:458
+//line sql.y:489
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), SingleAt)
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:462
+//line sql.y:493
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].bytes), DoubleAt)
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:467
+//line sql.y:498
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:471
+//line sql.y:502
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:477
+//line sql.y:508
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:483
+//line sql.y:514
 		{
 			yyVAL.statement = &Load{}
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:489
+//line sql.y:520
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5302,27 +5517,57 @@ yydefault:
 			sel.Into = yyDollar[5].selectInto
 			yyVAL.selStmt = sel
 		}
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:498
+//line sql.y:529
 		{
 			yyVAL.selStmt = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmt}, OrderBy: yyDollar[4].orderBy, Limit: yyDollar[5].limit, Lock: yyDollar[6].lock}
 		}
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:502
+//line sql.y:533
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:506
+//line sql.y:537
 		{
 			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), SelectExprs{Nextval{Expr: yyDollar[5].expr}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
-	case 44:
+	case 45:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:560
+		{
+			yyVAL.with = &With{Recursive: yyDollar[2].boolean, CTEs: []*CommonTableExpr{yyDollar[3].cte}}
+		}
+	case 46:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:564
+		{
+			yyVAL.with.CTEs = append(yyVAL.with.CTEs, yyDollar[3].cte)
+		}
+	case 47:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:569
+		{
+			yyVAL.boolean = false
+		}
+	case 48:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:573
+		{
+			yyVAL.boolean = true
+		}
+	case 49:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:529
+//line sql.y:579
+		{
+			yyVAL.cte = &CommonTableExpr{Name: yyDollar[1].tableIdent, Columns: yyDollar[2].columns, Subquery: yyDollar[4].subquery}
+		}
+	case 50:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:585
 		{
 			sel := yyDollar[1].selStmt.(*Select)
 			sel.OrderBy = yyDollar[2].orderBy
@@ -5330,45 +5575,47 @@ yydefault:
 			sel.Lock = yyDollar[4].lock
 			yyVAL.selStmt = sel
 		}
-	case 45:
+	case 51:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:537
+//line sql.y:593
 		{
 			yyVAL.selStmt = Unionize(yyDollar[1].selStmt, yyDollar[3].selStmt, yyDollar[2].boolean, yyDollar[4].orderBy, yyDollar[5].limit, yyDollar[6].lock)
 		}
-	case 46:
+	case 52:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:543
+//line sql.y:599
 		{
 			yyVAL.statement = &Stream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName}
 		}
-	case 47:
+	case 53:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:549
+//line sql.y:605
 		{
 			yyVAL.statement = &VStream{Comments: Comments(yyDollar[2].bytes2), SelectExpr: yyDollar[3].selectExpr, Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].expr), Limit: yyDollar[7].limit}
 		}
-	case 48:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:557
+	case 54:
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line sql.y:613
 		{
-			yyVAL.selStmt = NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereClause, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingClause, yyDollar[8].expr))
+			sel := NewSelect(Comments(yyDollar[2].bytes2), yyDollar[4].selectExprs /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprs /*from*/, NewWhere(WhereClause, yyDollar[6].expr), GroupBy(yyDollar[7].exprs), NewWhere(HavingClause, yyDollar[8].expr))
+			sel.Windows = yyDollar[9].windowDefinitions
+			yyVAL.selStmt = sel
 		}
-	case 49:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:563
+//line sql.y:621
 		{
 			yyVAL.selStmt = yyDollar[1].selStmt
 		}
-	case 50:
+	case 56:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:567
+//line sql.y:625
 		{
 			yyVAL.selStmt = &ParenSelect{Select: yyDollar[2].selStmt}
 		}
-	case 51:
+	case 57:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:574
+//line sql.y:632
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].ins
@@ -5380,9 +5627,9 @@ yydefault:
 			ins.OnDup = OnDup(yyDollar[7].updateExprs)
 			yyVAL.statement = ins
 		}
-	case 52:
+	case 58:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:586
+//line sql.y:644
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprs))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprs))
@@ -5392,210 +5639,210 @@ yydefault:
 			}
 			yyVAL.statement = &Insert{Action: yyDollar[1].insertAction, Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitions, Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprs)}
 		}
-	case 53:
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:598
+//line sql.y:656
 		{
 			yyVAL.insertAction = InsertAct
 		}
-	case 54:
+	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:602
+//line sql.y:660
 		{
 			yyVAL.insertAction = ReplaceAct
 		}
-	case 55:
+	case 61:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:608
+//line sql.y:666
 		{
 			yyVAL.statement = &Update{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: yyDollar[4].tableExprs, Exprs: yyDollar[6].updateExprs, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 56:
+	case 62:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:614
+//line sql.y:672
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitions, Where: NewWhere(WhereClause, yyDollar[7].expr), OrderBy: yyDollar[8].orderBy, Limit: yyDollar[9].limit}
 		}
-	case 57:
+	case 63:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:618
+//line sql.y:676
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[5].tableNames, TableExprs: yyDollar[7].tableExprs, Where: NewWhere(WhereClause, yyDollar[8].expr)}
 		}
-	case 58:
+	case 64:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:622
+//line sql.y:680
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
-	case 59:
+	case 65:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:626
+//line sql.y:684
 		{
 			yyVAL.statement = &Delete{Comments: Comments(yyDollar[2].bytes2), Ignore: yyDollar[3].ignore, Targets: yyDollar[4].tableNames, TableExprs: yyDollar[6].tableExprs, Where: NewWhere(WhereClause, yyDollar[7].expr)}
 		}
-	case 60:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:631
+//line sql.y:689
 		{
 		}
-	case 61:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:632
+//line sql.y:690
 		{
 		}
-	case 62:
+	case 68:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:636
+//line sql.y:694
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
-	case 63:
+	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:640
+//line sql.y:698
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName.ToViewName())
 		}
-	case 64:
+	case 70:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:646
+//line sql.y:704
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 65:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:650
+//line sql.y:708
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 66:
+	case 72:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:656
+//line sql.y:714
 		{
 			yyVAL.tableNames = TableNames{yyDollar[1].tableName}
 		}
-	case 67:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:660
+//line sql.y:718
 		{
 			yyVAL.tableNames = append(yyVAL.tableNames, yyDollar[3].tableName)
 		}
-	case 68:
+	case 74:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:665
+//line sql.y:723
 		{
 			yyVAL.partitions = nil
 		}
-	case 69:
+	case 75:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:669
+//line sql.y:727
 		{
 			yyVAL.partitions = yyDollar[3].partitions
 		}
-	case 70:
+	case 76:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:675
+//line sql.y:733
 		{
 			yyVAL.statement = &Set{Comments: Comments(yyDollar[2].bytes2), Exprs: yyDollar[3].setExprs}
 		}
-	case 71:
+	case 77:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:681
+//line sql.y:739
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Scope: yyDollar[3].scope, Characteristics: yyDollar[5].characteristics}
 		}
-	case 72:
+	case 78:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:685
+//line sql.y:743
 		{
 			yyVAL.statement = &SetTransaction{Comments: Comments(yyDollar[2].bytes2), Characteristics: yyDollar[4].characteristics, Scope: ImplicitScope}
 		}
-	case 73:
+	case 79:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:691
+//line sql.y:749
 		{
 			yyVAL.characteristics = []Characteristic{yyDollar[1].characteristic}
 		}
-	case 74:
+	case 80:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:695
+//line sql.y:753
 		{
 			yyVAL.characteristics = append(yyVAL.characteristics, yyDollar[3].characteristic)
 		}
-	case 75:
+	case 81:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:701
+//line sql.y:759
 		{
 			yyVAL.characteristic = yyDollar[3].isolationLevel
 		}
-	case 76:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:705
+//line sql.y:763
 		{
 			yyVAL.characteristic = ReadWrite
 		}
-	case 77:
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:709
+//line sql.y:767
 		{
 			yyVAL.characteristic = ReadOnly
 		}
-	case 78:
+	case 84:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:715
+//line sql.y:773
 		{
 			yyVAL.isolationLevel = RepeatableRead
 		}
-	case 79:
+	case 85:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:719
+//line sql.y:777
 		{
 			yyVAL.isolationLevel = ReadCommitted
 		}
-	case 80:
+	case 86:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:723
+//line sql.y:781
 		{
 			yyVAL.isolationLevel = ReadUncommitted
 		}
-	case 81:
+	case 87:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:727
+//line sql.y:785
 		{
 			yyVAL.isolationLevel = Serializable
 		}
-	case 82:
+	case 88:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:733
+//line sql.y:791
 		{
 			yyVAL.scope = SessionScope
 		}
-	case 83:
+	case 89:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:737
+//line sql.y:795
 		{
 			yyVAL.scope = GlobalScope
 		}
-	case 84:
+	case 90:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:743
+//line sql.y:801
 		{
 			yyDollar[1].createTable.TableSpec = yyDollar[2].TableSpec
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 85:
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:749
+//line sql.y:807
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTable.OptLike = yyDollar[2].optLike
 			yyDollar[1].createTable.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createTable
 		}
-	case 86:
+	case 92:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:756
+//line sql.y:814
 		{
 			yyDollar[1].createIndex.Columns = yyDollar[3].indexColumns
 			yyDollar[1].createIndex.Options = append(yyDollar[1].createIndex.Options, yyDollar[5].indexOptions...)
@@ -5603,268 +5850,268 @@ yydefault:
 			yyDollar[1].createIndex.FullyParsed = true
 			yyVAL.statement = yyDollar[1].createIndex
 		}
-	case 87:
+	case 93:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:764
+//line sql.y:822
 		{
 			yyVAL.statement = &CreateView{ViewName: yyDollar[7].tableName.ToViewName(), IsReplace: yyDollar[2].boolean, Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columns, Select: yyDollar[10].selStmt, CheckOption: yyDollar[11].str}
 		}
-	case 88:
+	case 94:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:768
+//line sql.y:826
 		{
 			yyDollar[1].createDatabase.FullyParsed = true
 			yyDollar[1].createDatabase.CreateOptions = yyDollar[2].collateAndCharsets
 			yyVAL.statement = yyDollar[1].createDatabase
 		}
-	case 89:
+	case 95:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:775
+//line sql.y:833
 		{
 			yyVAL.boolean = false
 		}
-	case 90:
+	case 96:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:779
+//line sql.y:837
 		{
 			yyVAL.boolean = true
 		}
-	case 91:
+	case 97:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:784
+//line sql.y:842
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 92:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:788
+//line sql.y:846
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 93:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:794
+//line sql.y:852
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 94:
+	case 100:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:799
+//line sql.y:857
 		{
 			var v []VindexParam
 			yyVAL.vindexParams = v
 		}
-	case 95:
+	case 101:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:804
+//line sql.y:862
 		{
 			yyVAL.vindexParams = yyDollar[2].vindexParams
 		}
-	case 96:
+	case 102:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:810
+//line sql.y:868
 		{
 			yyVAL.vindexParams = make([]VindexParam, 0, 4)
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[1].vindexParam)
 		}
-	case 97:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:815
+//line sql.y:873
 		{
 			yyVAL.vindexParams = append(yyVAL.vindexParams, yyDollar[3].vindexParam)
 		}
-	case 98:
+	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:821
+//line sql.y:879
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 99:
+	case 105:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:827
+//line sql.y:885
 		{
 			yyVAL.createTable = &CreateTable{Table: yyDollar[4].tableName, IfNotExists: yyDollar[3].boolean}
 			setDDL(yylex, yyVAL.createTable)
 		}
-	case 100:
+	case 106:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:834
+//line sql.y:892
 		{
 			yyVAL.alterTable = &AlterTable{Table: yyDollar[3].tableName}
 			setDDL(yylex, yyVAL.alterTable)
 		}
-	case 101:
+	case 107:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:841
+//line sql.y:899
 		{
 			yyVAL.createIndex = &CreateIndex{Constraint: yyDollar[2].str, Name: yyDollar[4].colIdent, Options: yyDollar[5].indexOptions, Table: yyDollar[7].tableName}
 			setDDL(yylex, yyVAL.createIndex)
 		}
-	case 102:
+	case 108:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:848
+//line sql.y:906
 		{
 			yyVAL.createDatabase = &CreateDatabase{DBName: string(yyDollar[4].colIdent.String()), IfNotExists: yyDollar[3].boolean}
 			setDDL(yylex, yyVAL.createDatabase)
 		}
-	case 103:
+	case 109:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:855
+//line sql.y:913
 		{
 			yyVAL.alterDatabase = &AlterDatabase{}
 			setDDL(yylex, yyVAL.alterDatabase)
 		}
-	case 106:
+	case 112:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:866
+//line sql.y:924
 		{
 			yyVAL.TableSpec = yyDollar[2].TableSpec
 			yyVAL.TableSpec.Options = yyDollar[4].tableOptions
 		}
-	case 107:
+	case 113:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:872
+//line sql.y:930
 		{
 			yyVAL.collateAndCharsets = nil
 		}
-	case 108:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:876
+//line sql.y:934
 		{
 			yyVAL.collateAndCharsets = yyDollar[1].collateAndCharsets
 		}
-	case 109:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:882
+//line sql.y:940
 		{
 			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
-	case 110:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:886
+//line sql.y:944
 		{
 			yyVAL.collateAndCharsets = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
-	case 111:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:890
+//line sql.y:948
 		{
 			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
 		}
-	case 112:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:894
+//line sql.y:952
 		{
 			yyVAL.collateAndCharsets = append(yyDollar[1].collateAndCharsets, yyDollar[2].collateAndCharset)
 		}
-	case 113:
+	case 119:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:899
+//line sql.y:957
 		{
 			yyVAL.boolean = false
 		}
-	case 114:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:903
+//line sql.y:961
 		{
 			yyVAL.boolean = true
 		}
-	case 115:
+	case 121:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:909
+//line sql.y:967
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 116:
+	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:913
+//line sql.y:971
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 117:
+	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:919
+//line sql.y:977
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].boolean}
 		}
-	case 118:
+	case 124:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:923
+//line sql.y:981
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: ("'" + string(yyDollar[4].bytes) + "'"), IsDefault: yyDollar[1].boolean}
 		}
-	case 119:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:930
+//line sql.y:988
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
-	case 120:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:934
+//line sql.y:992
 		{
 			yyVAL.optLike = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
-	case 121:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:940
+//line sql.y:998
 		{
 			yyVAL.columnDefinitions = []*ColumnDefinition{yyDollar[1].columnDefinition}
 		}
-	case 122:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:944
+//line sql.y:1002
 		{
 			yyVAL.columnDefinitions = append(yyDollar[1].columnDefinitions, yyDollar[3].columnDefinition)
 		}
-	case 123:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:950
+//line sql.y:1008
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddColumn(yyDollar[1].columnDefinition)
 		}
-	case 124:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:955
+//line sql.y:1013
 		{
 			yyVAL.TableSpec = &TableSpec{}
 			yyVAL.TableSpec.AddConstraint(yyDollar[1].constraintDefinition)
 		}
-	case 125:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:960
+//line sql.y:1018
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 		}
-	case 126:
+	case 132:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:964
+//line sql.y:1022
 		{
 			yyVAL.TableSpec.AddColumn(yyDollar[3].columnDefinition)
 			yyVAL.TableSpec.AddConstraint(yyDollar[4].constraintDefinition)
 		}
-	case 127:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:969
+//line sql.y:1027
 		{
 			yyVAL.TableSpec.AddIndex(yyDollar[3].indexDefinition)
 		}
-	case 128:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:973
+//line sql.y:1031
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 129:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:977
+//line sql.y:1035
 		{
 			yyVAL.TableSpec.AddConstraint(yyDollar[3].constraintDefinition)
 		}
-	case 130:
+	case 136:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:983
+//line sql.y:1041
 		{
 			yyDollar[2].columnType.NotNull = yyDollar[3].boolean
 			yyDollar[2].columnType.Default = yyDollar[4].optVal
@@ -5874,1428 +6121,1428 @@ yydefault:
 			yyDollar[2].columnType.Comment = yyDollar[8].literal
 			yyVAL.columnDefinition = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
-	case 131:
+	case 137:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:994
+//line sql.y:1052
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].boolean
 			yyVAL.columnType.Zerofill = yyDollar[3].boolean
 		}
-	case 135:
+	case 141:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1005
+//line sql.y:1063
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literal
 		}
-	case 136:
+	case 142:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1010
+//line sql.y:1068
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 		}
-	case 137:
+	case 143:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1016
+//line sql.y:1074
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 138:
+	case 144:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1020
+//line sql.y:1078
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 139:
+	case 145:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1024
+//line sql.y:1082
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 140:
+	case 146:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1028
+//line sql.y:1086
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 141:
+	case 147:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1032
+//line sql.y:1090
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 142:
+	case 148:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1036
+//line sql.y:1094
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 143:
+	case 149:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1040
+//line sql.y:1098
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 144:
+	case 150:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1044
+//line sql.y:1102
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 145:
+	case 151:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1048
+//line sql.y:1106
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 146:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1054
+//line sql.y:1112
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 147:
+	case 153:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1060
+//line sql.y:1118
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 148:
+	case 154:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1066
+//line sql.y:1124
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 149:
+	case 155:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1072
+//line sql.y:1130
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 150:
+	case 156:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1078
+//line sql.y:1136
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 151:
+	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1086
+//line sql.y:1144
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 152:
+	case 158:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1090
+//line sql.y:1148
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 153:
+	case 159:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1094
+//line sql.y:1152
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 154:
+	case 160:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1098
+//line sql.y:1156
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 155:
+	case 161:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1102
+//line sql.y:1160
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 156:
+	case 162:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1108
+//line sql.y:1166
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 157:
+	case 163:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1112
+//line sql.y:1170
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
-	case 158:
+	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1116
+//line sql.y:1174
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 159:
+	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1120
+//line sql.y:1178
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 160:
+	case 166:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1124
+//line sql.y:1182
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 161:
+	case 167:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1128
+//line sql.y:1186
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 162:
+	case 168:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1132
+//line sql.y:1190
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 163:
+	case 169:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1136
+//line sql.y:1194
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
-	case 164:
+	case 170:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1140
+//line sql.y:1198
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 165:
+	case 171:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1144
+//line sql.y:1202
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 166:
+	case 172:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1148
+//line sql.y:1206
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 167:
+	case 173:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1152
+//line sql.y:1210
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 168:
+	case 174:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1156
+//line sql.y:1214
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 169:
+	case 175:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1160
+//line sql.y:1218
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 170:
+	case 176:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1165
+//line sql.y:1223
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
-	case 171:
+	case 177:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1171
+//line sql.y:1229
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 172:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1175
+//line sql.y:1233
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 173:
+	case 179:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1179
+//line sql.y:1237
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 174:
+	case 180:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1183
+//line sql.y:1241
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 175:
+	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1187
+//line sql.y:1245
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 176:
+	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1191
+//line sql.y:1249
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 177:
+	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1195
+//line sql.y:1253
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 178:
+	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1199
+//line sql.y:1257
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].bytes)}
 		}
-	case 179:
+	case 185:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1205
+//line sql.y:1263
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, "'"+string(yyDollar[1].bytes)+"'")
 		}
-	case 180:
+	case 186:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1210
+//line sql.y:1268
 		{
 			yyVAL.strs = append(yyDollar[1].strs, "'"+string(yyDollar[3].bytes)+"'")
 		}
-	case 181:
+	case 187:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1215
+//line sql.y:1273
 		{
 			yyVAL.literal = nil
 		}
-	case 182:
+	case 188:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1219
+//line sql.y:1277
 		{
 			yyVAL.literal = NewIntLiteral(yyDollar[2].bytes)
 		}
-	case 183:
+	case 189:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1224
+//line sql.y:1282
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 184:
+	case 190:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1228
+//line sql.y:1286
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 185:
+	case 191:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1236
+//line sql.y:1294
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 186:
+	case 192:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1240
+//line sql.y:1298
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 			}
 		}
-	case 187:
+	case 193:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1246
+//line sql.y:1304
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].bytes),
 				Scale:  NewIntLiteral(yyDollar[4].bytes),
 			}
 		}
-	case 188:
+	case 194:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1254
+//line sql.y:1312
 		{
 			yyVAL.boolean = false
 		}
-	case 189:
+	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1258
+//line sql.y:1316
 		{
 			yyVAL.boolean = true
 		}
-	case 190:
+	case 196:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1263
+//line sql.y:1321
 		{
 			yyVAL.boolean = false
 		}
-	case 191:
+	case 197:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1267
+//line sql.y:1325
 		{
 			yyVAL.boolean = true
 		}
-	case 192:
+	case 198:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1273
+//line sql.y:1331
 		{
 			yyVAL.boolean = false
 		}
-	case 193:
+	case 199:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1277
+//line sql.y:1335
 		{
 			yyVAL.boolean = false
 		}
-	case 194:
+	case 200:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1281
+//line sql.y:1339
 		{
 			yyVAL.boolean = true
 		}
-	case 195:
+	case 201:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1286
+//line sql.y:1344
 		{
 			yyVAL.optVal = nil
 		}
-	case 196:
+	case 202:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1290
+//line sql.y:1348
 		{
 			yyVAL.optVal = yyDollar[2].expr
 		}
-	case 197:
+	case 203:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1295
+//line sql.y:1353
 		{
 			yyVAL.optVal = nil
 		}
-	case 198:
+	case 204:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1299
+//line sql.y:1357
 		{
 			yyVAL.optVal = yyDollar[3].expr
 		}
-	case 199:
+	case 205:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1304
+//line sql.y:1362
 		{
 			yyVAL.boolean = false
 		}
-	case 200:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1308
+//line sql.y:1366
 		{
 			yyVAL.boolean = true
 		}
-	case 201:
+	case 207:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1313
+//line sql.y:1371
 		{
 			yyVAL.str = ""
 		}
-	case 202:
+	case 208:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1317
+//line sql.y:1375
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 203:
+	case 209:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1321
+//line sql.y:1379
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 204:
+	case 210:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1326
+//line sql.y:1384
 		{
 			yyVAL.str = ""
 		}
-	case 205:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1330
+//line sql.y:1388
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 206:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1334
+//line sql.y:1392
 		{
 			yyVAL.str = string(yyDollar[2].bytes)
 		}
-	case 207:
+	case 213:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1339
+//line sql.y:1397
 		{
 			yyVAL.colKeyOpt = colKeyNone
 		}
-	case 208:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1343
+//line sql.y:1401
 		{
 			yyVAL.colKeyOpt = colKeyPrimary
 		}
-	case 209:
+	case 215:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1347
+//line sql.y:1405
 		{
 			yyVAL.colKeyOpt = colKey
 		}
-	case 210:
+	case 216:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1351
+//line sql.y:1409
 		{
 			yyVAL.colKeyOpt = colKeyUniqueKey
 		}
-	case 211:
+	case 217:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1355
+//line sql.y:1413
 		{
 			yyVAL.colKeyOpt = colKeyUnique
 		}
-	case 212:
+	case 218:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1360
+//line sql.y:1418
 		{
 			yyVAL.literal = nil
 		}
-	case 213:
+	case 219:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1364
+//line sql.y:1422
 		{
 			yyVAL.literal = NewStrLiteral(yyDollar[2].bytes)
 		}
-	case 214:
+	case 220:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1370
+//line sql.y:1428
 		{
 			yyVAL.indexDefinition = &IndexDefinition{Info: yyDollar[1].indexInfo, Columns: yyDollar[3].indexColumns, Options: yyDollar[5].indexOptions}
 		}
-	case 215:
+	case 221:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1375
+//line sql.y:1433
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 216:
+	case 222:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1379
+//line sql.y:1437
 		{
 			yyVAL.indexOptions = yyDollar[1].indexOptions
 		}
-	case 217:
+	case 223:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1385
+//line sql.y:1443
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 218:
+	case 224:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1389
+//line sql.y:1447
 		{
 			yyVAL.indexOptions = append(yyVAL.indexOptions, yyDollar[2].indexOption)
 		}
-	case 219:
+	case 225:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1395
+//line sql.y:1453
 		{
 			yyVAL.indexOption = yyDollar[1].indexOption
 		}
-	case 220:
+	case 226:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1399
+//line sql.y:1457
 		{
 			// should not be string
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 221:
+	case 227:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1404
+//line sql.y:1462
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[2].bytes)}
 		}
-	case 222:
+	case 228:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1408
+//line sql.y:1466
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes), String: yyDollar[3].colIdent.String()}
 		}
-	case 223:
+	case 229:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1414
+//line sql.y:1472
 		{
 			yyVAL.str = ""
 		}
-	case 224:
+	case 230:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1418
+//line sql.y:1476
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 225:
+	case 231:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1424
+//line sql.y:1482
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
-	case 226:
+	case 232:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1428
+//line sql.y:1486
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
-	case 227:
+	case 233:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1432
+//line sql.y:1490
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].bytes) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
-	case 228:
+	case 234:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1436
+//line sql.y:1494
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
-	case 229:
+	case 235:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1440
+//line sql.y:1498
 		{
 			yyVAL.indexInfo = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
-	case 230:
+	case 236:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1445
+//line sql.y:1503
 		{
 			yyVAL.str = ""
 		}
-	case 231:
+	case 237:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1449
+//line sql.y:1507
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 232:
+	case 238:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1455
+//line sql.y:1513
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 233:
+	case 239:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1459
+//line sql.y:1517
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 234:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1463
+//line sql.y:1521
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 235:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1470
+//line sql.y:1528
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 236:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1474
+//line sql.y:1532
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 237:
+	case 243:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1479
+//line sql.y:1537
 		{
 			yyVAL.str = "key"
 		}
-	case 238:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1483
+//line sql.y:1541
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 239:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1489
+//line sql.y:1547
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 240:
+	case 246:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1493
+//line sql.y:1551
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 241:
+	case 247:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1498
+//line sql.y:1556
 		{
 			yyVAL.str = ""
 		}
-	case 242:
+	case 248:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1502
+//line sql.y:1560
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 243:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1508
+//line sql.y:1566
 		{
 			yyVAL.indexColumns = []*IndexColumn{yyDollar[1].indexColumn}
 		}
-	case 244:
+	case 250:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1512
+//line sql.y:1570
 		{
 			yyVAL.indexColumns = append(yyVAL.indexColumns, yyDollar[3].indexColumn)
 		}
-	case 245:
+	case 251:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1518
+//line sql.y:1576
 		{
 			yyVAL.indexColumn = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literal, Direction: yyDollar[3].orderDirection}
 		}
-	case 246:
+	case 252:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1524
+//line sql.y:1582
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 247:
+	case 253:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1528
+//line sql.y:1586
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 248:
+	case 254:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1534
+//line sql.y:1592
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Name: string(yyDollar[2].colIdent.String()), Details: yyDollar[3].constraintInfo}
 		}
-	case 249:
+	case 255:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1538
+//line sql.y:1596
 		{
 			yyVAL.constraintDefinition = &ConstraintDefinition{Details: yyDollar[1].constraintInfo}
 		}
-	case 250:
+	case 256:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:1544
+//line sql.y:1602
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns}
 		}
-	case 251:
+	case 257:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1548
+//line sql.y:1606
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction}
 		}
-	case 252:
+	case 258:
 		yyDollar = yyS[yypt-11 : yypt+1]
-//line sql.y:1552
+//line sql.y:1610
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnUpdate: yyDollar[11].ReferenceAction}
 		}
-	case 253:
+	case 259:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:1556
+//line sql.y:1614
 		{
 			yyVAL.constraintInfo = &ForeignKeyDefinition{Source: yyDollar[4].columns, ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columns, OnDelete: yyDollar[11].ReferenceAction, OnUpdate: yyDollar[12].ReferenceAction}
 		}
-	case 254:
+	case 260:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1562
+//line sql.y:1620
 		{
 			yyVAL.constraintInfo = &CheckConstraintDefinition{Expr: yyDollar[3].expr, Enforced: yyDollar[5].boolean}
 		}
-	case 255:
+	case 261:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1568
+//line sql.y:1626
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 256:
+	case 262:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1574
+//line sql.y:1632
 		{
 			yyVAL.ReferenceAction = yyDollar[3].ReferenceAction
 		}
-	case 257:
+	case 263:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1580
+//line sql.y:1638
 		{
 			yyVAL.ReferenceAction = Restrict
 		}
-	case 258:
+	case 264:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1584
+//line sql.y:1642
 		{
 			yyVAL.ReferenceAction = Cascade
 		}
-	case 259:
+	case 265:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1588
+//line sql.y:1646
 		{
 			yyVAL.ReferenceAction = NoAction
 		}
-	case 260:
+	case 266:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1592
+//line sql.y:1650
 		{
 			yyVAL.ReferenceAction = SetDefault
 		}
-	case 261:
+	case 267:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1596
+//line sql.y:1654
 		{
 			yyVAL.ReferenceAction = SetNull
 		}
-	case 262:
+	case 268:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1601
+//line sql.y:1659
 		{
 			yyVAL.str = ""
 		}
-	case 263:
+	case 269:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1605
+//line sql.y:1663
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 264:
+	case 270:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1609
+//line sql.y:1667
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 265:
+	case 271:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1614
+//line sql.y:1672
 		{
 			yyVAL.boolean = true
 		}
-	case 266:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1618
+//line sql.y:1676
 		{
 			yyVAL.boolean = true
 		}
-	case 267:
+	case 273:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1622
+//line sql.y:1680
 		{
 			yyVAL.boolean = false
 		}
-	case 268:
+	case 274:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1627
+//line sql.y:1685
 		{
 			yyVAL.tableOptions = nil
 		}
-	case 269:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1631
+//line sql.y:1689
 		{
 			yyVAL.tableOptions = yyDollar[1].tableOptions
 		}
-	case 270:
+	case 276:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1637
+//line sql.y:1695
 		{
 			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
-	case 271:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1641
+//line sql.y:1699
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[3].tableOption)
 		}
-	case 272:
+	case 278:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1645
+//line sql.y:1703
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
-	case 273:
+	case 279:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1651
+//line sql.y:1709
 		{
 			yyVAL.tableOptions = TableOptions{yyDollar[1].tableOption}
 		}
-	case 274:
+	case 280:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1655
+//line sql.y:1713
 		{
 			yyVAL.tableOptions = append(yyDollar[1].tableOptions, yyDollar[2].tableOption)
 		}
-	case 275:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1661
+//line sql.y:1719
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 276:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1665
+//line sql.y:1723
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 277:
+	case 283:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1669
+//line sql.y:1727
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[2].bytes)), String: yyDollar[4].str}
 		}
-	case 278:
+	case 284:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1673
+//line sql.y:1731
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[2].bytes), String: yyDollar[4].str}
 		}
-	case 279:
+	case 285:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1677
+//line sql.y:1735
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 280:
+	case 286:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1681
+//line sql.y:1739
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 281:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1685
+//line sql.y:1743
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 282:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1689
+//line sql.y:1747
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 283:
+	case 289:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1693
+//line sql.y:1751
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
-	case 284:
+	case 290:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1697
+//line sql.y:1755
 		{
 			yyVAL.tableOption = &TableOption{Name: (string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)), Value: NewStrLiteral(yyDollar[4].bytes)}
 		}
-	case 285:
+	case 291:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1701
+//line sql.y:1759
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 286:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1705
+//line sql.y:1763
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 287:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1709
+//line sql.y:1767
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: yyDollar[3].colIdent.String()}
 		}
-	case 288:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1713
+//line sql.y:1771
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 289:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1717
+//line sql.y:1775
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 290:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1721
+//line sql.y:1779
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 291:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1725
+//line sql.y:1783
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 292:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1729
+//line sql.y:1787
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 293:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1733
+//line sql.y:1791
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 294:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1737
+//line sql.y:1795
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 295:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1741
+//line sql.y:1799
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewStrLiteral(yyDollar[3].bytes)}
 		}
-	case 296:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1745
+//line sql.y:1803
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 297:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1749
+//line sql.y:1807
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 298:
+	case 304:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1753
+//line sql.y:1811
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 299:
+	case 305:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1757
+//line sql.y:1815
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 300:
+	case 306:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1761
+//line sql.y:1819
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 301:
+	case 307:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1765
+//line sql.y:1823
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Value: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 302:
+	case 308:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1769
+//line sql.y:1827
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
-	case 303:
+	case 309:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1773
+//line sql.y:1831
 		{
 			yyVAL.tableOption = &TableOption{Name: string(yyDollar[1].bytes), Tables: yyDollar[4].tableNames}
 		}
-	case 304:
+	case 310:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1778
+//line sql.y:1836
 		{
 			yyVAL.str = ""
 		}
-	case 305:
+	case 311:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1782
+//line sql.y:1840
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 306:
+	case 312:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1786
+//line sql.y:1844
 		{
 			yyVAL.str = " " + string(yyDollar[1].bytes) + " " + string(yyDollar[2].bytes)
 		}
-	case 316:
+	case 322:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1805
+//line sql.y:1863
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 317:
+	case 323:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1809
+//line sql.y:1867
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'"
 		}
-	case 318:
+	case 324:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1813
+//line sql.y:1871
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 319:
+	case 325:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1818
+//line sql.y:1876
 		{
 			yyVAL.bytes = []byte("")
 		}
-	case 321:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1824
+//line sql.y:1882
 		{
 			yyVAL.colName = nil
 		}
-	case 322:
+	case 328:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1828
+//line sql.y:1886
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 323:
+	case 329:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1833
+//line sql.y:1891
 		{
 			yyVAL.colName = nil
 		}
-	case 324:
+	case 330:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1837
+//line sql.y:1895
 		{
 			yyVAL.colName = yyDollar[2].colName
 		}
-	case 325:
+	case 331:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1842
+//line sql.y:1900
 		{
 			yyVAL.alterOptions = nil
 		}
-	case 326:
+	case 332:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1846
+//line sql.y:1904
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 327:
+	case 333:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1850
+//line sql.y:1908
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, &OrderByOption{Cols: yyDollar[5].columns})
 		}
-	case 328:
+	case 334:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1854
+//line sql.y:1912
 		{
 			yyVAL.alterOptions = yyDollar[1].alterOptions
 		}
-	case 329:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1858
+//line sql.y:1916
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...)
 		}
-	case 330:
+	case 336:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:1862
+//line sql.y:1920
 		{
 			yyVAL.alterOptions = append(append(yyDollar[1].alterOptions, yyDollar[3].alterOptions...), &OrderByOption{Cols: yyDollar[7].columns})
 		}
-	case 331:
+	case 337:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1868
+//line sql.y:1926
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 332:
+	case 338:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1872
+//line sql.y:1930
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 333:
+	case 339:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1876
+//line sql.y:1934
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 334:
+	case 340:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1882
+//line sql.y:1940
 		{
 			yyVAL.alterOption = yyDollar[1].tableOptions
 		}
-	case 335:
+	case 341:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1886
+//line sql.y:1944
 		{
 			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
 		}
-	case 336:
+	case 342:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1890
+//line sql.y:1948
 		{
 			yyVAL.alterOption = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinition}
 		}
-	case 337:
+	case 343:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1894
+//line sql.y:1952
 		{
 			yyVAL.alterOption = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinition}
 		}
-	case 338:
+	case 344:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1898
+//line sql.y:1956
 		{
 			yyVAL.alterOption = &AddColumns{Columns: yyDollar[4].columnDefinitions}
 		}
-	case 339:
+	case 345:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1902
+//line sql.y:1960
 		{
 			yyVAL.alterOption = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinition}, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
-	case 340:
+	case 346:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1906
+//line sql.y:1964
 		{
 			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: true}
 		}
-	case 341:
+	case 347:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1910
+//line sql.y:1968
 		{
 			yyVAL.alterOption = &AlterColumn{Column: yyDollar[3].colName, DropDefault: false, DefaultVal: yyDollar[6].expr}
 		}
-	case 342:
+	case 348:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1914
+//line sql.y:1972
 		{
 			yyVAL.alterOption = &ChangeColumn{OldColumn: yyDollar[3].colName, NewColDefinition: yyDollar[4].columnDefinition, First: yyDollar[5].colName, After: yyDollar[6].colName}
 		}
-	case 343:
+	case 349:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1918
+//line sql.y:1976
 		{
 			yyVAL.alterOption = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinition, First: yyDollar[4].colName, After: yyDollar[5].colName}
 		}
-	case 344:
+	case 350:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1922
+//line sql.y:1980
 		{
 			yyVAL.alterOption = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
-	case 345:
+	case 351:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1926
+//line sql.y:1984
 		{
 			yyVAL.alterOption = &KeyState{Enable: false}
 		}
-	case 346:
+	case 352:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1930
+//line sql.y:1988
 		{
 			yyVAL.alterOption = &KeyState{Enable: true}
 		}
-	case 347:
+	case 353:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1934
+//line sql.y:1992
 		{
 			yyVAL.alterOption = &TablespaceOperation{Import: false}
 		}
-	case 348:
+	case 354:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1938
+//line sql.y:1996
 		{
 			yyVAL.alterOption = &TablespaceOperation{Import: true}
 		}
-	case 349:
+	case 355:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1942
+//line sql.y:2000
 		{
 			yyVAL.alterOption = &DropColumn{Name: yyDollar[3].colName}
 		}
-	case 350:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1946
+//line sql.y:2004
 		{
 			yyVAL.alterOption = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent.String()}
 		}
-	case 351:
+	case 357:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1950
+//line sql.y:2008
 		{
 			yyVAL.alterOption = &DropKey{Type: PrimaryKeyType}
 		}
-	case 352:
+	case 358:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1954
+//line sql.y:2012
 		{
 			yyVAL.alterOption = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent.String()}
 		}
-	case 353:
+	case 359:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1958
+//line sql.y:2016
 		{
 			yyVAL.alterOption = &Force{}
 		}
-	case 354:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1962
+//line sql.y:2020
 		{
 			yyVAL.alterOption = &RenameTable{Table: yyDollar[3].tableName}
 		}
-	case 355:
+	case 361:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1966
+//line sql.y:2024
 		{
 			yyVAL.alterOption = &RenameIndex{OldName: yyDollar[3].colIdent.String(), NewName: yyDollar[5].colIdent.String()}
 		}
-	case 356:
+	case 362:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1972
+//line sql.y:2030
 		{
 			yyVAL.alterOptions = []AlterOption{yyDollar[1].alterOption}
 		}
-	case 357:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1976
+//line sql.y:2034
 		{
 			yyVAL.alterOptions = append(yyDollar[1].alterOptions, yyDollar[3].alterOption)
 		}
-	case 358:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1982
+//line sql.y:2040
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 359:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1986
+//line sql.y:2044
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 360:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1990
+//line sql.y:2048
 		{
 			yyVAL.alterOption = AlgorithmValue(string(yyDollar[3].bytes))
 		}
-	case 361:
+	case 367:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1994
+//line sql.y:2052
 		{
 			yyVAL.alterOption = &LockOption{Type: DefaultType}
 		}
-	case 362:
+	case 368:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1998
+//line sql.y:2056
 		{
 			yyVAL.alterOption = &LockOption{Type: NoneType}
 		}
-	case 363:
+	case 369:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2002
+//line sql.y:2060
 		{
 			yyVAL.alterOption = &LockOption{Type: SharedType}
 		}
-	case 364:
+	case 370:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2006
+//line sql.y:2064
 		{
 			yyVAL.alterOption = &LockOption{Type: ExclusiveType}
 		}
-	case 365:
+	case 371:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2010
+//line sql.y:2068
 		{
 			yyVAL.alterOption = &Validation{With: true}
 		}
-	case 366:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2014
+//line sql.y:2072
 		{
 			yyVAL.alterOption = &Validation{With: false}
 		}
-	case 367:
+	case 373:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2020
+//line sql.y:2078
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 368:
+	case 374:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2026
+//line sql.y:2084
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = &PartitionSpec{Action: RemoveAction}
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 369:
+	case 375:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2033
+//line sql.y:2091
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.AlterOptions = yyDollar[2].alterOptions
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[4].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 370:
+	case 376:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2040
+//line sql.y:2098
 		{
 			yyDollar[1].alterTable.FullyParsed = true
 			yyDollar[1].alterTable.PartitionSpec = yyDollar[2].partSpec
 			yyVAL.statement = yyDollar[1].alterTable
 		}
-	case 371:
+	case 377:
 		yyDollar = yyS[yypt-10 : yypt+1]
-//line sql.y:2046
+//line sql.y:2104
 		{
 			yyVAL.statement = &AlterView{ViewName: yyDollar[6].tableName.ToViewName(), Algorithm: yyDollar[2].str, Definer: yyDollar[3].str, Security: yyDollar[4].str, Columns: yyDollar[7].columns, Select: yyDollar[9].selStmt, CheckOption: yyDollar[10].str}
 		}
-	case 372:
+	case 378:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2050
+//line sql.y:2108
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.AlterOptions = yyDollar[3].collateAndCharsets
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 373:
+	case 379:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2057
+//line sql.y:2115
 		{
 			yyDollar[1].alterDatabase.FullyParsed = true
 			yyDollar[1].alterDatabase.DBName = yyDollar[2].colIdent.String()
 			yyDollar[1].alterDatabase.UpdateDataDirectory = true
 			yyVAL.statement = yyDollar[1].alterDatabase
 		}
-	case 374:
+	case 380:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2064
+//line sql.y:2122
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -7307,9 +7554,9 @@ yydefault:
 				},
 			}
 		}
-	case 375:
+	case 381:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2076
+//line sql.y:2134
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -7319,21 +7566,21 @@ yydefault:
 				},
 			}
 		}
-	case 376:
+	case 382:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2086
+//line sql.y:2144
 		{
 			yyVAL.statement = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 377:
+	case 383:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2090
+//line sql.y:2148
 		{
 			yyVAL.statement = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 378:
+	case 384:
 		yyDollar = yyS[yypt-12 : yypt+1]
-//line sql.y:2094
+//line sql.y:2152
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -7346,9 +7593,9 @@ yydefault:
 				VindexCols: yyDollar[9].columns,
 			}
 		}
-	case 379:
+	case 385:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2107
+//line sql.y:2165
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -7358,15 +7605,15 @@ yydefault:
 				},
 			}
 		}
-	case 380:
+	case 386:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2117
+//line sql.y:2175
 		{
 			yyVAL.statement = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 381:
+	case 387:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:2121
+//line sql.y:2179
 		{
 			yyVAL.statement = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -7377,415 +7624,415 @@ yydefault:
 				},
 			}
 		}
-	case 382:
+	case 388:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2134
+//line sql.y:2192
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDef}}
 		}
-	case 383:
+	case 389:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2138
+//line sql.y:2196
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitions}
 		}
-	case 384:
+	case 390:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2142
+//line sql.y:2200
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitions, Definitions: yyDollar[6].partDefs}
 		}
-	case 385:
+	case 391:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2146
+//line sql.y:2204
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitions}
 		}
-	case 386:
+	case 392:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2150
+//line sql.y:2208
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
-	case 387:
+	case 393:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2154
+//line sql.y:2212
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitions}
 		}
-	case 388:
+	case 394:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2158
+//line sql.y:2216
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
-	case 389:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2162
+//line sql.y:2220
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitions}
 		}
-	case 390:
+	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2166
+//line sql.y:2224
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
-	case 391:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2170
+//line sql.y:2228
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].bytes)}
 		}
-	case 392:
+	case 398:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2174
+//line sql.y:2232
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].boolean}
 		}
-	case 393:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2178
+//line sql.y:2236
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitions}
 		}
-	case 394:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2182
+//line sql.y:2240
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
-	case 395:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2186
+//line sql.y:2244
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitions}
 		}
-	case 396:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2190
+//line sql.y:2248
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
-	case 397:
+	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2194
+//line sql.y:2252
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitions}
 		}
-	case 398:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2198
+//line sql.y:2256
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
-	case 399:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2202
+//line sql.y:2260
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitions}
 		}
-	case 400:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2206
+//line sql.y:2264
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
-	case 401:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2210
+//line sql.y:2268
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitions}
 		}
-	case 402:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2214
+//line sql.y:2272
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
-	case 403:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2218
+//line sql.y:2276
 		{
 			yyVAL.partSpec = &PartitionSpec{Action: UpgradeAction}
 		}
-	case 404:
+	case 410:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2223
+//line sql.y:2281
 		{
 			yyVAL.boolean = false
 		}
-	case 405:
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2227
+//line sql.y:2285
 		{
 			yyVAL.boolean = false
 		}
-	case 406:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2231
+//line sql.y:2289
 		{
 			yyVAL.boolean = true
 		}
-	case 407:
+	case 413:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2238
+//line sql.y:2296
 		{
 			yyVAL.partDefs = []*PartitionDefinition{yyDollar[1].partDef}
 		}
-	case 408:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2242
+//line sql.y:2300
 		{
 			yyVAL.partDefs = append(yyDollar[1].partDefs, yyDollar[3].partDef)
 		}
-	case 409:
+	case 415:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2248
+//line sql.y:2306
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].expr}
 		}
-	case 410:
+	case 416:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:2252
+//line sql.y:2310
 		{
 			yyVAL.partDef = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
-	case 411:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2258
+//line sql.y:2316
 		{
 			yyVAL.statement = yyDollar[3].ddl
 		}
-	case 412:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2264
+//line sql.y:2322
 		{
 			yyVAL.ddl = &DDL{Action: RenameDDLAction, FromTables: TableNames{yyDollar[1].tableName}, ToTables: TableNames{yyDollar[3].tableName}}
 		}
-	case 413:
+	case 419:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2268
+//line sql.y:2326
 		{
 			yyVAL.ddl = yyDollar[1].ddl
 			yyVAL.ddl.FromTables = append(yyVAL.ddl.FromTables, yyDollar[3].tableName)
 			yyVAL.ddl.ToTables = append(yyVAL.ddl.ToTables, yyDollar[5].tableName)
 		}
-	case 414:
+	case 420:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2276
+//line sql.y:2334
 		{
 			yyVAL.statement = &DropTable{FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
 		}
-	case 415:
+	case 421:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:2280
+//line sql.y:2338
 		{
 			// Change this to an alter statement
 			yyVAL.statement = &DDL{Action: AlterDDLAction, Table: yyDollar[5].tableName}
 		}
-	case 416:
+	case 422:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2285
+//line sql.y:2343
 		{
 			yyVAL.statement = &DropView{FromTables: yyDollar[4].tableNames, IfExists: yyDollar[3].boolean}
 		}
-	case 417:
+	case 423:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2289
+//line sql.y:2347
 		{
 			yyVAL.statement = &DropDatabase{DBName: string(yyDollar[4].colIdent.String()), IfExists: yyDollar[3].boolean}
 		}
-	case 418:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2295
+//line sql.y:2353
 		{
 			yyVAL.statement = &DDL{Action: TruncateDDLAction, Table: yyDollar[3].tableName}
 		}
-	case 419:
+	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2299
+//line sql.y:2357
 		{
 			yyVAL.statement = &DDL{Action: TruncateDDLAction, Table: yyDollar[2].tableName}
 		}
-	case 420:
+	case 426:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2304
+//line sql.y:2362
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 421:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2310
+//line sql.y:2368
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilter}}
 		}
-	case 422:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2314
+//line sql.y:2372
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilter}}
 		}
-	case 423:
+	case 429:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2318
+//line sql.y:2376
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
 		}
-	case 424:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2322
+//line sql.y:2380
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
 		}
-	case 425:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2326
+//line sql.y:2384
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
 		}
-	case 426:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2330
+//line sql.y:2388
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilter}}
 		}
-	case 427:
+	case 433:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2334
+//line sql.y:2392
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilter}}
 		}
-	case 428:
+	case 434:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2338
+//line sql.y:2396
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Privilege}}
 		}
-	case 429:
+	case 435:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2342
+//line sql.y:2400
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilter}}
 		}
-	case 430:
+	case 436:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2346
+//line sql.y:2404
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilter}}
 		}
-	case 431:
+	case 437:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2350
+//line sql.y:2408
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilter}}
 		}
-	case 432:
+	case 438:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2354
+//line sql.y:2412
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilter}}
 		}
-	case 433:
+	case 439:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2358
+//line sql.y:2416
 		{
 			yyVAL.statement = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilter}}
 		}
-	case 434:
+	case 440:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2362
+//line sql.y:2420
 		{
 			yyVAL.statement = &Show{&ShowTableStatus{DatabaseName: yyDollar[4].str, Filter: yyDollar[5].showFilter}}
 		}
-	case 435:
+	case 441:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2366
+//line sql.y:2424
 		{
 			yyVAL.statement = &Show{&ShowColumns{Full: yyDollar[2].str, Table: yyDollar[5].tableName, DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}}
 		}
-	case 436:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2370
+//line sql.y:2428
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 437:
+	case 443:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2374
+//line sql.y:2432
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 438:
+	case 444:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2378
+//line sql.y:2436
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 439:
+	case 445:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2383
+//line sql.y:2441
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 440:
+	case 446:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2387
+//line sql.y:2445
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 441:
+	case 447:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2391
+//line sql.y:2449
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 442:
+	case 448:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2395
+//line sql.y:2453
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 443:
+	case 449:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2399
+//line sql.y:2457
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 444:
+	case 450:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2403
+//line sql.y:2461
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 445:
+	case 451:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2407
+//line sql.y:2465
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 446:
+	case 452:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2411
+//line sql.y:2469
 		{
 			showTablesOpt := &ShowTablesOpt{DbName: yyDollar[6].str, Filter: yyDollar[7].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Extended: string(yyDollar[2].str), Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt, OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
-	case 447:
+	case 453:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2416
+//line sql.y:2474
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 448:
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2420
+//line sql.y:2478
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
-	case 449:
+	case 455:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2424
+//line sql.y:2482
 		{
 			// this is ugly, but I couldn't find a better way for now
 			if yyDollar[3].str == "processlist" {
@@ -7795,938 +8042,944 @@ yydefault:
 				yyVAL.statement = &Show{&ShowLegacy{Type: yyDollar[3].str, ShowTablesOpt: showTablesOpt, Scope: ImplicitScope}}
 			}
 		}
-	case 450:
+	case 456:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2434
+//line sql.y:2492
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].bytes), ShowTablesOpt: showTablesOpt}}
 		}
-	case 451:
+	case 457:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2439
+//line sql.y:2497
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 452:
+	case 458:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2443
+//line sql.y:2501
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), Scope: ImplicitScope}}
 		}
-	case 453:
+	case 459:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2447
+//line sql.y:2505
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes) + " " + string(yyDollar[3].bytes), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
-	case 454:
+	case 460:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2451
+//line sql.y:2509
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 455:
+	case 461:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2456
+//line sql.y:2514
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[3].showFilter}
 			yyVAL.statement = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
-	case 456:
+	case 462:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2470
+//line sql.y:2528
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
-	case 457:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2474
+//line sql.y:2532
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 458:
+	case 464:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2478
+//line sql.y:2536
 		{
 			yyVAL.statement = &Show{&ShowLegacy{Type: string(yyDollar[2].bytes), Scope: ImplicitScope}}
 		}
-	case 459:
+	case 465:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2484
+//line sql.y:2542
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 460:
+	case 466:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2488
+//line sql.y:2546
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 461:
+	case 467:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2494
+//line sql.y:2552
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 462:
+	case 468:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2498
+//line sql.y:2556
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 463:
+	case 469:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2504
+//line sql.y:2562
 		{
 			yyVAL.str = ""
 		}
-	case 464:
+	case 470:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2508
+//line sql.y:2566
 		{
 			yyVAL.str = "extended "
 		}
-	case 465:
+	case 471:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2514
+//line sql.y:2572
 		{
 			yyVAL.str = ""
 		}
-	case 466:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2518
+//line sql.y:2576
 		{
 			yyVAL.str = "full "
 		}
-	case 467:
+	case 473:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2524
+//line sql.y:2582
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 468:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2528
+//line sql.y:2586
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 469:
+	case 475:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2534
+//line sql.y:2592
 		{
 			yyVAL.str = ""
 		}
-	case 470:
+	case 476:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2538
+//line sql.y:2596
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 471:
+	case 477:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2542
+//line sql.y:2600
 		{
 			yyVAL.str = yyDollar[2].tableIdent.v
 		}
-	case 472:
+	case 478:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2548
+//line sql.y:2606
 		{
 			yyVAL.showFilter = nil
 		}
-	case 473:
+	case 479:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2552
+//line sql.y:2610
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 474:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2556
+//line sql.y:2614
 		{
 			yyVAL.showFilter = &ShowFilter{Filter: yyDollar[2].expr}
 		}
-	case 475:
+	case 481:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2562
+//line sql.y:2620
 		{
 			yyVAL.showFilter = nil
 		}
-	case 476:
+	case 482:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2566
+//line sql.y:2624
 		{
 			yyVAL.showFilter = &ShowFilter{Like: string(yyDollar[2].bytes)}
 		}
-	case 477:
+	case 483:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2572
+//line sql.y:2630
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 478:
+	case 484:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2576
+//line sql.y:2634
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 479:
+	case 485:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2580
+//line sql.y:2638
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 480:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2586
+//line sql.y:2644
 		{
 			yyVAL.statement = &Use{DBName: yyDollar[2].tableIdent}
 		}
-	case 481:
+	case 487:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2590
+//line sql.y:2648
 		{
 			yyVAL.statement = &Use{DBName: TableIdent{v: ""}}
 		}
-	case 482:
+	case 488:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2596
+//line sql.y:2654
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 483:
+	case 489:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2600
+//line sql.y:2658
 		{
 			yyVAL.statement = &Begin{}
 		}
-	case 484:
+	case 490:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2606
+//line sql.y:2664
 		{
 			yyVAL.statement = &Commit{}
 		}
-	case 485:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2612
+//line sql.y:2670
 		{
 			yyVAL.statement = &Rollback{}
 		}
-	case 486:
+	case 492:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2616
+//line sql.y:2674
 		{
 			yyVAL.statement = &SRollback{Name: yyDollar[5].colIdent}
 		}
-	case 487:
+	case 493:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2621
+//line sql.y:2679
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 488:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2623
+//line sql.y:2681
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 489:
+	case 495:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2626
+//line sql.y:2684
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 490:
+	case 496:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2628
+//line sql.y:2686
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 491:
+	case 497:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2633
+//line sql.y:2691
 		{
 			yyVAL.statement = &Savepoint{Name: yyDollar[2].colIdent}
 		}
-	case 492:
+	case 498:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2639
+//line sql.y:2697
 		{
 			yyVAL.statement = &Release{Name: yyDollar[3].colIdent}
 		}
-	case 493:
+	case 499:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2644
+//line sql.y:2702
 		{
 			yyVAL.explainType = EmptyType
 		}
-	case 494:
+	case 500:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2648
+//line sql.y:2706
 		{
 			yyVAL.explainType = JSONType
 		}
-	case 495:
+	case 501:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2652
+//line sql.y:2710
 		{
 			yyVAL.explainType = TreeType
 		}
-	case 496:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2656
+//line sql.y:2714
 		{
 			yyVAL.explainType = VitessType
 		}
-	case 497:
+	case 503:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2660
+//line sql.y:2718
 		{
 			yyVAL.explainType = TraditionalType
 		}
-	case 498:
+	case 504:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2664
+//line sql.y:2722
 		{
 			yyVAL.explainType = AnalyzeType
 		}
-	case 499:
+	case 505:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2670
+//line sql.y:2728
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 500:
+	case 506:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2674
+//line sql.y:2732
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 501:
+	case 507:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2678
+//line sql.y:2736
 		{
 			yyVAL.bytes = yyDollar[1].bytes
 		}
-	case 502:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2684
+//line sql.y:2742
 		{
 			yyVAL.statement = yyDollar[1].selStmt
 		}
-	case 503:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2688
+//line sql.y:2746
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 504:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2692
+//line sql.y:2750
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 505:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2696
+//line sql.y:2754
 		{
 			yyVAL.statement = yyDollar[1].statement
 		}
-	case 506:
+	case 512:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2701
+//line sql.y:2759
 		{
 			yyVAL.str = ""
 		}
-	case 507:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2705
+//line sql.y:2763
 		{
 			yyVAL.str = ""
 		}
-	case 508:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2709
+//line sql.y:2767
 		{
 			yyVAL.str = ""
 		}
-	case 509:
+	case 515:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2715
+//line sql.y:2773
 		{
 			yyVAL.statement = &OtherRead{}
 		}
-	case 510:
+	case 516:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2719
+//line sql.y:2777
 		{
 			yyVAL.statement = &Explain{Type: yyDollar[2].explainType, Statement: yyDollar[3].statement}
 		}
-	case 511:
+	case 517:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2725
+//line sql.y:2783
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 512:
+	case 518:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2729
+//line sql.y:2787
 		{
 			yyVAL.statement = &OtherAdmin{}
 		}
-	case 513:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2735
+//line sql.y:2793
 		{
 			yyVAL.statement = &LockTables{Tables: yyDollar[3].tableAndLockTypes}
 		}
-	case 514:
+	case 520:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2741
+//line sql.y:2799
 		{
 			yyVAL.tableAndLockTypes = TableAndLockTypes{yyDollar[1].tableAndLockType}
 		}
-	case 515:
+	case 521:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2745
+//line sql.y:2803
 		{
 			yyVAL.tableAndLockTypes = append(yyDollar[1].tableAndLockTypes, yyDollar[3].tableAndLockType)
 		}
-	case 516:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2751
+//line sql.y:2809
 		{
 			yyVAL.tableAndLockType = &TableAndLockType{Table: yyDollar[1].aliasedTableName, Lock: yyDollar[2].lockType}
 		}
-	case 517:
+	case 523:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2757
+//line sql.y:2815
 		{
 			yyVAL.lockType = Read
 		}
-	case 518:
+	case 524:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2761
+//line sql.y:2819
 		{
 			yyVAL.lockType = ReadLocal
 		}
-	case 519:
+	case 525:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2765
+//line sql.y:2823
 		{
 			yyVAL.lockType = Write
 		}
-	case 520:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2769
+//line sql.y:2827
 		{
 			yyVAL.lockType = LowPriorityWrite
 		}
-	case 521:
+	case 527:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2775
+//line sql.y:2833
 		{
 			yyVAL.statement = &UnlockTables{}
 		}
-	case 522:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2781
+//line sql.y:2839
 		{
 			yyVAL.statement = &DDL{Action: FlushDDLAction}
 		}
-	case 523:
+	case 529:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2785
+//line sql.y:2843
 		{
 			setAllowComments(yylex, true)
 		}
-	case 524:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2789
+//line sql.y:2847
 		{
 			yyVAL.bytes2 = yyDollar[2].bytes2
 			setAllowComments(yylex, false)
 		}
-	case 525:
+	case 531:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2795
+//line sql.y:2853
 		{
 			yyVAL.bytes2 = nil
 		}
-	case 526:
+	case 532:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2799
+//line sql.y:2857
 		{
 			yyVAL.bytes2 = append(yyDollar[1].bytes2, yyDollar[2].bytes)
 		}
-	case 527:
+	case 533:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2805
+//line sql.y:2863
 		{
 			yyVAL.boolean = true
 		}
-	case 528:
+	case 534:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2809
+//line sql.y:2867
 		{
 			yyVAL.boolean = false
 		}
-	case 529:
+	case 535:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2813
+//line sql.y:2871
 		{
 			yyVAL.boolean = true
 		}
-	case 530:
+	case 536:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2818
+//line sql.y:2876
 		{
 			yyVAL.str = ""
 		}
-	case 531:
+	case 537:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2822
+//line sql.y:2880
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 532:
+	case 538:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2826
+//line sql.y:2884
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 533:
+	case 539:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2831
+//line sql.y:2889
 		{
 			yyVAL.boolean = false
 		}
-	case 534:
+	case 540:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2835
+//line sql.y:2893
 		{
 			yyVAL.boolean = true
 		}
-	case 535:
+	case 541:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2839
+//line sql.y:2897
 		{
 			yyVAL.boolean = true
 		}
-	case 536:
+	case 542:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2844
+//line sql.y:2902
 		{
 			yyVAL.selectExprs = nil
 		}
-	case 537:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2848
+//line sql.y:2906
 		{
 			yyVAL.selectExprs = yyDollar[1].selectExprs
 		}
-	case 538:
+	case 544:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2853
+//line sql.y:2911
 		{
 			yyVAL.strs = nil
 		}
-	case 539:
+	case 545:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2857
+//line sql.y:2915
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 540:
+	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2861
+//line sql.y:2919
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 541:
+	case 547:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2865
+//line sql.y:2923
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 542:
+	case 548:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:2869
+//line sql.y:2927
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 543:
+	case 549:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2875
+//line sql.y:2933
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 544:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2879
+//line sql.y:2937
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 545:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2883
+//line sql.y:2941
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 546:
+	case 552:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2887
+//line sql.y:2945
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 547:
+	case 553:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2891
+//line sql.y:2949
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 548:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2895
+//line sql.y:2953
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 549:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2901
+//line sql.y:2959
 		{
 			yyVAL.selectExprs = SelectExprs{yyDollar[1].selectExpr}
 		}
-	case 550:
+	case 556:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2905
+//line sql.y:2963
 		{
 			yyVAL.selectExprs = append(yyVAL.selectExprs, yyDollar[3].selectExpr)
 		}
-	case 551:
+	case 557:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2911
+//line sql.y:2969
 		{
 			yyVAL.selectExpr = &StarExpr{}
 		}
-	case 552:
+	case 558:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2915
+//line sql.y:2973
 		{
 			yyVAL.selectExpr = &AliasedExpr{Expr: yyDollar[1].expr, As: yyDollar[2].colIdent}
 		}
-	case 553:
+	case 559:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2919
+//line sql.y:2977
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
-	case 554:
+	case 560:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2923
+//line sql.y:2981
 		{
 			yyVAL.selectExpr = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
-	case 555:
+	case 561:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2928
+//line sql.y:2986
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 556:
+	case 562:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2932
+//line sql.y:2990
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 557:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2936
+//line sql.y:2994
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 559:
+	case 565:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2943
+//line sql.y:3001
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 560:
+	case 566:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2948
+//line sql.y:3006
 		{
 			yyVAL.tableExprs = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
-	case 561:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2952
+//line sql.y:3010
 		{
 			yyVAL.tableExprs = yyDollar[2].tableExprs
 		}
-	case 562:
+	case 568:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2958
+//line sql.y:3016
 		{
 			yyVAL.tableExprs = TableExprs{yyDollar[1].tableExpr}
 		}
-	case 563:
+	case 569:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2962
+//line sql.y:3020
 		{
 			yyVAL.tableExprs = append(yyVAL.tableExprs, yyDollar[3].tableExpr)
 		}
-	case 566:
+	case 572:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2972
+//line sql.y:3030
 		{
 			yyVAL.tableExpr = yyDollar[1].aliasedTableName
 		}
-	case 567:
+	case 573:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2976
+//line sql.y:3034
 		{
 			yyVAL.tableExpr = &AliasedTableExpr{Expr: yyDollar[1].derivedTable, As: yyDollar[3].tableIdent}
 		}
-	case 568:
+	case 574:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2980
+//line sql.y:3038
 		{
 			yyVAL.tableExpr = &ParenTableExpr{Exprs: yyDollar[2].tableExprs}
 		}
-	case 569:
+	case 575:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2986
+//line sql.y:3044
 		{
 			yyVAL.derivedTable = &DerivedTable{yyDollar[2].selStmt}
 		}
-	case 570:
+	case 576:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3048
+		{
+			yyVAL.derivedTable = &DerivedTable{SetWith(yyDollar[3].selStmt, yyDollar[2].with)}
+		}
+	case 577:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2992
+//line sql.y:3054
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHints}
 		}
-	case 571:
+	case 578:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:2996
+//line sql.y:3058
 		{
 			yyVAL.aliasedTableName = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitions, As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHints}
 		}
-	case 572:
+	case 579:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3001
+//line sql.y:3063
 		{
 			yyVAL.columns = nil
 		}
-	case 573:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3005
+//line sql.y:3067
 		{
 			yyVAL.columns = yyDollar[2].columns
 		}
-	case 574:
+	case 581:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3011
+//line sql.y:3073
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 575:
+	case 582:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3015
+//line sql.y:3077
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 576:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3021
+//line sql.y:3083
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 577:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3025
+//line sql.y:3087
 		{
 			yyVAL.columns = Columns{NewColIdent(string(yyDollar[1].bytes))}
 		}
-	case 578:
+	case 585:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3029
+//line sql.y:3091
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 579:
+	case 586:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3033
+//line sql.y:3095
 		{
 			yyVAL.columns = append(yyVAL.columns, NewColIdent(string(yyDollar[3].bytes)))
 		}
-	case 580:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3039
+//line sql.y:3101
 		{
 			yyVAL.partitions = Partitions{yyDollar[1].colIdent}
 		}
-	case 581:
+	case 588:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3043
+//line sql.y:3105
 		{
 			yyVAL.partitions = append(yyVAL.partitions, yyDollar[3].colIdent)
 		}
-	case 582:
+	case 589:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3056
+//line sql.y:3118
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 583:
+	case 590:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3060
+//line sql.y:3122
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 584:
+	case 591:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3064
+//line sql.y:3126
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr, Condition: yyDollar[4].joinCondition}
 		}
-	case 585:
+	case 592:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3068
+//line sql.y:3130
 		{
 			yyVAL.tableExpr = &JoinTableExpr{LeftExpr: yyDollar[1].tableExpr, Join: yyDollar[2].joinType, RightExpr: yyDollar[3].tableExpr}
 		}
-	case 586:
+	case 593:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3074
+//line sql.y:3136
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 587:
+	case 594:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3076
+//line sql.y:3138
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columns}
 		}
-	case 588:
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3080
+//line sql.y:3142
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 589:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3082
+//line sql.y:3144
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 590:
+	case 597:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3086
+//line sql.y:3148
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 591:
+	case 598:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3088
+//line sql.y:3150
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].expr}
 		}
-	case 592:
+	case 599:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3091
+//line sql.y:3153
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 593:
+	case 600:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3093
+//line sql.y:3155
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 594:
+	case 601:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3096
+//line sql.y:3158
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 595:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3100
+//line sql.y:3162
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 596:
+	case 603:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3104
+//line sql.y:3166
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 598:
+	case 605:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3111
+//line sql.y:3173
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 599:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3117
+//line sql.y:3179
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 600:
+	case 607:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3121
+//line sql.y:3183
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 601:
+	case 608:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3125
+//line sql.y:3187
 		{
 			yyVAL.joinType = NormalJoinType
 		}
-	case 602:
+	case 609:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3131
+//line sql.y:3193
 		{
 			yyVAL.joinType = StraightJoinType
 		}
-	case 603:
+	case 610:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3137
+//line sql.y:3199
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 604:
+	case 611:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3141
+//line sql.y:3203
 		{
 			yyVAL.joinType = LeftJoinType
 		}
-	case 605:
+	case 612:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3145
+//line sql.y:3207
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 606:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3149
+//line sql.y:3211
 		{
 			yyVAL.joinType = RightJoinType
 		}
-	case 607:
+	case 614:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3155
+//line sql.y:3217
 		{
 			yyVAL.joinType = NaturalJoinType
 		}
-	case 608:
+	case 615:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3159
+//line sql.y:3221
 		{
 			if yyDollar[2].joinType == LeftJoinType {
 				yyVAL.joinType = NaturalLeftJoinType
@@ -8734,489 +8987,495 @@ yydefault:
 				yyVAL.joinType = NaturalRightJoinType
 			}
 		}
-	case 609:
+	case 616:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3169
+//line sql.y:3231
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 610:
+	case 617:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3173
+//line sql.y:3235
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 611:
+	case 618:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3179
+//line sql.y:3241
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 612:
+	case 619:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3183
+//line sql.y:3245
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 613:
+	case 620:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3189
+//line sql.y:3251
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 614:
+	case 621:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3194
+//line sql.y:3256
 		{
 			yyVAL.indexHints = nil
 		}
-	case 615:
+	case 622:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3198
+//line sql.y:3260
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columns}
 		}
-	case 616:
+	case 623:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3202
+//line sql.y:3264
 		{
 			yyVAL.indexHints = &IndexHints{Type: UseOp}
 		}
-	case 617:
+	case 624:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3206
+//line sql.y:3268
 		{
 			yyVAL.indexHints = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columns}
 		}
-	case 618:
+	case 625:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3210
+//line sql.y:3272
 		{
 			yyVAL.indexHints = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columns}
 		}
-	case 619:
+	case 626:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3215
+//line sql.y:3277
 		{
 			yyVAL.expr = nil
 		}
-	case 620:
+	case 627:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3219
+//line sql.y:3281
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 621:
+	case 628:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3225
+//line sql.y:3287
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 622:
+	case 629:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3229
+//line sql.y:3291
 		{
 			yyVAL.expr = &AndExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 623:
+	case 630:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3233
+//line sql.y:3295
 		{
 			yyVAL.expr = &OrExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 624:
+	case 631:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3237
+//line sql.y:3299
 		{
 			yyVAL.expr = &XorExpr{Left: yyDollar[1].expr, Right: yyDollar[3].expr}
 		}
-	case 625:
+	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3241
+//line sql.y:3303
 		{
 			yyVAL.expr = &NotExpr{Expr: yyDollar[2].expr}
 		}
-	case 626:
+	case 633:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3245
+//line sql.y:3307
 		{
 			yyVAL.expr = &IsExpr{Operator: yyDollar[3].isExprOperator, Expr: yyDollar[1].expr}
 		}
-	case 627:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3249
+//line sql.y:3311
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 628:
+	case 635:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3253
+//line sql.y:3315
 		{
 			yyVAL.expr = &Default{ColName: yyDollar[2].str}
 		}
-	case 629:
+	case 636:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3259
+//line sql.y:3321
 		{
 			yyVAL.str = ""
 		}
-	case 630:
+	case 637:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3263
+//line sql.y:3325
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 631:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3269
+//line sql.y:3331
 		{
 			yyVAL.boolVal = BoolVal(true)
 		}
-	case 632:
+	case 639:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3273
+//line sql.y:3335
 		{
 			yyVAL.boolVal = BoolVal(false)
 		}
-	case 633:
+	case 640:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3279
+//line sql.y:3341
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: yyDollar[2].comparisonExprOperator, Right: yyDollar[3].expr}
 		}
-	case 634:
+	case 641:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3283
+//line sql.y:3345
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: InOp, Right: yyDollar[3].colTuple}
 		}
-	case 635:
+	case 642:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3287
+//line sql.y:3349
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotInOp, Right: yyDollar[4].colTuple}
 		}
-	case 636:
+	case 643:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3291
+//line sql.y:3353
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: LikeOp, Right: yyDollar[3].expr, Escape: yyDollar[4].expr}
 		}
-	case 637:
+	case 644:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3295
+//line sql.y:3357
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotLikeOp, Right: yyDollar[4].expr, Escape: yyDollar[5].expr}
 		}
-	case 638:
+	case 645:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3299
+//line sql.y:3361
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: RegexpOp, Right: yyDollar[3].expr}
 		}
-	case 639:
+	case 646:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3303
+//line sql.y:3365
 		{
 			yyVAL.expr = &ComparisonExpr{Left: yyDollar[1].expr, Operator: NotRegexpOp, Right: yyDollar[4].expr}
 		}
-	case 640:
+	case 647:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3307
+//line sql.y:3369
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: BetweenOp, From: yyDollar[3].expr, To: yyDollar[5].expr}
 		}
-	case 641:
+	case 648:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3311
+//line sql.y:3373
 		{
 			yyVAL.expr = &RangeCond{Left: yyDollar[1].expr, Operator: NotBetweenOp, From: yyDollar[4].expr, To: yyDollar[6].expr}
 		}
-	case 642:
+	case 649:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3315
+//line sql.y:3377
 		{
 			yyVAL.expr = &ExistsExpr{Subquery: yyDollar[2].subquery}
 		}
-	case 643:
+	case 650:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3321
+//line sql.y:3383
 		{
 			yyVAL.isExprOperator = IsNullOp
 		}
-	case 644:
+	case 651:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3325
+//line sql.y:3387
 		{
 			yyVAL.isExprOperator = IsNotNullOp
 		}
-	case 645:
+	case 652:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3329
+//line sql.y:3391
 		{
 			yyVAL.isExprOperator = IsTrueOp
 		}
-	case 646:
+	case 653:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3333
+//line sql.y:3395
 		{
 			yyVAL.isExprOperator = IsNotTrueOp
 		}
-	case 647:
+	case 654:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3337
+//line sql.y:3399
 		{
 			yyVAL.isExprOperator = IsFalseOp
 		}
-	case 648:
+	case 655:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3341
+//line sql.y:3403
 		{
 			yyVAL.isExprOperator = IsNotFalseOp
 		}
-	case 649:
+	case 656:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3347
+//line sql.y:3409
 		{
 			yyVAL.comparisonExprOperator = EqualOp
 		}
-	case 650:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3351
+//line sql.y:3413
 		{
 			yyVAL.comparisonExprOperator = LessThanOp
 		}
-	case 651:
+	case 658:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3355
+//line sql.y:3417
 		{
 			yyVAL.comparisonExprOperator = GreaterThanOp
 		}
-	case 652:
+	case 659:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3359
+//line sql.y:3421
 		{
 			yyVAL.comparisonExprOperator = LessEqualOp
 		}
-	case 653:
+	case 660:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3363
+//line sql.y:3425
 		{
 			yyVAL.comparisonExprOperator = GreaterEqualOp
 		}
-	case 654:
+	case 661:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3367
+//line sql.y:3429
 		{
 			yyVAL.comparisonExprOperator = NotEqualOp
 		}
-	case 655:
+	case 662:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3371
+//line sql.y:3433
 		{
 			yyVAL.comparisonExprOperator = NullSafeEqualOp
 		}
-	case 656:
+	case 663:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3376
+//line sql.y:3438
 		{
 			yyVAL.expr = nil
 		}
-	case 657:
+	case 664:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3380
+//line sql.y:3442
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 658:
+	case 665:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3386
+//line sql.y:3448
 		{
 			yyVAL.colTuple = yyDollar[1].valTuple
 		}
-	case 659:
+	case 666:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3390
+//line sql.y:3452
 		{
 			yyVAL.colTuple = yyDollar[1].subquery
 		}
-	case 660:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3394
+//line sql.y:3456
 		{
 			yyVAL.colTuple = ListArg(yyDollar[1].bytes)
 		}
-	case 661:
+	case 668:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3400
+//line sql.y:3462
 		{
 			yyVAL.subquery = &Subquery{yyDollar[2].selStmt}
 		}
-	case 662:
+	case 669:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:3466
+		{
+			yyVAL.subquery = &Subquery{SetWith(yyDollar[3].selStmt, yyDollar[2].with)}
+		}
+	case 670:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3406
+//line sql.y:3472
 		{
 			yyVAL.exprs = Exprs{yyDollar[1].expr}
 		}
-	case 663:
+	case 671:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3410
+//line sql.y:3476
 		{
 			yyVAL.exprs = append(yyDollar[1].exprs, yyDollar[3].expr)
 		}
-	case 664:
+	case 672:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3416
+//line sql.y:3482
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 665:
+	case 673:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3420
+//line sql.y:3486
 		{
 			yyVAL.expr = yyDollar[1].boolVal
 		}
-	case 666:
+	case 674:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3424
+//line sql.y:3490
 		{
 			yyVAL.expr = yyDollar[1].colName
 		}
-	case 667:
+	case 675:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3428
+//line sql.y:3494
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 668:
+	case 676:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3432
+//line sql.y:3498
 		{
 			yyVAL.expr = yyDollar[1].subquery
 		}
-	case 669:
+	case 677:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3436
+//line sql.y:3502
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitAndOp, Right: yyDollar[3].expr}
 		}
-	case 670:
+	case 678:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3440
+//line sql.y:3506
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitOrOp, Right: yyDollar[3].expr}
 		}
-	case 671:
+	case 679:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3444
+//line sql.y:3510
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: BitXorOp, Right: yyDollar[3].expr}
 		}
-	case 672:
+	case 680:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3448
+//line sql.y:3514
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: PlusOp, Right: yyDollar[3].expr}
 		}
-	case 673:
+	case 681:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3452
+//line sql.y:3518
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MinusOp, Right: yyDollar[3].expr}
 		}
-	case 674:
+	case 682:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3456
+//line sql.y:3522
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: MultOp, Right: yyDollar[3].expr}
 		}
-	case 675:
+	case 683:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3460
+//line sql.y:3526
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: DivOp, Right: yyDollar[3].expr}
 		}
-	case 676:
+	case 684:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3464
+//line sql.y:3530
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: IntDivOp, Right: yyDollar[3].expr}
 		}
-	case 677:
+	case 685:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3468
+//line sql.y:3534
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
-	case 678:
+	case 686:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3472
+//line sql.y:3538
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ModOp, Right: yyDollar[3].expr}
 		}
-	case 679:
+	case 687:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3476
+//line sql.y:3542
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftLeftOp, Right: yyDollar[3].expr}
 		}
-	case 680:
+	case 688:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3480
+//line sql.y:3546
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].expr, Operator: ShiftRightOp, Right: yyDollar[3].expr}
 		}
-	case 681:
+	case 689:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3484
+//line sql.y:3550
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONExtractOp, Right: yyDollar[3].expr}
 		}
-	case 682:
+	case 690:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3488
+//line sql.y:3554
 		{
 			yyVAL.expr = &BinaryExpr{Left: yyDollar[1].colName, Operator: JSONUnquoteExtractOp, Right: yyDollar[3].expr}
 		}
-	case 683:
+	case 691:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3492
+//line sql.y:3558
 		{
 			yyVAL.expr = &CollateExpr{Expr: yyDollar[1].expr, Charset: yyDollar[3].str}
 		}
-	case 684:
+	case 692:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3496
+//line sql.y:3562
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].expr}
 		}
-	case 685:
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3500
+//line sql.y:3566
 		{
 			yyVAL.expr = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].expr}
 		}
-	case 686:
+	case 694:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3504
+//line sql.y:3570
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].expr}
 		}
-	case 687:
+	case 695:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3508
+//line sql.y:3574
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].expr}
 		}
-	case 688:
+	case 696:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3512
+//line sql.y:3578
 		{
 			yyVAL.expr = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].expr}
 		}
-	case 689:
+	case 697:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3516
+//line sql.y:3582
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				yyVAL.expr = num
@@ -9224,9 +9483,9 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UPlusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 690:
+	case 698:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3524
+//line sql.y:3590
 		{
 			if num, ok := yyDollar[2].expr.(*Literal); ok && num.Type == IntVal {
 				// Handle double negative
@@ -9240,21 +9499,21 @@ yydefault:
 				yyVAL.expr = &UnaryExpr{Operator: UMinusOp, Expr: yyDollar[2].expr}
 			}
 		}
-	case 691:
+	case 699:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3538
+//line sql.y:3604
 		{
 			yyVAL.expr = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].expr}
 		}
-	case 692:
+	case 700:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3542
+//line sql.y:3608
 		{
 			yyVAL.expr = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].expr}
 		}
-	case 693:
+	case 701:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3546
+//line sql.y:3612
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -9262,509 +9521,515 @@ yydefault:
 			// will be non-trivial because of grammar conflicts.
 			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
 		}
-	case 698:
+	case 706:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3564
+//line sql.y:3630
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs}
 		}
-	case 699:
+	case 707:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3568
+//line sql.y:3634
+		{
+			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprs, Over: yyDollar[5].overClause}
+		}
+	case 708:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:3638
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 700:
+	case 709:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3572
+//line sql.y:3642
 		{
 			yyVAL.expr = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprs}
 		}
-	case 701:
+	case 710:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3576
+//line sql.y:3646
 		{
 			yyVAL.expr = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprs}
 		}
-	case 702:
+	case 711:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3586
+//line sql.y:3656
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 703:
+	case 712:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3590
+//line sql.y:3660
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 704:
+	case 713:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3594
+//line sql.y:3664
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 705:
+	case 714:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3598
+//line sql.y:3668
 		{
 			yyVAL.expr = &ConvertExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].convertType}
 		}
-	case 706:
+	case 715:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:3602
+//line sql.y:3672
 		{
 			yyVAL.expr = &ConvertUsingExpr{Expr: yyDollar[3].expr, Type: yyDollar[5].str}
 		}
-	case 707:
+	case 716:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3606
+//line sql.y:3676
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 708:
+	case 717:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3610
+//line sql.y:3680
 		{
 			yyVAL.expr = &SubstrExpr{Name: yyDollar[3].colName, From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 709:
+	case 718:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3614
+//line sql.y:3684
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 710:
+	case 719:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3618
+//line sql.y:3688
 		{
 			yyVAL.expr = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].bytes), From: yyDollar[5].expr, To: yyDollar[7].expr}
 		}
-	case 711:
+	case 720:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:3622
+//line sql.y:3692
 		{
 			yyVAL.expr = &MatchExpr{Columns: yyDollar[3].selectExprs, Expr: yyDollar[7].expr, Option: yyDollar[8].matchExprOption}
 		}
-	case 712:
+	case 721:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3626
+//line sql.y:3696
 		{
 			yyVAL.expr = &GroupConcatExpr{Distinct: yyDollar[3].boolean, Exprs: yyDollar[4].selectExprs, OrderBy: yyDollar[5].orderBy, Separator: yyDollar[6].str, Limit: yyDollar[7].limit}
 		}
-	case 713:
+	case 722:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3630
+//line sql.y:3700
 		{
 			yyVAL.expr = &CaseExpr{Expr: yyDollar[2].expr, Whens: yyDollar[3].whens, Else: yyDollar[4].expr}
 		}
-	case 714:
+	case 723:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3634
+//line sql.y:3704
 		{
 			yyVAL.expr = &ValuesFuncExpr{Name: yyDollar[3].colName}
 		}
-	case 715:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3638
+//line sql.y:3708
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent(string(yyDollar[1].bytes))}
 		}
-	case 716:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3648
+//line sql.y:3718
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
-	case 717:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3652
+//line sql.y:3722
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
-	case 718:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3656
+//line sql.y:3726
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
-	case 719:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3661
+//line sql.y:3731
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
-	case 720:
+	case 729:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3666
+//line sql.y:3736
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtime")}
 		}
-	case 721:
+	case 730:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3671
+//line sql.y:3741
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
-	case 722:
+	case 731:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3677
+//line sql.y:3747
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_date")}
 		}
-	case 723:
+	case 732:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3682
+//line sql.y:3752
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("current_time")}
 		}
-	case 724:
+	case 733:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3687
+//line sql.y:3757
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 725:
+	case 734:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3691
+//line sql.y:3761
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 726:
+	case 735:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3695
+//line sql.y:3765
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].expr}
 		}
-	case 727:
+	case 736:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3700
+//line sql.y:3770
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].expr}
 		}
-	case 728:
+	case 737:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3705
+//line sql.y:3775
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].expr}
 		}
-	case 729:
+	case 738:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3710
+//line sql.y:3780
 		{
 			yyVAL.expr = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].expr}
 		}
-	case 730:
+	case 739:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3714
+//line sql.y:3784
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 731:
+	case 740:
 		yyDollar = yyS[yypt-8 : yypt+1]
-//line sql.y:3718
+//line sql.y:3788
 		{
 			yyVAL.expr = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].expr, Expr2: yyDollar[7].expr}
 		}
-	case 734:
+	case 743:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3728
+//line sql.y:3798
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 735:
+	case 744:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3738
+//line sql.y:3808
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 736:
+	case 745:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3742
+//line sql.y:3812
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 737:
+	case 746:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3746
+//line sql.y:3816
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 738:
+	case 747:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3750
+//line sql.y:3820
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 739:
+	case 748:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3754
+//line sql.y:3824
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 740:
+	case 749:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3758
+//line sql.y:3828
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 741:
+	case 750:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3762
+//line sql.y:3832
 		{
 			yyVAL.expr = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprs}
 		}
-	case 742:
+	case 751:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3768
+//line sql.y:3838
 		{
 			yyVAL.matchExprOption = NoOption
 		}
-	case 743:
+	case 752:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3772
+//line sql.y:3842
 		{
 			yyVAL.matchExprOption = BooleanModeOpt
 		}
-	case 744:
+	case 753:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3776
+//line sql.y:3846
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeOpt
 		}
-	case 745:
+	case 754:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line sql.y:3780
+//line sql.y:3850
 		{
 			yyVAL.matchExprOption = NaturalLanguageModeWithQueryExpansionOpt
 		}
-	case 746:
+	case 755:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3784
+//line sql.y:3854
 		{
 			yyVAL.matchExprOption = QueryExpansionOpt
 		}
-	case 747:
+	case 756:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3790
+//line sql.y:3860
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 748:
+	case 757:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3794
+//line sql.y:3864
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 749:
+	case 758:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3798
+//line sql.y:3868
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 750:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3804
+//line sql.y:3874
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 751:
+	case 760:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3808
+//line sql.y:3878
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
-	case 752:
+	case 761:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3812
+//line sql.y:3882
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal, Charset: string(yyDollar[3].colIdent.String())}
 		}
-	case 753:
+	case 762:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3816
+//line sql.y:3886
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 754:
+	case 763:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3820
+//line sql.y:3890
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 755:
+	case 764:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3824
+//line sql.y:3894
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 			yyVAL.convertType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.convertType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
-	case 756:
+	case 765:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3830
+//line sql.y:3900
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 757:
+	case 766:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3834
+//line sql.y:3904
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 758:
+	case 767:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3838
+//line sql.y:3908
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 759:
+	case 768:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3842
+//line sql.y:3912
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 760:
+	case 769:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3846
+//line sql.y:3916
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes), Length: yyDollar[2].literal}
 		}
-	case 761:
+	case 770:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3850
+//line sql.y:3920
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 762:
+	case 771:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3854
+//line sql.y:3924
 		{
 			yyVAL.convertType = &ConvertType{Type: string(yyDollar[1].bytes)}
 		}
-	case 763:
+	case 772:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3859
+//line sql.y:3929
 		{
 			yyVAL.expr = nil
 		}
-	case 764:
+	case 773:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3863
+//line sql.y:3933
 		{
 			yyVAL.expr = yyDollar[1].expr
 		}
-	case 765:
+	case 774:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3868
+//line sql.y:3938
 		{
 			yyVAL.str = string("")
 		}
-	case 766:
+	case 775:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3872
+//line sql.y:3942
 		{
 			yyVAL.str = " separator '" + string(yyDollar[2].bytes) + "'"
 		}
-	case 767:
+	case 776:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3878
+//line sql.y:3948
 		{
 			yyVAL.whens = []*When{yyDollar[1].when}
 		}
-	case 768:
+	case 777:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3882
+//line sql.y:3952
 		{
 			yyVAL.whens = append(yyDollar[1].whens, yyDollar[2].when)
 		}
-	case 769:
+	case 778:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3888
+//line sql.y:3958
 		{
 			yyVAL.when = &When{Cond: yyDollar[2].expr, Val: yyDollar[4].expr}
 		}
-	case 770:
+	case 779:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3893
+//line sql.y:3963
 		{
 			yyVAL.expr = nil
 		}
-	case 771:
+	case 780:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3897
+//line sql.y:3967
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 772:
+	case 781:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3903
+//line sql.y:3973
 		{
 			yyVAL.colName = &ColName{Name: yyDollar[1].colIdent}
 		}
-	case 773:
+	case 782:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3907
+//line sql.y:3977
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
-	case 774:
+	case 783:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:3911
+//line sql.y:3981
 		{
 			yyVAL.colName = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
-	case 775:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3917
+//line sql.y:3987
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 776:
+	case 785:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3921
+//line sql.y:3991
 		{
 			yyVAL.expr = NewHexLiteral(yyDollar[1].bytes)
 		}
-	case 777:
+	case 786:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3925
+//line sql.y:3995
 		{
 			yyVAL.expr = NewBitLiteral(yyDollar[1].bytes)
 		}
-	case 778:
+	case 787:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3929
+//line sql.y:3999
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 779:
+	case 788:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3933
+//line sql.y:4003
 		{
 			yyVAL.expr = NewFloatLiteral(yyDollar[1].bytes)
 		}
-	case 780:
+	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3937
+//line sql.y:4007
 		{
 			yyVAL.expr = NewHexNumLiteral(yyDollar[1].bytes)
 		}
-	case 781:
+	case 790:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3941
+//line sql.y:4011
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 782:
+	case 791:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3945
+//line sql.y:4015
 		{
 			yyVAL.expr = &NullVal{}
 		}
-	case 783:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3951
+//line sql.y:4021
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -9773,597 +10038,759 @@ yydefault:
 			}
 			yyVAL.expr = NewIntLiteral([]byte("1"))
 		}
-	case 784:
+	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3960
+//line sql.y:4030
 		{
 			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
 		}
-	case 785:
+	case 794:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3964
+//line sql.y:4034
 		{
 			yyVAL.expr = NewArgument(yyDollar[1].bytes)
 		}
-	case 786:
+	case 795:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3969
+//line sql.y:4039
 		{
 			yyVAL.exprs = nil
 		}
-	case 787:
+	case 796:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3973
+//line sql.y:4043
 		{
 			yyVAL.exprs = yyDollar[3].exprs
 		}
-	case 788:
+	case 797:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3978
+//line sql.y:4048
 		{
 			yyVAL.expr = nil
 		}
-	case 789:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3982
+//line sql.y:4052
 		{
 			yyVAL.expr = yyDollar[2].expr
 		}
-	case 790:
+	case 799:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3987
+//line sql.y:4057
+		{
+			yyVAL.windowDefinitions = nil
+		}
+	case 800:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4061
+		{
+			yyVAL.windowDefinitions = yyDollar[2].windowDefinitions
+		}
+	case 801:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4067
+		{
+			yyVAL.windowDefinitions = WindowDefinitions{yyDollar[1].windowDefinition}
+		}
+	case 802:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4071
+		{
+			yyVAL.windowDefinitions = append(yyDollar[1].windowDefinitions, yyDollar[3].windowDefinition)
+		}
+	case 803:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4077
+		{
+			yyVAL.windowDefinition = &WindowDefinition{Name: yyDollar[1].colIdent, WindowSpec: yyDollar[4].windowSpec}
+		}
+	case 804:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4083
+		{
+			yyVAL.overClause = &OverClause{WindowSpec: yyDollar[3].windowSpec}
+		}
+	case 805:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4087
+		{
+			yyVAL.overClause = &OverClause{WindowName: yyDollar[2].colIdent}
+		}
+	case 806:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:4093
+		{
+			yyVAL.windowSpec = &WindowSpec{Name: yyDollar[1].colIdent, PartitionBy: yyDollar[2].exprs, OrderBy: yyDollar[3].orderBy, Frame: yyDollar[4].frameClause}
+		}
+	case 807:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4103
+		{
+			yyVAL.colIdent = ColIdent{}
+		}
+	case 808:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4107
+		{
+			yyVAL.colIdent = yyDollar[1].colIdent
+		}
+	case 809:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4112
+		{
+			yyVAL.exprs = nil
+		}
+	case 810:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4116
+		{
+			yyVAL.exprs = yyDollar[3].exprs
+		}
+	case 811:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4121
+		{
+			yyVAL.frameClause = nil
+		}
+	case 812:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4125
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].frameUnit, Start: yyDollar[2].framePoint}
+		}
+	case 813:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4129
+		{
+			yyVAL.frameClause = &FrameClause{Unit: yyDollar[1].frameUnit, Start: yyDollar[3].framePoint, End: yyDollar[5].framePoint}
+		}
+	case 814:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4135
+		{
+			yyVAL.frameUnit = RowsUnit
+		}
+	case 815:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4139
+		{
+			yyVAL.frameUnit = RangeUnit
+		}
+	case 816:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4145
+		{
+			yyVAL.framePoint = &FramePoint{Type: CurrentRowType}
+		}
+	case 817:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4149
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedPrecedingType}
+		}
+	case 818:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4153
+		{
+			yyVAL.framePoint = &FramePoint{Type: UnboundedFollowingType}
+		}
+	case 819:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4157
+		{
+			yyVAL.framePoint = &FramePoint{Type: ExprPrecedingType, Expr: yyDollar[1].expr}
+		}
+	case 820:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4161
+		{
+			yyVAL.framePoint = &FramePoint{Type: ExprFollowingType, Expr: yyDollar[1].expr}
+		}
+	case 821:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4167
+		{
+			yyVAL.expr = NewIntLiteral(yyDollar[1].bytes)
+		}
+	case 822:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:4171
+		{
+			yyVAL.expr = NewArgument(yyDollar[1].bytes)
+		}
+	case 823:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:4175
+		{
+			yyVAL.expr = &IntervalExpr{Expr: yyDollar[2].expr, Unit: yyDollar[3].colIdent.String()}
+		}
+	case 824:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line sql.y:4180
 		{
 			yyVAL.orderBy = nil
 		}
-	case 791:
+	case 825:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3991
+//line sql.y:4184
 		{
 			yyVAL.orderBy = yyDollar[3].orderBy
 		}
-	case 792:
+	case 826:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3997
+//line sql.y:4190
 		{
 			yyVAL.orderBy = OrderBy{yyDollar[1].order}
 		}
-	case 793:
+	case 827:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4001
+//line sql.y:4194
 		{
 			yyVAL.orderBy = append(yyDollar[1].orderBy, yyDollar[3].order)
 		}
-	case 794:
+	case 828:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4007
+//line sql.y:4200
 		{
 			yyVAL.order = &Order{Expr: yyDollar[1].expr, Direction: yyDollar[2].orderDirection}
 		}
-	case 795:
+	case 829:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4012
+//line sql.y:4205
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 796:
+	case 830:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4016
+//line sql.y:4209
 		{
 			yyVAL.orderDirection = AscOrder
 		}
-	case 797:
+	case 831:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4020
+//line sql.y:4213
 		{
 			yyVAL.orderDirection = DescOrder
 		}
-	case 798:
+	case 832:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4025
+//line sql.y:4218
 		{
 			yyVAL.limit = nil
 		}
-	case 799:
+	case 833:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4029
+//line sql.y:4222
 		{
 			yyVAL.limit = &Limit{Rowcount: yyDollar[2].expr}
 		}
-	case 800:
+	case 834:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4033
+//line sql.y:4226
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[2].expr, Rowcount: yyDollar[4].expr}
 		}
-	case 801:
+	case 835:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4037
+//line sql.y:4230
 		{
 			yyVAL.limit = &Limit{Offset: yyDollar[4].expr, Rowcount: yyDollar[2].expr}
 		}
-	case 802:
+	case 836:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4042
+//line sql.y:4235
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 803:
+	case 837:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4046
+//line sql.y:4239
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption, yyDollar[2].indexOption}
 		}
-	case 804:
+	case 838:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4050
+//line sql.y:4243
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption, yyDollar[2].indexOption}
 		}
-	case 805:
+	case 839:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4054
+//line sql.y:4247
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 806:
+	case 840:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4058
+//line sql.y:4251
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 807:
+	case 841:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4065
+//line sql.y:4258
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 808:
+	case 842:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4069
+//line sql.y:4262
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 809:
+	case 843:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4073
+//line sql.y:4266
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 810:
+	case 844:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4077
+//line sql.y:4270
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 811:
+	case 845:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4083
+//line sql.y:4276
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 812:
+	case 846:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4087
+//line sql.y:4280
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 813:
+	case 847:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4091
+//line sql.y:4284
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[3].bytes)}
 		}
-	case 814:
+	case 848:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4096
+//line sql.y:4289
 		{
 			yyVAL.str = ""
 		}
-	case 815:
+	case 849:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4100
+//line sql.y:4293
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 816:
+	case 850:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4104
+//line sql.y:4297
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 817:
+	case 851:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4108
+//line sql.y:4301
 		{
 			yyVAL.str = string(yyDollar[3].bytes)
 		}
-	case 818:
+	case 852:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4113
+//line sql.y:4306
 		{
 			yyVAL.str = ""
 		}
-	case 819:
+	case 853:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4117
+//line sql.y:4310
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 820:
+	case 854:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4123
+//line sql.y:4316
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 821:
+	case 855:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4127
+//line sql.y:4320
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 822:
+	case 856:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4132
+//line sql.y:4325
 		{
 			yyVAL.str = ""
 		}
-	case 823:
+	case 857:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4136
+//line sql.y:4329
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 824:
+	case 858:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4141
+//line sql.y:4334
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 825:
+	case 859:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4145
+//line sql.y:4338
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 826:
+	case 860:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4149
+//line sql.y:4342
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 827:
+	case 861:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4154
+//line sql.y:4347
 		{
 			yyVAL.str = ""
 		}
-	case 828:
+	case 862:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4158
+//line sql.y:4351
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 829:
+	case 863:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4164
+//line sql.y:4357
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 830:
+	case 864:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4168
+//line sql.y:4361
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 831:
+	case 865:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4172
+//line sql.y:4365
 		{
 			yyVAL.str = "'" + string(yyDollar[1].bytes) + "'@" + string(yyDollar[2].bytes)
 		}
-	case 832:
+	case 866:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4176
+//line sql.y:4369
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 833:
+	case 867:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4181
+//line sql.y:4374
 		{
 			yyVAL.lock = NoLock
 		}
-	case 834:
+	case 868:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4185
+//line sql.y:4378
 		{
 			yyVAL.lock = ForUpdateLock
 		}
-	case 835:
+	case 869:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4189
+//line sql.y:4382
 		{
 			yyVAL.lock = ShareModeLock
 		}
-	case 836:
+	case 870:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4194
+//line sql.y:4387
 		{
 			yyVAL.selectInto = nil
 		}
-	case 837:
+	case 871:
 		yyDollar = yyS[yypt-9 : yypt+1]
-//line sql.y:4198
+//line sql.y:4391
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfileS3, FileName: string(yyDollar[4].bytes), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
-	case 838:
+	case 872:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4202
+//line sql.y:4395
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoDumpfile, FileName: string(yyDollar[3].bytes), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
-	case 839:
+	case 873:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4206
+//line sql.y:4399
 		{
 			yyVAL.selectInto = &SelectInto{Type: IntoOutfile, FileName: string(yyDollar[3].bytes), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
-	case 840:
+	case 874:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4211
+//line sql.y:4404
 		{
 			yyVAL.str = ""
 		}
-	case 841:
+	case 875:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4215
+//line sql.y:4408
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 842:
+	case 876:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4219
+//line sql.y:4412
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 843:
+	case 877:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4224
+//line sql.y:4417
 		{
 			yyVAL.str = ""
 		}
-	case 844:
+	case 878:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4228
+//line sql.y:4421
 		{
 			yyVAL.str = " header"
 		}
-	case 845:
+	case 879:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4233
+//line sql.y:4426
 		{
 			yyVAL.str = ""
 		}
-	case 846:
+	case 880:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4237
+//line sql.y:4430
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 847:
+	case 881:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4241
+//line sql.y:4434
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 848:
+	case 882:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4246
+//line sql.y:4439
 		{
 			yyVAL.str = ""
 		}
-	case 849:
+	case 883:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4250
+//line sql.y:4443
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 850:
+	case 884:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4254
+//line sql.y:4447
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 851:
+	case 885:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4260
+//line sql.y:4453
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 852:
+	case 886:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4265
+//line sql.y:4458
 		{
 			yyVAL.str = ""
 		}
-	case 853:
+	case 887:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4269
+//line sql.y:4462
 		{
 			yyVAL.str = " lines" + yyDollar[2].str + yyDollar[3].str
 		}
-	case 854:
+	case 888:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4274
+//line sql.y:4467
 		{
 			yyVAL.str = ""
 		}
-	case 855:
+	case 889:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4278
+//line sql.y:4471
 		{
 			yyVAL.str = " starting by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 856:
+	case 890:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4283
+//line sql.y:4476
 		{
 			yyVAL.str = ""
 		}
-	case 857:
+	case 891:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4287
+//line sql.y:4480
 		{
 			yyVAL.str = " terminated by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 858:
+	case 892:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4292
+//line sql.y:4485
 		{
 			yyVAL.str = ""
 		}
-	case 859:
+	case 893:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4296
+//line sql.y:4489
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str + yyDollar[3].str + yyDollar[4].str
 		}
-	case 860:
+	case 894:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4301
+//line sql.y:4494
 		{
 			yyVAL.str = ""
 		}
-	case 861:
+	case 895:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4305
+//line sql.y:4498
 		{
 			yyVAL.str = " escaped by '" + string(yyDollar[3].bytes) + "'"
 		}
-	case 862:
+	case 896:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4310
+//line sql.y:4503
 		{
 			yyVAL.str = ""
 		}
-	case 863:
+	case 897:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4314
+//line sql.y:4507
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by '" + string(yyDollar[4].bytes) + "'"
 		}
-	case 864:
+	case 898:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4319
+//line sql.y:4512
 		{
 			yyVAL.str = ""
 		}
-	case 865:
+	case 899:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4323
+//line sql.y:4516
 		{
 			yyVAL.str = " optionally"
 		}
-	case 866:
+	case 900:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4336
+//line sql.y:4529
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[2].values}
 		}
-	case 867:
+	case 901:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4340
+//line sql.y:4533
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[1].selStmt}
 		}
-	case 868:
+	case 902:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:4537
+		{
+			yyVAL.ins = &Insert{Rows: SetWith(yyDollar[2].selStmt, yyDollar[1].with)}
+		}
+	case 903:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4344
+//line sql.y:4541
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[5].values}
 		}
-	case 869:
+	case 904:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4348
+//line sql.y:4545
 		{
 			yyVAL.ins = &Insert{Rows: yyDollar[4].values}
 		}
-	case 870:
+	case 905:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4352
+//line sql.y:4549
 		{
 			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: yyDollar[4].selStmt}
 		}
-	case 871:
+	case 906:
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line sql.y:4553
+		{
+			yyVAL.ins = &Insert{Columns: yyDollar[2].columns, Rows: SetWith(yyDollar[5].selStmt, yyDollar[4].with)}
+		}
+	case 907:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4358
+//line sql.y:4559
 		{
 			yyVAL.columns = Columns{yyDollar[1].colIdent}
 		}
-	case 872:
+	case 908:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4362
+//line sql.y:4563
 		{
 			yyVAL.columns = Columns{yyDollar[3].colIdent}
 		}
-	case 873:
+	case 909:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4366
+//line sql.y:4567
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[3].colIdent)
 		}
-	case 874:
+	case 910:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4370
+//line sql.y:4571
 		{
 			yyVAL.columns = append(yyVAL.columns, yyDollar[5].colIdent)
 		}
-	case 875:
+	case 911:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4375
+//line sql.y:4576
 		{
 			yyVAL.updateExprs = nil
 		}
-	case 876:
+	case 912:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4379
+//line sql.y:4580
 		{
 			yyVAL.updateExprs = yyDollar[5].updateExprs
 		}
-	case 877:
+	case 913:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4385
+//line sql.y:4586
 		{
 			yyVAL.values = Values{yyDollar[1].valTuple}
 		}
-	case 878:
+	case 914:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4389
+//line sql.y:4590
 		{
 			yyVAL.values = append(yyDollar[1].values, yyDollar[3].valTuple)
 		}
-	case 879:
+	case 915:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4395
+//line sql.y:4596
 		{
 			yyVAL.valTuple = yyDollar[1].valTuple
 		}
-	case 880:
+	case 916:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4399
+//line sql.y:4600
 		{
 			yyVAL.valTuple = ValTuple{}
 		}
-	case 881:
+	case 917:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4405
+//line sql.y:4606
 		{
 			yyVAL.valTuple = ValTuple(yyDollar[2].exprs)
 		}
-	case 882:
+	case 918:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4411
+//line sql.y:4612
 		{
 			if len(yyDollar[1].valTuple) == 1 {
 				yyVAL.expr = yyDollar[1].valTuple[0]
@@ -10371,259 +10798,259 @@ yydefault:
 				yyVAL.expr = yyDollar[1].valTuple
 			}
 		}
-	case 883:
+	case 919:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4421
+//line sql.y:4622
 		{
 			yyVAL.updateExprs = UpdateExprs{yyDollar[1].updateExpr}
 		}
-	case 884:
+	case 920:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4425
+//line sql.y:4626
 		{
 			yyVAL.updateExprs = append(yyDollar[1].updateExprs, yyDollar[3].updateExpr)
 		}
-	case 885:
+	case 921:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4431
+//line sql.y:4632
 		{
 			yyVAL.updateExpr = &UpdateExpr{Name: yyDollar[1].colName, Expr: yyDollar[3].expr}
 		}
-	case 886:
+	case 922:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4437
+//line sql.y:4638
 		{
 			yyVAL.setExprs = SetExprs{yyDollar[1].setExpr}
 		}
-	case 887:
+	case 923:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4441
+//line sql.y:4642
 		{
 			yyVAL.setExprs = append(yyDollar[1].setExprs, yyDollar[3].setExpr)
 		}
-	case 888:
+	case 924:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4447
+//line sql.y:4648
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("on"))}
 		}
-	case 889:
+	case 925:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4451
+//line sql.y:4652
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral([]byte("off"))}
 		}
-	case 890:
+	case 926:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4455
+//line sql.y:4656
 		{
 			yyVAL.setExpr = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].expr}
 		}
-	case 891:
+	case 927:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4459
+//line sql.y:4660
 		{
 			yyVAL.setExpr = &SetExpr{Name: NewColIdent(string(yyDollar[1].bytes)), Scope: ImplicitScope, Expr: yyDollar[2].expr}
 		}
-	case 892:
+	case 928:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4463
+//line sql.y:4664
 		{
 			yyDollar[2].setExpr.Scope = yyDollar[1].scope
 			yyVAL.setExpr = yyDollar[2].setExpr
 		}
-	case 894:
+	case 930:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4471
+//line sql.y:4672
 		{
 			yyVAL.bytes = []byte("charset")
 		}
-	case 897:
+	case 933:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4481
+//line sql.y:4682
 		{
 			yyVAL.expr = NewStrLiteral([]byte(yyDollar[1].colIdent.String()))
 		}
-	case 898:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4485
+//line sql.y:4686
 		{
 			yyVAL.expr = NewStrLiteral(yyDollar[1].bytes)
 		}
-	case 899:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4489
+//line sql.y:4690
 		{
 			yyVAL.expr = &Default{}
 		}
-	case 902:
+	case 938:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4498
+//line sql.y:4699
 		{
 			yyVAL.boolean = false
 		}
-	case 903:
+	case 939:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4500
+//line sql.y:4701
 		{
 			yyVAL.boolean = true
 		}
-	case 904:
+	case 940:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4503
+//line sql.y:4704
 		{
 			yyVAL.boolean = false
 		}
-	case 905:
+	case 941:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4505
+//line sql.y:4706
 		{
 			yyVAL.boolean = true
 		}
-	case 906:
+	case 942:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4508
+//line sql.y:4709
 		{
 			yyVAL.ignore = false
 		}
-	case 907:
+	case 943:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4510
+//line sql.y:4711
 		{
 			yyVAL.ignore = true
 		}
-	case 908:
+	case 944:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4513
+//line sql.y:4714
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 909:
+	case 945:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4515
+//line sql.y:4716
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 910:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4517
+//line sql.y:4718
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 911:
+	case 947:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4520
+//line sql.y:4721
 		{
 			yyVAL.str = ""
 		}
-	case 912:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4522
+//line sql.y:4723
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 913:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4524
+//line sql.y:4725
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 914:
+	case 950:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4526
+//line sql.y:4727
 		{
 			yyVAL.str = string(yyDollar[1].bytes)
 		}
-	case 915:
+	case 951:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4529
+//line sql.y:4730
 		{
 			yyVAL.indexOptions = nil
 		}
-	case 916:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4531
+//line sql.y:4732
 		{
 			yyVAL.indexOptions = []*IndexOption{yyDollar[1].indexOption}
 		}
-	case 917:
+	case 953:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4535
+//line sql.y:4736
 		{
 			yyVAL.indexOption = &IndexOption{Name: string(yyDollar[1].bytes), String: string(yyDollar[2].colIdent.String())}
 		}
-	case 918:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4541
+//line sql.y:4742
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 919:
+	case 955:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4545
+//line sql.y:4746
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 921:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4552
+//line sql.y:4753
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].bytes))
 		}
-	case 922:
+	case 958:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4558
+//line sql.y:4759
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 923:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4562
+//line sql.y:4763
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 925:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4569
+//line sql.y:4770
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].bytes))
 		}
-	case 1307:
+	case 1347:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4975
+//line sql.y:5180
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1308:
+	case 1348:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4984
+//line sql.y:5189
 		{
 			decNesting(yylex)
 		}
-	case 1309:
+	case 1349:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4989
+//line sql.y:5194
 		{
 			skipToEnd(yylex)
 		}
-	case 1310:
+	case 1350:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4994
+//line sql.y:5199
 		{
 			skipToEnd(yylex)
 		}
-	case 1311:
+	case 1351:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4998
+//line sql.y:5203
 		{
 			skipToEnd(yylex)
 		}
-	case 1312:
+	case 1352:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5002
+//line sql.y:5207
 		{
 			skipToEnd(yylex)
 		}
@@ -54,6 +54,15 @@ func skipToEnd(yylex interface{}) {
   empty         struct{}
   statement     Statement
   selStmt       SelectStatement
+  with          *With
+  cte           *CommonTableExpr
+  overClause    *OverClause
+  windowSpec    *WindowSpec
+  frameClause   *FrameClause
+  frameUnit     FrameUnitType
+  framePoint    *FramePoint
+  windowDefinition *WindowDefinition
+  windowDefinitions WindowDefinitions
   ddl           *DDL
   ins           *Insert
   byt           byte
@@ -150,6 +159,8 @@ func skipToEnd(yylex interface{}) {
 }
 
 %token LEX_ERROR
+// ROWS has a lower precedence than UNION, see window_name_opt.
+%nonassoc <bytes> ROWS
 %left <bytes> UNION
 %token <bytes> SELECT STREAM VSTREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
 %token <bytes> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE KEY DEFAULT SET LOCK UNLOCK KEYS DO
@@ -248,6 +259,9 @@ func skipToEnd(yylex interface{}) {
 %token <bytes> RANDOM REFERENCE REQUIRE_ROW_FORMAT RESOURCE RESPECT RESTART RETAIN REUSE ROLE SECONDARY SECONDARY_ENGINE SECONDARY_LOAD SECONDARY_UNLOAD SKIP SRID
 %token <bytes> THREAD_PRIORITY TIES UNBOUNDED VCPU VISIBLE
 
+// Window functions
+%token <bytes> CURRENT ROW RANGE
+
 // Explain tokens
 %token <bytes> FORMAT TREE VITESS TRADITIONAL
 
@@ -260,6 +274,19 @@ func skipToEnd(yylex interface{}) {
 
 %type <statement> command
 %type <selStmt> simple_select select_statement base_select union_rhs
+%type <with> with_clause
+%type <cte> common_table_expr
+%type <overClause> over_clause
+%type <windowSpec> window_spec
+%type <frameClause> frame_clause_opt
+%type <frameUnit> frame_unit
+%type <framePoint> frame_point
+%type <expr> frame_expression
+%type <exprs> partition_by_opt
+%type <colIdent> window_name_opt
+%type <windowDefinition> window_definition
+%type <windowDefinitions> window_clause_opt window_definition_list
+%type <boolean> recursive_opt
 %type <statement> explain_statement explainable_statement
 %type <statement> stream_statement vstream_statement insert_statement update_statement delete_statement set_statement set_transaction_statement
 %type <statement> create_statement alter_statement rename_statement drop_statement truncate_statement flush_statement do_statement
@@ -417,6 +444,10 @@ command:
   {
     $$ = $1
   }
+| with_clause select_statement
+  {
+    $$ = SetWith($2, $1)
+  }
 | stream_statement
 | vstream_statement
 | insert_statement
@@ -524,6 +555,31 @@ select_statement:
 // is for subquery.
 // Not allowing parenthesis for subselects will force the above
 // construct to use the first interpretation.
+with_clause:
+  WITH recursive_opt common_table_expr
+  {
+    $$ = &With{Recursive: $2, CTEs: []*CommonTableExpr{$3}}
+  }
+| with_clause ',' common_table_expr
+  {
+    $$.CTEs = append($$.CTEs, $3)
+  }
+
+recursive_opt:
+  {
+    $$ = false
+  }
+| RECURSIVE
+  {
+    $$ = true
+  }
+
+common_table_expr:
+  table_id column_list_opt AS subquery
+  {
+    $$ = &CommonTableExpr{Name: $1, Columns: $2, Subquery: $4}
+  }
+
 simple_select:
   base_select order_by_opt limit_opt lock_opt
   {
@@ -552,10 +608,12 @@ vstream_statement:
 
 // base_select is an unparenthesized SELECT with no order by clause or beyond.
 base_select:
-//  1         2            3              4                    5             6                7           8
-  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt
+//  1         2            3              4                    5             6                7           8          9
+  SELECT comment_opt select_options select_expression_list from_opt where_expression_opt group_by_opt having_opt window_clause_opt
   {
-    $$ = NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel := NewSelect(Comments($2), $4/*SelectExprs*/, $3/*options*/, $5/*from*/, NewWhere(WhereClause, $6), GroupBy($7), NewWhere(HavingClause, $8))
+    sel.Windows = $9
+    $$ = sel
   }
 
 union_rhs:
@@ -2986,6 +3044,10 @@ derived_table:
   {
     $$ = &DerivedTable{$2}
   }
+| openb with_clause select_statement closeb
+  {
+    $$ = &DerivedTable{SetWith($3, $2)}
+  }
 
 aliased_table_name:
 table_name as_opt_id index_hint_list
@@ -3400,6 +3462,10 @@ subquery:
   {
     $$ = &Subquery{$2}
   }
+| openb with_clause simple_select closeb
+  {
+    $$ = &Subquery{SetWith($3, $2)}
+  }
 
 expression_list:
   expression
@@ -3564,6 +3630,10 @@ function_call_generic:
   {
     $$ = &FuncExpr{Name: $1, Exprs: $3}
   }
+| sql_id openb select_expression_list_opt closeb over_clause
+  {
+    $$ = &FuncExpr{Name: $1, Exprs: $3, Over: $5}
+  }
 | sql_id openb DISTINCT select_expression_list closeb
   {
     $$ = &FuncExpr{Name: $1, Distinct: true, Exprs: $4}
@@ -3983,6 +4053,129 @@ having_opt:
     $$ = $2
   }
 
+window_clause_opt:
+  {
+    $$ = nil
+  }
+| WINDOW window_definition_list
+  {
+    $$ = $2
+  }
+
+window_definition_list:
+  window_definition
+  {
+    $$ = WindowDefinitions{$1}
+  }
+| window_definition_list ',' window_definition
+  {
+    $$ = append($1, $3)
+  }
+
+window_definition:
+  sql_id AS openb window_spec closeb
+  {
+    $$ = &WindowDefinition{Name: $1, WindowSpec: $4}
+  }
+
+over_clause:
+  OVER openb window_spec closeb
+  {
+    $$ = &OverClause{WindowSpec: $3}
+  }
+| OVER sql_id
+  {
+    $$ = &OverClause{WindowName: $2}
+  }
+
+window_spec:
+  window_name_opt partition_by_opt order_by_opt frame_clause_opt
+  {
+    $$ = &WindowSpec{Name: $1, PartitionBy: $2, OrderBy: $3, Frame: $4}
+  }
+
+// There is a grammar conflict here: ROWS is a non-reserved keyword, so
+// in OVER (ROWS ...) it could be the name of a window. The %prec override
+// below makes the parser choose the frame clause. This is the same
+// behavior as MySQL, where ROWS is reserved.
+window_name_opt:
+%prec UNION
+  {
+    $$ = ColIdent{}
+  }
+| sql_id
+  {
+    $$ = $1
+  }
+
+partition_by_opt:
+  {
+    $$ = nil
+  }
+| PARTITION BY expression_list
+  {
+    $$ = $3
+  }
+
+frame_clause_opt:
+  {
+    $$ = nil
+  }
+| frame_unit frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $2}
+  }
+| frame_unit BETWEEN frame_point AND frame_point
+  {
+    $$ = &FrameClause{Unit: $1, Start: $3, End: $5}
+  }
+
+frame_unit:
+  ROWS
+  {
+    $$ = RowsUnit
+  }
+| RANGE
+  {
+    $$ = RangeUnit
+  }
+
+frame_point:
+  CURRENT ROW
+  {
+    $$ = &FramePoint{Type: CurrentRowType}
+  }
+| UNBOUNDED PRECEDING
+  {
+    $$ = &FramePoint{Type: UnboundedPrecedingType}
+  }
+| UNBOUNDED FOLLOWING
+  {
+    $$ = &FramePoint{Type: UnboundedFollowingType}
+  }
+| frame_expression PRECEDING
+  {
+    $$ = &FramePoint{Type: ExprPrecedingType, Expr: $1}
+  }
+| frame_expression FOLLOWING
+  {
+    $$ = &FramePoint{Type: ExprFollowingType, Expr: $1}
+  }
+
+frame_expression:
+  INTEGRAL
+  {
+    $$ = NewIntLiteral($1)
+  }
+| VALUE_ARG
+  {
+    $$ = NewArgument($1)
+  }
+| INTERVAL value_expression sql_id
+  {
+    $$ = &IntervalExpr{Expr: $2, Unit: $3.String()}
+  }
+
 order_by_opt:
   {
     $$ = nil
@@ -4340,6 +4533,10 @@ insert_data:
   {
     $$ = &Insert{Rows: $1}
   }
+| with_clause select_statement
+  {
+    $$ = &Insert{Rows: SetWith($2, $1)}
+  }
 | openb ins_column_list closeb VALUES tuple_list
   {
     $$ = &Insert{Columns: $2, Rows: $5}
@@ -4352,6 +4549,10 @@ insert_data:
   {
     $$ = &Insert{Columns: $2, Rows: $4}
   }
+| openb ins_column_list closeb with_clause select_statement
+  {
+    $$ = &Insert{Columns: $2, Rows: SetWith($5, $4)}
+  }
 
 ins_column_list:
   sql_id
@@ -4679,6 +4880,7 @@ reserved_keyword:
 | REGEXP
 | RENAME
 | REPLACE
+| RANGE
 | RIGHT
 | ROW_NUMBER
 | SCHEMA
@@ -4757,6 +4959,7 @@ non_reserved_keyword:
 | CONNECTION
 | COPY
 | CSV
+| CURRENT
 | DATA
 | DATE
 | DATETIME
@@ -4902,6 +5105,8 @@ non_reserved_keyword:
 | RETAIN
 | REUSE
 | ROLE
+| ROW
+| ROWS
 | ROLLBACK
 | ROW_FORMAT
 | S3
@@ -143,6 +143,12 @@ var keywords = map[string]int{
 	"continue":            UNUSED,
 	"convert":             CONVERT,
 	"copy":                COPY,
+	"current":             CURRENT,
+	"following":           FOLLOWING,
+	"over":                OVER,
+	"preceding":           PRECEDING,
+	"row":                 ROW,
+	"rows":                ROWS,
 	"substr":              SUBSTR,
 	"substring":           SUBSTRING,
 	"create":              CREATE,
@@ -354,12 +360,13 @@ var keywords = map[string]int{
 	"processlist":         PROCESSLIST,
 	"procedure":           PROCEDURE,
 	"query":               QUERY,
-	"range":               UNUSED,
+	"range":               RANGE,
 	"read":                READ,
 	"reads":               UNUSED,
 	"read_write":          UNUSED,
 	"real":                REAL,
 	"rebuild":             REBUILD,
+	"recursive":           RECURSIVE,
 	"redundant":           REDUNDANT,
 	"references":          REFERENCES,
 	"regexp":              REGEXP,
@@ -421,6 +428,7 @@ var keywords = map[string]int{
 	"stored":              UNUSED,
 	"straight_join":       STRAIGHT_JOIN,
 	"stream":              STREAM,
+	"unbounded":           UNBOUNDED,
 	"vstream":             VSTREAM,
 	"table":               TABLE,
 	"tables":              TABLES,
@@ -481,6 +489,7 @@ var keywords = map[string]int{
 	"when":                WHEN,
 	"where":               WHERE,
 	"while":               UNUSED,
+	"window":              WINDOW,
 	"with":                WITH,
 	"without":             WITHOUT,
 	"work":                WORK,
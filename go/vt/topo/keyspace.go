@@ -293,6 +293,9 @@ func (ts *Server) DeleteKeyspace(ctx context.Context, keyspace string) error {
 	if err := ts.DeleteVSchema(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
 		return err
 	}
+	if err := ts.DeleteThrottlerConfig(ctx, keyspace); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
 
 	event.Dispatch(&events.KeyspaceChange{
 		KeyspaceName: keyspace,
@@ -66,16 +66,19 @@ const (
 
 // Filenames for all object types.
 const (
-	CellInfoFile         = "CellInfo"
-	CellsAliasFile       = "CellsAlias"
-	KeyspaceFile         = "Keyspace"
-	ShardFile            = "Shard"
-	VSchemaFile          = "VSchema"
-	ShardReplicationFile = "ShardReplication"
-	TabletFile           = "Tablet"
-	SrvVSchemaFile       = "SrvVSchema"
-	SrvKeyspaceFile      = "SrvKeyspace"
-	RoutingRulesFile     = "RoutingRules"
+	CellInfoFile          = "CellInfo"
+	CellsAliasFile        = "CellsAlias"
+	KeyspaceFile          = "Keyspace"
+	ShardFile             = "Shard"
+	VSchemaFile           = "VSchema"
+	ShardReplicationFile  = "ShardReplication"
+	TabletFile            = "Tablet"
+	SrvVSchemaFile        = "SrvVSchema"
+	SrvKeyspaceFile       = "SrvKeyspace"
+	RoutingRulesFile      = "RoutingRules"
+	ShardRoutingRulesFile = "ShardRoutingRules"
+	ThrottlerAppsFile     = "ThrottlerApps"
+	ThrottlerConfigFile   = "ThrottlerConfig"
 )
 
 // Path for all object types.
@@ -363,6 +363,10 @@ func (ts *Server) GetOrCreateShard(ctx context.Context, keyspace, shard string)
 // DeleteShard wraps the underlying conn.Delete
 // and dispatches the event.
 func (ts *Server) DeleteShard(ctx context.Context, keyspace, shard string) error {
+	// The throttler app table lives in the shard directory.
+	if err := ts.globalCell.Delete(ctx, throttlerAppsFilePath(keyspace, shard), nil); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
 	shardPath := shardFilePath(keyspace, shard)
 	if err := ts.globalCell.Delete(ctx, shardPath, nil); err != nil {
 		return err
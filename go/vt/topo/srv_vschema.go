@@ -198,6 +198,14 @@ func (ts *Server) RebuildSrvVSchema(ctx context.Context, cells []string) error {
 	}
 	srvVSchema.RoutingRules = rr
 
+	srr, err := ts.GetShardRoutingRules(ctx)
+	if err != nil {
+		return fmt.Errorf("GetShardRoutingRules failed: %v", err)
+	}
+	if len(srr.Rules) > 0 {
+		srvVSchema.ShardRoutingRules = srr
+	}
+
 	// now save the SrvVSchema in all cells in parallel
 	for _, cell := range cells {
 		wg.Add(1)
@@ -91,6 +91,21 @@ func TopoServerTestSuite(t *testing.T, factory func() *topo.Server) {
 	checkRoutingRules(t, ts)
 	ts.Close()
 
+	t.Log("=== checkShardRoutingRules")
+	ts = factory()
+	checkShardRoutingRules(t, ts)
+	ts.Close()
+
+	t.Log("=== checkThrottlerApps")
+	ts = factory()
+	checkThrottlerApps(t, ts)
+	ts.Close()
+
+	t.Log("=== checkThrottlerConfig")
+	ts = factory()
+	checkThrottlerConfig(t, ts)
+	ts.Close()
+
 	t.Log("=== checkElection")
 	ts = factory()
 	checkElection(t, ts)
@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// checkThrottlerApps runs the tests on the throttler app table part of the API
+func checkThrottlerApps(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	require.NoError(t, ts.CreateKeyspace(ctx, "test_keyspace", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateShard(ctx, "test_keyspace", "-80"))
+
+	got, err := ts.GetThrottlerApps(ctx, "test_keyspace", "-80")
+	require.NoError(t, err)
+	require.Empty(t, got.Apps)
+
+	app := &topodatapb.ThrottlerApp{Name: "vreplication", Priority: topodatapb.ThrottlerApp_HIGH}
+	_, err = ts.UpdateThrottlerApps(ctx, "test_keyspace", "-80", func(apps *topodatapb.ThrottlerApps) error {
+		apps.Apps = append(apps.Apps, app)
+		return nil
+	})
+	require.NoError(t, err)
+	got, err = ts.GetThrottlerApps(ctx, "test_keyspace", "-80")
+	require.NoError(t, err)
+	want := &topodatapb.ThrottlerApps{Apps: []*topodatapb.ThrottlerApp{app}}
+	if !proto.Equal(got, want) {
+		t.Errorf("GetThrottlerApps: %v, want %v", got, want)
+	}
+
+	// The app table doesn't show up as a shard.
+	shards, err := ts.GetShardNames(ctx, "test_keyspace")
+	require.NoError(t, err)
+	require.Equal(t, []string{"-80"}, shards)
+
+	// Deleting the shard deletes its app table.
+	require.NoError(t, ts.DeleteShard(ctx, "test_keyspace", "-80"))
+	got, err = ts.GetThrottlerApps(ctx, "test_keyspace", "-80")
+	require.NoError(t, err)
+	require.Empty(t, got.Apps)
+	require.NoError(t, ts.CreateShard(ctx, "test_keyspace", "-80"))
+
+	// Removing all the apps removes the app table.
+	_, err = ts.UpdateThrottlerApps(ctx, "test_keyspace", "-80", func(apps *topodatapb.ThrottlerApps) error {
+		apps.Apps = append(apps.Apps, app)
+		return nil
+	})
+	require.NoError(t, err)
+	_, err = ts.UpdateThrottlerApps(ctx, "test_keyspace", "-80", func(apps *topodatapb.ThrottlerApps) error {
+		apps.Apps = nil
+		return nil
+	})
+	require.NoError(t, err)
+	got, err = ts.GetThrottlerApps(ctx, "test_keyspace", "-80")
+	require.NoError(t, err)
+	require.Empty(t, got.Apps)
+}
+
+// checkThrottlerConfig runs the tests on the throttler config part of the API
+func checkThrottlerConfig(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	require.NoError(t, ts.CreateKeyspace(ctx, "test_keyspace", &topodatapb.Keyspace{}))
+
+	_, err := ts.GetThrottlerConfig(ctx, "test_keyspace")
+	require.True(t, topo.IsErrType(err, topo.NoNode), "GetThrottlerConfig: %v", err)
+	current, _, _ := ts.WatchThrottlerConfig(ctx, "test_keyspace")
+	require.True(t, topo.IsErrType(current.Err, topo.NoNode), "WatchThrottlerConfig: %v", current.Err)
+
+	// A new throttler config is enabled.
+	_, err = ts.UpdateThrottlerConfig(ctx, "test_keyspace", func(config *topodatapb.ThrottlerConfig) error {
+		config.Threshold = 5
+		return nil
+	})
+	require.NoError(t, err)
+
+	watchCtx, cancelWatch := context.WithCancel(ctx)
+	defer cancelWatch()
+	current, changes, cancel := ts.WatchThrottlerConfig(watchCtx, "test_keyspace")
+	require.NoError(t, current.Err)
+	want := &topodatapb.ThrottlerConfig{Enabled: true, Threshold: 5}
+	if !proto.Equal(current.Value, want) {
+		t.Errorf("WatchThrottlerConfig: %v, want %v", current.Value, want)
+	}
+
+	_, err = ts.UpdateThrottlerConfig(ctx, "test_keyspace", func(config *topodatapb.ThrottlerConfig) error {
+		config.ExemptedApps = []string{"vreplication"}
+		return nil
+	})
+	require.NoError(t, err)
+	want = &topodatapb.ThrottlerConfig{Enabled: true, Threshold: 5, ExemptedApps: []string{"vreplication"}}
+	got, err := ts.GetThrottlerConfig(ctx, "test_keyspace")
+	require.NoError(t, err)
+	if !proto.Equal(got, want) {
+		t.Errorf("GetThrottlerConfig: %v, want %v", got, want)
+	}
+	change := <-changes
+	require.NoError(t, change.Err)
+	if !proto.Equal(change.Value, want) {
+		t.Errorf("WatchThrottlerConfig change: %v, want %v", change.Value, want)
+	}
+
+	// Deleting the keyspace deletes its throttler config.
+	require.NoError(t, ts.DeleteKeyspace(ctx, "test_keyspace"))
+	change = <-changes
+	require.True(t, topo.IsErrType(change.Err, topo.NoNode), "WatchThrottlerConfig change: %v", change.Err)
+	cancel()
+}
@@ -115,3 +115,38 @@ func checkRoutingRules(t *testing.T, ts *topo.Server) {
 		t.Errorf("GetRoutingRules: %v, want %v", got, want)
 	}
 }
+
+// checkShardRoutingRules runs the tests on the shard routing rules part of the API
+func checkShardRoutingRules(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+
+	got, err := ts.GetShardRoutingRules(ctx)
+	require.NoError(t, err)
+	require.Empty(t, got.Rules)
+
+	want := &vschemapb.ShardRoutingRules{
+		Rules: []*vschemapb.ShardRoutingRule{{
+			FromKeyspace: "ks1",
+			ToKeyspace:   "ks2",
+			Shard:        "-80",
+			Tables:       []string{"t1"},
+		}},
+	}
+	if err := ts.SaveShardRoutingRules(ctx, want); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err = ts.GetShardRoutingRules(ctx)
+	require.NoError(t, err)
+	if !proto.Equal(got, want) {
+		t.Errorf("GetShardRoutingRules: %v, want %v", got, want)
+	}
+
+	// Saving empty rules removes them.
+	if err := ts.SaveShardRoutingRules(ctx, &vschemapb.ShardRoutingRules{}); err != nil {
+		t.Fatal(err)
+	}
+	got, err = ts.GetShardRoutingRules(ctx)
+	require.NoError(t, err)
+	require.Empty(t, got.Rules)
+}
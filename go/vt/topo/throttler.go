@@ -0,0 +1,218 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"context"
+	"path"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// GetThrottlerApps returns the app table of the tablet throttler of a shard.
+// A shard without registered apps has an empty app table.
+func (ts *Server) GetThrottlerApps(ctx context.Context, keyspace, shard string) (*topodatapb.ThrottlerApps, error) {
+	apps, _, err := ts.getThrottlerApps(ctx, keyspace, shard)
+	return apps, err
+}
+
+func (ts *Server) getThrottlerApps(ctx context.Context, keyspace, shard string) (*topodatapb.ThrottlerApps, Version, error) {
+	apps := &topodatapb.ThrottlerApps{}
+	data, version, err := ts.globalCell.Get(ctx, throttlerAppsFilePath(keyspace, shard))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return apps, nil, nil
+		}
+		return nil, nil, err
+	}
+	if err := proto.Unmarshal(data, apps); err != nil {
+		return nil, nil, vterrors.Wrapf(err, "GetThrottlerApps(%v,%v): bad throttler apps data", keyspace, shard)
+	}
+	return apps, version, nil
+}
+
+// UpdateThrottlerApps reads the app table of the tablet throttler of a shard,
+// calls update on it, and writes it back. If the write fails due to a
+// concurrent change, it re-reads the app table and retries the update.
+// If update returns ErrNoUpdateNeeded, nothing is written.
+// An empty app table is removed from the topo.
+func (ts *Server) UpdateThrottlerApps(ctx context.Context, keyspace, shard string, update func(*topodatapb.ThrottlerApps) error) (*topodatapb.ThrottlerApps, error) {
+	filePath := throttlerAppsFilePath(keyspace, shard)
+	for {
+		apps, version, err := ts.getThrottlerApps(ctx, keyspace, shard)
+		if err != nil {
+			return nil, err
+		}
+		if err := update(apps); err != nil {
+			if IsErrType(err, NoUpdateNeeded) {
+				return apps, nil
+			}
+			return nil, err
+		}
+		switch {
+		case len(apps.Apps) == 0 && version == nil:
+			return apps, nil
+		case len(apps.Apps) == 0:
+			err = ts.globalCell.Delete(ctx, filePath, version)
+		default:
+			var data []byte
+			data, err = proto.Marshal(apps)
+			if err != nil {
+				return nil, err
+			}
+			if version == nil {
+				_, err = ts.globalCell.Create(ctx, filePath, data)
+			} else {
+				_, err = ts.globalCell.Update(ctx, filePath, data, version)
+			}
+		}
+		if !IsErrType(err, BadVersion) && !IsErrType(err, NodeExists) && !IsErrType(err, NoNode) {
+			return apps, err
+		}
+	}
+}
+
+func throttlerAppsFilePath(keyspace, shard string) string {
+	return path.Join(KeyspacesPath, keyspace, ShardsPath, shard, ThrottlerAppsFile)
+}
+
+// GetThrottlerConfig returns the throttler configuration of a keyspace.
+// It returns a NoNode error if the keyspace has no throttler configuration.
+func (ts *Server) GetThrottlerConfig(ctx context.Context, keyspace string) (*topodatapb.ThrottlerConfig, error) {
+	config, _, err := ts.getThrottlerConfig(ctx, keyspace)
+	return config, err
+}
+
+func (ts *Server) getThrottlerConfig(ctx context.Context, keyspace string) (*topodatapb.ThrottlerConfig, Version, error) {
+	data, version, err := ts.globalCell.Get(ctx, throttlerConfigFilePath(keyspace))
+	if err != nil {
+		return nil, nil, err
+	}
+	config := &topodatapb.ThrottlerConfig{}
+	if err := proto.Unmarshal(data, config); err != nil {
+		return nil, nil, vterrors.Wrapf(err, "GetThrottlerConfig(%v): bad throttler config data", keyspace)
+	}
+	return config, version, nil
+}
+
+// UpdateThrottlerConfig reads the throttler configuration of a keyspace,
+// calls update on it, and writes it back. A keyspace without a throttler
+// configuration starts from an enabled one, since the throttler checks are
+// enforced when there is no configuration. If the write fails due to a
+// concurrent change, it re-reads the configuration and retries the update.
+// If update returns ErrNoUpdateNeeded, nothing is written.
+func (ts *Server) UpdateThrottlerConfig(ctx context.Context, keyspace string, update func(*topodatapb.ThrottlerConfig) error) (*topodatapb.ThrottlerConfig, error) {
+	filePath := throttlerConfigFilePath(keyspace)
+	for {
+		config, version, err := ts.getThrottlerConfig(ctx, keyspace)
+		if IsErrType(err, NoNode) {
+			config = &topodatapb.ThrottlerConfig{Enabled: true}
+		} else if err != nil {
+			return nil, err
+		}
+		if err := update(config); err != nil {
+			if IsErrType(err, NoUpdateNeeded) {
+				return config, nil
+			}
+			return nil, err
+		}
+		data, err := proto.Marshal(config)
+		if err != nil {
+			return nil, err
+		}
+		if version == nil {
+			_, err = ts.globalCell.Create(ctx, filePath, data)
+		} else {
+			_, err = ts.globalCell.Update(ctx, filePath, data, version)
+		}
+		if !IsErrType(err, BadVersion) && !IsErrType(err, NodeExists) && !IsErrType(err, NoNode) {
+			return config, err
+		}
+	}
+}
+
+// DeleteThrottlerConfig removes the throttler configuration of a keyspace,
+// so that its tablets go back to their command line configuration.
+func (ts *Server) DeleteThrottlerConfig(ctx context.Context, keyspace string) error {
+	return ts.globalCell.Delete(ctx, throttlerConfigFilePath(keyspace), nil)
+}
+
+// WatchThrottlerConfigData wraps the data we receive on the watch channel
+// The WatchThrottlerConfig API guarantees exactly one of Value or Err will be set.
+type WatchThrottlerConfigData struct {
+	Value *topodatapb.ThrottlerConfig
+	Err   error
+}
+
+// WatchThrottlerConfig will set a watch on the throttler configuration
+// of a keyspace. It has the same contract as conn.Watch, but it also
+// unpacks the contents into a ThrottlerConfig object.
+func (ts *Server) WatchThrottlerConfig(ctx context.Context, keyspace string) (*WatchThrottlerConfigData, <-chan *WatchThrottlerConfigData, CancelFunc) {
+	current, wdChannel, cancel := ts.globalCell.Watch(ctx, throttlerConfigFilePath(keyspace))
+	if current.Err != nil {
+		return &WatchThrottlerConfigData{Err: current.Err}, nil, nil
+	}
+	value := &topodatapb.ThrottlerConfig{}
+	if err := proto.Unmarshal(current.Contents, value); err != nil {
+		// Cancel the watch, drain channel.
+		cancel()
+		for range wdChannel {
+		}
+		return &WatchThrottlerConfigData{Err: vterrors.Wrapf(err, "error unpacking initial ThrottlerConfig object")}, nil, nil
+	}
+
+	changes := make(chan *WatchThrottlerConfigData, 10)
+	// The background routine reads any event from the watch channel,
+	// translates it, and sends it to the caller.
+	// If cancel() is called, the underlying Watch() code will
+	// send an ErrInterrupted and then close the channel. We'll
+	// just propagate that back to our caller.
+	go func() {
+		defer close(changes)
+
+		for wd := range wdChannel {
+			if wd.Err != nil {
+				// Last error value, we're done.
+				// wdChannel will be closed right after
+				// this, no need to do anything.
+				changes <- &WatchThrottlerConfigData{Err: wd.Err}
+				return
+			}
+
+			value := &topodatapb.ThrottlerConfig{}
+			if err := proto.Unmarshal(wd.Contents, value); err != nil {
+				cancel()
+				for range wdChannel {
+				}
+				changes <- &WatchThrottlerConfigData{Err: vterrors.Wrapf(err, "error unpacking ThrottlerConfig object")}
+				return
+			}
+
+			changes <- &WatchThrottlerConfigData{Value: value}
+		}
+	}()
+
+	return &WatchThrottlerConfigData{Value: value}, changes, cancel
+}
+
+func throttlerConfigFilePath(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, ThrottlerConfigFile)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topoproto
+
+import (
+	"fmt"
+	"strings"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// ParseThrottlerAppPriority parses a string into a throttler app priority.
+// The empty string is the normal priority.
+func ParseThrottlerAppPriority(param string) (topodatapb.ThrottlerApp_Priority, error) {
+	if param == "" {
+		return topodatapb.ThrottlerApp_NORMAL, nil
+	}
+	value, ok := topodatapb.ThrottlerApp_Priority_value[strings.ToUpper(param)]
+	if !ok {
+		return topodatapb.ThrottlerApp_NORMAL, fmt.Errorf("unknown throttler app priority %v", param)
+	}
+	return topodatapb.ThrottlerApp_Priority(value), nil
+}
+
+// SetThrottlerApp adds the app to the app table, or replaces the app
+// with the same name.
+func SetThrottlerApp(apps *topodatapb.ThrottlerApps, app *topodatapb.ThrottlerApp) {
+	for i, existing := range apps.Apps {
+		if existing.Name == app.Name {
+			apps.Apps[i] = app
+			return
+		}
+	}
+	apps.Apps = append(apps.Apps, app)
+}
+
+// RemoveThrottlerApp removes the app with the given name from the app table.
+// It returns false if there was no such app.
+func RemoveThrottlerApp(apps *topodatapb.ThrottlerApps, name string) bool {
+	for i, existing := range apps.Apps {
+		if existing.Name == name {
+			apps.Apps = append(apps.Apps[:i], apps.Apps[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -167,6 +167,30 @@ func TestRebuildVSchema(t *testing.T) {
 		}
 	}
 
+	srr := &vschemapb.ShardRoutingRules{
+		Rules: []*vschemapb.ShardRoutingRule{{
+			FromKeyspace: "ks1",
+			ToKeyspace:   "ks2",
+			Shard:        "-80",
+			Tables:       []string{"t1"},
+		}},
+	}
+	if err := ts.SaveShardRoutingRules(ctx, srr); err != nil {
+		t.Fatalf("SaveShardRoutingRules() failed: %v", err)
+	}
+	if err := ts.RebuildSrvVSchema(ctx, nil); err != nil {
+		t.Errorf("RebuildVSchema failed: %v", err)
+	}
+	wanted3.ShardRoutingRules = srr
+	for _, cell := range cells {
+		if v, err := ts.GetSrvVSchema(ctx, cell); err != nil || !proto.Equal(v, wanted3) {
+			t.Errorf("unexpected GetSrvVSchema(%v) result: %v %v", cell, v, err)
+		}
+	}
+	if err := ts.SaveShardRoutingRules(ctx, &vschemapb.ShardRoutingRules{}); err != nil {
+		t.Fatalf("SaveShardRoutingRules() failed: %v", err)
+	}
+
 	wanted4 := wanted1
 	wanted4.RoutingRules = rr
 
@@ -104,6 +104,7 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/textutil"
 	hk "vitess.io/vitess/go/vt/hook"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
@@ -115,6 +116,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
 	"vitess.io/vitess/go/vt/wrangler"
 
 	replicationdatapb "vitess.io/vitess/go/vt/proto/replicationdata"
@@ -272,6 +274,12 @@ var commands = []commandGroup{
 			{"DeleteShard", commandDeleteShard,
 				"[-recursive] [-even_if_serving] <keyspace/shard> ...",
 				"Deletes the specified shard(s). In recursive mode, it also deletes all tablets belonging to the shard. Otherwise, there must be no tablets left in the shard."},
+			{"GetThrottlerApps", commandGetThrottlerApps,
+				"<keyspace/shard>",
+				"Outputs a JSON structure that contains the apps registered with the tablet throttler of the shard."},
+			{"UpdateThrottlerApp", commandUpdateThrottlerApp,
+				"[-priority=low|normal|high] [-threshold_ratio=<ratio>] [-remove] <app> <keyspace/shard> ...",
+				"Registers an app with the tablet throttler of the specified shard(s), or removes it. As replication lag approaches the throttle threshold, low priority apps are throttled first, while high priority apps keep running above the threshold. The threshold_ratio, if set, overrides the ratio of the threshold at which the app is throttled."},
 		},
 	},
 	{
@@ -291,6 +299,21 @@ var commands = []commandGroup{
 			{"GetKeyspaces", commandGetKeyspaces,
 				"",
 				"Outputs a sorted list of all keyspaces."},
+			{"GetThrottlerConfig", commandGetThrottlerConfig,
+				"<keyspace>",
+				"Outputs a JSON structure that contains the tablet throttler configuration of the keyspace."},
+			{"UpdateThrottlerConfig", commandUpdateThrottlerConfig,
+				"[-enable|-disable] [-threshold=<threshold>] [-metric_name=<metric>] [-exempted_apps=app1,app2,...] [-clear] <keyspace>",
+				"Updates the tablet throttler configuration of the keyspace. The tablets of the keyspace apply it live, and it overrides their -throttle_threshold flag. Only the specified settings are changed, and a new configuration is enabled unless -disable is given. -enable has no effect on the tablets that run without -enable-lag-throttler. Use -clear to remove the configuration, so that the tablets go back to their command line configuration."},
+			{"ListDistributedTransactions", commandListDistributedTransactions,
+				"[<keyspace>]",
+				"Outputs a JSON list of the unresolved distributed transactions whose records are owned by the shards of the keyspace, or of all the keyspaces. Shards that don't run with 2PC are skipped, and the shards that can't be read are reported after the list."},
+			{"GetDistributedTransaction", commandGetDistributedTransaction,
+				"<dtid>",
+				"Outputs a JSON structure that contains the state and the participants of an unresolved distributed transaction."},
+			{"ConcludeDistributedTransaction", commandConcludeDistributedTransaction,
+				"<dtid>",
+				"Resolves an unresolved distributed transaction: it's committed on all the participants if the commit decision was made, and rolled back otherwise."},
 			{"SetKeyspaceShardingInfo", commandSetKeyspaceShardingInfo,
 				"[-force] <keyspace name> [<column name>] [<column type>]",
 				"Updates the sharding information for a keyspace."},
@@ -318,6 +341,9 @@ var commands = []commandGroup{
 			{"ExternalizeVindex", commandExternalizeVindex,
 				"<keyspace>.<vindex>",
 				`Externalize a backfilled vindex.`},
+			{"BackfillLookupVindex", commandBackfillLookupVindex,
+				"[-cell=<cell>] [-tablet_types=<source_tablet_types>] <keyspace> <json_spec>",
+				`Create a lookup vindex in write_only mode, wait for the backfill, verify the lookup table and switch the vindex to read mode. The json_spec is the same as for CreateLookupVindex. Running the command again resumes an interrupted or failed backfill.`},
 			{"Materialize", commandMaterialize,
 				`<json_spec>, example : '{"workflow": "aaa", "source_keyspace": "source", "target_keyspace": "target", "table_settings": [{"target_table": "customer", "source_expression": "select * from customer", "create_ddl": "copy"}]}'`,
 				"Performs materialization based on the json spec. Is used directly to form VReplication rules, with an optional step to copy table structure/DDL."},
@@ -328,8 +354,12 @@ var commands = []commandGroup{
 				"<from_keyspace> <to_keyspace> <tables>",
 				"Start the VerticalSplitClone process to perform vertical resharding. Example: SplitClone from_ks to_ks 'a,/b.*/'"},
 			{"VDiff", commandVDiff,
-				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
-				"Perform a diff of all tables in the workflow"},
+				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] [-tables=<tables>] [-limit=<rows>] [-max_mismatch_rows=<rows>] <keyspace.workflow> [start|stop|resume|show [<uuid>]]",
+				"Perform a diff of all tables in the workflow. Without an action, the diff runs in this process and prints its report. " +
+					"The start action creates a job on the target masters that diffs their shards and saves the progress and the keys of the mismatched rows; it prints the uuid of the job. " +
+					"The job compares up to -limit rows of each table, and saves up to -max_mismatch_rows mismatched rows of each table. " +
+					"The stop and resume actions stop and resume the job with the given uuid; they don't take the -limit and -max_mismatch_rows flags, which are set when the job starts. " +
+					"The show action shows the jobs of the workflow, or the progress and up to -max_mismatch_rows mismatches per target master of the job with the given uuid."},
 			{"MigrateServedTypes", commandMigrateServedTypes,
 				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] [-filtered_replication_wait_time=30s] [-reverse_replication=false] <keyspace/shard> <served tablet type>",
 				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
@@ -340,8 +370,8 @@ var commands = []commandGroup{
 				"[-cells=c1,c2,...] [-reverse] -tablet_type={replica|rdonly} [-dry-run] <keyspace.workflow>",
 				"Switch read traffic for the specified workflow."},
 			{"SwitchWrites", commandSwitchWrites,
-				"[-timeout=30s] [-reverse] [-reverse_replication=true] [-dry-run] <keyspace.workflow>",
-				"Switch write traffic for the specified workflow."},
+				"[-timeout=30s] [-reverse] [-reverse_replication=true] [-dry-run] [-shards=shard1,shard2,...] <keyspace.workflow>",
+				"Switch write traffic for the specified workflow. With -shards, only the writes of the specified shards of a MoveTables workflow are switched."},
 			{"CancelResharding", commandCancelResharding,
 				"<keyspace/shard>",
 				"Permanently cancels a resharding in progress. All resharding related metadata will be deleted."},
@@ -399,7 +429,7 @@ var commands = []commandGroup{
 				"Validates that the master schema from shard 0 matches the schema on all of the other tablets in the keyspace."},
 			{"ApplySchema", commandApplySchema,
 				"[-allow_long_unavailability] [-wait_replicas_timeout=10s] [-ddl_strategy=<ddl_strategy>] {-sql=<sql> || -sql-file=<filename>} <keyspace>",
-				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. ddl_strategy is used to intruct migrations via gh-ost or pt-osc with optional parameters"},
+				"Applies the schema change to the specified keyspace on every master, running in parallel on all shards. The changes are then propagated to replicas via replication. If -allow_long_unavailability is set, schema changes affecting a large number of rows (and possibly incurring a longer period of unavailability) will not be rejected. ddl_strategy is used to intruct migrations via vreplication (online), gh-ost or pt-osc with optional parameters"},
 			{"CopySchemaShard", commandCopySchemaShard,
 				"[-tables=<table1>,<table2>,...] [-exclude_tables=<table1>,<table2>,...] [-include-views] [-skip-verify] [-wait_replicas_timeout=10s] {<source keyspace/shard> || <source tablet alias>} <destination keyspace/shard>",
 				"Copies the schema from a source shard's master (or a specific tablet) to a destination shard. The schema is applied directly on the master of the destination shard, and it is propagated to the replicas through binlogs."},
@@ -1616,6 +1646,70 @@ func commandDeleteShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	return nil
 }
 
+func commandGetThrottlerApps(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace/shard> argument is required for the GetThrottlerApps command")
+	}
+
+	keyspace, shard, err := topoproto.ParseKeyspaceShard(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	apps, err := wr.TopoServer().GetThrottlerApps(ctx, keyspace, shard)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), apps)
+}
+
+func commandUpdateThrottlerApp(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	priorityStr := subFlags.String("priority", "normal", "The priority class of the app: low, normal or high")
+	thresholdRatio := subFlags.Float64("threshold_ratio", 0, "If positive, overrides the ratio of the throttle threshold at which the app is throttled")
+	remove := subFlags.Bool("remove", false, "Removes the app from the app table")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 2 {
+		return fmt.Errorf("the <app> and <keyspace/shard> arguments are required for the UpdateThrottlerApp command")
+	}
+	priority, err := topoproto.ParseThrottlerAppPriority(*priorityStr)
+	if err != nil {
+		return err
+	}
+	if *thresholdRatio < 0 {
+		return fmt.Errorf("invalid threshold_ratio: %v", *thresholdRatio)
+	}
+
+	app := &topodatapb.ThrottlerApp{
+		Name:           subFlags.Arg(0),
+		Priority:       priority,
+		ThresholdRatio: *thresholdRatio,
+	}
+	keyspaceShards, err := shardParamsToKeyspaceShards(ctx, wr, subFlags.Args()[1:])
+	if err != nil {
+		return err
+	}
+	for _, ks := range keyspaceShards {
+		_, err := wr.TopoServer().UpdateThrottlerApps(ctx, ks.Keyspace, ks.Shard, func(apps *topodatapb.ThrottlerApps) error {
+			if *remove {
+				if !topoproto.RemoveThrottlerApp(apps, app.Name) {
+					return topo.NewError(topo.NoUpdateNeeded, app.Name)
+				}
+				return nil
+			}
+			topoproto.SetThrottlerApp(apps, app)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func commandCreateKeyspace(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	shardingColumnName := subFlags.String("sharding_column_name", "", "Specifies the column to use for sharding operations")
 	shardingColumnType := subFlags.String("sharding_column_type", "", "Specifies the type of the column to use for sharding operations")
@@ -1792,6 +1886,119 @@ func commandGetKeyspaces(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	return nil
 }
 
+func commandGetThrottlerConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the GetThrottlerConfig command")
+	}
+
+	throttlerConfig, err := wr.TopoServer().GetThrottlerConfig(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), throttlerConfig)
+}
+
+func commandUpdateThrottlerConfig(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	enable := subFlags.Bool("enable", false, "Enables the throttler checks, on the tablets that run with -enable-lag-throttler")
+	disable := subFlags.Bool("disable", false, "Disables the throttler checks: all checks pass")
+	threshold := subFlags.Float64("threshold", 0, "The throttle threshold of the metric. Zero means the threshold configured on the tablets")
+	metricName := subFlags.String("metric_name", "", "The metric checked by the apps that don't name one. Empty means replication lag")
+	exemptedApps := subFlags.String("exempted_apps", "", "Comma-separated list of apps that are never throttled")
+	clearConfig := subFlags.Bool("clear", false, "Removes the throttler configuration of the keyspace")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the UpdateThrottlerConfig command")
+	}
+	if *enable && *disable {
+		return fmt.Errorf("-enable and -disable are mutually exclusive")
+	}
+	if *threshold < 0 {
+		return fmt.Errorf("invalid threshold: %v", *threshold)
+	}
+	keyspace := subFlags.Arg(0)
+	if _, err := wr.TopoServer().GetKeyspace(ctx, keyspace); err != nil {
+		return err
+	}
+	if *clearConfig {
+		return wr.TopoServer().DeleteThrottlerConfig(ctx, keyspace)
+	}
+
+	setFlags := make(map[string]bool)
+	subFlags.Visit(func(f *flag.Flag) {
+		setFlags[f.Name] = true
+	})
+	throttlerConfig, err := wr.TopoServer().UpdateThrottlerConfig(ctx, keyspace, func(throttlerConfig *topodatapb.ThrottlerConfig) error {
+		if *enable {
+			throttlerConfig.Enabled = true
+		}
+		if *disable {
+			throttlerConfig.Enabled = false
+		}
+		if setFlags["threshold"] {
+			throttlerConfig.Threshold = *threshold
+		}
+		if setFlags["metric_name"] {
+			throttlerConfig.MetricName = *metricName
+		}
+		if setFlags["exempted_apps"] {
+			throttlerConfig.ExemptedApps = textutil.SplitDelimitedList(*exemptedApps)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), throttlerConfig)
+}
+
+func commandListDistributedTransactions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() > 1 {
+		return fmt.Errorf("the ListDistributedTransactions command accepts at most one <keyspace> argument")
+	}
+
+	// The transactions of the shards that could be read are printed
+	// even if some shards failed.
+	transactions, err := wr.ListDistributedTransactions(ctx, subFlags.Arg(0))
+	if printErr := printJSON(wr.Logger(), transactions); printErr != nil {
+		return printErr
+	}
+	return err
+}
+
+func commandGetDistributedTransaction(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <dtid> argument is required for the GetDistributedTransaction command")
+	}
+
+	transaction, err := wr.GetDistributedTransaction(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), transaction)
+}
+
+func commandConcludeDistributedTransaction(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <dtid> argument is required for the ConcludeDistributedTransaction command")
+	}
+
+	return wr.ConcludeDistributedTransaction(ctx, subFlags.Arg(0))
+}
+
 func commandSetKeyspaceShardingInfo(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	force := subFlags.Bool("force", false, "Updates fields even if they are already set. Use caution before calling this command.")
 	if err := subFlags.Parse(args); err != nil {
@@ -2119,33 +2326,57 @@ func commandVRWorkflow(ctx context.Context, wr *wrangler.Wrangler, subFlags *fla
 	}
 
 	printCopyProgress := func() error {
-		copyProgress, err := wf.GetCopyProgress()
+		progress, err := wf.GetWorkflowProgress(ctx)
 		if err != nil {
 			return err
 		}
-		if copyProgress == nil {
+		formatETA := func(eta time.Duration) string {
+			if eta == wrangler.ETAUnknown {
+				return "unknown"
+			}
+			return eta.String()
+		}
+		if len(progress.Tables) == 0 {
 			wr.Logger().Printf("\nCopy Completed.\n")
 		} else {
 			wr.Logger().Printf("\nCopy Progress (approx):\n")
 			var tables []string
-			for table := range *copyProgress {
+			for table := range progress.Tables {
 				tables = append(tables, table)
 			}
 			sort.Strings(tables)
 			s := ""
-			var progress wrangler.TableCopyProgress
-			for table := range *copyProgress {
-				progress = *(*copyProgress)[table]
-				rowCountPct := 100.0 * progress.TargetRowCount / progress.SourceRowCount
-				tableSizePct := 100.0 * progress.TargetTableSize / progress.SourceTableSize
-				s += fmt.Sprintf("%s: rows copied %d/%d (%d%%), size copied %d/%d (%d%%)\n",
-					table, progress.TargetRowCount, progress.SourceRowCount, rowCountPct,
-					progress.TargetTableSize, progress.SourceTableSize, tableSizePct)
+			for _, table := range tables {
+				tp := progress.Tables[table]
+				var rowCountPct, tableSizePct int64
+				if tp.SourceRowCount > 0 {
+					rowCountPct = 100.0 * tp.TargetRowCount / tp.SourceRowCount
+				}
+				if tp.SourceTableSize > 0 {
+					tableSizePct = 100.0 * tp.TargetTableSize / tp.SourceTableSize
+				}
+				s += fmt.Sprintf("%s: rows copied %d/%d (%d%%), size copied %d/%d (%d%%), rate %.0f rows/s, ETA %s\n",
+					table, tp.TargetRowCount, tp.SourceRowCount, rowCountPct,
+					tp.TargetTableSize, tp.SourceTableSize, tableSizePct, tp.RowsPerSecond, formatETA(tp.ETA))
 			}
 			wr.Logger().Printf("\n%s\n", s)
 		}
+		s := ""
+		for _, sp := range progress.Streams {
+			s += fmt.Sprintf("id=%d on %s: Status: %s.", sp.ID, sp.TargetShard, sp.State)
+			if len(sp.Tables) != 0 {
+				s += fmt.Sprintf(" Copying %s: rows copied %d/%d, rate %.0f rows/s, ETA %s.",
+					strings.Join(sp.Tables, ","), sp.RowsCopied, sp.RowsEstimated, sp.RowsPerSecond, formatETA(sp.ETA))
+			} else {
+				s += fmt.Sprintf(" Replication Lag: %ds.", sp.ReplicationLag)
+			}
+			s += "\n"
+		}
+		if len(progress.Tables) != 0 {
+			s += fmt.Sprintf("Workflow %s.%s: rate %.0f rows/s, ETA %s\n", target, workflow, progress.RowsPerSecond, formatETA(progress.ETA))
+		}
+		wr.Logger().Printf("\n%s\n", s)
 		return printDetails()
-
 	}
 
 	if *dryRun {
@@ -2272,6 +2503,23 @@ func commandCreateLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFl
 	return wr.CreateLookupVindex(ctx, keyspace, specs, *cell, *tabletTypes)
 }
 
+func commandBackfillLookupVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	cell := subFlags.String("cell", "", "Cell to replicate from.")
+	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from.")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("two arguments are required: keyspace and json_spec")
+	}
+	keyspace := subFlags.Arg(0)
+	specs := &vschemapb.Keyspace{}
+	if err := json2.Unmarshal([]byte(subFlags.Arg(1)), specs); err != nil {
+		return err
+	}
+	return wr.BackfillLookupVindex(ctx, keyspace, specs, *cell, *tabletTypes)
+}
+
 func commandExternalizeVindex(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -2322,19 +2570,65 @@ func commandVerticalSplitClone(ctx context.Context, wr *wrangler.Wrangler, subFl
 	return wr.VerticalSplitClone(ctx, fromKeyspace, toKeyspace, tables)
 }
 
+// vdiffJobAction runs an action on the vdiff jobs that run on the target masters.
+func vdiffJobAction(ctx context.Context, wr *wrangler.Wrangler, keyspace, workflow, action, uuid string, options *vdiff.Options) error {
+	if action != "start" && action != "show" && uuid == "" {
+		return fmt.Errorf("<uuid> is required for the %s action", action)
+	}
+	var qr *sqltypes.Result
+	var err error
+	switch action {
+	case "start":
+		if uuid != "" {
+			return fmt.Errorf("<uuid> is not allowed for the start action")
+		}
+		uuid, err = wr.VDiffStart(ctx, keyspace, workflow, options)
+		if err != nil {
+			return err
+		}
+		wr.Logger().Printf("VDiff %s started on the target masters of workflow %s.%s\n", uuid, keyspace, workflow)
+		return nil
+	case "stop":
+		qr, err = wr.VDiffStop(ctx, keyspace, workflow, uuid)
+	case "resume":
+		qr, err = wr.VDiffResume(ctx, keyspace, workflow, uuid)
+	case "show":
+		report, err := wr.VDiffShow(ctx, keyspace, workflow, uuid, options.MaxMismatchRows)
+		if err != nil {
+			return err
+		}
+		printQueryResult(loggerWriter{wr.Logger()}, report.Jobs)
+		if report.Tables != nil {
+			printQueryResult(loggerWriter{wr.Logger()}, report.Tables)
+		}
+		if report.Mismatches != nil && len(report.Mismatches.Rows) != 0 {
+			printQueryResult(loggerWriter{wr.Logger()}, report.Mismatches)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown VDiff action: %s", action)
+	}
+	if err != nil {
+		return err
+	}
+	printQueryResult(loggerWriter{wr.Logger()}, qr)
+	return nil
+}
+
 func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	sourceCell := subFlags.String("source_cell", "", "The source cell to compare from")
 	targetCell := subFlags.String("target_cell", "", "The target cell to compare with")
 	tabletTypes := subFlags.String("tablet_types", "master,replica,rdonly", "Tablet types for source and target")
 	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "Specifies the maximum time to wait, in seconds, for filtered replication to catch up on master migrations. The migration will be cancelled on a timeout.")
 	maxRows := subFlags.Int64("limit", math.MaxInt64, "Max rows to stop comparing after")
+	maxMismatchRows := subFlags.Int64("max_mismatch_rows", 1000, "Max mismatched rows of a table that a vdiff job saves, and that the show action shows per target master")
 	format := subFlags.String("format", "", "Format of report") //"json" or ""
 	tables := subFlags.String("tables", "", "Only run vdiff for these tables in the workflow")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 
-	if subFlags.NArg() != 1 {
+	if subFlags.NArg() < 1 || subFlags.NArg() > 3 {
 		return fmt.Errorf("<keyspace.workflow> is required")
 	}
 	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
@@ -2344,6 +2638,26 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	if *maxRows <= 0 {
 		return fmt.Errorf("maximum number of rows to compare needs to be greater than 0")
 	}
+	if *maxMismatchRows <= 0 {
+		return fmt.Errorf("maximum number of mismatched rows needs to be greater than 0")
+	}
+	if subFlags.NArg() > 1 {
+		var tableList []string
+		if *tables != "" {
+			tableList = strings.Split(*tables, ",")
+		}
+		options := &vdiff.Options{
+			SourceCell:                  *sourceCell,
+			TabletTypes:                 *tabletTypes,
+			Tables:                      tableList,
+			FilteredReplicationWaitTime: *filteredReplicationWaitTime,
+			MaxMismatchRows:             *maxMismatchRows,
+		}
+		if *maxRows != math.MaxInt64 {
+			options.MaxRows = *maxRows
+		}
+		return vdiffJobAction(ctx, wr, keyspace, workflow, subFlags.Arg(1), subFlags.Arg(2), options)
+	}
 	_, err = wr.
 		VDiff(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format, *maxRows, *tables)
 	if err != nil {
@@ -2512,6 +2826,7 @@ func commandSwitchWrites(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 	cancel := subFlags.Bool("cancel", false, "Cancel the failed migration and serve from source")
 	reverse := subFlags.Bool("reverse", false, "Reverse a previous SwitchWrites serve from source")
 	dryRun := subFlags.Bool("dry_run", false, "Does a dry run of SwitchWrites and only reports the actions to be taken")
+	shards := subFlags.String("shards", "", "Switch only the writes of these shards of a MoveTables workflow, comma separated. The source and target keyspaces must have the same shards")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
@@ -2527,6 +2842,13 @@ func commandSwitchWrites(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 		timeout = filteredReplicationWaitTime
 	}
 
+	if *shards != "" {
+		if *cancel || *dryRun || !*reverseReplication {
+			return fmt.Errorf("-cancel, -dry_run and -reverse_replication are not supported with -shards")
+		}
+		return wr.SwitchShardWrites(ctx, keyspace, workflow, strings.Split(*shards, ","), *timeout, *reverse)
+	}
+
 	journalID, dryRunResults, err := wr.SwitchWrites(ctx, keyspace, workflow, *timeout, *cancel, *reverse, *reverseReplication, *dryRun)
 	if err != nil {
 		return err
@@ -2796,7 +3118,7 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
 	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
 	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the SQL commands")
-	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyDirect), "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100'")
+	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyDirect), "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'online', 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100'")
 	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "The amount of time to wait for replicas to receive the schema change via replication.")
 	if err := subFlags.Parse(args); err != nil {
 		return err
@@ -0,0 +1,378 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// This file implements a VStream output in the Debezium format, so that
+// change data capture tools that understand Debezium can consume the
+// change events of vtgate without a custom translator.
+
+const (
+	// DebeziumVStreamHandler is the path of the HTTP endpoint that streams
+	// change events in the Debezium format. It takes a VStreamRequest
+	// encoded as JSON in the body of a POST, and responds with one
+	// Debezium record per line.
+	DebeziumVStreamHandler = "/vstream/debezium"
+
+	debeziumConnector   = "vitess"
+	debeziumDefaultName = "vitess"
+	ndjsonContentType   = "application/x-ndjson"
+)
+
+// Debezium operations.
+const (
+	debeziumCreate = "c"
+	debeziumUpdate = "u"
+	debeziumDelete = "d"
+	debeziumRead   = "r"
+)
+
+// debeziumRecord is a Debezium record, as it would be sent to a Kafka topic.
+type debeziumRecord struct {
+	Key   interface{} `json:"key"`
+	Value interface{} `json:"value"`
+}
+
+// debeziumChange is the value of a data change record.
+type debeziumChange struct {
+	Before *debeziumRow    `json:"before"`
+	After  *debeziumRow    `json:"after"`
+	Source *debeziumSource `json:"source"`
+	Op     string          `json:"op"`
+	TsMs   int64           `json:"ts_ms"`
+}
+
+// debeziumSchemaChange is the value of a schema change record.
+type debeziumSchemaChange struct {
+	Source       *debeziumSource `json:"source"`
+	DatabaseName string          `json:"databaseName"`
+	DDL          string          `json:"ddl"`
+	TsMs         int64           `json:"ts_ms"`
+}
+
+// debeziumHeartbeat is the value of a heartbeat record.
+type debeziumHeartbeat struct {
+	TsMs int64 `json:"ts_ms"`
+}
+
+// debeziumSource is the source block of the change records.
+// Vgtid is the JSON encoded VGtid to restart from after the event.
+type debeziumSource struct {
+	Connector string `json:"connector"`
+	Name      string `json:"name"`
+	TsMs      int64  `json:"ts_ms"`
+	Snapshot  string `json:"snapshot"`
+	DB        string `json:"db"`
+	Keyspace  string `json:"keyspace"`
+	Shard     string `json:"shard"`
+	Table     string `json:"table"`
+	Vgtid     string `json:"vgtid"`
+}
+
+// debeziumRow is a row image. It's encoded as a JSON object with
+// the columns in table order.
+type debeziumRow struct {
+	fields []*querypb.Field
+	values []sqltypes.Value
+}
+
+// MarshalJSON encodes the row as a JSON object. Numbers are encoded
+// as numbers, binary values as base64 strings and the rest as strings,
+// like Debezium does with the default settings.
+func (row *debeziumRow) MarshalJSON() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	buf.WriteByte('{')
+	for i, field := range row.fields {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		name, _ := json.Marshal(field.Name)
+		buf.Write(name)
+		buf.WriteByte(':')
+		val, err := json.Marshal(debeziumValue(field, row.values[i]))
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(val)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func debeziumValue(field *querypb.Field, val sqltypes.Value) interface{} {
+	switch {
+	case val.IsNull():
+		return nil
+	case sqltypes.IsIntegral(field.Type), sqltypes.IsFloat(field.Type):
+		return json.Number(val.ToString())
+	case sqltypes.IsBinary(field.Type):
+		return val.ToBytes()
+	}
+	return val.ToString()
+}
+
+// debeziumConverter converts the events of a VStream into Debezium records.
+// It remembers the fields of every table and the last VGTID, so the events
+// of a stream must be fed to the same converter in order.
+//
+// The VGTID of a transaction comes after its rows, so the rows are kept
+// in pending until that VGTID arrives, and their source carries the VGTID
+// to restart from once they have been consumed. Rows that are not followed
+// by a VGTID are never sent: a client restarting from the last VGTID it
+// received gets them again.
+type debeziumConverter struct {
+	name    string
+	vgtid   *binlogdatapb.VGtid
+	fields  map[string][]*querypb.Field
+	pending []*debeziumPendingRows
+	// now is mockable for tests.
+	now func() time.Time
+}
+
+// debeziumPendingRows is a row event waiting for the VGTID that
+// closes its transaction.
+type debeziumPendingRows struct {
+	event  *binlogdatapb.VEvent
+	fields []*querypb.Field
+}
+
+func newDebeziumConverter(name string) *debeziumConverter {
+	if name == "" {
+		name = debeziumDefaultName
+	}
+	return &debeziumConverter{
+		name:   name,
+		fields: make(map[string][]*querypb.Field),
+		now:    time.Now,
+	}
+}
+
+// convert returns the Debezium records for the events. Events that have
+// no Debezium equivalent produce no records.
+func (dc *debeziumConverter) convert(events []*binlogdatapb.VEvent) ([]*debeziumRecord, error) {
+	var records []*debeziumRecord
+	for _, event := range events {
+		switch event.Type {
+		case binlogdatapb.VEventType_VGTID:
+			dc.vgtid = event.Vgtid
+			for _, rows := range dc.pending {
+				recs, err := dc.convertRows(rows)
+				if err != nil {
+					return nil, err
+				}
+				records = append(records, recs...)
+			}
+			dc.pending = nil
+		case binlogdatapb.VEventType_FIELD:
+			dc.fields[event.FieldEvent.TableName] = event.FieldEvent.Fields
+		case binlogdatapb.VEventType_ROW:
+			fields, ok := dc.fields[event.RowEvent.TableName]
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "no fields for table %s", event.RowEvent.TableName)
+			}
+			dc.pending = append(dc.pending, &debeziumPendingRows{event: event, fields: fields})
+		case binlogdatapb.VEventType_DDL:
+			source, err := dc.source(event, "")
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, &debeziumRecord{
+				Key: map[string]string{"databaseName": event.Keyspace},
+				Value: &debeziumSchemaChange{
+					Source:       source,
+					DatabaseName: event.Keyspace,
+					DDL:          event.Statement,
+					TsMs:         dc.now().UnixNano() / int64(time.Millisecond),
+				},
+			})
+		case binlogdatapb.VEventType_HEARTBEAT:
+			records = append(records, &debeziumRecord{
+				Key:   map[string]string{"serverName": dc.name},
+				Value: &debeziumHeartbeat{TsMs: dc.now().UnixNano() / int64(time.Millisecond)},
+			})
+		}
+	}
+	return records, nil
+}
+
+func (dc *debeziumConverter) convertRows(rows *debeziumPendingRows) ([]*debeziumRecord, error) {
+	event, fields := rows.event, rows.fields
+	table := strings.TrimPrefix(event.RowEvent.TableName, event.Keyspace+".")
+	source, err := dc.source(event, table)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]*debeziumRecord, 0, len(event.RowEvent.RowChanges))
+	for _, change := range event.RowEvent.RowChanges {
+		value := &debeziumChange{
+			Source: source,
+			TsMs:   dc.now().UnixNano() / int64(time.Millisecond),
+		}
+		if change.Before != nil {
+			value.Before = &debeziumRow{fields: fields, values: sqltypes.MakeRowTrusted(fields, change.Before)}
+		}
+		if change.After != nil {
+			value.After = &debeziumRow{fields: fields, values: sqltypes.MakeRowTrusted(fields, change.After)}
+		}
+		switch {
+		case value.Before == nil && source.Snapshot == "true":
+			value.Op = debeziumRead
+		case value.Before == nil:
+			value.Op = debeziumCreate
+		case value.After == nil:
+			value.Op = debeziumDelete
+		default:
+			value.Op = debeziumUpdate
+		}
+		image := value.After
+		if image == nil {
+			image = value.Before
+		}
+		records = append(records, &debeziumRecord{
+			Key:   debeziumKey(image),
+			Value: value,
+		})
+	}
+	return records, nil
+}
+
+// debeziumKey returns the primary key columns of the row, or nil
+// if the table has no primary key.
+func debeziumKey(row *debeziumRow) *debeziumRow {
+	key := &debeziumRow{}
+	for i, field := range row.fields {
+		if field.Flags&uint32(querypb.MySqlFlag_PRI_KEY_FLAG) == 0 {
+			continue
+		}
+		key.fields = append(key.fields, field)
+		key.values = append(key.values, row.values[i])
+	}
+	if len(key.fields) == 0 {
+		return nil
+	}
+	return key
+}
+
+func (dc *debeziumConverter) source(event *binlogdatapb.VEvent, table string) (*debeziumSource, error) {
+	source := &debeziumSource{
+		Connector: debeziumConnector,
+		Name:      dc.name,
+		TsMs:      event.Timestamp * 1000,
+		Snapshot:  "false",
+		DB:        event.Keyspace,
+		Keyspace:  event.Keyspace,
+		Shard:     event.Shard,
+		Table:     table,
+	}
+	if dc.vgtid == nil {
+		return source, nil
+	}
+	vgtid, err := (&jsonpb.Marshaler{OrigName: true}).MarshalToString(dc.vgtid)
+	if err != nil {
+		return nil, err
+	}
+	source.Vgtid = vgtid
+	// The rows of a table are a snapshot while the table is being copied.
+	for _, sgtid := range dc.vgtid.ShardGtids {
+		if sgtid.Keyspace != event.Keyspace || sgtid.Shard != event.Shard {
+			continue
+		}
+		for _, tablePK := range sgtid.TablePKs {
+			if table != "" && tablePK.TableName == table {
+				source.Snapshot = "true"
+			}
+		}
+	}
+	return source, nil
+}
+
+// vstreamFunc is the signature of VTGate.VStream.
+type vstreamFunc func(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error
+
+// serveDebeziumVStream streams the events requested by the VStreamRequest
+// in the body of the request as Debezium records, one per line.
+// The optional name parameter is the logical name of the source.
+func serveDebeziumVStream(w http.ResponseWriter, r *http.Request, vstream vstreamFunc) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+	request := &vtgatepb.VStreamRequest{}
+	if err := jsonpb.Unmarshal(r.Body, request); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	dc := newDebeziumConverter(r.FormValue("name"))
+	flusher, _ := w.(http.Flusher)
+	enc := json.NewEncoder(w)
+	started := false
+	err := vstream(r.Context(), request.TabletType, request.Vgtid, request.Filter, request.Flags, func(events []*binlogdatapb.VEvent) error {
+		records, err := dc.convert(events)
+		if err != nil {
+			return err
+		}
+		if !started {
+			w.Header().Set("Content-Type", ndjsonContentType)
+			started = true
+		}
+		for _, record := range records {
+			if err := enc.Encode(record); err != nil {
+				return err
+			}
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err == nil || r.Context().Err() != nil {
+		return
+	}
+	if !started {
+		status := http.StatusInternalServerError
+		if vterrors.Code(err) == vtrpcpb.Code_INVALID_ARGUMENT {
+			status = http.StatusBadRequest
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	// The response has already started: report the error as the last line.
+	log.Errorf("Debezium vstream error: %v", err)
+	_ = enc.Encode(map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,261 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var debeziumTestEvents = []*binlogdatapb.VEvent{{
+	Type: binlogdatapb.VEventType_VGTID,
+	Vgtid: &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "ks",
+			Shard:    "-80",
+			Gtid:     "pos0",
+		}},
+	},
+}, {
+	Type: binlogdatapb.VEventType_BEGIN,
+}, {
+	Type:     binlogdatapb.VEventType_FIELD,
+	Keyspace: "ks",
+	Shard:    "-80",
+	FieldEvent: &binlogdatapb.FieldEvent{
+		TableName: "ks.t1",
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int64, Flags: uint32(querypb.MySqlFlag_PRI_KEY_FLAG)},
+			{Name: "val", Type: sqltypes.VarChar},
+			{Name: "price", Type: sqltypes.Decimal},
+			{Name: "data", Type: sqltypes.VarBinary},
+		},
+	},
+}, {
+	Type:      binlogdatapb.VEventType_ROW,
+	Timestamp: 10,
+	Keyspace:  "ks",
+	Shard:     "-80",
+	RowEvent: &binlogdatapb.RowEvent{
+		TableName: "ks.t1",
+		RowChanges: []*binlogdatapb.RowChange{{
+			After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("aaa"), sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.50")), sqltypes.NewVarBinary("\x01")}),
+		}, {
+			Before: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("aaa"), sqltypes.MakeTrusted(sqltypes.Decimal, []byte("1.50")), sqltypes.NewVarBinary("\x01")}),
+			After:  sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("bbb"), sqltypes.NULL, sqltypes.NewVarBinary("\x01")}),
+		}, {
+			Before: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewVarChar("bbb"), sqltypes.NULL, sqltypes.NewVarBinary("\x01")}),
+		}},
+	},
+}, {
+	Type:     binlogdatapb.VEventType_FIELD,
+	Keyspace: "ks",
+	Shard:    "-80",
+	FieldEvent: &binlogdatapb.FieldEvent{
+		TableName: "ks.t2",
+		Fields:    []*querypb.Field{{Name: "id", Type: sqltypes.Int32}},
+	},
+}, {
+	Type:     binlogdatapb.VEventType_ROW,
+	Keyspace: "ks",
+	Shard:    "-80",
+	RowEvent: &binlogdatapb.RowEvent{
+		TableName: "ks.t2",
+		RowChanges: []*binlogdatapb.RowChange{{
+			After: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt32(2)}),
+		}},
+	},
+}, {
+	// The VGTID that closes the transaction comes after its rows.
+	Type: binlogdatapb.VEventType_VGTID,
+	Vgtid: &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "ks",
+			Shard:    "-80",
+			Gtid:     "pos1",
+			TablePKs: []*binlogdatapb.TableLastPK{{TableName: "t2"}},
+		}},
+	},
+}, {
+	Type: binlogdatapb.VEventType_COMMIT,
+}, {
+	// The VGTID of a DDL comes before it.
+	Type: binlogdatapb.VEventType_VGTID,
+	Vgtid: &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "ks",
+			Shard:    "-80",
+			Gtid:     "pos2",
+		}},
+	},
+}, {
+	Type:      binlogdatapb.VEventType_DDL,
+	Timestamp: 20,
+	Keyspace:  "ks",
+	Shard:     "-80",
+	Statement: "alter table t1 add column c int",
+}, {
+	Type: binlogdatapb.VEventType_HEARTBEAT,
+}}
+
+var debeziumTestOutput = []string{
+	`{"key":{"id":1},"value":{"before":null,"after":{"id":1,"val":"aaa","price":"1.50","data":"AQ=="},"source":{"connector":"vitess","name":"test","ts_ms":10000,"snapshot":"false","db":"ks","keyspace":"ks","shard":"-80","table":"t1","vgtid":"{\"shard_gtids\":[{\"keyspace\":\"ks\",\"shard\":\"-80\",\"gtid\":\"pos1\",\"table_p_ks\":[{\"table_name\":\"t2\"}]}]}"},"op":"c","ts_ms":1000}}`,
+	`{"key":{"id":1},"value":{"before":{"id":1,"val":"aaa","price":"1.50","data":"AQ=="},"after":{"id":1,"val":"bbb","price":null,"data":"AQ=="},"source":{"connector":"vitess","name":"test","ts_ms":10000,"snapshot":"false","db":"ks","keyspace":"ks","shard":"-80","table":"t1","vgtid":"{\"shard_gtids\":[{\"keyspace\":\"ks\",\"shard\":\"-80\",\"gtid\":\"pos1\",\"table_p_ks\":[{\"table_name\":\"t2\"}]}]}"},"op":"u","ts_ms":1000}}`,
+	`{"key":{"id":1},"value":{"before":{"id":1,"val":"bbb","price":null,"data":"AQ=="},"after":null,"source":{"connector":"vitess","name":"test","ts_ms":10000,"snapshot":"false","db":"ks","keyspace":"ks","shard":"-80","table":"t1","vgtid":"{\"shard_gtids\":[{\"keyspace\":\"ks\",\"shard\":\"-80\",\"gtid\":\"pos1\",\"table_p_ks\":[{\"table_name\":\"t2\"}]}]}"},"op":"d","ts_ms":1000}}`,
+	`{"key":null,"value":{"before":null,"after":{"id":2},"source":{"connector":"vitess","name":"test","ts_ms":0,"snapshot":"true","db":"ks","keyspace":"ks","shard":"-80","table":"t2","vgtid":"{\"shard_gtids\":[{\"keyspace\":\"ks\",\"shard\":\"-80\",\"gtid\":\"pos1\",\"table_p_ks\":[{\"table_name\":\"t2\"}]}]}"},"op":"r","ts_ms":1000}}`,
+	`{"key":{"databaseName":"ks"},"value":{"source":{"connector":"vitess","name":"test","ts_ms":20000,"snapshot":"false","db":"ks","keyspace":"ks","shard":"-80","table":"","vgtid":"{\"shard_gtids\":[{\"keyspace\":\"ks\",\"shard\":\"-80\",\"gtid\":\"pos2\"}]}"},"databaseName":"ks","ddl":"alter table t1 add column c int","ts_ms":1000}}`,
+	`{"key":{"serverName":"test"},"value":{"ts_ms":1000}}`,
+}
+
+func newTestDebeziumConverter() *debeziumConverter {
+	dc := newDebeziumConverter("test")
+	dc.now = func() time.Time { return time.Unix(1, 0) }
+	return dc
+}
+
+func TestDebeziumConvert(t *testing.T) {
+	dc := newTestDebeziumConverter()
+	records, err := dc.convert(debeziumTestEvents)
+	require.NoError(t, err)
+	var got []string
+	for _, record := range records {
+		b, err := json.Marshal(record)
+		require.NoError(t, err)
+		got = append(got, string(b))
+	}
+	assert.Equal(t, debeziumTestOutput, got)
+
+	// Rows are only sent with the VGTID that closes their transaction.
+	rows := []*binlogdatapb.VEvent{debeziumTestEvents[2], debeziumTestEvents[3]}
+	records, err = dc.convert(rows)
+	require.NoError(t, err)
+	assert.Empty(t, records)
+	records, err = dc.convert([]*binlogdatapb.VEvent{{
+		Type: binlogdatapb.VEventType_VGTID,
+		Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: "ks",
+				Shard:    "-80",
+				Gtid:     "pos3",
+			}},
+		},
+	}})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	for _, record := range records {
+		assert.Equal(t, `{"shard_gtids":[{"keyspace":"ks","shard":"-80","gtid":"pos3"}]}`, record.Value.(*debeziumChange).Source.Vgtid)
+	}
+
+	_, err = dc.convert([]*binlogdatapb.VEvent{{
+		Type:     binlogdatapb.VEventType_ROW,
+		RowEvent: &binlogdatapb.RowEvent{TableName: "ks.t3"},
+	}})
+	assert.EqualError(t, err, "no fields for table ks.t3")
+
+	assert.Equal(t, "vitess", newDebeziumConverter("").name)
+}
+
+func TestServeDebeziumVStream(t *testing.T) {
+	request := `{"tablet_type": "MASTER", "vgtid": {"shard_gtids": [{"keyspace": "ks", "gtid": "current"}]}, "flags": {"heartbeat_interval": 1}}`
+	wantVgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "ks",
+			Gtid:     "current",
+		}},
+	}
+	var vstreamErr error
+	vstream := func(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error {
+		assert.Equal(t, topodatapb.TabletType_MASTER, tabletType)
+		assert.True(t, proto.Equal(wantVgtid, vgtid), "vgtid: %v", vgtid)
+		assert.Equal(t, uint32(1), flags.GetHeartbeatInterval())
+		if vstreamErr == nil {
+			return send(debeziumTestEvents)
+		}
+		if err := send([]*binlogdatapb.VEvent{{Type: binlogdatapb.VEventType_HEARTBEAT}}); err != nil {
+			return err
+		}
+		return vstreamErr
+	}
+	serve := func(w http.ResponseWriter, r *http.Request) {
+		serveDebeziumVStream(w, r, vstream)
+	}
+
+	req := httptest.NewRequest(http.MethodPost, DebeziumVStreamHandler+"?name=test", strings.NewReader(request))
+	w := httptest.NewRecorder()
+	serve(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, ndjsonContentType, w.Header().Get("Content-Type"))
+	lines := strings.Split(strings.TrimSuffix(w.Body.String(), "\n"), "\n")
+	require.Len(t, lines, len(debeziumTestOutput))
+	// The processing times are not mocked here: ignore them.
+	for i, line := range lines {
+		var got, want map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(line), &got))
+		require.NoError(t, json.Unmarshal([]byte(debeziumTestOutput[i]), &want))
+		delete(got["value"].(map[string]interface{}), "ts_ms")
+		delete(want["value"].(map[string]interface{}), "ts_ms")
+		assert.Equal(t, want, got, "line %d", i)
+	}
+
+	// Errors that happen after the stream started are sent as the last line.
+	vstreamErr = errors.New("stream failed")
+	req = httptest.NewRequest(http.MethodPost, DebeziumVStreamHandler, strings.NewReader(request))
+	w = httptest.NewRecorder()
+	serve(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, strings.HasSuffix(w.Body.String(), "{\"error\":\"stream failed\"}\n"), w.Body.String())
+
+	// Errors before the stream started are returned as HTTP errors.
+	vstream = func(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func([]*binlogdatapb.VEvent) error) error {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "vgtid must have at least one value with a starting position")
+	}
+	req = httptest.NewRequest(http.MethodPost, DebeziumVStreamHandler, strings.NewReader(request))
+	w = httptest.NewRecorder()
+	serve(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "vgtid must have at least one value with a starting position")
+
+	req = httptest.NewRequest(http.MethodPost, DebeziumVStreamHandler, strings.NewReader("{invalid"))
+	w = httptest.NewRecorder()
+	serve(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.Contains(t, w.Body.String(), "cannot parse request")
+
+	req = httptest.NewRequest(http.MethodGet, DebeziumVStreamHandler, nil)
+	w = httptest.NewRecorder()
+	serve(w, req)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
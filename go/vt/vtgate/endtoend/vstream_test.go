@@ -79,7 +79,7 @@ func TestVStream(t *testing.T) {
 			Match: "/.*/",
 		}},
 	}
-	reader, err := gconn.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter)
+	reader, err := gconn.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter, nil)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -194,7 +194,7 @@ func TestVStreamCopyBasic(t *testing.T) {
 			Filter: "select * from t1",
 		}},
 	}
-	reader, err := gconn.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter)
+	reader, err := gconn.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter, nil)
 	_, _ = conn, mconn
 	if err != nil {
 		t.Fatal(err)
@@ -247,7 +247,7 @@ func TestVStreamCurrent(t *testing.T) {
 			Filter: "select * from t1",
 		}},
 	}
-	reader, err := gconn.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter)
+	reader, err := gconn.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, filter, nil)
 	_, _ = conn, mconn
 	if err != nil {
 		t.Fatal(err)
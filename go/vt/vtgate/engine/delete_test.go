@@ -87,6 +87,35 @@ func TestDeleteEqual(t *testing.T) {
 	expectError(t, "Execute", err, "execDeleteEqual: missing bind var aa")
 }
 
+func TestDeleteEqualMultiCol(t *testing.T) {
+	vindex, _ := vindexes.NewMultiCol("", map[string]string{"column_count": "2"})
+	del := &Delete{
+		DML: DML{
+			Opcode: Equal,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			Query:  "dummy_delete",
+			Vindex: vindex,
+			Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(166b40b406e7ea22)`,
+		`ExecuteMultiShard ks.-20: dummy_delete {} true true`,
+	})
+
+	// Failure case
+	del.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Key: "aa"}}
+	_, err = del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execDeleteEqual: missing bind var aa")
+}
+
 func TestDeleteEqualNoRoute(t *testing.T) {
 	vindex, _ := vindexes.NewLookupUnique("", map[string]string{
 		"table": "lkp",
@@ -152,6 +181,7 @@ func TestDeleteOwnedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 	}
 
@@ -264,6 +294,7 @@ func TestDeleteScatterOwnedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 	}
 
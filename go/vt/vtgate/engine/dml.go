@@ -40,14 +40,18 @@ type DML struct {
 	Query string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 
 	// Values specifies the vindex values to use for routing.
-	// For now, only one value is specified.
+	// For a multi-column vindex, there is one value per column.
 	Values []sqltypes.PlanValue
 
 	// Keyspace Id Vindex
-	KsidVindex vindexes.SingleColumn
+	KsidVindex vindexes.Vindex
+
+	// KsidLength is the number of columns of the KsidVindex
+	// that lead the rows of the OwnedVindexQuery.
+	KsidLength int
 
 	// Table specifies the table for the update.
 	Table *vindexes.Table
@@ -100,12 +104,12 @@ func (op DMLOpcode) String() string {
 	return opcodeName[op]
 }
 
-func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query string, bindVars map[string]*querypb.BindVariable, pv sqltypes.PlanValue, vindex vindexes.SingleColumn) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, table string, query string, bindVars map[string]*querypb.BindVariable, pv sqltypes.PlanValue, vindex vindexes.Vindex) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
 	keys, err := pv.ResolveList(bindVars)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "execDeleteIn")
 	}
-	rss, err := resolveMultiShard(vcursor, vindex, keyspace, keys)
+	rss, err := resolveMultiShard(vcursor, vindex, keyspace, table, keys)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "execDeleteIn")
 	}
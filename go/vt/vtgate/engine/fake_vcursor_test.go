@@ -215,6 +215,10 @@ func (t noopVCursor) ResolveDestinations(keyspace string, ids []*querypb.Value,
 	panic("unimplemented")
 }
 
+func (t noopVCursor) ResolveDMLDestinations(keyspace, table string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	panic("unimplemented")
+}
+
 func (t noopVCursor) SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error {
 	panic("unimplemented")
 }
@@ -367,6 +371,10 @@ func (f *loggingVCursor) StreamExecuteMulti(query string, rss []*srvtopo.Resolve
 	return callback(r)
 }
 
+func (f *loggingVCursor) ResolveDMLDestinations(keyspace, table string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	return f.ResolveDestinations(keyspace, ids, destinations)
+}
+
 func (f *loggingVCursor) ResolveDestinations(keyspace string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	f.log = append(f.log, fmt.Sprintf("ResolveDestinations %v %v %v", keyspace, ids, key.DestinationsString(destinations)))
 	if f.shardErr != nil {
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*Filter)(nil)
+
+// Filter is a primitive that returns the rows of its input
+// for which the predicate is true. It is used when the
+// predicate cannot be sent to the underlying routes, like a
+// HAVING clause on the results of a scatter aggregation.
+type Filter struct {
+	Predicate evalengine.Expr
+	Input     Primitive
+
+	// TruncateColumnCount specifies the number of columns to return
+	// in the final result. Rest of the columns are truncated
+	// from the result received. If 0, no truncation happens.
+	TruncateColumnCount int `json:",omitempty"`
+
+	noTxNeeded
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (f *Filter) RouteType() string {
+	return f.Input.RouteType()
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (f *Filter) GetKeyspaceName() string {
+	return f.Input.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (f *Filter) GetTableName() string {
+	return f.Input.GetTableName()
+}
+
+// SetTruncateColumnCount sets the truncate column count.
+func (f *Filter) SetTruncateColumnCount(count int) {
+	f.TruncateColumnCount = count
+}
+
+// Execute satisfies the Primitive interface.
+func (f *Filter) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result, err := f.Input.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	return f.filter(result, bindVars)
+}
+
+// StreamExecute satisfies the Primitive interface.
+func (f *Filter) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	return f.Input.StreamExecute(vcursor, bindVars, wantfields, func(result *sqltypes.Result) error {
+		qr, err := f.filter(result, bindVars)
+		if err != nil {
+			return err
+		}
+		return callback(qr)
+	})
+}
+
+// GetFields satisfies the Primitive interface.
+func (f *Filter) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := f.Input.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Truncate(f.TruncateColumnCount), nil
+}
+
+// filter returns the rows of the input for which the predicate is true.
+// A NULL result does not match, like in a WHERE clause.
+func (f *Filter) filter(input *sqltypes.Result, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{
+		Fields: input.Fields,
+	}
+	env := evalengine.ExpressionEnv{
+		BindVars: bindVars,
+	}
+	for _, row := range input.Rows {
+		env.Row = row
+		res, err := f.Predicate.Evaluate(env)
+		if err != nil {
+			return nil, err
+		}
+		if res.ToBoolean() {
+			result.Rows = append(result.Rows, row)
+		}
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result.Truncate(f.TruncateColumnCount), nil
+}
+
+// Inputs returns the input to this primitive
+func (f *Filter) Inputs() []Primitive {
+	return []Primitive{f.Input}
+}
+
+func (f *Filter) description() PrimitiveDescription {
+	other := map[string]interface{}{
+		"Predicate": f.Predicate.String(),
+	}
+	if f.TruncateColumnCount > 0 {
+		other["ResultColumns"] = f.TruncateColumnCount
+	}
+	return PrimitiveDescription{
+		OperatorType: "Filter",
+		Other:        other,
+	}
+}
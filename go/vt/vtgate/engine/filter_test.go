@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestFilterExecute(t *testing.T) {
+	// select col, count(*) from t group by col having count(*) > :n
+	filter := &Filter{
+		Predicate: &evalengine.ComparisonExpr{
+			Op:    &evalengine.GreaterThanOp{},
+			Left:  evalengine.NewColumn(1),
+			Right: evalengine.NewBindVar("n"),
+		},
+		Input: &fakePrimitive{results: []*sqltypes.Result{
+			r("col|count(*)", "varchar|int64",
+				"a|1",
+				"b|3",
+				"null|5",
+				"c|2",
+			),
+		}},
+	}
+	bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(1)}
+	want := r("col|count(*)", "varchar|int64",
+		"b|3",
+		"null|5",
+		"c|2",
+	)
+
+	qr, err := filter.Execute(&noopVCursor{ctx: context.Background()}, bindVars, true)
+	require.NoError(t, err)
+	assert.Equal(t, want, qr)
+
+	filter.Input.(*fakePrimitive).rewind()
+	qr, err = wrapStreamExecute(filter, &noopVCursor{ctx: context.Background()}, bindVars, true)
+	require.NoError(t, err)
+	assert.Equal(t, want.Fields, qr.Fields)
+	assert.Equal(t, want.Rows, qr.Rows)
+}
+
+func TestFilterNullIsFalse(t *testing.T) {
+	filter := &Filter{
+		Predicate: &evalengine.ComparisonExpr{
+			Op:    &evalengine.EqualOp{},
+			Left:  evalengine.NewColumn(0),
+			Right: evalengine.NewLiteralInt(1),
+		},
+		Input: &fakePrimitive{results: []*sqltypes.Result{
+			r("a", "int64", "1", "null", "2"),
+		}},
+	}
+
+	qr, err := filter.Execute(&noopVCursor{ctx: context.Background()}, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, r("a", "int64", "1").Rows, qr.Rows)
+}
+
+func TestFilterTruncate(t *testing.T) {
+	// select col from t group by col having count(*) > 1
+	filter := &Filter{
+		Predicate: &evalengine.ComparisonExpr{
+			Op:    &evalengine.GreaterThanOp{},
+			Left:  evalengine.NewColumn(1),
+			Right: evalengine.NewLiteralInt(1),
+		},
+		Input: &fakePrimitive{results: []*sqltypes.Result{
+			r("col|count(*)", "varchar|int64",
+				"a|1",
+				"b|2",
+			),
+		}},
+		TruncateColumnCount: 1,
+	}
+	want := r("col", "varchar", "b")
+
+	qr, err := filter.Execute(&noopVCursor{ctx: context.Background()}, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, want.Fields, qr.Fields)
+	assert.Equal(t, want.Rows, qr.Rows)
+
+	filter.Input.(*fakePrimitive).rewind()
+	qr, err = filter.GetFields(&noopVCursor{ctx: context.Background()}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, want.Fields, qr.Fields)
+}
+
+func TestFilterInputError(t *testing.T) {
+	filter := &Filter{
+		Predicate: evalengine.NewLiteralInt(1),
+		Input:     &fakePrimitive{sendErr: errors.New("input error")},
+	}
+
+	_, err := filter.Execute(&noopVCursor{ctx: context.Background()}, nil, false)
+	require.EqualError(t, err, "input error")
+
+	_, err = wrapStreamExecute(filter, &noopVCursor{ctx: context.Background()}, nil, false)
+	require.EqualError(t, err, "input error")
+}
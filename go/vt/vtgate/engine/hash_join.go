@@ -0,0 +1,313 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ Primitive = (*HashJoin)(nil)
+
+// HashJoin specifies the parameters for a hash join primitive.
+// Unlike Join, which executes the RHS once for every row of the LHS,
+// HashJoin executes both sides only once. The LHS result is
+// materialized in memory into a hash table keyed by the join
+// columns, and the rows of the RHS are matched against it
+// as they come.
+type HashJoin struct {
+	// Left and Right are the LHS and RHS primitives
+	// of the HashJoin. They can be any primitive.
+	Left, Right Primitive `json:",omitempty"`
+
+	// Cols defines which columns from the left
+	// or right results should be used to build the
+	// return result. It follows the same convention
+	// as Join: -1, -2, etc. for the left columns,
+	// and 1, 2, etc. for the right columns.
+	Cols []int `json:",omitempty"`
+
+	// Keys are the pairs of columns compared for
+	// equality by the join.
+	Keys []HashJoinKey `json:",omitempty"`
+}
+
+// HashJoinKey is a pair of columns compared by a HashJoin.
+type HashJoinKey struct {
+	// LHS and RHS are the column numbers of the key
+	// in the left and right results.
+	LHS, RHS int
+
+	// LHSWeightString and RHSWeightString are the column numbers
+	// of the weight_string of the key in the left and right results,
+	// or -1 if not available. Text keys are compared by their weight
+	// strings, so that the collation is honored, and fail without them.
+	LHSWeightString, RHSWeightString int
+}
+
+// Execute performs a non-streaming exec.
+func (hj *HashJoin) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	hashTable, err := hj.buildHashTable(vcursor, lresult.Rows)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return nil, err
+	}
+	result := &sqltypes.Result{}
+	if wantfields {
+		result.Fields = joinFields(lresult.Fields, rresult.Fields, hj.Cols)
+	}
+	result.Rows, err = hashTable.join(rresult.Rows, hj.Cols)
+	if err != nil {
+		return nil, err
+	}
+	if vcursor.ExceedsMaxMemoryRows(len(result.Rows)) {
+		return nil, fmt.Errorf("in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	result.RowsAffected = uint64(len(result.Rows))
+	return result, nil
+}
+
+// StreamExecute performs a streaming exec.
+func (hj *HashJoin) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	var lfields []*querypb.Field
+	var lrows [][]sqltypes.Value
+	err := hj.Left.StreamExecute(vcursor, bindVars, wantfields, func(lresult *sqltypes.Result) error {
+		if lfields == nil {
+			lfields = lresult.Fields
+		}
+		lrows = append(lrows, lresult.Rows...)
+		if vcursor.ExceedsMaxMemoryRows(len(lrows)) {
+			return hashJoinMemoryError(vcursor)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	hashTable, err := hj.buildHashTable(vcursor, lrows)
+	if err != nil {
+		return err
+	}
+	return hj.Right.StreamExecute(vcursor, bindVars, wantfields, func(rresult *sqltypes.Result) error {
+		result := &sqltypes.Result{}
+		if wantfields && rresult.Fields != nil {
+			wantfields = false
+			result.Fields = joinFields(lfields, rresult.Fields, hj.Cols)
+		}
+		rows, err := hashTable.join(rresult.Rows, hj.Cols)
+		if err != nil {
+			return err
+		}
+		result.Rows = rows
+		if result.Fields == nil && len(result.Rows) == 0 {
+			return nil
+		}
+		return callback(result)
+	})
+}
+
+// GetFields fetches the field info.
+func (hj *HashJoin) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	lresult, err := hj.Left.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	rresult, err := hj.Right.GetFields(vcursor, bindVars)
+	if err != nil {
+		return nil, err
+	}
+	return &sqltypes.Result{Fields: joinFields(lresult.Fields, rresult.Fields, hj.Cols)}, nil
+}
+
+// Inputs returns the input primitives for this join
+func (hj *HashJoin) Inputs() []Primitive {
+	return []Primitive{hj.Left, hj.Right}
+}
+
+// RouteType returns a description of the query routing type used by the primitive
+func (hj *HashJoin) RouteType() string {
+	return "HashJoin"
+}
+
+// GetKeyspaceName specifies the Keyspace that this primitive routes to.
+func (hj *HashJoin) GetKeyspaceName() string {
+	if hj.Left.GetKeyspaceName() == hj.Right.GetKeyspaceName() {
+		return hj.Left.GetKeyspaceName()
+	}
+	return hj.Left.GetKeyspaceName() + "_" + hj.Right.GetKeyspaceName()
+}
+
+// GetTableName specifies the table that this primitive routes to.
+func (hj *HashJoin) GetTableName() string {
+	return hj.Left.GetTableName() + "_" + hj.Right.GetTableName()
+}
+
+// NeedsTransaction implements the Primitive interface
+func (hj *HashJoin) NeedsTransaction() bool {
+	return hj.Right.NeedsTransaction() || hj.Left.NeedsTransaction()
+}
+
+func (hj *HashJoin) description() PrimitiveDescription {
+	var lhsKeys, rhsKeys []string
+	for _, key := range hj.Keys {
+		lhsKeys = append(lhsKeys, strconv.Itoa(key.LHS))
+		rhsKeys = append(rhsKeys, strconv.Itoa(key.RHS))
+	}
+	other := map[string]interface{}{
+		"TableName":         hj.GetTableName(),
+		"JoinColumnIndexes": strings.Trim(strings.Join(strings.Fields(fmt.Sprint(hj.Cols)), ","), "[]"),
+		"LHSKeys":           strings.Join(lhsKeys, ","),
+		"RHSKeys":           strings.Join(rhsKeys, ","),
+	}
+	return PrimitiveDescription{
+		OperatorType: "Join",
+		Variant:      "HashJoin",
+		Other:        other,
+	}
+}
+
+func hashJoinMemoryError(vcursor VCursor) error {
+	return fmt.Errorf("hash join: in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+}
+
+// hashTable is the in-memory hash table built from the LHS rows.
+type hashTable struct {
+	keys []HashJoinKey
+	rows map[string][][]sqltypes.Value
+}
+
+func (hj *HashJoin) buildHashTable(vcursor VCursor, lrows [][]sqltypes.Value) (*hashTable, error) {
+	if vcursor.ExceedsMaxMemoryRows(len(lrows)) {
+		return nil, hashJoinMemoryError(vcursor)
+	}
+	pt := &hashTable{
+		keys: hj.Keys,
+		rows: make(map[string][][]sqltypes.Value),
+	}
+	for _, lrow := range lrows {
+		hash, ok, err := pt.hash(lrow, true)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			// NULL values never match.
+			continue
+		}
+		pt.rows[hash] = append(pt.rows[hash], lrow)
+	}
+	return pt, nil
+}
+
+// join returns the joined rows for the RHS rows that have a match.
+func (pt *hashTable) join(rrows [][]sqltypes.Value, cols []int) ([][]sqltypes.Value, error) {
+	var rows [][]sqltypes.Value
+	for _, rrow := range rrows {
+		hash, ok, err := pt.hash(rrow, false)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		for _, lrow := range pt.rows[hash] {
+			match, err := pt.matches(lrow, rrow)
+			if err != nil {
+				return nil, err
+			}
+			if match {
+				rows = append(rows, joinRows(lrow, rrow, cols))
+			}
+		}
+	}
+	return rows, nil
+}
+
+// hash computes the hash of the keys of a row. It returns false
+// if any of the keys is NULL.
+func (pt *hashTable) hash(row []sqltypes.Value, left bool) (string, bool, error) {
+	var buf strings.Builder
+	for _, key := range pt.keys {
+		col, weightString := key.RHS, key.RHSWeightString
+		if left {
+			col, weightString = key.LHS, key.LHSWeightString
+		}
+		v := row[col]
+		if v.IsNull() {
+			return "", false, nil
+		}
+		switch {
+		case sqltypes.IsNumber(v.Type()):
+			// Numbers of different types can be equal, so they are hashed
+			// by their numeric value, and compared afterwards.
+			hashcode, err := evalengine.NullsafeHashcode(v)
+			if err != nil {
+				return "", false, err
+			}
+			buf.WriteString("n")
+			buf.WriteString(strconv.FormatInt(hashcode, 10))
+		case v.IsText():
+			// Text values are only equal under their collation, which
+			// is what the weight string encodes.
+			if weightString < 0 {
+				return "", false, fmt.Errorf("hash join: no weight_string for text value of type %v", v.Type())
+			}
+			ws := row[weightString].ToBytes()
+			buf.WriteString("s")
+			buf.WriteString(strconv.Itoa(len(ws)))
+			buf.WriteString(":")
+			buf.Write(ws)
+		default:
+			raw := v.ToBytes()
+			buf.WriteString("s")
+			buf.WriteString(strconv.Itoa(len(raw)))
+			buf.WriteString(":")
+			buf.Write(raw)
+		}
+	}
+	return buf.String(), true, nil
+}
+
+// matches eliminates the false positives of the numeric hash codes.
+// Other values are matched exactly by their hash.
+func (pt *hashTable) matches(lrow, rrow []sqltypes.Value) (bool, error) {
+	for _, key := range pt.keys {
+		lv, rv := lrow[key.LHS], rrow[key.RHS]
+		if !sqltypes.IsNumber(lv.Type()) {
+			continue
+		}
+		cmp, err := evalengine.NullsafeCompare(lv, rv)
+		if err != nil {
+			return false, err
+		}
+		if cmp != 0 {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestHashJoinExecute(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			r("col1|col2", "int64|varchar",
+				"1|a",
+				"2|b",
+				"3|c",
+				"null|d",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			r("col3|col4", "int32|varchar",
+				"1|x",
+				"3|y",
+				"3|z",
+				"4|w",
+				"null|v",
+			),
+		},
+	}
+
+	// select t1.col2, t2.col4 from t1 join t2 on t1.col1 = t2.col3
+	hj := &HashJoin{
+		Left:  leftPrim,
+		Right: rightPrim,
+		Cols:  []int{-2, 2},
+		Keys:  []HashJoinKey{{LHS: 0, RHS: 0, LHSWeightString: -1, RHSWeightString: -1}},
+	}
+	want := r("col2|col4", "varchar|varchar",
+		"a|x",
+		"c|y",
+		"c|z",
+	)
+
+	qr, err := hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{`Execute  true`})
+	rightPrim.ExpectLog(t, []string{`Execute  true`})
+	expectResult(t, "hj.Execute", qr, want)
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	qr, err = wrapStreamExecute(hj, &noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	leftPrim.ExpectLog(t, []string{`StreamExecute  true`})
+	rightPrim.ExpectLog(t, []string{`StreamExecute  true`})
+	expectResult(t, "hj.StreamExecute", qr, want)
+
+	leftPrim.rewind()
+	rightPrim.rewind()
+	qr, err = hj.GetFields(&noopVCursor{}, map[string]*querypb.BindVariable{})
+	require.NoError(t, err)
+	assert.Equal(t, want.Fields, qr.Fields)
+}
+
+func TestHashJoinCompositeKey(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			r("a|b|id", "int64|int64|int64",
+				"1|1|10",
+				"1|2|11",
+				"2|1|12",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			r("a|b|id", "decimal|int64|int64",
+				"1.0|2|20",
+				"2|2|21",
+				"2.0|1|22",
+			),
+		},
+	}
+
+	hj := &HashJoin{
+		Left:  leftPrim,
+		Right: rightPrim,
+		Cols:  []int{-3, 3},
+		Keys: []HashJoinKey{
+			{LHS: 0, RHS: 0, LHSWeightString: -1, RHSWeightString: -1},
+			{LHS: 1, RHS: 1, LHSWeightString: -1, RHSWeightString: -1},
+		},
+	}
+	qr, err := hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	expectResult(t, "hj.Execute", qr, r("id|id", "int64|int64",
+		"11|20",
+		"12|22",
+	))
+}
+
+func TestHashJoinWeightString(t *testing.T) {
+	leftPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			r("id|name|weight_string(name)", "int64|varchar|varbinary",
+				"1|abc|ABC",
+				"2|def|DEF",
+			),
+		},
+	}
+	rightPrim := &fakePrimitive{
+		results: []*sqltypes.Result{
+			r("id|name|weight_string(name)", "int64|varchar|varbinary",
+				"10|ABC|ABC",
+				"11|def|DEF",
+				"12|ghi|GHI",
+			),
+		},
+	}
+
+	hj := &HashJoin{
+		Left:  leftPrim,
+		Right: rightPrim,
+		Cols:  []int{-1, 1},
+		Keys:  []HashJoinKey{{LHS: 1, RHS: 1, LHSWeightString: 2, RHSWeightString: 2}},
+	}
+	qr, err := hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	expectResult(t, "hj.Execute", qr, r("id|id", "int64|int64",
+		"1|10",
+		"2|11",
+	))
+
+	// Without weight strings, text keys cannot be compared.
+	leftPrim.rewind()
+	rightPrim.rewind()
+	hj.Keys = []HashJoinKey{{LHS: 1, RHS: 1, LHSWeightString: -1, RHSWeightString: -1}}
+	_, err = hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, true)
+	require.EqualError(t, err, "hash join: no weight_string for text value of type VARCHAR")
+}
+
+func TestHashJoinMaxMemoryRows(t *testing.T) {
+	saveMax := testMaxMemoryRows
+	saveIgnore := testIgnoreMaxMemoryRows
+	testMaxMemoryRows = 2
+	defer func() {
+		testMaxMemoryRows = saveMax
+		testIgnoreMaxMemoryRows = saveIgnore
+	}()
+
+	testCases := []struct {
+		ignoreMaxMemoryRows bool
+		err                 string
+	}{
+		{true, ""},
+		{false, "hash join: in-memory row count exceeded allowed limit of 2"},
+	}
+	for _, test := range testCases {
+		leftPrim := &fakePrimitive{
+			results: []*sqltypes.Result{
+				r("col1", "int64", "1", "2", "3"),
+			},
+		}
+		rightPrim := &fakePrimitive{
+			results: []*sqltypes.Result{
+				r("col2", "int64", "1"),
+			},
+		}
+		hj := &HashJoin{
+			Left:  leftPrim,
+			Right: rightPrim,
+			Cols:  []int{-1, 1},
+			Keys:  []HashJoinKey{{LHS: 0, RHS: 0, LHSWeightString: -1, RHSWeightString: -1}},
+		}
+
+		testIgnoreMaxMemoryRows = test.ignoreMaxMemoryRows
+		_, err := hj.Execute(&noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+		if test.ignoreMaxMemoryRows {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, test.err)
+		}
+
+		leftPrim.rewind()
+		rightPrim.rewind()
+		_, err = wrapStreamExecute(hj, &noopVCursor{}, map[string]*querypb.BindVariable{}, false)
+		if test.ignoreMaxMemoryRows {
+			require.NoError(t, err)
+		} else {
+			require.EqualError(t, err, test.err)
+		}
+	}
+}
@@ -84,8 +84,16 @@ type Insert struct {
 	// QueryTimeout contains the optional timeout (in milliseconds) to apply to this query
 	QueryTimeout int
 
-	// Insert does not take inputs
-	noInputs
+	// Input is set for INSERT ... SELECT statements. The rows returned by
+	// Input are inserted into the table, and Query, Mid and VindexValues
+	// are unused. The query for each shard is built from Prefix, the values
+	// of the rows that go to that shard and Suffix.
+	Input Primitive
+
+	// VindexValueOffset has the column numbers of the vindex columns in
+	// the rows returned by Input. It's indexed by colVindex and column,
+	// like VindexValues.
+	VindexValueOffset [][]int
 
 	// Insert needs tx handling
 	txNeeded
@@ -132,6 +140,9 @@ type Generate struct {
 	// values will be generated based on how many were not
 	// supplied (NULL).
 	Values sqltypes.PlanValue
+	// Offset is the column number of the auto-inc column in the
+	// rows returned by the Input of an INSERT ... SELECT.
+	Offset int
 }
 
 // InsertOpcode is a number representing the opcode
@@ -194,6 +205,9 @@ func (ins *Insert) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVar
 		defer cancel()
 	}
 
+	if ins.Input != nil {
+		return ins.execInsertFromSelect(vcursor, bindVars)
+	}
 	switch ins.Opcode {
 	case InsertUnsharded:
 		return ins.execInsertUnsharded(vcursor, bindVars)
@@ -215,13 +229,21 @@ func (ins *Insert) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindV
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unreachable code for %q", ins.Query)
 }
 
+// Inputs implements the Primitive interface
+func (ins *Insert) Inputs() []Primitive {
+	if ins.Input == nil {
+		return nil
+	}
+	return []Primitive{ins.Input}
+}
+
 func (ins *Insert) execInsertUnsharded(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
 	insertID, err := ins.processGenerate(vcursor, bindVars)
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execInsertUnsharded")
 	}
 
-	rss, _, err := vcursor.ResolveDestinations(ins.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	rss, _, err := vcursor.ResolveDMLDestinations(ins.Keyspace.Name, ins.GetTableName(), nil, []key.Destination{key.DestinationAllShards{}})
 	if err != nil {
 		return nil, vterrors.Wrap(err, "execInsertUnsharded")
 	}
@@ -273,6 +295,87 @@ func (ins *Insert) execInsertSharded(vcursor VCursor, bindVars map[string]*query
 	return result, nil
 }
 
+func (ins *Insert) execInsertFromSelect(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	input, err := ins.Input.Execute(vcursor, bindVars, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertFromSelect")
+	}
+	if len(input.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	// The rows are sent back to the shards in a single statement per shard,
+	// so the whole result is held in memory.
+	if vcursor.ExceedsMaxMemoryRows(len(input.Rows)) {
+		return nil, fmt.Errorf("insert from select: in-memory row count exceeded allowed limit of %d", vcursor.MaxMemoryRows())
+	}
+	rows := input.Rows
+	insertID, err := ins.processGenerateFromRows(vcursor, rows)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "execInsertFromSelect")
+	}
+
+	var rss []*srvtopo.ResolvedShard
+	var queries []*querypb.BoundQuery
+	if ins.Opcode == InsertUnsharded {
+		rss, _, err = vcursor.ResolveDMLDestinations(ins.Keyspace.Name, ins.GetTableName(), nil, []key.Destination{key.DestinationAllShards{}})
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execInsertFromSelect")
+		}
+		if len(rss) != 1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "Keyspace does not have exactly one shard: %v", rss)
+		}
+		queries = []*querypb.BoundQuery{{
+			Sql:           ins.insertSelectQuery(rows),
+			BindVariables: bindVars,
+		}}
+	} else {
+		rss, queries, err = ins.getInsertSelectRoute(vcursor, bindVars, rows)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "execInsertFromSelect")
+		}
+		if len(rss) == 0 {
+			// All the rows were dropped by an insert ignore.
+			return &sqltypes.Result{}, nil
+		}
+	}
+
+	autocommit := (len(rss) == 1 || ins.MultiShardAutocommit) && vcursor.AutocommitApproval()
+	err = allowOnlyMaster(rss...)
+	if err != nil {
+		return nil, err
+	}
+	result, errs := vcursor.ExecuteMultiShard(rss, queries, true /* rollbackOnError */, autocommit)
+	if errs != nil {
+		return nil, vterrors.Wrap(vterrors.Aggregate(errs), "execInsertFromSelect")
+	}
+
+	if insertID != 0 {
+		result.InsertID = uint64(insertID)
+	}
+	return result, nil
+}
+
+// insertSelectQuery builds the insert statement for rows returned by Input.
+func (ins *Insert) insertSelectQuery(rows [][]sqltypes.Value) string {
+	buf := &strings.Builder{}
+	buf.WriteString(ins.Prefix)
+	for i, row := range rows {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString("(")
+		for j, v := range row {
+			if j > 0 {
+				buf.WriteString(", ")
+			}
+			v.EncodeSQL(buf)
+		}
+		buf.WriteString(")")
+	}
+	buf.WriteString(ins.Suffix)
+	return buf.String()
+}
+
 // shouldGenerate determines if a sequence value should be generated for a given value
 func shouldGenerate(v sqltypes.Value) bool {
 	if v.IsNull() {
@@ -303,33 +406,9 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 	if err != nil {
 		return 0, vterrors.Wrap(err, "processGenerate")
 	}
-	count := int64(0)
-	for _, val := range resolved {
-		if shouldGenerate(val) {
-			count++
-		}
-	}
-
-	// If generation is needed, generate the requested number of values (as one call).
-	if count != 0 {
-		rss, _, err := vcursor.ResolveDestinations(ins.Generate.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
-		if err != nil {
-			return 0, vterrors.Wrap(err, "processGenerate")
-		}
-		if len(rss) != 1 {
-			return 0, vterrors.Wrapf(err, "processGenerate len(rss)=%v", len(rss))
-		}
-		bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
-		qr, err := vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rss[0])
-		if err != nil {
-			return 0, err
-		}
-		// If no rows are returned, it's an internal error, and the code
-		// must panic, which will be caught and reported.
-		insertID, err = evalengine.ToInt64(qr.Rows[0][0])
-		if err != nil {
-			return 0, err
-		}
+	insertID, err = ins.generate(vcursor, resolved)
+	if err != nil {
+		return 0, err
 	}
 
 	// Fill the holes where no value was supplied.
@@ -345,6 +424,60 @@ func (ins *Insert) processGenerate(vcursor VCursor, bindVars map[string]*querypb
 	return insertID, nil
 }
 
+// processGenerateFromRows generates new values for the auto-inc column
+// of the rows returned by Input, if necessary. The generated values are
+// stored in the rows. If no value was generated, it returns 0.
+func (ins *Insert) processGenerateFromRows(vcursor VCursor, rows [][]sqltypes.Value) (insertID int64, err error) {
+	if ins.Generate == nil {
+		return 0, nil
+	}
+	resolved := make([]sqltypes.Value, len(rows))
+	for i, row := range rows {
+		resolved[i] = row[ins.Generate.Offset]
+	}
+	insertID, err = ins.generate(vcursor, resolved)
+	if err != nil {
+		return 0, vterrors.Wrap(err, "processGenerateFromRows")
+	}
+	cur := insertID
+	for _, row := range rows {
+		if shouldGenerate(row[ins.Generate.Offset]) {
+			row[ins.Generate.Offset] = sqltypes.NewInt64(cur)
+			cur++
+		}
+	}
+	return insertID, nil
+}
+
+// generate fetches new values from the sequence for the values
+// that were not supplied, as one call. It returns the first value.
+func (ins *Insert) generate(vcursor VCursor, resolved []sqltypes.Value) (int64, error) {
+	count := int64(0)
+	for _, val := range resolved {
+		if shouldGenerate(val) {
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, nil
+	}
+	rss, _, err := vcursor.ResolveDestinations(ins.Generate.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
+	if err != nil {
+		return 0, vterrors.Wrap(err, "processGenerate")
+	}
+	if len(rss) != 1 {
+		return 0, vterrors.Wrapf(err, "processGenerate len(rss)=%v", len(rss))
+	}
+	bindVars := map[string]*querypb.BindVariable{"n": sqltypes.Int64BindVariable(count)}
+	qr, err := vcursor.ExecuteStandalone(ins.Generate.Query, bindVars, rss[0])
+	if err != nil {
+		return 0, err
+	}
+	// If no rows are returned, it's an internal error, and the code
+	// must panic, which will be caught and reported.
+	return evalengine.ToInt64(qr.Rows[0][0])
+}
+
 // getInsertShardedRoute performs all the vindex related work
 // and returns a map of shard to queries.
 // Using the primary vindex, it computes the target keyspace ids.
@@ -390,28 +523,11 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		}
 	}
 
-	// The output from the following 'process' functions is a list of
-	// keyspace ids. For regular inserts, a failure to find a route
-	// results in an error. For 'ignore' type inserts, the keyspace
-	// id is returned as nil, which is used later to drop the corresponding rows.
-	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], ins.Table.ColumnVindexes[0])
+	keyspaceIDs, err := ins.processVindexes(vcursor, vindexRowsValues)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
 	}
 
-	for vIdx := 1; vIdx < len(ins.Table.ColumnVindexes); vIdx++ {
-		colVindex := ins.Table.ColumnVindexes[vIdx]
-		var err error
-		if colVindex.Owned {
-			err = ins.processOwned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
-		} else {
-			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
-		}
-		if err != nil {
-			return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
-		}
-	}
-
 	// Build 3-d bindvars. Skip rows with nil keyspace ids in case
 	// we're executing an insert ignore.
 	for vIdx, colVindex := range ins.Table.ColumnVindexes {
@@ -428,7 +544,107 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		}
 	}
 
-	// We need to know the keyspace ids and the Mids associated with
+	rss, rowsPerRss, err := ins.resolveKeyspaceIDs(vcursor, keyspaceIDs)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		var mids []string
+		for _, index := range rowsPerRss[i] {
+			mids = append(mids, ins.Mid[index])
+		}
+		rewritten := ins.Prefix + strings.Join(mids, ",") + ins.Suffix
+		queries[i] = &querypb.BoundQuery{
+			Sql:           rewritten,
+			BindVariables: bindVars,
+		}
+	}
+
+	return rss, queries, nil
+}
+
+// getInsertSelectRoute performs the vindex related work for the rows
+// returned by Input, like getInsertShardedRoute does for the values
+// of the insert, and returns the queries for each shard.
+func (ins *Insert) getInsertSelectRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, rows [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+	if len(ins.VindexValueOffset) != len(ins.Table.ColumnVindexes) {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: supplied vindex column offsets don't match vschema: %v", ins.VindexValueOffset)
+	}
+	vindexRowsValues := make([][][]sqltypes.Value, len(ins.VindexValueOffset))
+	for vIdx, offsets := range ins.VindexValueOffset {
+		vindexRowsValues[vIdx] = make([][]sqltypes.Value, len(rows))
+		for rowNum, row := range rows {
+			for _, offset := range offsets {
+				vindexRowsValues[vIdx][rowNum] = append(vindexRowsValues[vIdx][rowNum], row[offset])
+			}
+		}
+	}
+
+	keyspaceIDs, err := ins.processVindexes(vcursor, vindexRowsValues)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "getInsertSelectRoute")
+	}
+
+	// Unowned vindex values may have been reverse mapped.
+	for vIdx, offsets := range ins.VindexValueOffset {
+		for rowNum, row := range rows {
+			for colIdx, offset := range offsets {
+				row[offset] = vindexRowsValues[vIdx][rowNum][colIdx]
+			}
+		}
+	}
+
+	rss, rowsPerRss, err := ins.resolveKeyspaceIDs(vcursor, keyspaceIDs)
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "getInsertSelectRoute")
+	}
+
+	queries := make([]*querypb.BoundQuery, len(rss))
+	for i := range rss {
+		shardRows := make([][]sqltypes.Value, 0, len(rowsPerRss[i]))
+		for _, index := range rowsPerRss[i] {
+			shardRows = append(shardRows, rows[index])
+		}
+		queries[i] = &querypb.BoundQuery{
+			Sql:           ins.insertSelectQuery(shardRows),
+			BindVariables: bindVars,
+		}
+	}
+	return rss, queries, nil
+}
+
+// processVindexes computes the keyspace ids of the rows, and creates
+// or validates the entries of the other vindexes. For regular inserts,
+// a failure to find a route results in an error. For 'ignore' type
+// inserts, the keyspace id is returned as nil, which is used later
+// to drop the corresponding rows.
+func (ins *Insert) processVindexes(vcursor VCursor, vindexRowsValues [][][]sqltypes.Value) ([][]byte, error) {
+	keyspaceIDs, err := ins.processPrimary(vcursor, vindexRowsValues[0], ins.Table.ColumnVindexes[0])
+	if err != nil {
+		return nil, err
+	}
+
+	for vIdx := 1; vIdx < len(ins.Table.ColumnVindexes); vIdx++ {
+		colVindex := ins.Table.ColumnVindexes[vIdx]
+		var err error
+		if colVindex.Owned {
+			err = ins.processOwned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
+		} else {
+			err = ins.processUnowned(vcursor, vindexRowsValues[vIdx], colVindex, keyspaceIDs)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return keyspaceIDs, nil
+}
+
+// resolveKeyspaceIDs resolves the shards of the keyspace ids, and returns
+// the row numbers for each shard. Rows with nil keyspace ids are skipped.
+func (ins *Insert) resolveKeyspaceIDs(vcursor VCursor, keyspaceIDs [][]byte) ([]*srvtopo.ResolvedShard, [][]int, error) {
+	// We need to know the keyspace ids and the rows associated with
 	// each RSS.  So we pass the ksid indexes in as ids, and get them back
 	// as values. We also skip nil KeyspaceIds, no need to resolve them.
 	var indexes []*querypb.Value
@@ -447,28 +663,20 @@ func (ins *Insert) getInsertShardedRoute(vcursor VCursor, bindVars map[string]*q
 		return nil, nil, nil
 	}
 
-	rss, indexesPerRss, err := vcursor.ResolveDestinations(ins.Keyspace.Name, indexes, destinations)
+	rss, indexesPerRss, err := vcursor.ResolveDMLDestinations(ins.Keyspace.Name, ins.GetTableName(), indexes, destinations)
 	if err != nil {
-		return nil, nil, vterrors.Wrap(err, "getInsertShardedRoute")
+		return nil, nil, err
 	}
-
-	queries := make([]*querypb.BoundQuery, len(rss))
+	rowsPerRss := make([][]int, len(rss))
 	for i := range rss {
-		var mids []string
 		for _, indexValue := range indexesPerRss[i] {
 			index, _ := strconv.ParseInt(string(indexValue.Value), 0, 64)
 			if keyspaceIDs[index] != nil {
-				mids = append(mids, ins.Mid[index])
+				rowsPerRss[i] = append(rowsPerRss[i], int(index))
 			}
 		}
-		rewritten := ins.Prefix + strings.Join(mids, ",") + ins.Suffix
-		queries[i] = &querypb.BoundQuery{
-			Sql:           rewritten,
-			BindVariables: bindVars,
-		}
 	}
-
-	return rss, queries, nil
+	return rss, rowsPerRss, nil
 }
 
 // processPrimary maps the primary vindex values to the keyspace ids.
@@ -619,6 +827,18 @@ func (ins *Insert) description() PrimitiveDescription {
 		"MultiShardAutocommit": ins.MultiShardAutocommit,
 		"QueryTimeout":         ins.QueryTimeout,
 	}
+	if ins.Input != nil {
+		other["Prefix"] = ins.Prefix
+		if ins.Suffix != "" {
+			other["Suffix"] = ins.Suffix
+		}
+		if ins.VindexValueOffset != nil {
+			other["VindexValueOffset"] = ins.VindexValueOffset
+		}
+		if ins.Generate != nil {
+			other["AutoIncrement"] = fmt.Sprintf("%s:%d", ins.Generate.Keyspace.Name, ins.Generate.Offset)
+		}
+	}
 	return PrimitiveDescription{
 		OperatorType:     "Insert",
 		Keyspace:         ins.Keyspace,
@@ -1621,3 +1621,144 @@ func TestInsertShardedUnownedReverseMapFail(t *testing.T) {
 	_, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
 	expectError(t, "Execute", err, "execInsertSharded: getInsertShardedRoute: value must be supplied for column [c3]")
 }
+
+func TestInsertSelectGenerate(t *testing.T) {
+	invschema := &vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"sharded": {
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {
+						Type: "hash",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "hash",
+							Columns: []string{"id"},
+						}},
+					},
+				},
+			},
+		},
+	}
+	vs, err := vindexes.BuildVSchema(invschema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ks := vs.Keyspaces["sharded"]
+
+	// insert into t1(name, id) select name, id from t2
+	ins := NewInsert(
+		InsertSharded,
+		ks.Keyspace,
+		nil,
+		ks.Tables["t1"],
+		"insert into t1(name, id) values ",
+		nil,
+		" on duplicate key update name = values(name)",
+	)
+	ins.VindexValueOffset = [][]int{{1}}
+	ins.Input = &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("name|id", "varchar|int64"),
+			"a|1",
+			"b|null",
+			"c|0",
+		),
+	}}
+	ins.Generate = &Generate{
+		Keyspace: &vindexes.Keyspace{
+			Name:    "ks2",
+			Sharded: false,
+		},
+		Query:  "dummy_generate",
+		Offset: 1,
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.shardForKsid = []string{"20-", "-20", "20-"}
+	vc.results = []*sqltypes.Result{
+		sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"nextval",
+				"int64",
+			),
+			"2",
+		),
+		{RowsAffected: 3},
+	}
+
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks2 [] Destinations:DestinationAnyShard()`,
+		`ExecuteStandalone dummy_generate n: type:INT64 value:"2"  ks2 -20`,
+		// The rows have ids 1, 2 and 3.
+		`ResolveDestinations sharded [value:"0"  value:"1"  value:"2" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6),DestinationKeyspaceID(06e7ea22ce92708f),DestinationKeyspaceID(4eb190c9a2fa169c)`,
+		// Row 2 will go to -20, rows 1 & 3 will go to 20-
+		`ExecuteMultiShard ` +
+			`sharded.20-: insert into t1(name, id) values ('a', 1), ('c', 3) on duplicate key update name = values(name) {} ` +
+			`sharded.-20: insert into t1(name, id) values ('b', 2) on duplicate key update name = values(name) {} ` +
+			`true false`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 3, InsertID: 2})
+}
+
+func TestInsertSelectUnsharded(t *testing.T) {
+	ins := NewQueryInsert(InsertUnsharded, &vindexes.Keyspace{Name: "ks", Sharded: false}, "")
+	ins.Prefix = "insert into t1(id, name) values "
+	ins.Input = &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"),
+			"1|a",
+			"2|null",
+		),
+	}}
+
+	vc := newDMLTestVCursor("0")
+	vc.results = []*sqltypes.Result{{RowsAffected: 2}}
+
+	result, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+		`ExecuteMultiShard ks.0: insert into t1(id, name) values (1, 'a'), (2, null) {} true true`,
+	})
+	expectResult(t, "Execute", result, &sqltypes.Result{RowsAffected: 2})
+
+	// An empty result inserts nothing.
+	ins.Input = &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar")),
+	}}
+	vc.Rewind()
+	result, err = ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vc.ExpectLog(t, nil)
+	expectResult(t, "Execute", result, &sqltypes.Result{})
+}
+
+func TestInsertSelectMaxMemoryRows(t *testing.T) {
+	save := testMaxMemoryRows
+	testMaxMemoryRows = 1
+	defer func() { testMaxMemoryRows = save }()
+
+	ins := NewQueryInsert(InsertUnsharded, &vindexes.Keyspace{Name: "ks", Sharded: false}, "")
+	ins.Prefix = "insert into t1(id, name) values "
+	ins.Input = &fakePrimitive{results: []*sqltypes.Result{
+		sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"),
+			"1|a",
+			"2|b",
+		),
+	}}
+
+	vc := newDMLTestVCursor("0")
+	_, err := ins.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "insert from select: in-memory row count exceeded allowed limit of 1")
+	vc.ExpectLog(t, nil)
+}
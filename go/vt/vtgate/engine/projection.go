@@ -12,6 +12,11 @@ type Projection struct {
 	Cols  []string
 	Exprs []evalengine.Expr
 	Input Primitive
+	// Replace makes the projection output only the projected columns,
+	// instead of appending them to the columns of the input. The input
+	// columns that are part of the output are then projected with
+	// evalengine.Column expressions.
+	Replace bool
 	noTxNeeded
 }
 
@@ -32,90 +37,96 @@ func (p *Projection) Execute(vcursor VCursor, bindVars map[string]*querypb.BindV
 	if err != nil {
 		return nil, err
 	}
+	return p.project(result, bindVars, wantfields)
+}
 
-	env := evalengine.ExpressionEnv{
-		BindVars: bindVars,
-	}
-
-	if wantfields {
-		err := p.addFields(result, bindVars)
+func (p *Projection) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	needFields := wantfields
+	return p.Input.StreamExecute(vcursor, bindVars, wantfields, func(result *sqltypes.Result) error {
+		qr, err := p.project(result, bindVars, needFields)
 		if err != nil {
-			return nil, err
-		}
-	}
-	var rows [][]sqltypes.Value
-	for _, row := range result.Rows {
-		env.Row = row
-		for _, exp := range p.Exprs {
-			result, err := exp.Evaluate(env)
-			if err != nil {
-				return nil, err
-			}
-			row = append(row, result.Value())
+			return err
 		}
-		rows = append(rows, row)
-	}
-	result.Rows = rows
-	return result, nil
+		needFields = false
+		return callback(qr)
+	})
 }
 
-func (p *Projection) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantields bool, callback func(*sqltypes.Result) error) error {
-	result, err := p.Input.Execute(vcursor, bindVars, wantields)
+func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	qr, err := p.Input.GetFields(vcursor, bindVars)
 	if err != nil {
-		return err
+		return nil, err
 	}
-
-	env := evalengine.ExpressionEnv{
-		BindVars: bindVars,
+	fields, err := p.fields(qr.Fields, bindVars)
+	if err != nil {
+		return nil, err
 	}
+	return &sqltypes.Result{Fields: fields}, nil
+}
 
-	if wantields {
-		err = p.addFields(result, bindVars)
+// project evaluates the expressions against every row of the input,
+// and appends the results to the row, or replaces it if p.Replace is set.
+func (p *Projection) project(input *sqltypes.Result, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	result := &sqltypes.Result{
+		RowsAffected: input.RowsAffected,
+	}
+	if wantfields {
+		fields, err := p.fields(input.Fields, bindVars)
 		if err != nil {
-			return err
+			return nil, err
 		}
+		result.Fields = fields
 	}
-	var rows [][]sqltypes.Value
-	for _, row := range result.Rows {
+
+	env := evalengine.ExpressionEnv{
+		BindVars: bindVars,
+	}
+	for _, row := range input.Rows {
 		env.Row = row
+		var outRow []sqltypes.Value
+		if p.Replace {
+			outRow = make([]sqltypes.Value, 0, len(p.Exprs))
+		} else {
+			outRow = append(make([]sqltypes.Value, 0, len(row)+len(p.Exprs)), row...)
+		}
 		for _, exp := range p.Exprs {
-			result, err := exp.Evaluate(env)
+			// Columns are passed through untouched, so that values
+			// such as decimals do not lose precision.
+			if col, ok := exp.(*evalengine.Column); ok {
+				outRow = append(outRow, row[col.Offset])
+				continue
+			}
+			res, err := exp.Evaluate(env)
 			if err != nil {
-				return err
+				return nil, err
 			}
-			row = append(row, result.Value())
+			outRow = append(outRow, res.Value())
 		}
-		rows = append(rows, row)
+		result.Rows = append(result.Rows, outRow)
 	}
-	result.Rows = rows
-	return callback(result)
+	return result, nil
 }
 
-func (p *Projection) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	qr, err := p.Input.GetFields(vcursor, bindVars)
-	if err != nil {
-		return nil, err
+func (p *Projection) fields(inputFields []*querypb.Field, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error) {
+	env := evalengine.ExpressionEnv{
+		BindVars: bindVars,
+		Fields:   inputFields,
 	}
-	err = p.addFields(qr, bindVars)
-	if err != nil {
-		return nil, err
+	var fields []*querypb.Field
+	if !p.Replace {
+		fields = append(fields, inputFields...)
 	}
-	return qr, nil
-}
-
-func (p *Projection) addFields(qr *sqltypes.Result, bindVars map[string]*querypb.BindVariable) error {
-	env := evalengine.ExpressionEnv{BindVars: bindVars}
 	for i, col := range p.Cols {
 		q, err := p.Exprs[i].Type(env)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		qr.Fields = append(qr.Fields, &querypb.Field{
+		fields = append(fields, &querypb.Field{
 			Name: col,
 			Type: q,
 		})
 	}
-	return nil
+	return fields, nil
 }
 
 func (p *Projection) Inputs() []Primitive {
@@ -127,11 +138,15 @@ func (p *Projection) description() PrimitiveDescription {
 	for _, e := range p.Exprs {
 		exprs = append(exprs, e.String())
 	}
+	other := map[string]interface{}{
+		"Expressions": exprs,
+		"Columns":     p.Cols,
+	}
+	if p.Replace {
+		other["Replace"] = true
+	}
 	return PrimitiveDescription{
 		OperatorType: "Projection",
-		Other: map[string]interface{}{
-			"Expressions": exprs,
-			"Columns":     p.Cols,
-		},
+		Other:        other,
 	}
 }
@@ -0,0 +1,123 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+func TestProjectionExecute(t *testing.T) {
+	// select col, sum(a) / count(b) from t group by col
+	proj := &Projection{
+		Cols: []string{"col", "sum(a) / count(b)"},
+		Exprs: []evalengine.Expr{
+			evalengine.NewColumn(0),
+			&evalengine.BinaryOp{
+				Expr:  &evalengine.Division{},
+				Left:  evalengine.NewColumn(1),
+				Right: evalengine.NewColumn(2),
+			},
+		},
+		Input: &fakePrimitive{results: []*sqltypes.Result{
+			r("col|sum(a)|count(b)", "varchar|decimal|int64",
+				"a|10.50|2",
+				"b|3|0",
+				"c|null|0",
+			),
+		}},
+		Replace: true,
+	}
+
+	qr, err := proj.Execute(&noopVCursor{ctx: context.Background()}, nil, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("col|sum(a) / count(b)", "varchar|float64"),
+		"a|5.25",
+		"b|null",
+		"c|null",
+	)
+	assert.Equal(t, want.Fields, qr.Fields)
+	assert.Equal(t, want.Rows, qr.Rows)
+
+	proj.Input.(*fakePrimitive).rewind()
+	qr, err = wrapStreamExecute(proj, &noopVCursor{ctx: context.Background()}, nil, true)
+	require.NoError(t, err)
+	assert.Equal(t, want.Fields, qr.Fields)
+	assert.Equal(t, want.Rows, qr.Rows)
+
+	proj.Input.(*fakePrimitive).rewind()
+	qr, err = proj.GetFields(&noopVCursor{ctx: context.Background()}, nil)
+	require.NoError(t, err)
+	assert.Equal(t, want.Fields, qr.Fields)
+}
+
+func TestProjectionPassThroughDecimal(t *testing.T) {
+	proj := &Projection{
+		Cols: []string{"sum(a)", "1 + count(*)"},
+		Exprs: []evalengine.Expr{
+			evalengine.NewColumn(0),
+			&evalengine.BinaryOp{
+				Expr:  &evalengine.Addition{},
+				Left:  evalengine.NewLiteralInt(1),
+				Right: evalengine.NewColumn(1),
+			},
+		},
+		Input: &fakePrimitive{results: []*sqltypes.Result{
+			r("sum(a)|count(*)", "decimal|int64", "12345678901234567890.12|4"),
+		}},
+		Replace: true,
+	}
+
+	qr, err := proj.Execute(&noopVCursor{ctx: context.Background()}, nil, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("sum(a)|1 + count(*)", "decimal|int64"),
+		"12345678901234567890.12|5",
+	)
+	assert.Equal(t, want.Fields, qr.Fields)
+	assert.Equal(t, want.Rows, qr.Rows)
+}
+
+func TestProjectionAppend(t *testing.T) {
+	proj := &Projection{
+		Cols: []string{"a + 1"},
+		Exprs: []evalengine.Expr{
+			&evalengine.BinaryOp{
+				Expr:  &evalengine.Addition{},
+				Left:  evalengine.NewColumn(0),
+				Right: evalengine.NewLiteralInt(1),
+			},
+		},
+		Input: &fakePrimitive{results: []*sqltypes.Result{
+			r("a|b", "int64|varchar", "1|x", "2|y"),
+		}},
+	}
+
+	qr, err := proj.Execute(&noopVCursor{ctx: context.Background()}, nil, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a|b|a + 1", "int64|varchar|int64"),
+		"1|x|2",
+		"2|y|3",
+	)
+	assert.Equal(t, want.Fields, qr.Fields)
+	assert.Equal(t, want.Rows, qr.Rows)
+}
@@ -73,8 +73,10 @@ type Route struct {
 	FieldQuery string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 	// Values specifies the vindex values to use for routing.
+	// For a multi-column vindex, every value is for one of the
+	// leading columns of the vindex, in order.
 	Values []sqltypes.PlanValue
 
 	// OrderBy specifies the key order for merge sorting. This will be
@@ -533,11 +535,11 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
-	key, err := route.Values[0].ResolveValue(bindVars)
+	row, err := resolveRow(route.Values, bindVars)
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
+	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, [][]sqltypes.Value{row})
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectEqual")
 	}
@@ -553,7 +555,7 @@ func (route *Route) paramsSelectIn(vcursor VCursor, bindVars map[string]*querypb
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectIn")
 	}
-	rss, values, err := resolveShards(vcursor, route.Vindex, route.Keyspace, keys)
+	rss, values, err := resolveShards(vcursor, route.Vindex, route.Keyspace, singleColumnRows(keys))
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectIn")
 	}
@@ -565,7 +567,7 @@ func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectIn")
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, keys)
+	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, singleColumnRows(keys))
 	if err != nil {
 		return nil, nil, vterrors.Wrap(err, "paramsSelectIn")
 	}
@@ -576,15 +578,18 @@ func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]
 	return rss, multiBindVars, nil
 }
 
-func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+// resolveShards maps the vindex keys to their shards. Every key is a row
+// with the values of the leading columns of the vindex. The ids returned
+// for every shard are the values of the first column of its keys.
+func resolveShards(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKeys [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	// Convert vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
 	for i, vik := range vindexKeys {
-		ids[i] = sqltypes.ValueToProto(vik)
+		ids[i] = sqltypes.ValueToProto(vik[0])
 	}
 
 	// Map using the Vindex
-	destinations, err := vindex.Map(vcursor, vindexKeys)
+	destinations, err := vindexes.Map(vindex, vcursor, vindexKeys)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -593,6 +598,28 @@ func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vind
 	return vcursor.ResolveDestinations(keyspace.Name, ids, destinations)
 }
 
+// resolveRow resolves the values of the vindex columns into a single row.
+func resolveRow(values []sqltypes.PlanValue, bindVars map[string]*querypb.BindVariable) ([]sqltypes.Value, error) {
+	row := make([]sqltypes.Value, 0, len(values))
+	for _, pv := range values {
+		val, err := pv.ResolveValue(bindVars)
+		if err != nil {
+			return nil, err
+		}
+		row = append(row, val)
+	}
+	return row, nil
+}
+
+// singleColumnRows converts the keys of a single column into rows.
+func singleColumnRows(keys []sqltypes.Value) [][]sqltypes.Value {
+	rows := make([][]sqltypes.Value, 0, len(keys))
+	for _, key := range keys {
+		rows = append(rows, []sqltypes.Value{key})
+	}
+	return rows
+}
+
 func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	var err error
 	// Since Result is immutable, we make a copy.
@@ -634,8 +661,8 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	return out, err
 }
 
-func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+func resolveSingleShard(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, table string, vindexKey []sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -648,7 +675,7 @@ func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace
 	default:
 		return nil, nil, fmt.Errorf("cannot map vindex to unique keyspace id: %v", destinations[0])
 	}
-	rss, _, err := vcursor.ResolveDestinations(keyspace.Name, nil, destinations)
+	rss, _, err := vcursor.ResolveDMLDestinations(keyspace.Name, table, nil, destinations)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -658,20 +685,20 @@ func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace
 	return rss[0], ksid, nil
 }
 
-func resolveMultiShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey []sqltypes.Value) ([]*srvtopo.ResolvedShard, error) {
-	destinations, err := vindex.Map(vcursor, vindexKey)
+func resolveMultiShard(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, table string, vindexKey []sqltypes.Value) ([]*srvtopo.ResolvedShard, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, singleColumnRows(vindexKey))
 	if err != nil {
 		return nil, err
 	}
-	rss, _, err := vcursor.ResolveDestinations(keyspace.Name, nil, destinations)
+	rss, _, err := vcursor.ResolveDMLDestinations(keyspace.Name, table, nil, destinations)
 	if err != nil {
 		return nil, err
 	}
 	return rss, nil
 }
 
-func resolveKeyspaceID(vcursor VCursor, vindex vindexes.SingleColumn, vindexKey sqltypes.Value) ([]byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+func resolveKeyspaceID(vcursor VCursor, vindex vindexes.Vindex, vindexKey []sqltypes.Value) ([]byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, err
 	}
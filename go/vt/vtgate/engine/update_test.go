@@ -139,6 +139,35 @@ func TestUpdateScatter(t *testing.T) {
 	})
 }
 
+func TestUpdateEqualMultiCol(t *testing.T) {
+	vindex, _ := vindexes.NewMultiCol("", map[string]string{"column_count": "2"})
+	upd := &Update{
+		DML: DML{
+			Opcode: Equal,
+			Keyspace: &vindexes.Keyspace{
+				Name:    "ks",
+				Sharded: true,
+			},
+			Query:  "dummy_update",
+			Vindex: vindex,
+			Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}},
+		},
+	}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	_, err := upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(166b40b406e7ea22)`,
+		`ExecuteMultiShard ks.-20: dummy_update {} true true`,
+	})
+
+	// Failure case
+	upd.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Key: "aa"}}
+	_, err = upd.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	expectError(t, "Execute", err, "execUpdateEqual: missing bind var aa")
+}
+
 func TestUpdateEqualNoRoute(t *testing.T) {
 	vindex, _ := vindexes.NewLookupUnique("", map[string]string{
 		"table": "lkp",
@@ -204,6 +233,7 @@ func TestUpdateEqualChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]*VindexValues{
 			"twocol": {
@@ -343,6 +373,7 @@ func TestUpdateScatterChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]*VindexValues{
 			"twocol": {
@@ -484,6 +515,7 @@ func TestUpdateInChangedVindex(t *testing.T) {
 			Table:            ks.Tables["t1"],
 			OwnedVindexQuery: "dummy_subquery",
 			KsidVindex:       ks.Vindexes["hash"].(vindexes.SingleColumn),
+			KsidLength:       1,
 		},
 		ChangedVindexValues: map[string]*VindexValues{
 			"twocol": {
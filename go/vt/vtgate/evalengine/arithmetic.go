@@ -357,6 +357,10 @@ func multiplyNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
 func divideNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
 	v1 := makeNumeric(i1)
 	v2 := makeNumeric(i2)
+	if isZero(v2) {
+		// Division by zero yields NULL, as in MySQL
+		return EvalResult{typ: sqltypes.Null}, nil
+	}
 	switch v1.typ {
 	case sqltypes.Int64:
 		return floatDivideAnyWithError(float64(v1.ival), v2)
@@ -388,7 +392,22 @@ func makeNumericAndprioritize(i1, i2 EvalResult) (EvalResult, EvalResult) {
 	return v1, v2
 }
 
+func isZero(v EvalResult) bool {
+	switch v.typ {
+	case sqltypes.Int64:
+		return v.ival == 0
+	case sqltypes.Uint64:
+		return v.uval == 0
+	case sqltypes.Float64:
+		return v.fval == 0
+	}
+	return false
+}
+
 func makeNumeric(v EvalResult) EvalResult {
+	if v.typ == sqltypes.Decimal {
+		return v.decimalToFloat()
+	}
 	if sqltypes.IsNumber(v.typ) {
 		return v
 	}
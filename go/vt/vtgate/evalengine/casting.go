@@ -17,9 +17,15 @@ limitations under the License.
 package evalengine
 
 import (
+	"bytes"
+	"fmt"
+	"math"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 )
@@ -56,3 +62,239 @@ func (e *EvalResult) ToBooleanStrict() (bool, error) {
 	}
 	return false, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "is not a boolean")
 }
+
+// CastExpr represents a CAST or CONVERT to one of the supported types:
+// signed and unsigned integers, floats, and character or binary strings.
+// A non-negative Length truncates strings to that many characters,
+// and pads binary strings with zero bytes up to that length.
+type CastExpr struct {
+	Inner  Expr
+	To     querypb.Type
+	Length int
+}
+
+var _ Expr = (*CastExpr)(nil)
+
+// NewCastExpr returns a cast expression. It fails if the target
+// type is not supported.
+func NewCastExpr(inner Expr, typ querypb.Type, length int) (*CastExpr, error) {
+	switch {
+	case typ == sqltypes.Int64, typ == sqltypes.Uint64, typ == sqltypes.Float64:
+	case typ == sqltypes.VarChar, typ == sqltypes.VarBinary:
+	default:
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported cast to %s", typ.String())
+	}
+	return &CastExpr{Inner: inner, To: typ, Length: length}, nil
+}
+
+//Evaluate implements the Expr interface
+func (c *CastExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := c.Inner.Evaluate(env)
+	if err != nil || val.typ == sqltypes.Null {
+		return val, err
+	}
+	switch c.To {
+	case sqltypes.Int64:
+		return EvalResult{typ: sqltypes.Int64, ival: val.toInt64()}, nil
+	case sqltypes.Uint64:
+		return EvalResult{typ: sqltypes.Uint64, uval: val.toUint64()}, nil
+	case sqltypes.Float64:
+		return EvalResult{typ: sqltypes.Float64, fval: val.toFloat64()}, nil
+	case sqltypes.VarChar:
+		str := val.toBytes()
+		if c.Length >= 0 && utf8.RuneCount(str) > c.Length {
+			str = []byte(string([]rune(string(str))[:c.Length]))
+		}
+		return newEvalString(str, collationUnknown), nil
+	default:
+		str := val.toBytes()
+		if c.Length >= 0 {
+			if len(str) > c.Length {
+				str = str[:c.Length]
+			} else {
+				str = append(append([]byte{}, str...), make([]byte, c.Length-len(str))...)
+			}
+		}
+		return EvalResult{typ: sqltypes.VarBinary, bytes: str, collation: collationBinary}, nil
+	}
+}
+
+//Type implements the Expr interface
+func (c *CastExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return c.To, nil
+}
+
+//String implements the Expr interface
+func (c *CastExpr) String() string {
+	var typ string
+	switch c.To {
+	case sqltypes.Int64:
+		typ = "signed"
+	case sqltypes.Uint64:
+		typ = "unsigned"
+	case sqltypes.Float64:
+		typ = "double"
+	case sqltypes.VarChar:
+		typ = "char"
+	default:
+		typ = "binary"
+	}
+	if c.Length >= 0 {
+		typ = fmt.Sprintf("%s(%d)", typ, c.Length)
+	}
+	return fmt.Sprintf("cast(%s as %s)", c.Inner.String(), typ)
+}
+
+var (
+	resultNull  = EvalResult{typ: sqltypes.Null}
+	resultTrue  = EvalResult{typ: sqltypes.Int64, ival: 1}
+	resultFalse = EvalResult{typ: sqltypes.Int64, ival: 0}
+)
+
+func newEvalBool(b bool) EvalResult {
+	if b {
+		return resultTrue
+	}
+	return resultFalse
+}
+
+func newEvalString(str []byte, coll collation) EvalResult {
+	return EvalResult{typ: sqltypes.VarChar, bytes: str, collation: coll}
+}
+
+// isNumeric returns true if the value is a number. Any other value
+// that is not NULL is handled as a string.
+func (e *EvalResult) isNumeric() bool {
+	return sqltypes.IsNumber(e.typ)
+}
+
+// ToBoolean returns true if the value is true in a boolean context,
+// like a WHERE clause. NULL is not true.
+func (e *EvalResult) ToBoolean() bool {
+	b, _ := e.toBoolean()
+	return b
+}
+
+// toBoolean converts the value to a boolean the way MySQL does in a
+// boolean context: numbers are true if they are not zero, and strings
+// are converted to numbers first. The second return value is true if
+// the value is NULL.
+func (e *EvalResult) toBoolean() (bool, bool) {
+	if e.typ == sqltypes.Null {
+		return false, true
+	}
+	switch {
+	case sqltypes.IsSigned(e.typ):
+		return e.ival != 0, false
+	case sqltypes.IsUnsigned(e.typ):
+		return e.uval != 0, false
+	}
+	return e.toFloat64() != 0, false
+}
+
+// toFloat64 converts the value to a float64. Strings are converted
+// using their longest numeric prefix, so 'abc' is 0 and '1.5x' is 1.5.
+func (e *EvalResult) toFloat64() float64 {
+	switch {
+	case sqltypes.IsSigned(e.typ):
+		return float64(e.ival)
+	case sqltypes.IsUnsigned(e.typ):
+		return float64(e.uval)
+	case sqltypes.IsFloat(e.typ), e.typ == sqltypes.Decimal:
+		return e.fval
+	}
+	return parseFloatPrefix(e.bytes)
+}
+
+// toInt64 converts the value to an int64, rounding floats
+// to the nearest integer as MySQL does.
+func (e *EvalResult) toInt64() int64 {
+	switch {
+	case sqltypes.IsSigned(e.typ):
+		return e.ival
+	case sqltypes.IsUnsigned(e.typ):
+		return int64(e.uval)
+	}
+	f := math.Round(e.toFloat64())
+	switch {
+	case f >= math.MaxInt64:
+		return math.MaxInt64
+	case f <= math.MinInt64:
+		return math.MinInt64
+	}
+	return int64(f)
+}
+
+// toUint64 converts the value to an uint64. Negative
+// numbers wrap around, as in MySQL.
+func (e *EvalResult) toUint64() uint64 {
+	switch {
+	case sqltypes.IsUnsigned(e.typ):
+		return e.uval
+	case sqltypes.IsSigned(e.typ):
+		return uint64(e.ival)
+	}
+	f := math.Round(e.toFloat64())
+	switch {
+	case f >= math.MaxUint64:
+		return math.MaxUint64
+	case f < 0:
+		return uint64(int64(f))
+	}
+	return uint64(f)
+}
+
+// toBytes returns the string representation of the value.
+func (e *EvalResult) toBytes() []byte {
+	switch {
+	case sqltypes.IsSigned(e.typ):
+		return strconv.AppendInt(nil, e.ival, 10)
+	case sqltypes.IsUnsigned(e.typ):
+		return strconv.AppendUint(nil, e.uval, 10)
+	case sqltypes.IsFloat(e.typ):
+		return strconv.AppendFloat(nil, e.fval, 'g', -1, 64)
+	}
+	return e.bytes
+}
+
+// parseFloatPrefix parses the longest prefix of the string that is a
+// valid number. Leading spaces are ignored. It returns 0 if there is
+// no such prefix.
+func parseFloatPrefix(str []byte) float64 {
+	str = bytes.TrimLeft(str, " \t\r\n")
+	end := 0
+	if end < len(str) && (str[end] == '+' || str[end] == '-') {
+		end++
+	}
+	digits := 0
+	for ; end < len(str) && isDigit(str[end]); end++ {
+		digits++
+	}
+	if end < len(str) && str[end] == '.' {
+		end++
+		for ; end < len(str) && isDigit(str[end]); end++ {
+			digits++
+		}
+	}
+	if digits == 0 {
+		return 0
+	}
+	if end < len(str) && (str[end] == 'e' || str[end] == 'E') {
+		exp := end + 1
+		if exp < len(str) && (str[exp] == '+' || str[exp] == '-') {
+			exp++
+		}
+		if exp < len(str) && isDigit(str[exp]) {
+			for end = exp; end < len(str) && isDigit(str[end]); end++ {
+			}
+		}
+	}
+	// The prefix is a valid number: the only possible error is
+	// that it is out of range, in which case f is +/-Inf.
+	f, _ := strconv.ParseFloat(string(str[:end]), 64)
+	return f
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
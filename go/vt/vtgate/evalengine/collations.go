@@ -0,0 +1,300 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// collation decides how two strings are compared.
+//
+// MySQL compares literals and bind variables with the collation of the
+// connection, and text columns with the collation of the column, none
+// of which are known to vtgate. These strings have the unknown collation,
+// and can only be compared if they are equal byte for byte, or if they
+// are compared to a string whose collation is known. Binary strings use
+// the binary collation, and text columns use the collation of their field
+// if it is one of the collations evalengine implements.
+type collation int8
+
+const (
+	// collationUnknown is the collation of strings that
+	// can't be compared by evalengine.
+	collationUnknown collation = iota
+	// collationBinary compares strings byte by byte.
+	collationBinary
+	// collationUTF8Bin compares UTF-8 strings one character at
+	// a time, by code point, ignoring any trailing spaces. It's
+	// the collation of utf8_bin and utf8mb4_bin.
+	collationUTF8Bin
+)
+
+// collations maps the MySQL collation IDs that evalengine
+// implements to their collation.
+var collations = map[uint32]collation{
+	46: collationUTF8Bin, // utf8mb4_bin
+	63: collationBinary,  // binary
+	83: collationUTF8Bin, // utf8_bin
+}
+
+// collationOf returns the collation of a string value
+// read from a field with the given collation ID.
+func collationOf(typ querypb.Type, charset uint32) collation {
+	if coll, ok := collations[charset]; ok {
+		return coll
+	}
+	if sqltypes.IsBinary(typ) {
+		return collationBinary
+	}
+	return collationUnknown
+}
+
+// mergeCollations returns the collation to use to compare two strings.
+// Binary takes precedence, as in MySQL, and a known collation is used
+// over the unknown collation of literals and bind variables.
+func mergeCollations(c1, c2 collation) collation {
+	switch {
+	case c1 == collationBinary || c2 == collationBinary:
+		return collationBinary
+	case c1 == collationUnknown:
+		return c2
+	}
+	return c1
+}
+
+// errUnknownCollation is returned when two strings can't be compared.
+var errUnknownCollation = vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: comparison of strings with an unknown collation")
+
+// compare returns 0 if s1==s2, -1 if s1<s2, and 1 if s1>s2.
+func (c collation) compare(s1, s2 []byte) (int, error) {
+	switch c {
+	case collationBinary:
+		return bytes.Compare(s1, s2), nil
+	case collationUTF8Bin:
+		// The UTF-8 encoding sorts strings by code point.
+		return bytes.Compare(bytes.TrimRight(s1, " "), bytes.TrimRight(s2, " ")), nil
+	}
+	if bytes.Equal(s1, s2) {
+		return 0, nil
+	}
+	return 0, errUnknownCollation
+}
+
+// like returns true if s matches the LIKE pattern. The pattern can use
+// '%' to match any sequence of characters, '_' to match a single
+// character, and escape to match these characters literally.
+func (c collation) like(s, pattern []byte, escape rune) (bool, error) {
+	switch c {
+	case collationBinary:
+		return matchLike(byteRunes(s), compileLike(byteRunes(pattern), escape)), nil
+	case collationUTF8Bin:
+		return matchLike([]rune(string(s)), compileLike([]rune(string(pattern)), escape)), nil
+	}
+	return false, errUnknownCollation
+}
+
+// byteRunes returns one rune per byte: binary strings
+// are matched byte by byte.
+func byteRunes(s []byte) []rune {
+	runes := make([]rune, len(s))
+	for i, b := range s {
+		runes[i] = rune(b)
+	}
+	return runes
+}
+
+// likeToken is a character of a LIKE pattern: either a
+// wildcard, or a character that must match exactly.
+type likeToken struct {
+	r rune
+	// wildcard is '%', '_', or 0 if r must match exactly.
+	wildcard rune
+}
+
+// compileLike splits the pattern in tokens, removing the escape
+// characters and the consecutive '%', which are equivalent to one.
+func compileLike(pattern []rune, escape rune) []likeToken {
+	tokens := make([]likeToken, 0, len(pattern))
+	for i := 0; i < len(pattern); i++ {
+		switch p := pattern[i]; {
+		case p == escape && i+1 < len(pattern):
+			i++
+			tokens = append(tokens, likeToken{r: pattern[i]})
+		case p == '%':
+			if len(tokens) == 0 || tokens[len(tokens)-1].wildcard != '%' {
+				tokens = append(tokens, likeToken{wildcard: '%'})
+			}
+		case p == '_':
+			tokens = append(tokens, likeToken{wildcard: '_'})
+		default:
+			tokens = append(tokens, likeToken{r: p})
+		}
+	}
+	return tokens
+}
+
+// matchLike matches s against the pattern. When a character doesn't
+// match, it only backtracks to the last '%', which makes it linear in
+// the length of s for each '%' of the pattern.
+func matchLike(s []rune, pattern []likeToken) bool {
+	si, pi := 0, 0
+	// star is the position of the last '%' in the pattern,
+	// and next is where to resume matching s after it.
+	star, next := -1, 0
+	for si < len(s) {
+		switch {
+		case pi < len(pattern) && pattern[pi].wildcard == '%':
+			star, next = pi, si
+			pi++
+		case pi < len(pattern) && (pattern[pi].wildcard == '_' || (pattern[pi].wildcard == 0 && pattern[pi].r == s[si])):
+			si++
+			pi++
+		case star >= 0:
+			// Let the last '%' match one more character.
+			next++
+			si, pi = next, star+1
+		default:
+			return false
+		}
+	}
+	for pi < len(pattern) && pattern[pi].wildcard == '%' {
+		pi++
+	}
+	return pi == len(pattern)
+}
+
+// CanCompare returns false if comparing the values of the expressions
+// may need to compare two strings whose collation evalengine doesn't
+// know, like literals, bind variables and text columns. These
+// comparisons must be evaluated by MySQL.
+func CanCompare(left, right Expr) bool {
+	return valueKindOf(left) != unknownString || valueKindOf(right) != unknownString
+}
+
+// CanMatch is like CanCompare for LIKE, which always matches the
+// values as strings: one of them must have a known collation.
+func CanMatch(str, pattern Expr) bool {
+	return valueKindOf(str) == knownString || valueKindOf(pattern) == knownString
+}
+
+// canComparePair is CanCompare for the two arguments of a function.
+func canComparePair(args TupleExpr) bool {
+	return CanCompare(args[0], args[1])
+}
+
+// canCompareAll returns false if the arguments of GREATEST or LEAST
+// may have to be compared with a collation evalengine doesn't know.
+// They're compared as strings if any of them is a string, with the
+// collation of the strings whose collation is known.
+func canCompareAll(args TupleExpr) bool {
+	for _, arg := range args {
+		if valueKindOf(arg) == knownString {
+			return true
+		}
+	}
+	return mergeValueKinds(args) == number
+}
+
+// valueKind is what is known about the value of an expression
+// before it's evaluated.
+type valueKind int8
+
+const (
+	// number is a number or NULL.
+	number valueKind = iota
+	// knownString is a string whose collation is known.
+	knownString
+	// unknownString may be a string whose collation isn't known.
+	unknownString
+)
+
+// valueKindOf returns the kind of the value of the expression.
+func valueKindOf(e Expr) valueKind {
+	switch e := e.(type) {
+	case *Literal:
+		switch {
+		case e.Val.isNumeric() || e.Val.typ == sqltypes.Null:
+			return number
+		case e.Val.collation != collationUnknown:
+			return knownString
+		}
+	case *BinaryOp, *ComparisonExpr, *InExpr, *LogicalExpr, *NotExpr, *IsExpr:
+		return number
+	case *CastExpr:
+		switch {
+		case e.To == sqltypes.VarBinary:
+			return knownString
+		case sqltypes.IsNumber(e.To):
+			return number
+		}
+	case *Column:
+		if e.field == nil {
+			return unknownString
+		}
+		switch {
+		case sqltypes.IsNumber(e.field.Type):
+			return number
+		case collationOf(e.field.Type, e.field.Charset) != collationUnknown:
+			return knownString
+		}
+	case *CaseExpr:
+		results := make(TupleExpr, 0, len(e.Whens)+1)
+		for _, when := range e.Whens {
+			results = append(results, when.Val)
+		}
+		if e.Else != nil {
+			results = append(results, e.Else)
+		}
+		return mergeValueKinds(results)
+	case *CallExpr:
+		if e.f.results != nil {
+			return mergeValueKinds(e.f.results(e.Arguments))
+		}
+		if typ, err := e.Type(ExpressionEnv{}); err == nil && sqltypes.IsNumber(typ) {
+			return number
+		}
+		// The result of a string function has the collation of its
+		// arguments, which is known if it's known for any of them.
+		for _, arg := range e.Arguments {
+			if valueKindOf(arg) == knownString {
+				return knownString
+			}
+		}
+	}
+	return unknownString
+}
+
+// mergeValueKinds returns the kind of a value that can be
+// the value of any of the expressions.
+func mergeValueKinds(exprs TupleExpr) valueKind {
+	kind := number
+	for _, expr := range exprs {
+		switch valueKindOf(expr) {
+		case unknownString:
+			return unknownString
+		case knownString:
+			kind = knownString
+		}
+	}
+	return kind
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestCollationCompare(t *testing.T) {
+	tests := []struct {
+		coll   collation
+		s1, s2 string
+		want   int
+	}{
+		{collationBinary, "abc", "ABC", 1},
+		{collationBinary, "abc", "abc ", -1},
+		{collationUTF8Bin, "abc", "abc   ", 0},
+		{collationUTF8Bin, "abc", "ABC", 1},
+		{collationUTF8Bin, "abc", "abd", -1},
+		{collationUTF8Bin, "élan", "zèbre", 1},
+		{collationUnknown, "abc", "abc", 0},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d %s %s", tc.coll, tc.s1, tc.s2), func(t *testing.T) {
+			cmp, err := tc.coll.compare([]byte(tc.s1), []byte(tc.s2))
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, cmp)
+		})
+	}
+
+	_, err := collationUnknown.compare([]byte("abc"), []byte("ABC"))
+	assert.Equal(t, errUnknownCollation, err)
+}
+
+func TestCollationLike(t *testing.T) {
+	tests := []struct {
+		coll       collation
+		s, pattern string
+		want       bool
+	}{
+		{collationUTF8Bin, "Vitess", "V%", true},
+		{collationUTF8Bin, "Vitess", "v%", false},
+		{collationUTF8Bin, "Vitess", "%tess", true},
+		{collationUTF8Bin, "Vitess", "V_t%s", true},
+		{collationUTF8Bin, "Vitess", "V_t", false},
+		{collationUTF8Bin, "Vitess", "%%%", true},
+		{collationUTF8Bin, "Vitess", "%s%s", true},
+		{collationUTF8Bin, "Vitess", "%s%t", false},
+		{collationUTF8Bin, "", "%", true},
+		{collationUTF8Bin, "", "_", false},
+		{collationUTF8Bin, "50%", "50\\%", true},
+		{collationUTF8Bin, "500", "50\\%", false},
+		{collationUTF8Bin, "über", "_ber", true},
+		{collationBinary, "über", "_ber", false},
+		{collationBinary, "über", "__ber", true},
+	}
+	for _, tc := range tests {
+		t.Run(fmt.Sprintf("%d %s %s", tc.coll, tc.s, tc.pattern), func(t *testing.T) {
+			matched, err := tc.coll.like([]byte(tc.s), []byte(tc.pattern), '\\')
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, matched)
+		})
+	}
+
+	_, err := collationUnknown.like([]byte("abc"), []byte("a%"), '\\')
+	assert.Equal(t, errUnknownCollation, err)
+}
+
+func TestCollationLikeIsLinear(t *testing.T) {
+	// A backtracking matcher takes exponential time on this pattern.
+	s := []byte(strings.Repeat("a", 5000))
+	pattern := []byte(strings.Repeat("%a", 50) + "b")
+	matched, err := collationBinary.like(s, pattern, '\\')
+	require.NoError(t, err)
+	assert.False(t, matched)
+}
+
+func TestCompareValuesCollation(t *testing.T) {
+	literal := EvalResult{typ: sqltypes.VarBinary, bytes: []byte("abc  ")}
+	column := func(typ querypb.Type, charset uint32) EvalResult {
+		res, err := NewColumn(0).Evaluate(ExpressionEnv{
+			Row:    []sqltypes.Value{sqltypes.MakeTrusted(typ, []byte("abc"))},
+			Fields: []*querypb.Field{{Type: typ, Charset: charset}},
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	cmp, err := compareValues(column(sqltypes.VarChar, 46), literal)
+	require.NoError(t, err)
+	assert.Equal(t, 0, cmp, "utf8mb4_bin column compared to a literal")
+
+	cmp, err = compareValues(column(sqltypes.VarBinary, 63), literal)
+	require.NoError(t, err)
+	assert.Equal(t, -1, cmp, "binary column compared to a literal")
+
+	_, err = compareValues(column(sqltypes.VarChar, 45), literal)
+	assert.Equal(t, errUnknownCollation, err, "utf8mb4_general_ci column compared to a literal")
+}
+
+func TestCanCompare(t *testing.T) {
+	str := NewLiteralString([]byte("a"))
+	binary, err := NewCastExpr(str, sqltypes.VarBinary, -1)
+	require.NoError(t, err)
+	number := NewLiteralInt(1)
+
+	assert.False(t, CanCompare(str, NewColumn(0)))
+	assert.False(t, CanCompare(str, NewBindVar("v")))
+	assert.True(t, CanCompare(str, number))
+	assert.True(t, CanCompare(binary, NewColumn(0)))
+	assert.False(t, CanMatch(str, str))
+	assert.True(t, CanMatch(str, binary))
+
+	utf8mb4Bin := NewColumnWithField(0, &querypb.Field{Type: sqltypes.VarChar, Charset: 46})
+	utf8mb4GeneralCI := NewColumnWithField(0, &querypb.Field{Type: sqltypes.VarChar, Charset: 45})
+	assert.True(t, CanCompare(str, utf8mb4Bin))
+	assert.False(t, CanCompare(str, utf8mb4GeneralCI))
+	assert.True(t, CanCompare(str, NewColumnWithField(0, &querypb.Field{Type: sqltypes.Int64})))
+	assert.True(t, CanMatch(utf8mb4Bin, str))
+	assert.False(t, CanMatch(utf8mb4GeneralCI, str))
+
+	_, err = NewCallExpr("greatest", []Expr{str, NewColumn(0)})
+	assert.Error(t, err)
+	_, err = NewCallExpr("greatest", []Expr{binary, str, NewColumn(0)})
+	assert.NoError(t, err)
+}
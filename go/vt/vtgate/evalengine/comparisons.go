@@ -0,0 +1,294 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// ComparisonExpr compares two values. The result is 1 if the
+	// comparison holds, 0 if it doesn't, and NULL if any of the
+	// values is NULL, unless the operator is NULL-safe.
+	ComparisonExpr struct {
+		Op          ComparisonOp
+		Left, Right Expr
+	}
+
+	// ComparisonOp is the interface that all comparison operators must implement
+	ComparisonOp interface {
+		compare(left, right EvalResult) (EvalResult, error)
+		String() string
+	}
+
+	// InExpr represents an IN or NOT IN expression.
+	InExpr struct {
+		Left   Expr
+		Right  TupleExpr
+		Negate bool
+	}
+
+	// TupleExpr is the list of values of an IN expression.
+	TupleExpr []Expr
+
+	// Comparison ops
+	EqualOp         struct{}
+	NotEqualOp      struct{}
+	NullSafeEqualOp struct{}
+	LessThanOp      struct{}
+	LessEqualOp     struct{}
+	GreaterThanOp   struct{}
+	GreaterEqualOp  struct{}
+	LikeOp          struct {
+		Negate bool
+		Escape rune
+	}
+)
+
+var _ Expr = (*ComparisonExpr)(nil)
+var _ Expr = (*InExpr)(nil)
+
+var _ ComparisonOp = (*EqualOp)(nil)
+var _ ComparisonOp = (*NotEqualOp)(nil)
+var _ ComparisonOp = (*NullSafeEqualOp)(nil)
+var _ ComparisonOp = (*LessThanOp)(nil)
+var _ ComparisonOp = (*LessEqualOp)(nil)
+var _ ComparisonOp = (*GreaterThanOp)(nil)
+var _ ComparisonOp = (*GreaterEqualOp)(nil)
+var _ ComparisonOp = (*LikeOp)(nil)
+
+//Evaluate implements the Expr interface
+func (c *ComparisonExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	lVal, err := c.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	rVal, err := c.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if _, nullSafe := c.Op.(*NullSafeEqualOp); !nullSafe && (lVal.typ == sqltypes.Null || rVal.typ == sqltypes.Null) {
+		return resultNull, nil
+	}
+	return c.Op.compare(lVal, rVal)
+}
+
+//Type implements the Expr interface
+func (c *ComparisonExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (c *ComparisonExpr) String() string {
+	return c.Left.String() + " " + c.Op.String() + " " + c.Right.String()
+}
+
+//Evaluate implements the Expr interface
+func (i *InExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	lVal, err := i.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if lVal.typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	// If there is no match, the result is NULL
+	// if any of the values is NULL.
+	foundNull := false
+	for _, expr := range i.Right {
+		rVal, err := expr.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if rVal.typ == sqltypes.Null {
+			foundNull = true
+			continue
+		}
+		cmp, err := compareValues(lVal, rVal)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if cmp == 0 {
+			return newEvalBool(!i.Negate), nil
+		}
+	}
+	if foundNull {
+		return resultNull, nil
+	}
+	return newEvalBool(i.Negate), nil
+}
+
+//Type implements the Expr interface
+func (i *InExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (i *InExpr) String() string {
+	op := " in "
+	if i.Negate {
+		op = " not in "
+	}
+	return i.Left.String() + op + i.Right.String()
+}
+
+//String implements the Expr interface
+func (t TupleExpr) String() string {
+	var exprs []string
+	for _, expr := range t {
+		exprs = append(exprs, expr.String())
+	}
+	return "(" + strings.Join(exprs, ", ") + ")"
+}
+
+func (e *EqualOp) compare(left, right EvalResult) (EvalResult, error) {
+	cmp, err := compareValues(left, right)
+	return newEvalBool(cmp == 0), err
+}
+
+func (n *NotEqualOp) compare(left, right EvalResult) (EvalResult, error) {
+	cmp, err := compareValues(left, right)
+	return newEvalBool(cmp != 0), err
+}
+
+func (n *NullSafeEqualOp) compare(left, right EvalResult) (EvalResult, error) {
+	lNull, rNull := left.typ == sqltypes.Null, right.typ == sqltypes.Null
+	if lNull || rNull {
+		return newEvalBool(lNull && rNull), nil
+	}
+	cmp, err := compareValues(left, right)
+	return newEvalBool(cmp == 0), err
+}
+
+func (l *LessThanOp) compare(left, right EvalResult) (EvalResult, error) {
+	cmp, err := compareValues(left, right)
+	return newEvalBool(cmp < 0), err
+}
+
+func (l *LessEqualOp) compare(left, right EvalResult) (EvalResult, error) {
+	cmp, err := compareValues(left, right)
+	return newEvalBool(cmp <= 0), err
+}
+
+func (g *GreaterThanOp) compare(left, right EvalResult) (EvalResult, error) {
+	cmp, err := compareValues(left, right)
+	return newEvalBool(cmp > 0), err
+}
+
+func (g *GreaterEqualOp) compare(left, right EvalResult) (EvalResult, error) {
+	cmp, err := compareValues(left, right)
+	return newEvalBool(cmp >= 0), err
+}
+
+func (l *LikeOp) compare(left, right EvalResult) (EvalResult, error) {
+	coll := mergeCollations(left.collation, right.collation)
+	if left.isNumeric() && right.isNumeric() {
+		// Numbers are written with the same characters in all collations.
+		coll = collationBinary
+	}
+	matched, err := coll.like(left.toBytes(), right.toBytes(), l.Escape)
+	return newEvalBool(matched != l.Negate), err
+}
+
+//String implements the ComparisonOp interface
+func (e *EqualOp) String() string {
+	return "="
+}
+
+//String implements the ComparisonOp interface
+func (n *NotEqualOp) String() string {
+	return "!="
+}
+
+//String implements the ComparisonOp interface
+func (n *NullSafeEqualOp) String() string {
+	return "<=>"
+}
+
+//String implements the ComparisonOp interface
+func (l *LessThanOp) String() string {
+	return "<"
+}
+
+//String implements the ComparisonOp interface
+func (l *LessEqualOp) String() string {
+	return "<="
+}
+
+//String implements the ComparisonOp interface
+func (g *GreaterThanOp) String() string {
+	return ">"
+}
+
+//String implements the ComparisonOp interface
+func (g *GreaterEqualOp) String() string {
+	return ">="
+}
+
+//String implements the ComparisonOp interface
+func (l *LikeOp) String() string {
+	if l.Negate {
+		return "not like"
+	}
+	return "like"
+}
+
+// compareValues returns 0 if v1==v2, -1 if v1<v2, and 1 if v1>v2.
+// Neither of the values can be NULL. The values are compared following
+// the MySQL rules for type conversion in comparisons: two strings are
+// compared as strings using their collation, two integers are compared
+// as integers, decimals are compared exactly to integers and decimals,
+// and anything else is compared as floating point numbers.
+func compareValues(v1, v2 EvalResult) (int, error) {
+	switch {
+	case !v1.isNumeric() && !v2.isNumeric():
+		return mergeCollations(v1.collation, v2.collation).compare(v1.bytes, v2.bytes)
+	case v1.isNumeric() && v2.isNumeric():
+		if cmp, ok := compareExact(v1, v2); ok {
+			return cmp, nil
+		}
+		return compareNumeric(v1.normalize(), v2.normalize())
+	}
+	f1, f2 := v1.toFloat64(), v2.toFloat64()
+	switch {
+	case f1 == f2:
+		return 0, nil
+	case f1 < f2:
+		return -1, nil
+	}
+	return 1, nil
+}
+
+// normalize returns the same number as an Int64, Uint64 or Float64,
+// which are the only types the arithmetic and comparison functions
+// work with.
+func (e EvalResult) normalize() EvalResult {
+	switch {
+	case sqltypes.IsSigned(e.typ):
+		e.typ = sqltypes.Int64
+	case sqltypes.IsUnsigned(e.typ):
+		e.typ = sqltypes.Uint64
+	case sqltypes.IsFloat(e.typ), e.typ == sqltypes.Decimal:
+		e = e.decimalToFloat()
+		e.typ = sqltypes.Float64
+	}
+	return e
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"math/big"
+	"strconv"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// divPrecisionIncrement is the number of digits that a division adds
+// to the scale of the dividend, like MySQL's div_precision_increment.
+const divPrecisionIncrement = 4
+
+// maxDecimalScale is the maximum scale of a MySQL DECIMAL.
+const maxDecimalScale = 30
+
+// newEvalDecimal returns a DECIMAL value. The decimal is kept as text,
+// so that it is returned without losing precision, along with its
+// float64 approximation, which is used by the operations that don't
+// support decimals.
+func newEvalDecimal(raw []byte) (EvalResult, error) {
+	fval, err := strconv.ParseFloat(string(raw), 64)
+	if err != nil {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+	}
+	return EvalResult{typ: sqltypes.Decimal, fval: fval, bytes: raw}, nil
+}
+
+// newEvalDecimalFromRat returns a DECIMAL value with the given scale.
+func newEvalDecimalFromRat(r *big.Rat, scale int) EvalResult {
+	str := r.FloatString(scale)
+	fval, _ := r.Float64()
+	return EvalResult{typ: sqltypes.Decimal, fval: fval, bytes: []byte(str)}
+}
+
+// decimalToFloat converts a DECIMAL to its float64 approximation.
+// Other values are returned as they are.
+func (e EvalResult) decimalToFloat() EvalResult {
+	if e.typ != sqltypes.Decimal {
+		return e
+	}
+	return EvalResult{typ: sqltypes.Float64, fval: e.fval}
+}
+
+// decimalScale returns the number of digits after the decimal
+// point of an exact value.
+func (e *EvalResult) decimalScale() int {
+	if e.typ != sqltypes.Decimal {
+		return 0
+	}
+	if i := bytes.IndexByte(e.bytes, '.'); i >= 0 {
+		return len(e.bytes) - i - 1
+	}
+	return 0
+}
+
+// toRat converts an exact value to a big.Rat. It returns false
+// for floats and strings.
+func (e *EvalResult) toRat() (*big.Rat, bool) {
+	switch {
+	case sqltypes.IsSigned(e.typ):
+		return new(big.Rat).SetInt64(e.ival), true
+	case sqltypes.IsUnsigned(e.typ):
+		return new(big.Rat).SetUint64(e.uval), true
+	case e.typ == sqltypes.Decimal:
+		return new(big.Rat).SetString(string(e.bytes))
+	}
+	return nil, false
+}
+
+// compareExact compares two numbers exactly, when one of them is a
+// DECIMAL and the other one isn't a float: float64 only holds about 16
+// significant digits, which isn't enough to compare decimals as MySQL
+// does. The second return value is false if the numbers can't be
+// compared exactly.
+func compareExact(v1, v2 EvalResult) (int, bool) {
+	if v1.typ != sqltypes.Decimal && v2.typ != sqltypes.Decimal {
+		return 0, false
+	}
+	r1, ok := v1.toRat()
+	if !ok {
+		return 0, false
+	}
+	r2, ok := v2.toRat()
+	if !ok {
+		return 0, false
+	}
+	return r1.Cmp(r2), true
+}
+
+// DecimalDivision divides exact values the way MySQL does: the result
+// is a DECIMAL with divPrecisionIncrement more digits than the dividend.
+// If any of the values is a float or a string, the result is a float,
+// as with Division. It's used to compute AVG as SUM / COUNT.
+type DecimalDivision struct{}
+
+var _ BinaryExpr = (*DecimalDivision)(nil)
+
+// Evaluate implements the BinaryOp interface
+func (d *DecimalDivision) Evaluate(left, right EvalResult) (EvalResult, error) {
+	l, lok := left.toRat()
+	r, rok := right.toRat()
+	if !lok || !rok {
+		return divideNumericWithError(left, right)
+	}
+	if r.Sign() == 0 {
+		// Division by zero yields NULL, as in MySQL
+		return resultNull, nil
+	}
+	scale := left.decimalScale() + divPrecisionIncrement
+	if scale > maxDecimalScale {
+		scale = maxDecimalScale
+	}
+	return newEvalDecimalFromRat(l.Quo(l, r), scale), nil
+}
+
+// Type implements the BinaryExpr interface
+func (d *DecimalDivision) Type(left querypb.Type) querypb.Type {
+	if sqltypes.IsIntegral(left) || left == sqltypes.Decimal {
+		return sqltypes.Decimal
+	}
+	return sqltypes.Float64
+}
+
+// String implements the BinaryExpr interface
+func (d *DecimalDivision) String() string {
+	return "/"
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestDecimalDivision(t *testing.T) {
+	tcases := []struct {
+		left, right sqltypes.Value
+		out         sqltypes.Value
+	}{{
+		left:  sqltypes.NewInt64(10),
+		right: sqltypes.NewInt64(4),
+		out:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("2.5000")),
+	}, {
+		left:  sqltypes.NewInt64(1),
+		right: sqltypes.NewInt64(3),
+		out:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("0.3333")),
+	}, {
+		left:  sqltypes.NewInt64(2),
+		right: sqltypes.NewInt64(3),
+		out:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("0.6667")),
+	}, {
+		// The precision of big decimals is kept.
+		left:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("123456789012345678901234.50")),
+		right: sqltypes.NewInt64(2),
+		out:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("61728394506172839450617.250000")),
+	}, {
+		left:  sqltypes.MakeTrusted(sqltypes.Decimal, []byte("-7")),
+		right: sqltypes.NewUint64(2),
+		out:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("-3.5000")),
+	}, {
+		left:  sqltypes.NewInt64(1),
+		right: sqltypes.NewInt64(0),
+		out:   sqltypes.NULL,
+	}, {
+		// Floats are divided as floats.
+		left:  sqltypes.NewFloat64(1.5),
+		right: sqltypes.NewInt64(2),
+		out:   sqltypes.NewFloat64(0.75),
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.left.String()+" / "+tcase.right.String(), func(t *testing.T) {
+			expr := &BinaryOp{
+				Expr:  &DecimalDivision{},
+				Left:  NewColumn(0),
+				Right: NewColumn(1),
+			}
+			res, err := expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{tcase.left, tcase.right}})
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, res.Value())
+		})
+	}
+}
+
+func TestDecimalDivisionType(t *testing.T) {
+	d := &DecimalDivision{}
+	assert.Equal(t, sqltypes.Decimal, d.Type(sqltypes.Int64))
+	assert.Equal(t, sqltypes.Decimal, d.Type(sqltypes.Uint64))
+	assert.Equal(t, sqltypes.Decimal, d.Type(sqltypes.Decimal))
+	assert.Equal(t, sqltypes.Float64, d.Type(sqltypes.Float64))
+	assert.Equal(t, sqltypes.Float64, d.Type(sqltypes.VarChar))
+}
+
+func TestDecimalPassThrough(t *testing.T) {
+	// Decimals are returned as they are, and compared as numbers.
+	v := sqltypes.MakeTrusted(sqltypes.Decimal, []byte("12345678901234567890.12"))
+	res, err := NewColumn(0).Evaluate(ExpressionEnv{Row: []sqltypes.Value{v}})
+	require.NoError(t, err)
+	assert.Equal(t, v, res.Value())
+
+	cmp, err := NullsafeCompare(v, sqltypes.NewInt64(1))
+	require.NoError(t, err)
+	assert.Equal(t, 1, cmp)
+}
+
+func TestDecimalRounding(t *testing.T) {
+	decimal := func(s string) sqltypes.Value {
+		return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(s))
+	}
+	tcases := []struct {
+		function string
+		arg      sqltypes.Value
+		digits   int64
+		out      sqltypes.Value
+	}{
+		{"round", decimal("2.345"), 2, decimal("2.35")},
+		{"round", decimal("-2.345"), 2, decimal("-2.35")},
+		{"round", decimal("2.5"), 0, decimal("3")},
+		{"round", decimal("1.25"), 5, decimal("1.25")},
+		{"round", decimal("12345678901234567890.45"), 1, decimal("12345678901234567890.5")},
+		{"round", decimal("155.5"), -1, decimal("160")},
+		{"truncate", decimal("2.349"), 2, decimal("2.34")},
+		{"truncate", decimal("-2.349"), 2, decimal("-2.34")},
+		{"truncate", decimal("159.9"), -1, decimal("150")},
+		{"truncate", sqltypes.NewInt64(-1299), -2, sqltypes.NewInt64(-1200)},
+		{"round", sqltypes.NewInt64(1250), -2, sqltypes.NewInt64(1300)},
+		{"round", sqltypes.NewUint64(18446744073709551615), 0, sqltypes.NewUint64(18446744073709551615)},
+	}
+	for _, tcase := range tcases {
+		t.Run(fmt.Sprintf("%s(%s, %d)", tcase.function, tcase.arg.String(), tcase.digits), func(t *testing.T) {
+			expr, err := NewCallExpr(tcase.function, []Expr{NewColumn(0), NewLiteralInt(tcase.digits)})
+			require.NoError(t, err)
+			res, err := expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{tcase.arg}})
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, res.Value())
+		})
+	}
+
+	for _, function := range []string{"ceil", "floor", "abs"} {
+		expr, err := NewCallExpr(function, []Expr{NewColumn(0)})
+		require.NoError(t, err)
+		res, err := expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{decimal("-2.5")}})
+		require.NoError(t, err)
+		assert.Equal(t, sqltypes.Decimal, res.Value().Type(), function)
+		typ, err := expr.Type(ExpressionEnv{Fields: []*querypb.Field{{Type: sqltypes.Decimal}}})
+		require.NoError(t, err)
+		assert.Equal(t, sqltypes.Decimal, typ, function)
+	}
+}
+
+func TestDecimalComparison(t *testing.T) {
+	decimal := func(s string) sqltypes.Value {
+		return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(s))
+	}
+	tcases := []struct {
+		left, right sqltypes.Value
+		op          ComparisonOp
+		out         int64
+	}{
+		// Decimals that differ beyond the 16th digit are not equal.
+		{decimal("0.1000000000000000000001"), decimal("0.1"), &EqualOp{}, 0},
+		{decimal("0.1000000000000000000001"), decimal("0.1"), &GreaterThanOp{}, 1},
+		{decimal("99999999999999999999999999999999999.000000000000000000000000000001"), decimal("99999999999999999999999999999999999"), &GreaterThanOp{}, 1},
+		{decimal("12345678901234567890.12"), decimal("12345678901234567890.13"), &LessThanOp{}, 1},
+		{decimal("-0.00000000000000000001"), sqltypes.NewInt64(0), &LessThanOp{}, 1},
+		// Decimals are compared exactly to integers.
+		{decimal("9007199254740993"), sqltypes.NewInt64(9007199254740992), &NotEqualOp{}, 1},
+		{decimal("18446744073709551615.0"), sqltypes.NewUint64(18446744073709551615), &EqualOp{}, 1},
+		{decimal("1.0"), sqltypes.NewInt64(1), &NullSafeEqualOp{}, 1},
+		// Decimals are compared to floats as floats.
+		{decimal("0.1000000000000000000001"), sqltypes.NewFloat64(0.1), &EqualOp{}, 1},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.left.String()+" "+tcase.op.String()+" "+tcase.right.String(), func(t *testing.T) {
+			expr := &ComparisonExpr{
+				Op:    tcase.op,
+				Left:  NewColumn(0),
+				Right: NewColumn(1),
+			}
+			res, err := expr.Evaluate(ExpressionEnv{Row: []sqltypes.Value{tcase.left, tcase.right}})
+			require.NoError(t, err)
+			assert.Equal(t, sqltypes.NewInt64(tcase.out), res.Value())
+		})
+	}
+
+	in := &InExpr{Left: NewColumn(0), Right: TupleExpr{NewColumn(1)}}
+	res, err := in.Evaluate(ExpressionEnv{Row: []sqltypes.Value{decimal("0.1000000000000000000001"), decimal("0.1")}})
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.NewInt64(0), res.Value())
+
+	cmp, err := NullsafeCompare(decimal("0.1000000000000000000001"), decimal("0.1"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, cmp)
+}
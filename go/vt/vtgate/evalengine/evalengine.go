@@ -102,7 +102,7 @@ func ToFloat64(v sqltypes.Value) (float64, error) {
 		return float64(num.ival), nil
 	case sqltypes.Uint64:
 		return float64(num.uval), nil
-	case sqltypes.Float64:
+	case sqltypes.Float64, sqltypes.Decimal:
 		return num.fval, nil
 	}
 
@@ -143,7 +143,9 @@ func ToNative(v sqltypes.Value) (interface{}, error) {
 func newEvalResult(v sqltypes.Value) (EvalResult, error) {
 	raw := v.Raw()
 	switch {
-	case v.IsBinary() || v.IsText():
+	case v.IsBinary():
+		return EvalResult{bytes: raw, typ: sqltypes.VarBinary, collation: collationBinary}, nil
+	case v.IsText():
 		return EvalResult{bytes: raw, typ: sqltypes.VarBinary}, nil
 	case v.IsSigned():
 		ival, err := strconv.ParseInt(string(raw), 10, 64)
@@ -157,12 +159,14 @@ func newEvalResult(v sqltypes.Value) (EvalResult, error) {
 			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 		}
 		return EvalResult{uval: uval, typ: sqltypes.Uint64}, nil
-	case v.IsFloat() || v.Type() == sqltypes.Decimal:
+	case v.IsFloat():
 		fval, err := strconv.ParseFloat(string(raw), 64)
 		if err != nil {
 			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 		}
 		return EvalResult{fval: fval, typ: sqltypes.Float64}, nil
+	case v.Type() == sqltypes.Decimal:
+		return newEvalDecimal(raw)
 	default:
 		return EvalResult{typ: v.Type(), bytes: raw}, nil
 	}
@@ -204,7 +208,7 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, v.ival, 10))
 		case sqltypes.Uint64:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.uval), 10))
-		case sqltypes.Float64:
+		case sqltypes.Float64, sqltypes.Decimal:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.fval), 10))
 		}
 	case sqltypes.IsUnsigned(resultType):
@@ -213,7 +217,7 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, v.uval, 10))
 		case sqltypes.Int64:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.ival), 10))
-		case sqltypes.Float64:
+		case sqltypes.Float64, sqltypes.Decimal:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.fval), 10))
 		}
 	case sqltypes.IsFloat(resultType) || resultType == sqltypes.Decimal:
@@ -228,6 +232,11 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 				format = 'f'
 			}
 			return sqltypes.MakeTrusted(resultType, strconv.AppendFloat(nil, v.fval, format, -1, 64))
+		case sqltypes.Decimal:
+			if resultType == sqltypes.Decimal {
+				return sqltypes.MakeTrusted(resultType, v.bytes)
+			}
+			return sqltypes.MakeTrusted(resultType, strconv.AppendFloat(nil, v.fval, 'g', -1, 64))
 		}
 	default:
 		return sqltypes.MakeTrusted(resultType, v.bytes)
@@ -243,7 +252,7 @@ func hashCode(v EvalResult) int64 {
 		val = float64(v.ival)
 	case sqltypes.Uint64:
 		val = float64(v.uval)
-	case sqltypes.Float64:
+	case sqltypes.Float64, sqltypes.Decimal:
 		val = v.fval
 	}
 
@@ -253,6 +262,12 @@ func hashCode(v EvalResult) int64 {
 }
 
 func compareNumeric(v1, v2 EvalResult) (int, error) {
+	if cmp, ok := compareExact(v1, v2); ok {
+		return cmp, nil
+	}
+	// Decimals are compared to floats as floats.
+	v1, v2 = v1.decimalToFloat(), v2.decimalToFloat()
+
 	// Equalize the types.
 	switch v1.typ {
 	case sqltypes.Int64:
@@ -34,12 +34,15 @@ type (
 		uval  uint64
 		fval  float64
 		bytes []byte
+		// collation is only meaningful for string values.
+		collation collation
 	}
 	//ExpressionEnv contains the environment that the expression
 	//evaluates in, such as the current row and bindvars
 	ExpressionEnv struct {
 		BindVars map[string]*querypb.BindVariable
 		Row      []sqltypes.Value
+		Fields   []*querypb.Field
 	}
 
 	// Expr is the interface that all evaluating expressions must implement
@@ -59,7 +62,12 @@ type (
 	// Expressions
 	Literal      struct{ Val EvalResult }
 	BindVariable struct{ Key string }
-	Column       struct{ Offset int }
+	Column       struct {
+		Offset int
+		// field, if set, is the field of the column, which
+		// is then known before the expression is evaluated.
+		field *querypb.Field
+	}
 	BinaryOp     struct {
 		Expr        BinaryExpr
 		Left, Right Expr
@@ -105,6 +113,11 @@ func NewLiteralString(val []byte) Expr {
 	return &Literal{EvalResult{typ: sqltypes.VarBinary, bytes: val}}
 }
 
+//NewLiteralNull returns a NULL literal
+func NewLiteralNull() Expr {
+	return &Literal{resultNull}
+}
+
 //NewBindVar returns a bind variable
 func NewBindVar(key string) Expr {
 	return &BindVariable{Key: key}
@@ -117,6 +130,24 @@ func NewColumn(offset int) Expr {
 	}
 }
 
+// NewColumnWithField returns a column expression whose field is known
+// when the expression is built. Text columns can then be compared if
+// their collation is one that evalengine implements.
+func NewColumnWithField(offset int, field *querypb.Field) Expr {
+	return &Column{
+		Offset: offset,
+		field:  field,
+	}
+}
+
+// fieldOf returns the field of the column, if it's known.
+func (c *Column) fieldOf(env ExpressionEnv) *querypb.Field {
+	if c.field == nil && c.Offset < len(env.Fields) {
+		return env.Fields[c.Offset]
+	}
+	return c.field
+}
+
 var _ Expr = (*Literal)(nil)
 var _ Expr = (*BindVariable)(nil)
 var _ Expr = (*BinaryOp)(nil)
@@ -137,6 +168,10 @@ func (b *BinaryOp) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	if err != nil {
 		return EvalResult{}, err
 	}
+	// Arithmetic on NULL yields NULL, as in MySQL
+	if lVal.typ == sqltypes.Null || rVal.typ == sqltypes.Null {
+		return EvalResult{typ: sqltypes.Null}, nil
+	}
 	return b.Expr.Evaluate(lVal, rVal)
 }
 
@@ -158,6 +193,9 @@ func (b *BindVariable) Evaluate(env ExpressionEnv) (EvalResult, error) {
 func (c *Column) Evaluate(env ExpressionEnv) (EvalResult, error) {
 	value := env.Row[c.Offset]
 	numeric, err := newEvalResult(value)
+	if field := c.fieldOf(env); err == nil && field != nil && (value.IsText() || value.IsBinary()) {
+		numeric.collation = collationOf(field.Type, field.Charset)
+	}
 	return numeric, err
 }
 
@@ -231,7 +269,10 @@ func (l *Literal) Type(ExpressionEnv) (querypb.Type, error) {
 }
 
 //Type implements the Expr interface
-func (c *Column) Type(ExpressionEnv) (querypb.Type, error) {
+func (c *Column) Type(env ExpressionEnv) (querypb.Type, error) {
+	if field := c.fieldOf(env); field != nil {
+		return field.Type, nil
+	}
 	return sqltypes.Float64, nil
 }
 
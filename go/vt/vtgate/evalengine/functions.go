@@ -0,0 +1,568 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+	"math"
+	"math/big"
+	"strings"
+	"unicode/utf8"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// CallExpr represents a call to one of the builtin functions.
+type CallExpr struct {
+	Name      string
+	Arguments TupleExpr
+	f         *builtin
+}
+
+// builtin is the implementation of a function. Unless nullable is set,
+// the result is NULL if any of the arguments is NULL, and call only
+// gets non-NULL arguments. maxArgs is -1 for variadic functions.
+type builtin struct {
+	minArgs, maxArgs int
+	nullable         bool
+	call             func(args []EvalResult) (EvalResult, error)
+	typ              func(env ExpressionEnv, args TupleExpr) (querypb.Type, error)
+	// canCompare is set for the functions that compare their arguments.
+	// It returns false if they can't be compared by evalengine.
+	canCompare func(args TupleExpr) bool
+	// results returns the arguments the function can return, if
+	// it returns one of its arguments.
+	results func(args TupleExpr) TupleExpr
+}
+
+var _ Expr = (*CallExpr)(nil)
+
+// NewCallExpr returns a call to the builtin function with the given
+// name. It fails if the function is not supported, or if the number
+// of arguments is not correct.
+func NewCallExpr(name string, args []Expr) (*CallExpr, error) {
+	name = strings.ToLower(name)
+	f, ok := builtins[name]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported function: %s", name)
+	}
+	if len(args) < f.minArgs || (f.maxArgs >= 0 && len(args) > f.maxArgs) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Incorrect parameter count in the call to native function '%s'", name)
+	}
+	if f.canCompare != nil && !f.canCompare(args) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: comparison of strings with an unknown collation in %s", name)
+	}
+	return &CallExpr{Name: name, Arguments: args, f: f}, nil
+}
+
+// SupportedFunction returns true if the function can be evaluated by NewCallExpr.
+func SupportedFunction(name string) bool {
+	_, ok := builtins[strings.ToLower(name)]
+	return ok
+}
+
+//Evaluate implements the Expr interface
+func (c *CallExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	args := make([]EvalResult, len(c.Arguments))
+	for i, expr := range c.Arguments {
+		arg, err := expr.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if arg.typ == sqltypes.Null && !c.f.nullable {
+			return resultNull, nil
+		}
+		args[i] = arg
+	}
+	return c.f.call(args)
+}
+
+//Type implements the Expr interface
+func (c *CallExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	return c.f.typ(env, c.Arguments)
+}
+
+//String implements the Expr interface
+func (c *CallExpr) String() string {
+	return c.Name + c.Arguments.String()
+}
+
+var builtins map[string]*builtin
+
+func init() {
+	builtins = map[string]*builtin{
+		// String functions
+		"concat":           {minArgs: 1, maxArgs: -1, call: concat, typ: typeOf(sqltypes.VarChar)},
+		"concat_ws":        {minArgs: 2, maxArgs: -1, nullable: true, call: concatWs, typ: typeOf(sqltypes.VarChar)},
+		"lower":            {minArgs: 1, maxArgs: 1, call: lower, typ: typeOf(sqltypes.VarChar)},
+		"lcase":            {minArgs: 1, maxArgs: 1, call: lower, typ: typeOf(sqltypes.VarChar)},
+		"upper":            {minArgs: 1, maxArgs: 1, call: upper, typ: typeOf(sqltypes.VarChar)},
+		"ucase":            {minArgs: 1, maxArgs: 1, call: upper, typ: typeOf(sqltypes.VarChar)},
+		"length":           {minArgs: 1, maxArgs: 1, call: length, typ: typeOf(sqltypes.Int64)},
+		"octet_length":     {minArgs: 1, maxArgs: 1, call: length, typ: typeOf(sqltypes.Int64)},
+		"char_length":      {minArgs: 1, maxArgs: 1, call: charLength, typ: typeOf(sqltypes.Int64)},
+		"character_length": {minArgs: 1, maxArgs: 1, call: charLength, typ: typeOf(sqltypes.Int64)},
+		"left":             {minArgs: 2, maxArgs: 2, call: left, typ: typeOf(sqltypes.VarChar)},
+		"right":            {minArgs: 2, maxArgs: 2, call: right, typ: typeOf(sqltypes.VarChar)},
+		"substring":        {minArgs: 2, maxArgs: 3, call: substring, typ: typeOf(sqltypes.VarChar)},
+		"substr":           {minArgs: 2, maxArgs: 3, call: substring, typ: typeOf(sqltypes.VarChar)},
+		"reverse":          {minArgs: 1, maxArgs: 1, call: reverse, typ: typeOf(sqltypes.VarChar)},
+		"repeat":           {minArgs: 2, maxArgs: 2, call: repeat, typ: typeOf(sqltypes.VarChar)},
+		"replace":          {minArgs: 3, maxArgs: 3, call: replace, typ: typeOf(sqltypes.VarChar)},
+		"trim":             {minArgs: 1, maxArgs: 1, call: trimFunc(bytes.Trim), typ: typeOf(sqltypes.VarChar)},
+		"ltrim":            {minArgs: 1, maxArgs: 1, call: trimFunc(bytes.TrimLeft), typ: typeOf(sqltypes.VarChar)},
+		"rtrim":            {minArgs: 1, maxArgs: 1, call: trimFunc(bytes.TrimRight), typ: typeOf(sqltypes.VarChar)},
+
+		// Numeric functions
+		"abs":      {minArgs: 1, maxArgs: 1, call: abs, typ: typeOfNumericArg},
+		"ceil":     {minArgs: 1, maxArgs: 1, call: roundFunc(roundCeil), typ: typeOfNumericArg},
+		"ceiling":  {minArgs: 1, maxArgs: 1, call: roundFunc(roundCeil), typ: typeOfNumericArg},
+		"floor":    {minArgs: 1, maxArgs: 1, call: roundFunc(roundFloor), typ: typeOfNumericArg},
+		"round":    {minArgs: 1, maxArgs: 2, call: roundDigitsFunc(roundHalfAwayFromZero), typ: typeOfNumericArg},
+		"truncate": {minArgs: 2, maxArgs: 2, call: roundDigitsFunc(roundTowardZero), typ: typeOfNumericArg},
+		"sign":     {minArgs: 1, maxArgs: 1, call: sign, typ: typeOf(sqltypes.Int64)},
+
+		// Control flow and comparison functions
+		"coalesce": {minArgs: 1, maxArgs: -1, nullable: true, call: coalesce, typ: typeOfArgs, results: allArgs},
+		"ifnull":   {minArgs: 2, maxArgs: 2, nullable: true, call: coalesce, typ: typeOfArgs, results: allArgs},
+		"if":       {minArgs: 3, maxArgs: 3, nullable: true, call: ifFunc, typ: typeOfIf, results: ifResults},
+		"nullif":   {minArgs: 2, maxArgs: 2, nullable: true, call: nullif, typ: typeOfArgs, results: firstArg, canCompare: canComparePair},
+		"isnull":   {minArgs: 1, maxArgs: 1, nullable: true, call: isnull, typ: typeOf(sqltypes.Int64)},
+		"greatest": {minArgs: 2, maxArgs: -1, call: minmaxFunc(false), typ: typeOfArgs, results: allArgs, canCompare: canCompareAll},
+		"least":    {minArgs: 2, maxArgs: -1, call: minmaxFunc(true), typ: typeOfArgs, results: allArgs, canCompare: canCompareAll},
+	}
+}
+
+func typeOf(typ querypb.Type) func(ExpressionEnv, TupleExpr) (querypb.Type, error) {
+	return func(ExpressionEnv, TupleExpr) (querypb.Type, error) {
+		return typ, nil
+	}
+}
+
+// typeOfArgs returns the type that can hold any of the arguments.
+func typeOfArgs(env ExpressionEnv, args TupleExpr) (querypb.Type, error) {
+	return aggregateTypes(env, args)
+}
+
+// typeOfIf returns the type of IF(cond, a, b), which can be either a or b.
+func typeOfIf(env ExpressionEnv, args TupleExpr) (querypb.Type, error) {
+	return aggregateTypes(env, args[1:])
+}
+
+func allArgs(args TupleExpr) TupleExpr {
+	return args
+}
+
+func firstArg(args TupleExpr) TupleExpr {
+	return args[:1]
+}
+
+func ifResults(args TupleExpr) TupleExpr {
+	return args[1:]
+}
+
+// typeOfNumericArg returns the type of a function that returns a number of the
+// same type as its first argument. Decimals stay decimals, and strings
+// are converted to floats.
+func typeOfNumericArg(env ExpressionEnv, args TupleExpr) (querypb.Type, error) {
+	typ, err := args[0].Type(env)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case sqltypes.IsSigned(typ):
+		return sqltypes.Int64, nil
+	case sqltypes.IsUnsigned(typ):
+		return sqltypes.Uint64, nil
+	case typ == sqltypes.Decimal:
+		return sqltypes.Decimal, nil
+	}
+	return sqltypes.Float64, nil
+}
+
+// stringCollation returns the collation of the result of a string
+// function: binary if any of the string arguments is binary, and the
+// collation of the arguments otherwise, if it's known.
+func stringCollation(args []EvalResult) collation {
+	coll := collationUnknown
+	for _, arg := range args {
+		if !arg.isNumeric() {
+			coll = mergeCollations(coll, arg.collation)
+		}
+	}
+	return coll
+}
+
+func concat(args []EvalResult) (EvalResult, error) {
+	var buf []byte
+	for _, arg := range args {
+		buf = append(buf, arg.toBytes()...)
+	}
+	return newEvalString(buf, stringCollation(args)), nil
+}
+
+func concatWs(args []EvalResult) (EvalResult, error) {
+	if args[0].typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	sep := args[0].toBytes()
+	var parts [][]byte
+	for _, arg := range args[1:] {
+		if arg.typ != sqltypes.Null {
+			parts = append(parts, arg.toBytes())
+		}
+	}
+	return newEvalString(bytes.Join(parts, sep), stringCollation(args)), nil
+}
+
+// lower and upper don't change binary strings, as in MySQL.
+func lower(args []EvalResult) (EvalResult, error) {
+	str, coll := args[0].toBytes(), stringCollation(args)
+	if coll != collationBinary {
+		str = bytes.ToLower(str)
+	}
+	return newEvalString(str, coll), nil
+}
+
+func upper(args []EvalResult) (EvalResult, error) {
+	str, coll := args[0].toBytes(), stringCollation(args)
+	if coll != collationBinary {
+		str = bytes.ToUpper(str)
+	}
+	return newEvalString(str, coll), nil
+}
+
+func length(args []EvalResult) (EvalResult, error) {
+	return EvalResult{typ: sqltypes.Int64, ival: int64(len(args[0].toBytes()))}, nil
+}
+
+func charLength(args []EvalResult) (EvalResult, error) {
+	str := args[0].toBytes()
+	if stringCollation(args) == collationBinary {
+		return EvalResult{typ: sqltypes.Int64, ival: int64(len(str))}, nil
+	}
+	return EvalResult{typ: sqltypes.Int64, ival: int64(utf8.RuneCount(str))}, nil
+}
+
+// characters splits the string in characters: bytes
+// for binary strings, and runes for any other string.
+func characters(arg EvalResult, coll collation) []string {
+	str := arg.toBytes()
+	if coll == collationBinary {
+		chars := make([]string, len(str))
+		for i, b := range str {
+			chars[i] = string([]byte{b})
+		}
+		return chars
+	}
+	return strings.Split(string(str), "")
+}
+
+func left(args []EvalResult) (EvalResult, error) {
+	coll := stringCollation(args[:1])
+	chars := characters(args[0], coll)
+	n := clamp(args[1].toInt64(), 0, int64(len(chars)))
+	return newEvalString([]byte(strings.Join(chars[:n], "")), coll), nil
+}
+
+func right(args []EvalResult) (EvalResult, error) {
+	coll := stringCollation(args[:1])
+	chars := characters(args[0], coll)
+	n := clamp(args[1].toInt64(), 0, int64(len(chars)))
+	return newEvalString([]byte(strings.Join(chars[int64(len(chars))-n:], "")), coll), nil
+}
+
+// substring implements SUBSTRING(str, pos[, len]). Positions start at 1,
+// and a negative position counts from the end of the string.
+func substring(args []EvalResult) (EvalResult, error) {
+	coll := stringCollation(args[:1])
+	chars := characters(args[0], coll)
+	size := int64(len(chars))
+	pos := args[1].toInt64()
+	switch {
+	case pos > 0:
+		pos--
+	case pos < 0:
+		pos += size
+	default:
+		// Position 0 returns an empty string.
+		pos = size
+	}
+	if pos < 0 || pos > size {
+		pos = size
+	}
+	end := size
+	if len(args) == 3 {
+		end = pos + clamp(args[2].toInt64(), 0, size-pos)
+	}
+	return newEvalString([]byte(strings.Join(chars[pos:end], "")), coll), nil
+}
+
+func reverse(args []EvalResult) (EvalResult, error) {
+	coll := stringCollation(args)
+	chars := characters(args[0], coll)
+	for i, j := 0, len(chars)-1; i < j; i, j = i+1, j-1 {
+		chars[i], chars[j] = chars[j], chars[i]
+	}
+	return newEvalString([]byte(strings.Join(chars, "")), coll), nil
+}
+
+func repeat(args []EvalResult) (EvalResult, error) {
+	count := args[1].toInt64()
+	if count < 0 {
+		count = 0
+	}
+	str := args[0].toBytes()
+	// max_allowed_packet defaults to 64MB: MySQL returns NULL
+	// if the result would be larger than that.
+	if len(str) > 0 && count > (64<<20)/int64(len(str)) {
+		return resultNull, nil
+	}
+	return newEvalString(bytes.Repeat(str, int(count)), stringCollation(args[:1])), nil
+}
+
+// replace is always case sensitive, as in MySQL.
+func replace(args []EvalResult) (EvalResult, error) {
+	from := args[1].toBytes()
+	str := args[0].toBytes()
+	if len(from) > 0 {
+		str = bytes.ReplaceAll(str, from, args[2].toBytes())
+	}
+	return newEvalString(str, stringCollation(args)), nil
+}
+
+func trimFunc(trim func([]byte, string) []byte) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		return newEvalString(trim(args[0].toBytes(), " "), stringCollation(args)), nil
+	}
+}
+
+func abs(args []EvalResult) (EvalResult, error) {
+	if args[0].typ == sqltypes.Decimal {
+		r, _ := args[0].toRat()
+		return newEvalDecimalFromRat(r.Abs(r), args[0].decimalScale()), nil
+	}
+	arg := args[0].normalize()
+	switch arg.typ {
+	case sqltypes.Int64:
+		if arg.ival == math.MinInt64 {
+			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_OUT_OF_RANGE, "BIGINT value is out of range in 'abs(%d)'", arg.ival)
+		}
+		if arg.ival < 0 {
+			arg.ival = -arg.ival
+		}
+		return arg, nil
+	case sqltypes.Uint64:
+		return arg, nil
+	}
+	return EvalResult{typ: sqltypes.Float64, fval: math.Abs(arg.toFloat64())}, nil
+}
+
+// roundingMode decides how a number is rounded to a given number of digits.
+type roundingMode int8
+
+const (
+	// roundHalfAwayFromZero is the rounding of ROUND.
+	roundHalfAwayFromZero roundingMode = iota
+	// roundTowardZero is the rounding of TRUNCATE.
+	roundTowardZero
+	roundCeil
+	roundFloor
+)
+
+// roundFloat rounds f to the given number of digits after the decimal
+// point, or before it if digits is negative.
+func roundFloat(f float64, digits int64, mode roundingMode) float64 {
+	scale := math.Pow10(int(digits))
+	switch mode {
+	case roundTowardZero:
+		return math.Trunc(f*scale) / scale
+	case roundCeil:
+		return math.Ceil(f*scale) / scale
+	case roundFloor:
+		return math.Floor(f*scale) / scale
+	}
+	return math.Round(f*scale) / scale
+}
+
+// roundRat is like roundFloat for exact values.
+func roundRat(r *big.Rat, digits int64, mode roundingMode) *big.Rat {
+	exp := digits
+	if exp < 0 {
+		exp = -exp
+	}
+	pow := new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(exp), nil))
+	x := new(big.Rat).Set(r)
+	if digits >= 0 {
+		x.Mul(x, pow)
+	} else {
+		x.Quo(x, pow)
+	}
+	// q is x truncated toward zero, and m has the sign of x.
+	q, m := new(big.Int).QuoRem(x.Num(), x.Denom(), new(big.Int))
+	if m.Sign() != 0 {
+		switch mode {
+		case roundHalfAwayFromZero:
+			if new(big.Int).Lsh(m.Abs(m), 1).Cmp(x.Denom()) >= 0 {
+				q.Add(q, big.NewInt(int64(x.Sign())))
+			}
+		case roundCeil:
+			if x.Sign() > 0 {
+				q.Add(q, big.NewInt(1))
+			}
+		case roundFloor:
+			if x.Sign() < 0 {
+				q.Sub(q, big.NewInt(1))
+			}
+		}
+	}
+	rounded := new(big.Rat).SetInt(q)
+	if digits >= 0 {
+		return rounded.Quo(rounded, pow)
+	}
+	return rounded.Mul(rounded, pow)
+}
+
+// roundNumber rounds the number to the given number of digits. The
+// result has the same type as the number: integers and decimals are
+// rounded exactly, and strings are converted to floats.
+func roundNumber(arg EvalResult, digits int64, mode roundingMode) EvalResult {
+	if r, ok := arg.toRat(); ok {
+		if digits >= 0 && arg.typ != sqltypes.Decimal {
+			return arg.normalize()
+		}
+		rounded := roundRat(r, digits, mode)
+		if arg.typ == sqltypes.Decimal {
+			return newEvalDecimalFromRat(rounded, int(clamp(digits, 0, int64(arg.decimalScale()))))
+		}
+		// Integers rounded to a negative number of digits
+		// are still integers.
+		if sqltypes.IsSigned(arg.typ) {
+			return EvalResult{typ: sqltypes.Int64, ival: rounded.Num().Int64()}
+		}
+		return EvalResult{typ: sqltypes.Uint64, uval: rounded.Num().Uint64()}
+	}
+	return EvalResult{typ: sqltypes.Float64, fval: roundFloat(arg.toFloat64(), digits, mode)}
+}
+
+// roundFunc returns a function that rounds its argument to an
+// integer with the given rounding mode, like CEIL and FLOOR.
+func roundFunc(mode roundingMode) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		return roundNumber(args[0], 0, mode), nil
+	}
+}
+
+// roundDigitsFunc returns a function that rounds its first argument to
+// the number of digits of its second argument, like ROUND and TRUNCATE.
+func roundDigitsFunc(mode roundingMode) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		var digits int64
+		if len(args) == 2 {
+			digits = clamp(args[1].toInt64(), -maxDecimalScale, maxDecimalScale)
+		}
+		return roundNumber(args[0], digits, mode), nil
+	}
+}
+
+func sign(args []EvalResult) (EvalResult, error) {
+	f := args[0].toFloat64()
+	switch {
+	case f > 0:
+		return EvalResult{typ: sqltypes.Int64, ival: 1}, nil
+	case f < 0:
+		return EvalResult{typ: sqltypes.Int64, ival: -1}, nil
+	}
+	return EvalResult{typ: sqltypes.Int64, ival: 0}, nil
+}
+
+func coalesce(args []EvalResult) (EvalResult, error) {
+	for _, arg := range args {
+		if arg.typ != sqltypes.Null {
+			return arg, nil
+		}
+	}
+	return resultNull, nil
+}
+
+func ifFunc(args []EvalResult) (EvalResult, error) {
+	if cond, _ := args[0].toBoolean(); cond {
+		return args[1], nil
+	}
+	return args[2], nil
+}
+
+func nullif(args []EvalResult) (EvalResult, error) {
+	if args[0].typ == sqltypes.Null || args[1].typ == sqltypes.Null {
+		return args[0], nil
+	}
+	cmp, err := compareValues(args[0], args[1])
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if cmp == 0 {
+		return resultNull, nil
+	}
+	return args[0], nil
+}
+
+func isnull(args []EvalResult) (EvalResult, error) {
+	return newEvalBool(args[0].typ == sqltypes.Null), nil
+}
+
+// minmaxFunc returns GREATEST or LEAST. As in MySQL, the arguments are
+// compared as numbers if they're all numbers, and as strings otherwise.
+func minmaxFunc(min bool) func([]EvalResult) (EvalResult, error) {
+	return func(args []EvalResult) (EvalResult, error) {
+		numeric := true
+		for _, arg := range args {
+			numeric = numeric && arg.isNumeric()
+		}
+		coll := stringCollation(args)
+		result := args[0]
+		for _, arg := range args[1:] {
+			var cmp int
+			var err error
+			if numeric {
+				cmp, err = compareValues(arg, result)
+			} else {
+				cmp, err = coll.compare(arg.toBytes(), result.toBytes())
+			}
+			if err != nil {
+				return EvalResult{}, err
+			}
+			if (min && cmp < 0) || (!min && cmp > 0) {
+				result = arg
+			}
+		}
+		return result, nil
+	}
+}
+
+func clamp(v, min, max int64) int64 {
+	switch {
+	case v < min:
+		return min
+	case v > max:
+		return max
+	}
+	return v
+}
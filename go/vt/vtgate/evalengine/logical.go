@@ -0,0 +1,319 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// LogicalExpr represents AND, OR and XOR. They follow the
+	// three-valued logic of SQL: NULL means unknown.
+	LogicalExpr struct {
+		Op          LogicalOp
+		Left, Right Expr
+	}
+
+	// LogicalOp is an enum for LogicalExpr.Op
+	LogicalOp int8
+
+	// NotExpr represents a NOT expression.
+	NotExpr struct {
+		Inner Expr
+	}
+
+	// IsExpr represents the IS [NOT] NULL/TRUE/FALSE expressions.
+	// Their result is never NULL.
+	IsExpr struct {
+		Inner Expr
+		Op    IsOp
+	}
+
+	// IsOp is an enum for IsExpr.Op
+	IsOp int8
+
+	// CaseExpr represents a CASE expression. If Base is set, it is
+	// compared to the condition of each of the whens. Otherwise, the
+	// conditions are evaluated as booleans.
+	CaseExpr struct {
+		Base  Expr
+		Whens []WhenExpr
+		Else  Expr
+	}
+
+	// WhenExpr represents a WHEN ... THEN ... of a CASE expression.
+	WhenExpr struct {
+		Cond, Val Expr
+	}
+)
+
+// Logical operators
+const (
+	AndOp LogicalOp = iota
+	OrOp
+	XorOp
+)
+
+// IS operators
+const (
+	IsNullOp IsOp = iota
+	IsNotNullOp
+	IsTrueOp
+	IsNotTrueOp
+	IsFalseOp
+	IsNotFalseOp
+)
+
+var _ Expr = (*LogicalExpr)(nil)
+var _ Expr = (*NotExpr)(nil)
+var _ Expr = (*IsExpr)(nil)
+var _ Expr = (*CaseExpr)(nil)
+
+//Evaluate implements the Expr interface
+func (l *LogicalExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	lVal, err := l.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	left, lNull := lVal.toBoolean()
+	// The right side is not evaluated if the left side decides the result.
+	switch {
+	case l.Op == AndOp && !lNull && !left:
+		return resultFalse, nil
+	case l.Op == OrOp && !lNull && left:
+		return resultTrue, nil
+	}
+	rVal, err := l.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	right, rNull := rVal.toBoolean()
+	switch l.Op {
+	case AndOp:
+		switch {
+		case !rNull && !right:
+			return resultFalse, nil
+		case lNull || rNull:
+			return resultNull, nil
+		}
+		return resultTrue, nil
+	case OrOp:
+		switch {
+		case !rNull && right:
+			return resultTrue, nil
+		case lNull || rNull:
+			return resultNull, nil
+		}
+		return resultFalse, nil
+	default:
+		if lNull || rNull {
+			return resultNull, nil
+		}
+		return newEvalBool(left != right), nil
+	}
+}
+
+//Type implements the Expr interface
+func (l *LogicalExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (l *LogicalExpr) String() string {
+	return l.Left.String() + " " + l.Op.String() + " " + l.Right.String()
+}
+
+// String returns the operator as a string
+func (op LogicalOp) String() string {
+	switch op {
+	case AndOp:
+		return "and"
+	case OrOp:
+		return "or"
+	default:
+		return "xor"
+	}
+}
+
+//Evaluate implements the Expr interface
+func (n *NotExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := n.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	b, isNull := val.toBoolean()
+	if isNull {
+		return resultNull, nil
+	}
+	return newEvalBool(!b), nil
+}
+
+//Type implements the Expr interface
+func (n *NotExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (n *NotExpr) String() string {
+	return "not " + n.Inner.String()
+}
+
+//Evaluate implements the Expr interface
+func (i *IsExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	val, err := i.Inner.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	b, isNull := val.toBoolean()
+	switch i.Op {
+	case IsNullOp:
+		return newEvalBool(isNull), nil
+	case IsNotNullOp:
+		return newEvalBool(!isNull), nil
+	case IsTrueOp:
+		return newEvalBool(!isNull && b), nil
+	case IsNotTrueOp:
+		return newEvalBool(isNull || !b), nil
+	case IsFalseOp:
+		return newEvalBool(!isNull && !b), nil
+	default:
+		return newEvalBool(isNull || b), nil
+	}
+}
+
+//Type implements the Expr interface
+func (i *IsExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+//String implements the Expr interface
+func (i *IsExpr) String() string {
+	return i.Inner.String() + " " + i.Op.String()
+}
+
+// String returns the operator as a string
+func (op IsOp) String() string {
+	switch op {
+	case IsNullOp:
+		return "is null"
+	case IsNotNullOp:
+		return "is not null"
+	case IsTrueOp:
+		return "is true"
+	case IsNotTrueOp:
+		return "is not true"
+	case IsFalseOp:
+		return "is false"
+	default:
+		return "is not false"
+	}
+}
+
+//Evaluate implements the Expr interface
+func (c *CaseExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	var base EvalResult
+	if c.Base != nil {
+		var err error
+		base, err = c.Base.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+	}
+	for _, when := range c.Whens {
+		cond, err := when.Cond.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		var matched bool
+		if c.Base != nil {
+			if base.typ == sqltypes.Null || cond.typ == sqltypes.Null {
+				continue
+			}
+			cmp, err := compareValues(base, cond)
+			if err != nil {
+				return EvalResult{}, err
+			}
+			matched = cmp == 0
+		} else {
+			matched, _ = cond.toBoolean()
+		}
+		if matched {
+			return when.Val.Evaluate(env)
+		}
+	}
+	if c.Else == nil {
+		return resultNull, nil
+	}
+	return c.Else.Evaluate(env)
+}
+
+//Type implements the Expr interface
+func (c *CaseExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	exprs := make([]Expr, 0, len(c.Whens)+1)
+	for _, when := range c.Whens {
+		exprs = append(exprs, when.Val)
+	}
+	if c.Else != nil {
+		exprs = append(exprs, c.Else)
+	}
+	return aggregateTypes(env, exprs)
+}
+
+//String implements the Expr interface
+func (c *CaseExpr) String() string {
+	var sb strings.Builder
+	sb.WriteString("case")
+	if c.Base != nil {
+		sb.WriteString(" " + c.Base.String())
+	}
+	for _, when := range c.Whens {
+		sb.WriteString(" when " + when.Cond.String() + " then " + when.Val.String())
+	}
+	if c.Else != nil {
+		sb.WriteString(" else " + c.Else.String())
+	}
+	sb.WriteString(" end")
+	return sb.String()
+}
+
+// aggregateTypes returns the type of an expression that can return the
+// value of any of the given expressions, like CASE or COALESCE. NULLs
+// are ignored. Numbers are merged, and strings take precedence over
+// numbers.
+func aggregateTypes(env ExpressionEnv, exprs []Expr) (querypb.Type, error) {
+	result := sqltypes.Null
+	for _, expr := range exprs {
+		typ, err := expr.Type(env)
+		if err != nil {
+			return 0, err
+		}
+		switch {
+		case typ == sqltypes.Null:
+		case result == sqltypes.Null:
+			result = typ
+		case sqltypes.IsNumber(result) && sqltypes.IsNumber(typ):
+			result = mergeNumericalTypes(result, typ)
+		case result != typ:
+			result = sqltypes.VarChar
+		}
+	}
+	return result, nil
+}
@@ -1900,6 +1900,37 @@ func TestLeftJoinStream(t *testing.T) {
 	}
 }
 
+func TestLeftJoinExpressionsAndFilter(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	sbc1.SetResults([]*sqltypes.Result{{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int32},
+			{Name: "col", Type: sqltypes.Int32},
+		},
+		RowsAffected: 1,
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt32(1),
+			sqltypes.NewInt32(3),
+		}},
+	}})
+	sbc2.SetResults([]*sqltypes.Result{{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: sqltypes.Int32},
+		},
+	}})
+	// The filter and the ifnull must see the NULL of the missing row.
+	sql := "select u1.id, ifnull(u2.id, 0) as uid from user u1 left join user u2 on u2.id = u1.col where u1.id = 1 and u2.id is null"
+	result, err := executorExec(executor, sql, nil)
+	require.NoError(t, err)
+	wantRows := [][]sqltypes.Value{{
+		sqltypes.NewInt32(1),
+		sqltypes.NewInt64(0),
+	}}
+	assert.Equal(t, wantRows, result.Rows)
+	require.Len(t, result.Fields, 2)
+	assert.Equal(t, "uid", result.Fields[1].Name)
+}
+
 func TestEmptyJoin(t *testing.T) {
 	executor, sbc1, _, _ := createLegacyExecutorEnv()
 	// Empty result requires a field query for the second part of join,
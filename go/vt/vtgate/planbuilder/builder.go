@@ -95,8 +95,17 @@ func buildRoutePlan(stmt sqlparser.Statement, vschema ContextVSchema, f func(sta
 }
 
 func createInstructionFor(query string, stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
+	switch stmt.(type) {
+	case *sqlparser.Select, *sqlparser.Union, *sqlparser.Insert, *sqlparser.Update, *sqlparser.Delete:
+		if err := expandNestedCTEs(stmt); err != nil {
+			return nil, err
+		}
+	}
 	switch stmt := stmt.(type) {
 	case *sqlparser.Select:
+		if stmt.With != nil {
+			return buildRoutePlan(stmt, vschema, buildWithPlan(query))
+		}
 		return buildRoutePlan(stmt, vschema, buildSelectPlan(query))
 	case *sqlparser.Insert:
 		return buildRoutePlan(stmt, vschema, buildInsertPlan)
@@ -105,6 +114,9 @@ func createInstructionFor(query string, stmt sqlparser.Statement, vschema Contex
 	case *sqlparser.Delete:
 		return buildRoutePlan(stmt, vschema, buildDeletePlan)
 	case *sqlparser.Union:
+		if stmt.With != nil {
+			return buildRoutePlan(stmt, vschema, buildWithPlan(query))
+		}
 		return buildRoutePlan(stmt, vschema, buildUnionPlan)
 	case sqlparser.DDLStatement:
 		return buildGeneralDDLPlan(query, stmt, vschema)
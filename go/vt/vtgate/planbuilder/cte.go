@@ -0,0 +1,276 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"fmt"
+
+	"vitess.io/vitess/go/mysql"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// buildWithPlan builds the plan for a SELECT or UNION statement
+// that has a WITH clause.
+//
+// The common table expressions are replaced by derived tables
+// and the resulting statement is planned like any other. If that
+// produces a single route that targets a single shard, the
+// original statement is sent as is, WITH clause included.
+//
+// Recursive common table expressions cannot be rewritten as
+// derived tables. They are supported only if all the tables
+// they reference live in a single unsharded keyspace.
+func buildWithPlan(query string) func(sqlparser.Statement, ContextVSchema) (engine.Primitive, error) {
+	return func(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
+		sel := stmt.(sqlparser.SelectStatement)
+		with := sqlparser.GetWith(sel)
+		if with.Recursive {
+			return buildRecursiveWithPlan(sel, with, vschema)
+		}
+
+		rewritten, err := expandCTEs(sel, with)
+		if err != nil {
+			return nil, err
+		}
+		pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(rewritten)))
+		switch rewritten := rewritten.(type) {
+		case *sqlparser.Select:
+			err = pb.processSelect(rewritten, nil, query)
+		case *sqlparser.Union:
+			err = pb.processUnion(rewritten, nil)
+		default:
+			err = fmt.Errorf("BUG: unexpected SELECT type: %T", rewritten)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := pb.plan.Wireup(pb.plan, pb.jt); err != nil {
+			return nil, err
+		}
+		if rb, ok := pb.plan.(*route); ok && len(rb.substitutions) == 0 {
+			switch rb.eroute.Opcode {
+			case engine.SelectUnsharded, engine.SelectEqualUnique, engine.SelectReference:
+				// The whole statement goes to a single shard:
+				// there is no need to send the rewritten version.
+				rb.eroute.Query, rb.eroute.FieldQuery = generateWithQueries(sel)
+			}
+		}
+		return pb.plan.Primitive(), nil
+	}
+}
+
+// buildRecursiveWithPlan builds the plan for a statement with a recursive
+// WITH clause. All the tables it references must be routed to the same
+// unsharded keyspace.
+func buildRecursiveWithPlan(sel sqlparser.SelectStatement, with *sqlparser.With, vschema ContextVSchema) (engine.Primitive, error) {
+	ctes := make(map[string]bool)
+	for _, cte := range with.CTEs {
+		ctes[cte.Name.String()] = true
+	}
+	var from sqlparser.TableExprs
+	seen := make(map[string]bool)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		tableExpr, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok || (tableName.Qualifier.IsEmpty() && ctes[tableName.Name.String()]) {
+			return true, nil
+		}
+		if key := sqlparser.String(tableName); !seen[key] {
+			seen[key] = true
+			from = append(from, &sqlparser.AliasedTableExpr{
+				Expr: tableName,
+				As:   sqlparser.NewTableIdent(fmt.Sprintf("t%d", len(from))),
+			})
+		}
+		return true, nil
+	}, sel)
+	if len(from) == 0 {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression without tables")
+	}
+
+	// The tables are planned together to find out if they can all be
+	// sent to the same shard.
+	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
+	if err := pb.processTableExprs(from, nil); err != nil {
+		return nil, err
+	}
+	rb, ok := pb.plan.(*route)
+	if !ok || rb.eroute.Opcode != engine.SelectUnsharded || len(rb.substitutions) != 0 {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: recursive common table expression in sharded keyspace")
+	}
+	eroute := rb.eroute
+	eroute.Query, eroute.FieldQuery = generateWithQueries(sel)
+	return eroute, nil
+}
+
+// expandNestedCTEs replaces the statements with a WITH clause that are
+// nested in stmt, in derived tables, subqueries and the rows of an INSERT,
+// by their expanded version. The innermost ones are expanded first.
+// The WITH clause of a top level SELECT or UNION is left to buildWithPlan.
+func expandNestedCTEs(stmt sqlparser.Statement) error {
+	expand := func(sel sqlparser.SelectStatement) (sqlparser.SelectStatement, error) {
+		with := sqlparser.GetWith(sel)
+		if with == nil {
+			return sel, nil
+		}
+		if with.Recursive {
+			return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: nested recursive common table expression")
+		}
+		return expandCTEs(sel, with)
+	}
+	var err error
+	sqlparser.Rewrite(stmt, nil, func(cursor *sqlparser.Cursor) bool {
+		switch node := cursor.Node().(type) {
+		case *sqlparser.Subquery:
+			node.Select, err = expand(node.Select)
+		case *sqlparser.DerivedTable:
+			node.Select, err = expand(node.Select)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	if ins, ok := stmt.(*sqlparser.Insert); ok {
+		if sel, ok := ins.Rows.(sqlparser.SelectStatement); ok {
+			if ins.Rows, err = expand(sel); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// expandCTEs returns a copy of the statement in which the references
+// to the common table expressions are replaced by derived tables.
+// The WITH clause is removed.
+func expandCTEs(sel sqlparser.SelectStatement, with *sqlparser.With) (sqlparser.SelectStatement, error) {
+	// A common table expression can reference the ones defined
+	// before it. They are expanded in order of definition.
+	expanded := make(map[string]sqlparser.SelectStatement)
+	for _, cte := range with.CTEs {
+		name := cte.Name.String()
+		if _, ok := expanded[name]; ok {
+			return nil, mysql.NewSQLError(mysql.ERNonUniqTable, mysql.SSSyntaxErrorOrAccessViolation, "Not unique table/alias: '%s'", name)
+		}
+		body, err := expandDerivedTables(cte.Subquery.Select, expanded)
+		if err != nil {
+			return nil, err
+		}
+		if err := setCTEColumns(body, cte.Columns); err != nil {
+			return nil, err
+		}
+		expanded[name] = body
+	}
+
+	stmt := sqlparser.SetWith(sel, nil)
+	defer sqlparser.SetWith(sel, with)
+	return expandDerivedTables(stmt, expanded)
+}
+
+// expandDerivedTables returns a copy of the statement where the table
+// names found in ctes are replaced by a copy of their definition.
+func expandDerivedTables(sel sqlparser.SelectStatement, ctes map[string]sqlparser.SelectStatement) (sqlparser.SelectStatement, error) {
+	// The planner modifies the AST it works on, so every reference
+	// to a common table expression needs its own copy.
+	stmt := sqlparser.CloneSelectStatement(sel)
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		tableExpr, ok := node.(*sqlparser.AliasedTableExpr)
+		if !ok {
+			return true, nil
+		}
+		tableName, ok := tableExpr.Expr.(sqlparser.TableName)
+		if !ok || !tableName.Qualifier.IsEmpty() {
+			return true, nil
+		}
+		cte, ok := ctes[tableName.Name.String()]
+		if !ok {
+			return true, nil
+		}
+		tableExpr.Expr = &sqlparser.DerivedTable{Select: sqlparser.CloneSelectStatement(cte)}
+		if tableExpr.As.IsEmpty() {
+			tableExpr.As = tableName.Name
+		}
+		// The definition is already expanded.
+		return false, nil
+	}, stmt)
+	if err != nil {
+		return nil, err
+	}
+	return stmt, nil
+}
+
+// setCTEColumns renames the columns returned by the
+// definition of a common table expression.
+func setCTEColumns(sel sqlparser.SelectStatement, columns sqlparser.Columns) error {
+	if len(columns) == 0 {
+		return nil
+	}
+	for {
+		switch stmt := sel.(type) {
+		case *sqlparser.Union:
+			sel = stmt.FirstStatement
+			continue
+		case *sqlparser.ParenSelect:
+			sel = stmt.Select
+			continue
+		case *sqlparser.Select:
+			if len(stmt.SelectExprs) != len(columns) {
+				return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "In definition of view, derived table or common table expression, SELECT list and column names list have different column counts")
+			}
+			for i, expr := range stmt.SelectExprs {
+				aliased, ok := expr.(*sqlparser.AliasedExpr)
+				if !ok {
+					return vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: column list for common table expression with '%s'", sqlparser.String(expr))
+				}
+				aliased.As = columns[i]
+			}
+			return nil
+		default:
+			return fmt.Errorf("BUG: unexpected SELECT type: %T", stmt)
+		}
+	}
+}
+
+// generateWithQueries generates the query and the field query for a
+// statement with a WITH clause that is sent as is to a single shard.
+func generateWithQueries(sel sqlparser.SelectStatement) (query, fieldQuery string) {
+	formatter := func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		if tableName, ok := node.(sqlparser.TableName); ok && !sqlparser.SystemSchema(tableName.Qualifier.String()) {
+			tableName.Name.Format(buf)
+			return
+		}
+		node.Format(buf)
+	}
+	query = sqlparser.NewTrackedBuffer(formatter).WriteNode(sel).ParsedQuery().Query
+
+	fieldFormatter := func(buf *sqlparser.TrackedBuffer, node sqlparser.SQLNode) {
+		if tableName, ok := node.(sqlparser.TableName); ok && !sqlparser.SystemSchema(tableName.Qualifier.String()) {
+			tableName.Name.Format(buf)
+			return
+		}
+		sqlparser.FormatImpossibleQuery(buf, node)
+	}
+	fieldQuery = sqlparser.NewTrackedBuffer(fieldFormatter).WriteNode(sel).ParsedQuery().Query
+	return query, fieldQuery
+}
@@ -28,37 +28,55 @@ import (
 
 // getDMLRouting returns the vindex and values for the DML,
 // If it cannot find a unique vindex match, it returns an error.
-func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpcode, vindexes.SingleColumn, string, vindexes.SingleColumn, []sqltypes.PlanValue, error) {
-	var ksidVindex vindexes.SingleColumn
-	var ksidCol string
+// The ksid columns are the columns of the vindex that computes the
+// keyspace ids of the rows.
+func getDMLRouting(where *sqlparser.Where, table *vindexes.Table) (engine.DMLOpcode, vindexes.Vindex, []sqlparser.ColIdent, vindexes.Vindex, []sqltypes.PlanValue, error) {
+	var ksidVindex vindexes.Vindex
+	var ksidCols []sqlparser.ColIdent
 	for _, index := range table.Ordered {
 		if !index.Vindex.IsUnique() {
 			continue
 		}
-		single, ok := index.Vindex.(vindexes.SingleColumn)
-		if !ok {
-			continue
-		}
-		if ksidCol == "" {
-			ksidCol = sqlparser.String(index.Columns[0])
-			ksidVindex = single
+		if ksidCols == nil {
+			ksidCols = index.Columns
+			ksidVindex = index.Vindex
 		}
 		if where == nil {
-			return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
+			return engine.Scatter, ksidVindex, ksidCols, nil, nil, nil
 		}
 
+		if _, isMulti := index.Vindex.(vindexes.MultiColumn); isMulti {
+			if values, ok := getMultiColMatch(where.Expr, index.Columns); ok {
+				return engine.Equal, ksidVindex, ksidCols, index.Vindex, values, nil
+			}
+			continue
+		}
 		if pv, ok := getMatch(where.Expr, index.Columns[0]); ok {
 			opcode := engine.Equal
 			if pv.IsList() {
 				opcode = engine.In
 			}
-			return opcode, ksidVindex, ksidCol, single, []sqltypes.PlanValue{pv}, nil
+			return opcode, ksidVindex, ksidCols, index.Vindex, []sqltypes.PlanValue{pv}, nil
 		}
 	}
 	if ksidVindex == nil {
-		return engine.Scatter, nil, "", nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
+		return engine.Scatter, nil, nil, nil, nil, vterrors.New(vtrpcpb.Code_INTERNAL, "table without a primary vindex is not expected")
 	}
-	return engine.Scatter, ksidVindex, ksidCol, nil, nil, nil
+	return engine.Scatter, ksidVindex, ksidCols, nil, nil, nil
+}
+
+// getMultiColMatch returns the values of the equality constraints
+// on all the columns of a multi-column vindex.
+func getMultiColMatch(node sqlparser.Expr, cols []sqlparser.ColIdent) ([]sqltypes.PlanValue, bool) {
+	values := make([]sqltypes.PlanValue, 0, len(cols))
+	for _, col := range cols {
+		pv, ok := getMatch(node, col)
+		if !ok || pv.IsList() {
+			return nil, false
+		}
+		values = append(values, pv)
+	}
+	return values, true
 }
 
 // getMatch returns the matched value if there is an equality
@@ -100,12 +118,21 @@ func nameMatch(node sqlparser.Expr, col sqlparser.ColIdent) bool {
 	return ok && colname.Name.Equal(col)
 }
 
-func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (*engine.DML, vindexes.SingleColumn, string, error) {
-	edml := &engine.DML{}
+// buildDMLPlan builds the common part of the update and delete plans.
+// If the statement joins multiple tables of a sharded keyspace, the
+// tables must all be co-located, i.e. merged into a single route.
+// In that case, the returned alias is the alias of the target table,
+// to be used for qualifying its columns in the owned vindex query.
+func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Statement, tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, comments sqlparser.Comments, nodes ...sqlparser.SQLNode) (edml *engine.DML, ksidVindex vindexes.Vindex, ksidCols []sqlparser.ColIdent, alias sqlparser.TableName, err error) {
+	edml = &engine.DML{}
 	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(stmt)))
-	rb, err := pb.processDMLTable(tableExprs, nil)
+	var whereExpr sqlparser.Expr
+	if where != nil {
+		whereExpr = where.Expr
+	}
+	rb, err := pb.processDMLTable(tableExprs, whereExpr)
 	if err != nil {
-		return nil, nil, "", err
+		return nil, nil, nil, alias, err
 	}
 	edml.Keyspace = rb.eroute.Keyspace
 	if !edml.Keyspace.Sharded {
@@ -114,17 +141,17 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 		subqueryArgs = append(subqueryArgs, nodes...)
 		subqueryArgs = append(subqueryArgs, where, orderBy, limit)
 		if !pb.finalizeUnshardedDMLSubqueries(subqueryArgs...) {
-			return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
+			return nil, nil, nil, alias, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: sharded subqueries in DML")
 		}
 		edml.Opcode = engine.Unsharded
 		// Generate query after all the analysis. Otherwise table name substitutions for
 		// routed tables won't happen.
 		edml.Query = generateQuery(stmt)
-		return edml, nil, "", nil
+		return edml, nil, nil, alias, nil
 	}
 
 	if hasSubquery(stmt) {
-		return nil, nil, "", vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
+		return nil, nil, nil, alias, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subqueries in sharded DML")
 	}
 
 	// Generate query after all the analysis. Otherwise table name substitutions for
@@ -138,53 +165,160 @@ func buildDMLPlan(vschema ContextVSchema, dmlType string, stmt sqlparser.Stateme
 
 	edml.QueryTimeout = queryTimeout(directives)
 
-	if len(pb.st.tables) != 1 {
-		return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
-	}
-	for _, tval := range pb.st.tables {
-		// There is only one table.
-		edml.Table = tval.vschemaTable
-	}
-
-	routingType, ksidVindex, ksidCol, vindex, values, err := getDMLRouting(where, edml.Table)
-	if err != nil {
-		return nil, nil, "", err
+	var routingType engine.DMLOpcode
+	var vindex vindexes.Vindex
+	var values []sqltypes.PlanValue
+	if len(pb.st.tables) == 1 {
+		for _, tval := range pb.st.tables {
+			// There is only one table.
+			edml.Table = tval.vschemaTable
+		}
+		routingType, ksidVindex, ksidCols, vindex, values, err = getDMLRouting(where, edml.Table)
+		if err != nil {
+			return nil, nil, nil, alias, err
+		}
+	} else {
+		// processDMLTable succeeded, which means that all the tables
+		// were merged into a single route: their rows are co-located.
+		target, err := dmlTargetTable(pb.st, dmlType, stmt)
+		if err != nil {
+			return nil, nil, nil, alias, err
+		}
+		edml.Table = target.vschemaTable
+		alias = target.alias
+		_, ksidVindex, ksidCols, _, _, err = getDMLRouting(nil, edml.Table)
+		if err != nil {
+			return nil, nil, nil, alias, err
+		}
+		routingType, vindex, values = getMultiTableDMLRouting(pb, rb, whereExpr)
 	}
 
 	if rb.eroute.TargetDestination != nil {
 		if rb.eroute.TargetTabletType != topodatapb.TabletType_MASTER {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
+			return nil, nil, nil, alias, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported: %s statement with a replica target", dmlType)
 		}
 		edml.Opcode = engine.ByDestination
 		edml.TargetDestination = rb.eroute.TargetDestination
-		return edml, ksidVindex, ksidCol, nil
+		return edml, ksidVindex, ksidCols, alias, nil
 	}
 
 	edml.Opcode = routingType
 	if routingType == engine.Scatter {
 		if limit != nil {
-			return nil, nil, "", vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit", dmlType)
+			return nil, nil, nil, alias, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi shard %s with limit", dmlType)
 		}
 	} else {
 		edml.Vindex = vindex
 		edml.Values = values
 	}
 
-	return edml, ksidVindex, ksidCol, nil
+	return edml, ksidVindex, ksidCols, alias, nil
 }
 
-func generateDMLSubquery(where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCol string) string {
-	buf := sqlparser.NewTrackedBuffer(nil)
-	buf.Myprintf("select %s", ksidCol)
-	for _, cv := range table.Owned {
-		for _, column := range cv.Columns {
-			buf.Myprintf(", %v", column)
+// getMultiTableDMLRouting computes the routing of a DML statement
+// whose tables were merged into a single route. The route is
+// evaluated against the where clause like a select would be.
+// Only unique vindexes are used, like for single-table statements.
+func getMultiTableDMLRouting(pb *primitiveBuilder, rb *route, where sqlparser.Expr) (engine.DMLOpcode, vindexes.Vindex, []sqltypes.PlanValue) {
+	for _, filter := range splitAndExpression(nil, where) {
+		rb.UpdatePlan(pb, filter)
+	}
+	if rb.eroute.Vindex == nil || !rb.eroute.Vindex.IsUnique() {
+		return engine.Scatter, nil, nil
+	}
+	// The route condition is resolved here instead of in Wireup,
+	// which would rewrite the IN clause of the statement.
+	var opcode engine.DMLOpcode
+	var condition sqlparser.Expr
+	switch rb.eroute.Opcode {
+	case engine.SelectEqualUnique:
+		if _, isMulti := rb.eroute.Vindex.(vindexes.MultiColumn); isMulti {
+			// Every value of the tuple is for one column of the vindex.
+			var values []sqltypes.PlanValue
+			for _, val := range rb.condition.(sqlparser.ValTuple) {
+				pv, err := sqlparser.NewPlanValue(val)
+				if err != nil {
+					return engine.Scatter, nil, nil
+				}
+				values = append(values, pv)
+			}
+			return engine.Equal, rb.eroute.Vindex, values
 		}
+		opcode, condition = engine.Equal, rb.condition
+	case engine.SelectIN:
+		comparison, ok := rb.condition.(*sqlparser.ComparisonExpr)
+		if !ok {
+			return engine.Scatter, nil, nil
+		}
+		opcode, condition = engine.In, comparison.Right
+	default:
+		return engine.Scatter, nil, nil
+	}
+	pv, err := sqlparser.NewPlanValue(condition)
+	if err != nil {
+		return engine.Scatter, nil, nil
 	}
-	buf.Myprintf(" from %v%v%v%v for update", table.Name, where, orderBy, limit)
+	return opcode, rb.eroute.Vindex, []sqltypes.PlanValue{pv}
+}
+
+// dmlTargetTable returns the table that a multi-table DML statement
+// modifies. Statements that modify more than one table are not supported.
+func dmlTargetTable(st *symtab, dmlType string, stmt sqlparser.Statement) (*table, error) {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Delete:
+		if len(stmt.Targets) != 1 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
+		}
+		for _, t := range st.AllTables() {
+			if t.alias.Name == stmt.Targets[0].Name && (stmt.Targets[0].Qualifier.IsEmpty() || t.alias.Qualifier == stmt.Targets[0].Qualifier) {
+				return t, nil
+			}
+		}
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Unknown table '%s' in MULTI DELETE", stmt.Targets[0].Name.String())
+	case *sqlparser.Update:
+		var target *table
+		for _, expr := range stmt.Exprs {
+			if _, _, err := st.Find(expr.Name); err != nil {
+				return nil, err
+			}
+			c := expr.Name.Metadata.(*column)
+			var exprTable *table
+			for _, t := range st.AllTables() {
+				if t.columns[expr.Name.Name.Lowered()] == c {
+					exprTable = t
+					break
+				}
+			}
+			if exprTable == nil || (target != nil && target != exprTable) {
+				return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement changing more than one table in sharded keyspace", dmlType)
+			}
+			target = exprTable
+		}
+		if target == nil {
+			return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
+		}
+		return target, nil
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: multi-table %s statement in sharded keyspace", dmlType)
+}
+
+// generateDMLSubquery generates the query that selects the owned vindex
+// columns of the rows affected by the DML. If alias is set, the statement
+// joins multiple tables, and the columns of the target table are qualified.
+func generateDMLSubquery(tableExprs sqlparser.TableExprs, where *sqlparser.Where, orderBy sqlparser.OrderBy, limit *sqlparser.Limit, table *vindexes.Table, ksidCols []sqlparser.ColIdent, alias sqlparser.TableName) string {
+	buf, _ := initialQuery(ksidCols, table, alias)
+	buf.Myprintf(" from %v%v%v%v for update", dmlSource(tableExprs, table, alias), where, orderBy, limit)
 	return buf.String()
 }
 
+// dmlSource returns the from clause of the owned vindex query.
+func dmlSource(tableExprs sqlparser.TableExprs, table *vindexes.Table, alias sqlparser.TableName) sqlparser.SQLNode {
+	if alias.IsEmpty() {
+		return table.Name
+	}
+	return tableExprs
+}
+
 func generateQuery(statement sqlparser.Statement) string {
 	buf := sqlparser.NewTrackedBuffer(dmlFormatter)
 	statement.Format(buf)
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
@@ -81,6 +82,9 @@ func (ec *expressionConverter) convert(astExpr sqlparser.Expr, boolean, identifi
 		}
 	}
 	evalExpr, err := sqlparser.Convert(astExpr)
+	if err == nil && returnsText(evalExpr) {
+		err = sqlparser.ErrExprNotSupported
+	}
 	if err != nil {
 		if err != sqlparser.ErrExprNotSupported {
 			return nil, err
@@ -91,6 +95,15 @@ func (ec *expressionConverter) convert(astExpr sqlparser.Expr, boolean, identifi
 	return evalExpr, nil
 }
 
+// returnsText returns true if the expression builds a text string, like
+// CONCAT. MySQL returns these strings in the character set and collation
+// of the connection, which vtgate doesn't know, so they're computed by
+// MySQL. String literals are binary strings to vtgate.
+func returnsText(expr evalengine.Expr) bool {
+	typ, err := expr.Type(evalengine.ExpressionEnv{})
+	return err == nil && sqltypes.IsText(typ)
+}
+
 func (ec *expressionConverter) source(vschema ContextVSchema) (engine.Primitive, error) {
 	if len(ec.tabletExpressions) == 0 {
 		return &engine.SingleRow{}, nil
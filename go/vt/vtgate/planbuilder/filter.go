@@ -0,0 +1,241 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ logicalPlan = (*filter)(nil)
+
+// filter is the logicalPlan for engine.Filter.
+// This gets built when a predicate cannot be pushed down to
+// a route, because it applies to rows that vtgate computes.
+// For example, the HAVING clause of
+// 'select col, count(*) from t group by col having count(*) > 1'
+// is evaluated on top of the orderedAggregate:
+//    &engine.Filter {
+//      Predicate: Column(1) > 1,
+//      Input: &engine.OrderedAggregate{...},
+//    }
+// Values that the predicate needs but the input does not
+// produce, like aggregates that are not part of the select
+// list, are pushed down as hidden columns and truncated from
+// the result. Columns are only requested from the input during
+// wire-up, after all the select expressions have been pushed.
+//
+// A filter is also built on top of a join if the predicate
+// references the right side of a left join: it has to be
+// evaluated after the join adds the NULL rows.
+//
+// A filter on the columns of a derived table that cannot be
+// merged into a route sits between the subquery and the plan
+// of the derived table, whose result columns are the columns
+// of the table.
+type filter struct {
+	resultsBuilder
+	efilter *engine.Filter
+
+	// subquery is set if the filter is the input of a subquery.
+	subquery *subquery
+
+	// columns are the columns of the predicate that must be
+	// supplied by the input during wire-up.
+	columns []filterColumn
+}
+
+// filterColumn is a column of the predicate that is supplied by the
+// input during wire-up.
+type filterColumn struct {
+	col  *sqlparser.ColName
+	expr *evalengine.Column
+}
+
+// newFilter builds a new filter on top of input.
+func newFilter(input logicalPlan) *filter {
+	efilter := &engine.Filter{}
+	return &filter{
+		resultsBuilder: newResultsBuilder(input, efilter),
+		efilter:        efilter,
+	}
+}
+
+// Primitive implements the logicalPlan interface
+func (f *filter) Primitive() engine.Primitive {
+	f.efilter.Input = f.input.Primitive()
+	return f.efilter
+}
+
+// addPredicate converts the expression and ANDs it to the
+// predicate of the filter.
+func (f *filter) addPredicate(pb *primitiveBuilder, expr sqlparser.Expr, origin logicalPlan) error {
+	var convert func(sqlparser.Expr) (evalengine.Expr, error)
+	convert = func(expr sqlparser.Expr) (evalengine.Expr, error) {
+		return sqlparser.ConvertWith(expr, func(node sqlparser.Expr) (evalengine.Expr, error) {
+			switch node := node.(type) {
+			case *sqlparser.ColName:
+				// The columns of a derived table are the result
+				// columns of its plan.
+				if c := node.Metadata.(*column); f.subquery != nil && c.Origin() == f.subquery {
+					return evalengine.NewColumn(c.colNumber), nil
+				}
+			case *sqlparser.FuncExpr:
+				// avg(x) is computed as sum(x) / count(x), both of which
+				// can be aggregated across shards.
+				if node.Name.Lowered() == "avg" {
+					sum := &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("sum"), Distinct: node.Distinct, Exprs: node.Exprs}
+					count := &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("count"), Distinct: node.Distinct, Exprs: node.Exprs}
+					return convert(&sqlparser.BinaryExpr{Operator: sqlparser.DivOp, Left: sum, Right: count})
+				}
+			}
+			if inputNumber, ok := f.findInputColumn(node); ok {
+				return evalengine.NewColumn(inputNumber), nil
+			}
+			if col, ok := node.(*sqlparser.ColName); ok {
+				if f.subquery != nil {
+					return nil, errors.New("unsupported: filtering on results of cross-shard subquery")
+				}
+				expr := &evalengine.Column{}
+				f.columns = append(f.columns, filterColumn{col: col, expr: expr})
+				return expr, nil
+			}
+			if isSupportedAggregate(node) {
+				return f.pushHidden(pb, node, origin)
+			}
+			// Any other expression is evaluated by the filter.
+			return nil, nil
+		})
+	}
+	evalExpr, err := convert(expr)
+	if err == sqlparser.ErrExprNotSupported {
+		switch {
+		case f.subquery != nil:
+			return errors.New("unsupported: filtering on results of cross-shard subquery")
+		case isJoin(f.input):
+			return errors.New("unsupported: cross-shard left join and where clause")
+		}
+		return errors.New("unsupported: filtering on results of aggregates")
+	}
+	if err != nil {
+		return err
+	}
+	if f.efilter.Predicate == nil {
+		f.efilter.Predicate = evalExpr
+		return nil
+	}
+	f.efilter.Predicate = &evalengine.LogicalExpr{
+		Op:    evalengine.AndOp,
+		Left:  f.efilter.Predicate,
+		Right: evalExpr,
+	}
+	return nil
+}
+
+// findInputColumn returns the column number of the input if the
+// expression is a column that the input already produces.
+func (f *filter) findInputColumn(expr sqlparser.Expr) (int, bool) {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return 0, false
+	}
+	c := col.Metadata.(*column)
+	for i, rc := range f.input.ResultColumns() {
+		if rc.column == c {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// pushHidden pushes the expression down to the input as a
+// column that is only used by the filter.
+func (f *filter) pushHidden(pb *primitiveBuilder, expr sqlparser.Expr, origin logicalPlan) (evalengine.Expr, error) {
+	if f.subquery != nil {
+		return nil, errors.New("unsupported: filtering on results of cross-shard subquery")
+	}
+	newInput, _, innerCol, err := planProjection(pb, f.input, &sqlparser.AliasedExpr{Expr: expr}, origin)
+	if err != nil {
+		return nil, err
+	}
+	f.input = newInput
+	f.truncater.SetTruncateColumnCount(len(f.resultColumns))
+	return evalengine.NewColumn(innerCol), nil
+}
+
+// planFilterProjection pushes the select expression down to the
+// input of the filter, which passes it through.
+func planFilterProjection(pb *primitiveBuilder, f *filter, expr *sqlparser.AliasedExpr, origin logicalPlan) (logicalPlan, *resultColumn, int, error) {
+	newInput, rc, colNumber, err := planProjection(pb, f.input, expr, origin)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+	f.input = newInput
+	if colNumber != len(f.resultColumns) {
+		return nil, nil, 0, errors.New("unsupported: select expression on top of a filter with hidden columns")
+	}
+	f.resultColumns = append(f.resultColumns, rc)
+	return f, rc, colNumber, nil
+}
+
+// Wireup implements the logicalPlan interface
+func (f *filter) Wireup(plan logicalPlan, jt *jointab) error {
+	for _, fc := range f.columns {
+		_, fc.expr.Offset = f.input.SupplyCol(fc.col)
+	}
+	if len(f.input.ResultColumns()) > len(f.resultColumns) {
+		f.truncater.SetTruncateColumnCount(len(f.resultColumns))
+	}
+	return f.input.Wireup(plan, jt)
+}
+
+// planFilterOnTop evaluates the expression with a filter on top
+// of input. If input is already a filter, the expression is added
+// to its predicate.
+func planFilterOnTop(pb *primitiveBuilder, input logicalPlan, expr sqlparser.Expr, origin logicalPlan) (logicalPlan, error) {
+	f, ok := input.(*filter)
+	if !ok {
+		f = newFilter(input)
+	}
+	if err := f.addPredicate(pb, expr, origin); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// planSubqueryFilter evaluates the expression on the rows of the
+// derived table, before they are returned by the subquery.
+func planSubqueryFilter(pb *primitiveBuilder, sq *subquery, expr sqlparser.Expr, origin logicalPlan) (logicalPlan, error) {
+	f, ok := sq.input.(*filter)
+	if !ok || f.subquery != sq {
+		f = newFilter(sq.input)
+		f.subquery = sq
+	}
+	if err := f.addPredicate(pb, expr, origin); err != nil {
+		return nil, err
+	}
+	sq.input = f
+	return sq, nil
+}
+
+func isJoin(plan logicalPlan) bool {
+	_, ok := plan.(*join)
+	return ok
+}
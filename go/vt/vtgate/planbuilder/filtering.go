@@ -27,7 +27,7 @@ import (
 )
 
 // planFilter solves this particular expression, either by pushing it down to a child or changing this logicalPlan
-func planFilter(pb *primitiveBuilder, input logicalPlan, filter sqlparser.Expr, whereType string, origin logicalPlan) (logicalPlan, error) {
+func planFilter(pb *primitiveBuilder, input logicalPlan, expr sqlparser.Expr, whereType string, origin logicalPlan) (logicalPlan, error) {
 	switch node := input.(type) {
 	case *join:
 		isLeft := true
@@ -36,13 +36,15 @@ func planFilter(pb *primitiveBuilder, input logicalPlan, filter sqlparser.Expr,
 			in = node.Left
 		} else {
 			if node.ejoin.Opcode == engine.LeftJoin {
-				return nil, errors.New("unsupported: cross-shard left join and where clause")
+				// The filter must be applied after the join
+				// adds the NULL rows.
+				return planFilterOnTop(pb, node, expr, origin)
 			}
 			isLeft = false
 			in = node.Right
 		}
 
-		filtered, err := planFilter(pb, in, filter, whereType, origin)
+		filtered, err := planFilter(pb, in, expr, whereType, origin)
 		if err != nil {
 			return nil, err
 		}
@@ -50,6 +52,9 @@ func planFilter(pb *primitiveBuilder, input logicalPlan, filter sqlparser.Expr,
 			node.Left = filtered
 		} else {
 			node.Right = filtered
+			if key := newHashJoinKey(node, expr); key != nil {
+				node.hashJoinKeys = append(node.hashJoinKeys, key)
+			}
 		}
 		return node, nil
 
@@ -57,25 +62,25 @@ func planFilter(pb *primitiveBuilder, input logicalPlan, filter sqlparser.Expr,
 		sel := node.Select.(*sqlparser.Select)
 		switch whereType {
 		case sqlparser.WhereStr:
-			sel.AddWhere(filter)
+			sel.AddWhere(expr)
 		case sqlparser.HavingStr:
-			sel.AddHaving(filter)
+			sel.AddHaving(expr)
 		}
-		node.UpdatePlan(pb, filter)
+		node.UpdatePlan(pb, expr)
 		return node, nil
 	case *pulloutSubquery:
-		plan, err := planFilter(pb, node.underlying, filter, whereType, origin)
+		plan, err := planFilter(pb, node.underlying, expr, whereType, origin)
 		if err != nil {
 			return nil, err
 		}
 		node.underlying = plan
 		return node, nil
 	case *vindexFunc:
-		return filterVindexFunc(node, filter)
+		return filterVindexFunc(node, expr)
 	case *subquery:
-		return nil, errors.New("unsupported: filtering on results of cross-shard subquery")
-	case *orderedAggregate:
-		return nil, errors.New("unsupported: filtering on results of aggregates")
+		return planSubqueryFilter(pb, node, expr, origin)
+	case *orderedAggregate, *projection, *filter:
+		return planFilterOnTop(pb, node, expr, origin)
 	}
 
 	return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "%T.filtering: unreachable", input)
@@ -33,6 +33,7 @@ import (
 
 // processDMLTable analyzes the FROM clause for DMLs and returns a route.
 func (pb *primitiveBuilder) processDMLTable(tableExprs sqlparser.TableExprs, where sqlparser.Expr) (*route, error) {
+	pb.dml = true
 	if err := pb.processTableExprs(tableExprs, where); err != nil {
 		return nil, err
 	}
@@ -57,6 +58,7 @@ func (pb *primitiveBuilder) processTableExprs(tableExprs sqlparser.TableExprs, w
 		return err
 	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb.dml = pb.dml
 	if err := rpb.processTableExprs(tableExprs[1:], where); err != nil {
 		return err
 	}
@@ -280,6 +282,7 @@ func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr, where sq
 		return err
 	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb.dml = pb.dml
 	if err := rpb.processTableExpr(ajoin.RightExpr, where); err != nil {
 		return err
 	}
@@ -324,6 +327,7 @@ func (pb *primitiveBuilder) join(rpb *primitiveBuilder, ajoin *sqlparser.JoinTab
 	if lRoute.eroute.Opcode == engine.SelectReference {
 		// Swap the conditions & eroutes, and then merge.
 		lRoute.condition, rRoute.condition = rRoute.condition, lRoute.condition
+		lRoute.multiColValues, rRoute.multiColValues = rRoute.multiColValues, lRoute.multiColValues
 		lRoute.eroute, rRoute.eroute = rRoute.eroute, lRoute.eroute
 	}
 	lRoute.substitutions = append(lRoute.substitutions, rRoute.substitutions...)
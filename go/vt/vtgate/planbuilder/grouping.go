@@ -25,14 +25,15 @@ import (
 func planGroupBy(pb *primitiveBuilder, input logicalPlan, groupBy sqlparser.GroupBy) (logicalPlan, error) {
 	if len(groupBy) == 0 {
 		// if we have no grouping declared, we only want to visit orderedAggregate
-		_, isOrdered := input.(*orderedAggregate)
-		if !isOrdered {
+		switch input.(type) {
+		case *orderedAggregate, *projection:
+		default:
 			return input, nil
 		}
 	}
 
 	switch node := input.(type) {
-	case *mergeSort, *pulloutSubquery, *distinct:
+	case *mergeSort, *pulloutSubquery, *distinct, *filter:
 		inputs := node.Inputs()
 		input := inputs[0]
 
@@ -49,6 +50,8 @@ func planGroupBy(pb *primitiveBuilder, input logicalPlan, groupBy sqlparser.Grou
 	case *route:
 		node.Select.(*sqlparser.Select).GroupBy = groupBy
 		return node, nil
+	case *projection:
+		return planProjectionGroupBy(pb, groupBy, node)
 	case *orderedAggregate:
 		for _, expr := range groupBy {
 			colNumber := -1
@@ -118,6 +121,8 @@ func planDistinct(input logicalPlan) (logicalPlan, error) {
 		node.input = newInput
 		return node, nil
 
+	case *projection:
+		return newDistinct(node), nil
 	case *distinct:
 		return input, nil
 	}
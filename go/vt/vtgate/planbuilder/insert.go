@@ -55,17 +55,22 @@ func buildInsertPlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 	}
 	if !rb.eroute.Keyspace.Sharded {
 		if !pb.finalizeUnshardedDMLSubqueries(ins) {
+			if _, ok := ins.Rows.(sqlparser.SelectStatement); ok {
+				// The select cannot be sent along with the insert.
+				eins := engine.NewSimpleInsert(engine.InsertUnsharded, vschemaTable, vschemaTable.Keyspace)
+				return buildInsertSelectPlan(ins, eins, vschema)
+			}
 			return nil, errors.New("unsupported: sharded subquery in insert values")
 		}
-		return buildInsertUnshardedPlan(ins, vschemaTable)
+		return buildInsertUnshardedPlan(ins, vschemaTable, vschema)
 	}
 	if ins.Action == sqlparser.ReplaceAct {
 		return nil, errors.New("unsupported: REPLACE INTO with sharded schema")
 	}
-	return buildInsertShardedPlan(ins, vschemaTable)
+	return buildInsertShardedPlan(ins, vschemaTable, vschema)
 }
 
-func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema ContextVSchema) (engine.Primitive, error) {
 	eins := engine.NewSimpleInsert(
 		engine.InsertUnsharded,
 		table,
@@ -75,7 +80,8 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 	switch insertValues := ins.Rows.(type) {
 	case *sqlparser.Select, *sqlparser.Union:
 		if eins.Table.AutoIncrement != nil {
-			return nil, errors.New("unsupported: auto-inc and select in insert")
+			// The sequence values have to be generated for the rows of the select.
+			return buildInsertSelectPlan(ins, eins, vschema)
 		}
 		eins.Query = generateQuery(ins)
 		return eins, nil
@@ -109,7 +115,7 @@ func buildInsertUnshardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (eng
 	return eins, nil
 }
 
-func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engine.Primitive, error) {
+func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table, vschema ContextVSchema) (engine.Primitive, error) {
 	eins := engine.NewSimpleInsert(
 		engine.InsertSharded,
 		table,
@@ -139,8 +145,8 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 
 	var rows sqlparser.Values
 	switch insertValues := ins.Rows.(type) {
-	case *sqlparser.Select, *sqlparser.Union:
-		return nil, errors.New("unsupported: insert into select")
+	case *sqlparser.Select, *sqlparser.Union, *sqlparser.ParenSelect:
+		return buildInsertSelectPlan(ins, eins, vschema)
 	case sqlparser.Values:
 		rows = insertValues
 		if hasSubquery(rows) {
@@ -192,6 +198,138 @@ func buildInsertShardedPlan(ins *sqlparser.Insert, table *vindexes.Table) (engin
 	return eins, nil
 }
 
+// buildInsertSelectPlan builds the plan for an INSERT ... SELECT that
+// cannot be sent to the target keyspace as is. The select is executed
+// by vtgate, which generates the sequence values and computes the vindex
+// values for the rows it returns, and then inserts them.
+func buildInsertSelectPlan(ins *sqlparser.Insert, eins *engine.Insert, vschema ContextVSchema) (engine.Primitive, error) {
+	sel := ins.Rows.(sqlparser.SelectStatement)
+	table := eins.Table
+	if len(ins.Columns) == 0 && (eins.Opcode != engine.InsertUnsharded || table.AutoIncrement != nil) {
+		if !table.ColumnListAuthoritative {
+			return nil, errors.New("column list required for insert into select")
+		}
+		populateInsertColumnlist(ins, table)
+	}
+	if len(ins.Columns) != 0 {
+		if count, ok := selectColumnCount(sel); ok && count != len(ins.Columns) {
+			return nil, errors.New("column list doesn't match values")
+		}
+	}
+
+	// The vindex and auto-inc columns that are not in the column
+	// list are added to it, and selected as NULL.
+	columnOffset := func(col sqlparser.ColIdent) (int, error) {
+		for i, column := range ins.Columns {
+			if col.Equal(column) {
+				return i, nil
+			}
+		}
+		if err := addSelectNull(sel); err != nil {
+			return 0, err
+		}
+		ins.Columns = append(ins.Columns, col)
+		return len(ins.Columns) - 1, nil
+	}
+	if eins.Opcode != engine.InsertUnsharded {
+		eins.VindexValueOffset = make([][]int, len(table.ColumnVindexes))
+		for vIdx, colVindex := range table.ColumnVindexes {
+			for _, col := range colVindex.Columns {
+				offset, err := columnOffset(col)
+				if err != nil {
+					return nil, err
+				}
+				eins.VindexValueOffset[vIdx] = append(eins.VindexValueOffset[vIdx], offset)
+			}
+		}
+	}
+	if table.AutoIncrement != nil {
+		offset, err := columnOffset(table.AutoIncrement.Column)
+		if err != nil {
+			return nil, err
+		}
+		eins.Generate = &engine.Generate{
+			Keyspace: table.AutoIncrement.Sequence.Keyspace,
+			Query:    fmt.Sprintf("select next :n values from %s", sqlparser.String(table.AutoIncrement.Sequence.Name)),
+			Offset:   offset,
+		}
+	}
+
+	// The select may have been analyzed already as a subquery of the
+	// insert. Its column references are resolved again for this plan.
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			col.Metadata = nil
+		}
+		return true, nil
+	}, sel)
+	pb := newPrimitiveBuilder(vschema, newJointab(sqlparser.GetBindvars(sel)))
+	var err error
+	if s, ok := sel.(*sqlparser.Select); ok {
+		err = pb.processSelect(s, nil, "")
+	} else {
+		err = pb.processPart(sel, nil, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := pb.plan.Wireup(pb.plan, pb.jt); err != nil {
+		return nil, err
+	}
+	eins.Input = pb.plan.Primitive()
+
+	prefixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	prefixBuf.Myprintf("insert %v%sinto %v%v values ",
+		ins.Comments, ins.Ignore.ToString(),
+		ins.Table, ins.Columns)
+	eins.Prefix = prefixBuf.String()
+	suffixBuf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	suffixBuf.Myprintf("%v", ins.OnDup)
+	eins.Suffix = suffixBuf.String()
+	return eins, nil
+}
+
+// selectColumnCount returns the number of columns returned by the
+// select statement, if it can be known without the schema.
+func selectColumnCount(sel sqlparser.SelectStatement) (int, bool) {
+	switch sel := sel.(type) {
+	case *sqlparser.Select:
+		for _, expr := range sel.SelectExprs {
+			if _, ok := expr.(*sqlparser.StarExpr); ok {
+				return 0, false
+			}
+		}
+		return len(sel.SelectExprs), true
+	case *sqlparser.Union:
+		return selectColumnCount(sel.FirstStatement)
+	case *sqlparser.ParenSelect:
+		return selectColumnCount(sel.Select)
+	}
+	return 0, false
+}
+
+// addSelectNull adds a NULL column to the select statement.
+func addSelectNull(sel sqlparser.SelectStatement) error {
+	switch sel := sel.(type) {
+	case *sqlparser.Select:
+		sel.SelectExprs = append(sel.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.NullVal{}})
+		return nil
+	case *sqlparser.Union:
+		if err := addSelectNull(sel.FirstStatement); err != nil {
+			return err
+		}
+		for _, us := range sel.UnionSelects {
+			if err := addSelectNull(us.Statement); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *sqlparser.ParenSelect:
+		return addSelectNull(sel.Select)
+	}
+	return fmt.Errorf("BUG: unexpected construct in insert: %T", sel)
+}
+
 func populateInsertColumnlist(ins *sqlparser.Insert, table *vindexes.Table) {
 	cols := make(sqlparser.Columns, 0, len(table.Columns))
 	for _, c := range table.Columns {
@@ -22,6 +22,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 )
@@ -67,6 +68,26 @@ type join struct {
 	Left, Right logicalPlan
 
 	ejoin *engine.Join
+
+	// hashJoinKeys are the equality predicates between the two sides
+	// that were pushed into the RHS route. If the RHS does not need any
+	// other value from the LHS, the join is executed as a hash join on
+	// these keys, instead of sending one query per LHS row to the RHS.
+	hashJoinKeys []*hashJoinKey
+
+	// noHashJoin is set if the query asks for the join to be
+	// executed as a nested loop join. See disallowHashJoins.
+	noHashJoin bool
+
+	// ehashJoin is set if the join was converted to a hash join.
+	ehashJoin *engine.HashJoin
+}
+
+// hashJoinKey is an equality predicate between a column of the LHS
+// and a column of the RHS route of a join.
+type hashJoinKey struct {
+	expr        *sqlparser.ComparisonExpr
+	left, right *sqlparser.ColName
 }
 
 // newJoin makes a new join using the two planBuilder. ajoin can be nil
@@ -132,6 +153,12 @@ func (jb *join) Reorder(order int) {
 
 // Primitive implements the logicalPlan interface
 func (jb *join) Primitive() engine.Primitive {
+	if jb.ehashJoin != nil {
+		jb.ehashJoin.Left = jb.Left.Primitive()
+		jb.ehashJoin.Right = jb.Right.Primitive()
+		jb.ehashJoin.Cols = jb.ejoin.Cols
+		return jb.ehashJoin
+	}
 	jb.ejoin.Left = jb.Left.Primitive()
 	jb.ejoin.Right = jb.Right.Primitive()
 	return jb.ejoin
@@ -144,6 +171,9 @@ func (jb *join) ResultColumns() []*resultColumn {
 
 // Wireup implements the logicalPlan interface
 func (jb *join) Wireup(plan logicalPlan, jt *jointab) error {
+	if err := jb.planHashJoin(); err != nil {
+		return err
+	}
 	err := jb.Right.Wireup(plan, jt)
 	if err != nil {
 		return err
@@ -240,6 +270,181 @@ func (jb *join) Inputs() []logicalPlan {
 	return []logicalPlan{jb.Left, jb.Right}
 }
 
+// newHashJoinKey returns a hashJoinKey if the filter is an equality
+// between a column of the LHS and a column of the RHS route of the join.
+func newHashJoinKey(jb *join, filter sqlparser.Expr) *hashJoinKey {
+	if jb.ejoin.Opcode != engine.NormalJoin {
+		return nil
+	}
+	rb, ok := jb.Right.(*route)
+	if !ok {
+		return nil
+	}
+	cmp, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || cmp.Operator != sqlparser.EqualOp {
+		return nil
+	}
+	left, ok := cmp.Left.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	right, ok := cmp.Right.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	if left.Metadata.(*column).Origin() == rb {
+		left, right = right, left
+	}
+	if right.Metadata.(*column).Origin() != rb || !planContains(jb.Left, left.Metadata.(*column).Origin()) {
+		return nil
+	}
+	return &hashJoinKey{expr: cmp, left: left, right: right}
+}
+
+// hashable returns true if the values of both columns of the key can be
+// matched by their hashes. This needs the types of the columns to be known
+// from the vschema: numbers are hashed by value, binary strings by their
+// bytes, and text by its weight string, so that the collation is honored.
+// Text is never matched against numbers or binary strings.
+func (key *hashJoinKey) hashable() bool {
+	ltyp, rtyp := key.left.Metadata.(*column).typ, key.right.Metadata.(*column).typ
+	switch {
+	case sqltypes.IsNumber(ltyp):
+		return sqltypes.IsNumber(rtyp)
+	case sqltypes.IsText(ltyp):
+		return sqltypes.IsText(rtyp)
+	case sqltypes.IsBinary(ltyp):
+		return sqltypes.IsBinary(rtyp)
+	}
+	return false
+}
+
+// planContains returns true if target is plan or one of its inputs.
+func planContains(plan, target logicalPlan) bool {
+	if plan == target {
+		return true
+	}
+	for _, input := range plan.Inputs() {
+		if planContains(input, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// disallowHashJoins marks the joins of the FROM clause of a SELECT
+// that has the NO_HASH_JOIN directive. Joins of derived tables
+// follow the directives of their own SELECT.
+func disallowHashJoins(plan logicalPlan) {
+	jb, ok := plan.(*join)
+	if !ok {
+		return
+	}
+	jb.noHashJoin = true
+	disallowHashJoins(jb.Left)
+	disallowHashJoins(jb.Right)
+}
+
+// planHashJoin converts the join to a hash join unless the query has the
+// NO_HASH_JOIN directive, provided that the RHS is a route that only depends on the LHS through the hash
+// join keys. If the RHS route is resolved using one of the keys, the
+// nested loop join is kept, since it sends each query to a single shard.
+// The nested loop join is also kept if the LHS is a single row lookup,
+// or if the keys cannot be compared by their hashes.
+func (jb *join) planHashJoin() error {
+	if jb.noHashJoin || len(jb.hashJoinKeys) == 0 || jb.ehashJoin != nil {
+		return nil
+	}
+	switch left := jb.Left.(type) {
+	case *route:
+		if left.eroute.Opcode == engine.SelectEqualUnique {
+			return nil
+		}
+	case *vindexFunc:
+		return nil
+	}
+	rb, ok := jb.Right.(*route)
+	if !ok {
+		return nil
+	}
+	sel, ok := rb.Select.(*sqlparser.Select)
+	if !ok || sel.Where == nil {
+		return nil
+	}
+	keyExprs := make(map[sqlparser.Expr]bool)
+	for _, key := range jb.hashJoinKeys {
+		if key.right.Metadata.(*column).Origin() != rb || !key.hashable() {
+			return nil
+		}
+		keyExprs[key.expr] = true
+	}
+	dependsOnLeft := false
+	visit := func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.ComparisonExpr:
+			if keyExprs[node] {
+				return false, nil
+			}
+		case *sqlparser.ColName:
+			if !rb.isLocal(node) {
+				dependsOnLeft = true
+			}
+		}
+		return !dependsOnLeft, nil
+	}
+	// The vindex values of the route are not part of the query.
+	// If they come from the LHS, the route needs the join vars.
+	_ = sqlparser.Walk(visit, rb.condition, sel)
+	if dependsOnLeft {
+		return nil
+	}
+
+	sel.Where.Expr = removeExprs(sel.Where.Expr, keyExprs)
+	if sel.Where.Expr == nil {
+		sel.Where = nil
+	}
+	ehashJoin := &engine.HashJoin{}
+	for _, key := range jb.hashJoinKeys {
+		_, lhs := jb.Left.SupplyCol(key.left)
+		_, rhs := jb.Right.SupplyCol(key.right)
+		hashKey := engine.HashJoinKey{LHS: lhs, RHS: rhs, LHSWeightString: -1, RHSWeightString: -1}
+		if sqltypes.IsText(key.left.Metadata.(*column).typ) {
+			var err error
+			if hashKey.LHSWeightString, err = jb.Left.SupplyWeightString(lhs); err != nil {
+				return err
+			}
+			if hashKey.RHSWeightString, err = jb.Right.SupplyWeightString(rhs); err != nil {
+				return err
+			}
+		}
+		ehashJoin.Keys = append(ehashJoin.Keys, hashKey)
+	}
+	jb.ehashJoin = ehashJoin
+	return nil
+}
+
+// removeExprs removes the specified expressions from the AND tree of
+// expr. It returns nil if nothing is left.
+func removeExprs(expr sqlparser.Expr, exprs map[sqlparser.Expr]bool) sqlparser.Expr {
+	if exprs[expr] {
+		return nil
+	}
+	andExpr, ok := expr.(*sqlparser.AndExpr)
+	if !ok {
+		return expr
+	}
+	left := removeExprs(andExpr.Left, exprs)
+	right := removeExprs(andExpr.Right, exprs)
+	switch {
+	case left == nil:
+		return right
+	case right == nil:
+		return left
+	}
+	andExpr.Left, andExpr.Right = left, right
+	return andExpr
+}
+
 // isOnLeft returns true if the specified route number
 // is on the left side of the join. If false, it means
 // the node is on the right.
@@ -64,7 +64,19 @@ type orderedAggregate struct {
 // primitive and returns it. It returns a groupByHandler if there is aggregation it
 // can handle.
 func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
-	rb, isRoute := pb.plan.(*route)
+	// Pullout subqueries only supply values to the underlying route.
+	// So, the aggregation can be performed on the underlying route.
+	var pullout *pulloutSubquery
+	input := pb.plan
+	for {
+		ps, ok := input.(*pulloutSubquery)
+		if !ok {
+			break
+		}
+		pullout = ps
+		input = ps.underlying
+	}
+	rb, isRoute := input.(*route)
 	if isRoute && rb.isSingleShard() {
 		// since we can push down all of the aggregation to the route,
 		// we don't need to do anything else here
@@ -124,10 +136,15 @@ func (pb *primitiveBuilder) checkAggregates(sel *sqlparser.Select) error {
 
 	// We need an aggregator primitive.
 	eaggr := &engine.OrderedAggregate{}
-	pb.plan = &orderedAggregate{
+	oa := &orderedAggregate{
 		resultsBuilder: newResultsBuilder(rb, eaggr),
 		eaggr:          eaggr,
 	}
+	if pullout != nil {
+		pullout.setUnderlying(oa)
+	} else {
+		pb.plan = oa
+	}
 	pb.plan.Reorder(0)
 	return nil
 }
@@ -47,6 +47,16 @@ func planOrdering(pb *primitiveBuilder, input logicalPlan, orderBy sqlparser.Ord
 		return planJoinOrdering(pb, orderBy, node)
 	case *orderedAggregate:
 		return planOAOrdering(pb, orderBy, node)
+	case *projection:
+		return planProjectionOrdering(pb, orderBy, node)
+	case *filter:
+		// Filtering does not change the order of the rows.
+		newInput, err := planOrdering(pb, node.input, orderBy)
+		if err != nil {
+			return nil, err
+		}
+		node.input = newInput
+		return node, nil
 	case *mergeSort:
 		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "can't do ORDER BY on top of ORDER BY")
 	}
@@ -97,7 +97,7 @@ var _ planVisitor = setUpperLimit
 func setUpperLimit(plan logicalPlan) (bool, logicalPlan, error) {
 	arg := sqlparser.NewArgument([]byte(":__upper_limit"))
 	switch node := plan.(type) {
-	case *join:
+	case *join, *filter:
 		return false, node, nil
 	case *memorySort:
 		pv, err := sqlparser.NewPlanValue(arg)
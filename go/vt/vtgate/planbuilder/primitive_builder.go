@@ -25,6 +25,10 @@ type primitiveBuilder struct {
 	jt      *jointab
 	plan    logicalPlan
 	st      *symtab
+
+	// dml is set when building the FROM clause of an UPDATE or DELETE.
+	// Comma joins are then merged on the WHERE clause equalities.
+	dml bool
 }
 
 func newPrimitiveBuilder(vschema ContextVSchema, jt *jointab) *primitiveBuilder {
@@ -45,6 +45,7 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 			node.Left = newLeft
 		} else {
 			// Pushing of non-trivial expressions not allowed for RHS of left joins.
+			// Select expressions are evaluated by a projection on top of the join instead.
 			if _, ok := expr.Expr.(*sqlparser.ColName); !ok && node.ejoin.Opcode == engine.LeftJoin {
 				return nil, nil, 0, errors.New("unsupported: cross-shard left join and column expressions")
 			}
@@ -82,9 +83,10 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 			}
 		}
 
-		// Ensure that there are no aggregates in the expression.
+		// Expressions that combine aggregates, like sum(a)/count(b),
+		// are evaluated by a projection on top of oa.
 		if nodeHasAggregates(expr.Expr) {
-			return nil, nil, 0, errors.New("unsupported: in scatter query: complex aggregate expression")
+			return planProjection(pb, newProjection(node), expr, origin)
 		}
 
 		newInput, innerRC, _, err := planProjection(pb, node.input, expr, origin)
@@ -94,6 +96,14 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 		node.input = newInput
 		node.resultColumns = append(node.resultColumns, innerRC)
 		return node, innerRC, len(node.resultColumns) - 1, nil
+	case *projection:
+		rc, colNumber, err := node.push(pb, expr, origin)
+		if err != nil {
+			return nil, nil, 0, err
+		}
+		return node, rc, colNumber, nil
+	case *filter:
+		return planFilterProjection(pb, node, expr, origin)
 	case *route:
 		sel := node.Select.(*sqlparser.Select)
 		sel.SelectExprs = append(sel.SelectExprs, expr)
@@ -169,3 +179,46 @@ func planProjection(pb *primitiveBuilder, in logicalPlan, expr *sqlparser.Aliase
 	}
 	return nil, nil, 0, vterrors.Errorf(vtrpc.Code_UNIMPLEMENTED, "%T.projection: unreachable", in)
 }
+
+// canPushProjection returns false if the expression cannot be pushed
+// down to the plan, because it references the right side of a left
+// join. Such an expression must be evaluated after the join adds the
+// NULL rows. Columns can always be pushed down.
+func canPushProjection(plan logicalPlan, expr sqlparser.Expr, origin logicalPlan) bool {
+	if _, ok := expr.(*sqlparser.ColName); ok {
+		return true
+	}
+	switch node := plan.(type) {
+	case *join:
+		if node.isOnLeft(origin.Order()) {
+			return canPushProjection(node.Left, expr, origin)
+		}
+		if node.ejoin.Opcode == engine.LeftJoin {
+			return false
+		}
+		return canPushProjection(node.Right, expr, origin)
+	case *pulloutSubquery:
+		return canPushProjection(node.underlying, expr, origin)
+	case *projection:
+		return canPushProjection(node.input, expr, origin)
+	case *filter:
+		return canPushProjection(node.input, expr, origin)
+	}
+	return true
+}
+
+// exprOrigin returns the highest origin of the columns referenced by
+// the expression, like findOrigin does. The columns must already be
+// resolved.
+func exprOrigin(plan logicalPlan, expr sqlparser.Expr) logicalPlan {
+	highestOrigin := First(plan)
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		if col, ok := node.(*sqlparser.ColName); ok {
+			if c, ok := col.Metadata.(*column); ok && c.Origin().Order() > highestOrigin.Order() {
+				highestOrigin = c.Origin()
+			}
+		}
+		return true, nil
+	}, expr)
+	return highestOrigin
+}
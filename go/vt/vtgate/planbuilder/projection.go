@@ -0,0 +1,332 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package planbuilder
+
+import (
+	"errors"
+	"fmt"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var _ logicalPlan = (*projection)(nil)
+
+// projection is the logicalPlan for engine.Projection.
+// This gets built on top of an orderedAggregate when the select
+// list contains expressions that combine aggregates, like
+// 'select sum(a) / count(b) from t group by c'.
+// The aggregates (and any other column the expression references)
+// are pushed down to the orderedAggregate as hidden columns, and
+// the expression is evaluated by vtgate after the aggregation is
+// complete:
+//    &engine.Projection {
+//      Cols: []string{"c", "sum(a) / count(b)"},
+//      Exprs: []evalengine.Expr{Column(0), Column(1) / Column(2)},
+//      Input: &engine.OrderedAggregate{...},
+//      Replace: true,
+//    }
+// The columns of the underlying primitive that are part of the
+// select list are passed through as they are.
+// A projection is also built on top of a join if a select expression
+// references the right side of a left join, like
+// 'select ifnull(e.col, 0) from u left join e on u.id = e.uid', so that
+// the expression sees the NULLs of the rows without a match.
+type projection struct {
+	logicalPlanCommon
+	resultColumns []*resultColumn
+	columnNames   []string
+	columns       []evalengine.Expr
+}
+
+// newProjection builds a projection on top of input. All the
+// existing result columns of the input are passed through.
+func newProjection(input logicalPlan) *projection {
+	p := &projection{
+		logicalPlanCommon: newBuilderCommon(input),
+	}
+	for i, rc := range input.ResultColumns() {
+		p.addPassThrough(rc, i, rc.alias.String())
+	}
+	p.Reorder(0)
+	return p
+}
+
+// ResultColumns implements the logicalPlan interface
+func (p *projection) ResultColumns() []*resultColumn {
+	return p.resultColumns
+}
+
+// SupplyCol implements the logicalPlan interface
+func (p *projection) SupplyCol(col *sqlparser.ColName) (rc *resultColumn, colNumber int) {
+	c := col.Metadata.(*column)
+	for i, rc := range p.resultColumns {
+		if rc.column == c {
+			return rc, i
+		}
+	}
+	rc, colNumber = p.input.SupplyCol(col)
+	p.addPassThrough(rc, colNumber, sqlparser.String(col))
+	return rc, len(p.resultColumns) - 1
+}
+
+// SupplyWeightString implements the logicalPlan interface
+func (p *projection) SupplyWeightString(colNumber int) (weightcolNumber int, err error) {
+	col, ok := p.columns[colNumber].(*evalengine.Column)
+	if !ok {
+		return 0, errors.New("unsupported: cannot compute weight_string of an expression evaluated by vtgate")
+	}
+	inputNumber, err := p.input.SupplyWeightString(col.Offset)
+	if err != nil {
+		return 0, err
+	}
+	rc := p.input.ResultColumns()[inputNumber]
+	p.addPassThrough(rc, inputNumber, fmt.Sprintf("weight_string(%s)", p.columnNames[colNumber]))
+	return len(p.resultColumns) - 1, nil
+}
+
+// Primitive implements the logicalPlan interface
+func (p *projection) Primitive() engine.Primitive {
+	return &engine.Projection{
+		Cols:    p.columnNames,
+		Exprs:   p.columns,
+		Input:   p.input.Primitive(),
+		Replace: true,
+	}
+}
+
+func (p *projection) addPassThrough(rc *resultColumn, inputNumber int, name string) {
+	p.resultColumns = append(p.resultColumns, rc)
+	p.columnNames = append(p.columnNames, name)
+	p.columns = append(p.columns, evalengine.NewColumn(inputNumber))
+}
+
+// push adds the select expression to the projection. Expressions that
+// the input can produce by itself are pushed down and passed through.
+// Otherwise, the parts of the expression that the input can produce
+// are pushed down, and the expression is evaluated on top of them.
+func (p *projection) push(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, origin logicalPlan) (rc *resultColumn, colNumber int, err error) {
+	name := expr.As.String()
+	if name == "" {
+		name = sqlparser.String(expr.Expr)
+	}
+	if !needsProjection(expr.Expr) && canPushProjection(p.input, expr.Expr, origin) {
+		if inputNumber, ok := p.findInputColumn(expr.Expr); ok {
+			rc := p.input.ResultColumns()[inputNumber]
+			p.addPassThrough(rc, inputNumber, name)
+			return rc, len(p.resultColumns) - 1, nil
+		}
+		newInput, innerRC, innerCol, err := planProjection(pb, p.input, expr, origin)
+		if err != nil {
+			return nil, 0, err
+		}
+		p.input = newInput
+		p.addPassThrough(innerRC, innerCol, name)
+		return innerRC, len(p.resultColumns) - 1, nil
+	}
+
+	evalExpr, err := p.convert(pb, expr.Expr, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	rc = newResultColumn(expr, p)
+	p.resultColumns = append(p.resultColumns, rc)
+	p.columnNames = append(p.columnNames, name)
+	p.columns = append(p.columns, evalExpr)
+	return rc, len(p.resultColumns) - 1, nil
+}
+
+// convert builds the evalengine expression for an expression that
+// combines aggregates, or that references the right side of a left
+// join. The aggregates and any other operand that is not a constant
+// are pushed down to the input as hidden columns.
+func (p *projection) convert(pb *primitiveBuilder, expr sqlparser.Expr, origin logicalPlan) (evalengine.Expr, error) {
+	evalExpr, err := sqlparser.ConvertWith(expr, func(node sqlparser.Expr) (evalengine.Expr, error) {
+		if funcExpr, ok := node.(*sqlparser.FuncExpr); ok && funcExpr.Name.Lowered() == "avg" {
+			return p.convertAvg(pb, funcExpr, origin)
+		}
+		if inputNumber, ok := p.findInputColumn(node); ok {
+			return evalengine.NewColumn(inputNumber), nil
+		}
+		if nodeHasAggregates(node) && !isSupportedAggregate(node) {
+			// The expression is evaluated here, on top of the aggregates.
+			return nil, nil
+		}
+		nodeOrigin := origin
+		if !nodeHasAggregates(node) {
+			if evalExpr, err := sqlparser.Convert(node); err == nil {
+				return evalExpr, nil
+			}
+			// The columns of the left side of a left join must not be
+			// read from the right side, where they are NULL if there
+			// is no match.
+			nodeOrigin = exprOrigin(p.input, node)
+			if !canPushProjection(p.input, node, nodeOrigin) {
+				return nil, nil
+			}
+		}
+		newInput, _, innerCol, err := planProjection(pb, p.input, &sqlparser.AliasedExpr{Expr: node}, nodeOrigin)
+		if err != nil {
+			return nil, err
+		}
+		p.input = newInput
+		return evalengine.NewColumn(innerCol), nil
+	})
+	if err == sqlparser.ErrExprNotSupported {
+		if !nodeHasAggregates(expr) {
+			return nil, errors.New("unsupported: cross-shard left join and column expressions")
+		}
+		return nil, fmt.Errorf("unsupported: in scatter query: complex aggregate expression: %s", sqlparser.String(expr))
+	}
+	return evalExpr, err
+}
+
+// findInputColumn returns the column number of the input if the
+// expression is a column that the input already produces. Pushing
+// the same column twice would make references to it ambiguous.
+func (p *projection) findInputColumn(expr sqlparser.Expr) (int, bool) {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return 0, false
+	}
+	c := col.Metadata.(*column)
+	for i, rc := range p.input.ResultColumns() {
+		if rc.column == c {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// convertAvg computes avg(x) as sum(x) / count(x), both of which can be
+// aggregated across shards. As in MySQL, the average of exact values
+// is a decimal.
+func (p *projection) convertAvg(pb *primitiveBuilder, avg *sqlparser.FuncExpr, origin logicalPlan) (evalengine.Expr, error) {
+	sum, err := p.convert(pb, &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("sum"), Distinct: avg.Distinct, Exprs: avg.Exprs}, origin)
+	if err != nil {
+		return nil, err
+	}
+	count, err := p.convert(pb, &sqlparser.FuncExpr{Name: sqlparser.NewColIdent("count"), Distinct: avg.Distinct, Exprs: avg.Exprs}, origin)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.BinaryOp{Expr: &evalengine.DecimalDivision{}, Left: sum, Right: count}, nil
+}
+
+// isSupportedAggregate returns true if the expression is an aggregate
+// function that can be computed by orderedAggregate.
+func isSupportedAggregate(expr sqlparser.Expr) bool {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	if !ok {
+		return false
+	}
+	_, ok = engine.SupportedAggregates[funcExpr.Name.Lowered()]
+	return ok
+}
+
+// needsProjection returns true if the expression has aggregates that
+// cannot be computed by orderedAggregate by itself.
+func needsProjection(expr sqlparser.Expr) bool {
+	return !isSupportedAggregate(expr) && nodeHasAggregates(expr)
+}
+
+// planProjectionGroupBy translates the group by clause so that it
+// references the columns of the projection's input.
+func planProjectionGroupBy(pb *primitiveBuilder, groupBy sqlparser.GroupBy, p *projection) (logicalPlan, error) {
+	inputGroupBy := make(sqlparser.GroupBy, 0, len(groupBy))
+	for _, expr := range groupBy {
+		inputExpr, computed, err := p.inputReference(expr)
+		if err != nil {
+			return nil, err
+		}
+		if computed {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "group by expression cannot reference an aggregate function: %v", sqlparser.String(expr))
+		}
+		inputGroupBy = append(inputGroupBy, inputExpr)
+	}
+	newInput, err := planGroupBy(pb, p.input, inputGroupBy)
+	if err != nil {
+		return nil, err
+	}
+	p.input = newInput
+	return p, nil
+}
+
+// planProjectionOrdering pushes the ordering down to the input if it only
+// references columns passed through by the projection. If the ordering
+// references expressions evaluated by the projection, the rows are sorted
+// after the projection.
+func planProjectionOrdering(pb *primitiveBuilder, orderBy sqlparser.OrderBy, p *projection) (logicalPlan, error) {
+	postSort := false
+	inputOrderBy := make(sqlparser.OrderBy, 0, len(orderBy))
+	for _, order := range orderBy {
+		inputExpr, computed, err := p.inputReference(order.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if computed {
+			postSort = true
+			break
+		}
+		inputOrderBy = append(inputOrderBy, &sqlparser.Order{Expr: inputExpr, Direction: order.Direction})
+	}
+	if postSort {
+		// The input still has to be ordered by its grouping keys.
+		inputOrderBy = nil
+	}
+	newInput, err := planOrdering(pb, p.input, inputOrderBy)
+	if err != nil {
+		return nil, err
+	}
+	p.input = newInput
+	if postSort {
+		return newMemorySort(p, orderBy)
+	}
+	return p, nil
+}
+
+// inputReference translates a group by or order by expression of the
+// projection into an expression for its input. Column numbers are
+// remapped to the input's column numbers. It returns true if the
+// expression references a column computed by the projection.
+func (p *projection) inputReference(expr sqlparser.Expr) (sqlparser.Expr, bool, error) {
+	switch node := expr.(type) {
+	case *sqlparser.Literal:
+		if node.Type != sqlparser.IntVal {
+			return expr, false, nil
+		}
+		num, err := ResultFromNumber(p.resultColumns, node)
+		if err != nil {
+			return nil, false, err
+		}
+		col, ok := p.columns[num].(*evalengine.Column)
+		if !ok {
+			return nil, true, nil
+		}
+		return sqlparser.NewIntLiteral([]byte(fmt.Sprintf("%d", col.Offset+1))), false, nil
+	case *sqlparser.ColName:
+		return expr, node.Metadata.(*column).Origin() == p, nil
+	case *sqlparser.UnaryExpr:
+		if col, ok := node.Expr.(*sqlparser.ColName); ok {
+			return expr, col.Metadata.(*column).Origin() == p, nil
+		}
+	}
+	return expr, false, nil
+}
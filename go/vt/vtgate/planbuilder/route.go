@@ -58,9 +58,14 @@ type route struct {
 	substitutions []*tableSubstitution
 
 	// condition stores the AST condition that will be used
-	// to resolve the ERoute Values field.
+	// to resolve the ERoute Values field. For a multi-column
+	// vindex, it's a tuple with the values of its leading columns.
 	condition sqlparser.Expr
 
+	// multiColValues collects the values of the equality constraints
+	// on the columns of multi-column vindexes, indexed by column.
+	multiColValues map[*multiColVindex][]sqlparser.Expr
+
 	// eroute is the primitive being built.
 	eroute *engine.Route
 }
@@ -131,7 +136,16 @@ func (rb *route) SetLimit(limit *sqlparser.Limit) {
 // Wireup implements the logicalPlan interface
 func (rb *route) Wireup(plan logicalPlan, jt *jointab) error {
 	// Precaution: update ERoute.Values only if it's not set already.
-	if rb.eroute.Values == nil {
+	if _, isMulti := rb.eroute.Vindex.(vindexes.MultiColumn); isMulti && rb.eroute.Values == nil {
+		// Every value of the tuple is for one column of the vindex.
+		for _, val := range rb.condition.(sqlparser.ValTuple) {
+			pv, err := rb.procureValues(plan, jt, val)
+			if err != nil {
+				return err
+			}
+			rb.eroute.Values = append(rb.eroute.Values, pv)
+		}
+	} else if rb.eroute.Values == nil {
 		// Resolve values stored in the logical plan.
 		switch vals := rb.condition.(type) {
 		case *sqlparser.ComparisonExpr:
@@ -394,10 +408,18 @@ func (rb *route) JoinCanMerge(pb *primitiveBuilder, rrb *route, ajoin *sqlparser
 		}
 		return ajoin != nil
 	}
-	if ajoin == nil {
+	var filters []sqlparser.Expr
+	switch {
+	case ajoin != nil:
+		filters = splitAndExpression(nil, ajoin.Condition.On)
+	case pb.dml:
+		// A multi-table UPDATE or DELETE can only be routed if its tables
+		// are merged, so the comma joins are merged on the WHERE clause.
+		filters = splitAndExpression(nil, where)
+	default:
 		return false
 	}
-	for _, filter := range splitAndExpression(nil, ajoin.Condition.On) {
+	for _, filter := range filters {
 		if rb.canMergeOnFilter(pb, rrb, filter) {
 			return true
 		}
@@ -494,7 +516,10 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	}
 	opcode, vindex, values := rb.computePlan(pb, filter)
 	if opcode == engine.SelectScatter {
-		return
+		opcode, vindex, values = rb.computeMultiColPlan(pb, filter)
+		if opcode == engine.SelectScatter {
+			return
+		}
 	}
 	// If we get SelectNone in next filters, override the previous route plan.
 	if opcode == engine.SelectNone {
@@ -511,7 +536,8 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 		case engine.SelectEqualUnique:
 			rb.updateRoute(opcode, vindex, values)
 		case engine.SelectEqual:
-			if vindex.Cost() < rb.eroute.Vindex.Cost() {
+			// The same multi-column vindex only gets more columns.
+			if vindex.Cost() < rb.eroute.Vindex.Cost() || vindex == rb.eroute.Vindex {
 				rb.updateRoute(opcode, vindex, values)
 			}
 		}
@@ -541,14 +567,14 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	}
 }
 
-func (rb *route) updateRoute(opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (rb *route) updateRoute(opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	rb.eroute.Opcode = opcode
 	rb.eroute.Vindex = vindex
 	rb.condition = condition
 }
 
 // computePlan computes the plan for the specified filter.
-func (rb *route) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (rb *route) computePlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	switch node := filter.(type) {
 	case *sqlparser.ComparisonExpr:
 		switch node.Operator {
@@ -591,6 +617,73 @@ func (rb *route) computeEqualPlan(pb *primitiveBuilder, comparison *sqlparser.Co
 	return engine.SelectEqual, vindex, right
 }
 
+// computeMultiColPlan computes the plan for an equality constraint on a
+// column of multi-column vindexes. The values of the constraints on the
+// other columns come from the previous filters. If all the columns have
+// a value, the vindex maps to a unique keyspace id. If the vindex supports
+// it, the values of its leading columns map to a keyrange.
+func (rb *route) computeMultiColPlan(pb *primitiveBuilder, filter sqlparser.Expr) (opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
+	comparison, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok || comparison.Operator != sqlparser.EqualOp {
+		return engine.SelectScatter, nil, nil
+	}
+	left := comparison.Left
+	right := comparison.Right
+	col, mcvs := pb.st.MultiColVindexes(left, rb)
+	if col == nil {
+		left, right = right, left
+		col, mcvs = pb.st.MultiColVindexes(left, rb)
+		if col == nil {
+			return engine.SelectScatter, nil, nil
+		}
+	}
+	if !rb.exprIsValue(right) {
+		return engine.SelectScatter, nil, nil
+	}
+	if rb.multiColValues == nil {
+		rb.multiColValues = make(map[*multiColVindex][]sqlparser.Expr)
+	}
+	opcode = engine.SelectScatter
+	for _, mcv := range mcvs {
+		values, ok := rb.multiColValues[mcv]
+		if !ok {
+			values = make([]sqlparser.Expr, len(mcv.columns))
+			rb.multiColValues[mcv] = values
+		}
+		for i, mcvCol := range mcv.columns {
+			if mcvCol == col && values[i] == nil {
+				values[i] = right
+			}
+		}
+		newOpcode, newCondition := mcv.plan(values)
+		if newOpcode == engine.SelectScatter {
+			continue
+		}
+		if opcode == engine.SelectScatter || newOpcode == engine.SelectEqualUnique && opcode != engine.SelectEqualUnique || newOpcode == opcode && mcv.vindex.Cost() < vindex.Cost() {
+			opcode, vindex, condition = newOpcode, mcv.vindex, newCondition
+		}
+	}
+	return opcode, vindex, condition
+}
+
+// plan returns the plan for the values of the columns of the vindex.
+func (mcv *multiColVindex) plan(values []sqlparser.Expr) (engine.RouteOpcode, sqlparser.Expr) {
+	var prefix sqlparser.ValTuple
+	for _, val := range values {
+		if val == nil {
+			break
+		}
+		prefix = append(prefix, val)
+	}
+	switch {
+	case len(prefix) == len(values):
+		return engine.SelectEqualUnique, prefix
+	case len(prefix) > 0 && mcv.vindex.(vindexes.MultiColumn).PartialVindex():
+		return engine.SelectEqual, prefix
+	}
+	return engine.SelectScatter, nil
+}
+
 // computeIS computes the plan for an equality constraint.
 func (rb *route) computeISPlan(pb *primitiveBuilder, comparison *sqlparser.IsExpr) (opcode engine.RouteOpcode, vindex vindexes.SingleColumn, expr sqlparser.Expr) {
 	// we only handle IS NULL correct. IsExpr can contain other expressions as well
@@ -126,9 +126,12 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab,
 		return err
 	}
 
+	directives := sqlparser.ExtractCommentDirectives(sel.Comments)
+	if directives.IsSet(sqlparser.DirectiveNoHashJoin) {
+		disallowHashJoins(pb.plan)
+	}
 	if rb, ok := pb.plan.(*route); ok {
 		// TODO(sougou): this can probably be improved.
-		directives := sqlparser.ExtractCommentDirectives(sel.Comments)
 		rb.eroute.QueryTimeout = queryTimeout(directives)
 		if rb.eroute.TargetDestination != nil {
 			return errors.New("unsupported: SELECT with a target destination")
@@ -147,6 +150,9 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab,
 			return err
 		}
 	}
+	if err := pb.pushWindows(sel); err != nil {
+		return err
+	}
 	if err := pb.checkAggregates(sel); err != nil {
 		return err
 	}
@@ -168,6 +174,59 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, outer *symtab,
 	return setMiscFunc(pb.plan, sel)
 }
 
+// pushWindows returns an error if the select uses window functions
+// and cannot be sent as is to a single shard. A window function needs
+// to see all the rows of its partition, which vtgate cannot provide by
+// merging results from multiple shards. The named windows are pushed
+// into the route, where the select expressions can refer to them.
+func (pb *primitiveBuilder) pushWindows(sel *sqlparser.Select) error {
+	if len(sel.Windows) == 0 && !nodeHasWindowFunctions(sel.SelectExprs) && !nodeHasWindowFunctions(sel.OrderBy) {
+		return nil
+	}
+	rb, ok := pb.plan.(*route)
+	if !ok || !rb.isSingleShard() {
+		return vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: window functions in cross-shard query")
+	}
+	err := sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.ColName:
+			if _, _, err := pb.st.Find(node); err != nil {
+				return false, err
+			}
+		case *sqlparser.Subquery:
+			return false, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "unsupported: subquery in window definition")
+		}
+		return true, nil
+	}, sel.Windows)
+	if err != nil {
+		return err
+	}
+	query, ok := rb.Select.(*sqlparser.Select)
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected AST struct for query: %T", rb.Select)
+	}
+	query.Windows = sel.Windows
+	return nil
+}
+
+func nodeHasWindowFunctions(node sqlparser.SQLNode) bool {
+	hasWindowFunctions := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (kontinue bool, err error) {
+		switch node := node.(type) {
+		case *sqlparser.FuncExpr:
+			if node.Over != nil {
+				hasWindowFunctions = true
+				return false, errors.New("unused error")
+			}
+		case *sqlparser.Subquery:
+			// Subqueries are analyzed by themselves.
+			return false, nil
+		}
+		return true, nil
+	}, node)
+	return hasWindowFunctions
+}
+
 func setMiscFunc(in logicalPlan, sel *sqlparser.Select) error {
 	_, err := visit(in, func(plan logicalPlan) (bool, logicalPlan, error) {
 		switch node := plan.(type) {
@@ -339,6 +398,8 @@ func (pb *primitiveBuilder) pushFilter(in sqlparser.Expr, whereType string) erro
 		}
 		pb.addPullouts(pullouts)
 	}
+	// A filter may have been added to the plan.
+	pb.plan.Reorder(0)
 	return nil
 }
 
@@ -394,6 +455,9 @@ func (pb *primitiveBuilder) pushSelectRoutes(selectExprs sqlparser.SelectExprs)
 				return nil, err
 			}
 			node.Expr = expr
+			if _, ok := pb.plan.(*projection); !ok && !canPushProjection(pb.plan, expr, origin) {
+				pb.plan = newProjection(pb.plan)
+			}
 			newBuilder, rc, _, err := planProjection(pb, pb.plan, node, origin)
 			if err != nil {
 				return nil, err
@@ -107,9 +107,10 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTable *vinde
 	}
 
 	for _, cv := range vschemaTable.ColumnVindexes {
-		single, ok := cv.Vindex.(vindexes.SingleColumn)
-		if !ok {
-			continue
+		single, isSingle := cv.Vindex.(vindexes.SingleColumn)
+		var mcv *multiColVindex
+		if _, isMulti := cv.Vindex.(vindexes.MultiColumn); isMulti {
+			mcv = &multiColVindex{vindex: cv.Vindex}
 		}
 		for i, cvcol := range cv.Columns {
 			col, err := t.mergeColumn(cvcol, &column{
@@ -119,7 +120,12 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTable *vinde
 			if err != nil {
 				return err
 			}
-			if i == 0 {
+			if mcv != nil {
+				mcv.columns = append(mcv.columns, col)
+				col.multiColVindexes = append(col.multiColVindexes, mcv)
+				continue
+			}
+			if i == 0 && isSingle {
 				if col.vindex == nil || col.vindex.Cost() > single.Cost() {
 					col.vindex = single
 				}
@@ -410,6 +416,26 @@ func (st *symtab) Vindex(expr sqlparser.Expr, scope *route) vindexes.SingleColum
 	return c.vindex
 }
 
+// MultiColVindexes returns the multi-column vindexes of the expression if
+// it's a plain column reference that is part of the specified route.
+func (st *symtab) MultiColVindexes(expr sqlparser.Expr, scope *route) (*column, []*multiColVindex) {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil, nil
+	}
+	if col.Metadata == nil {
+		// Find will set the Metadata.
+		if _, _, err := st.Find(col); err != nil {
+			return nil, nil
+		}
+	}
+	c := col.Metadata.(*column)
+	if c.Origin() != scope || len(c.multiColVindexes) == 0 {
+		return nil, nil
+	}
+	return c, c.multiColVindexes
+}
+
 // BuildColName builds a *sqlparser.ColName for the resultColumn specified
 // by the index. The built ColName will correctly reference the resultColumn
 // it was built from.
@@ -521,6 +547,18 @@ type column struct {
 	vindex    vindexes.SingleColumn
 	typ       querypb.Type
 	colNumber int
+
+	// multiColVindexes are the multi-column vindexes
+	// the column is part of.
+	multiColVindexes []*multiColVindex
+}
+
+// multiColVindex is a multi-column vindex of a table in the symtab.
+// The same vindex of the same vschema table is a different
+// multiColVindex for every alias of the table.
+type multiColVindex struct {
+	vindex  vindexes.Vindex
+	columns []*column
 }
 
 // Origin returns the route that originates the column.
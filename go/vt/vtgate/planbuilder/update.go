@@ -29,7 +29,7 @@ import (
 // buildUpdatePlan builds the instructions for an UPDATE statement.
 func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.Primitive, error) {
 	upd := stmt.(*sqlparser.Update)
-	dml, ksidVindex, ksidCol, err := buildDMLPlan(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
+	dml, ksidVindex, ksidCols, alias, err := buildDMLPlan(vschema, "update", upd, upd.TableExprs, upd.Where, upd.OrderBy, upd.Limit, upd.Comments, upd.Exprs)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +41,7 @@ func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 		return eupd, nil
 	}
 
-	cvv, ovq, err := buildChangedVindexesValues(upd, eupd.Table, ksidCol)
+	cvv, ovq, err := buildChangedVindexesValues(upd, eupd.Table, ksidCols, alias)
 	if err != nil {
 		return nil, err
 	}
@@ -49,6 +49,7 @@ func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 	eupd.OwnedVindexQuery = ovq
 	if len(eupd.ChangedVindexValues) != 0 {
 		eupd.KsidVindex = ksidVindex
+		eupd.KsidLength = len(ksidCols)
 	}
 	return eupd, nil
 }
@@ -56,9 +57,9 @@ func buildUpdatePlan(stmt sqlparser.Statement, vschema ContextVSchema) (engine.P
 // buildChangedVindexesValues adds to the plan all the lookup vindexes that are changing.
 // Updates can only be performed to secondary lookup vindexes with no complex expressions
 // in the set clause.
-func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table, ksidCol string) (map[string]*engine.VindexValues, string, error) {
+func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table, ksidCols []sqlparser.ColIdent, alias sqlparser.TableName) (map[string]*engine.VindexValues, string, error) {
 	changedVindexes := make(map[string]*engine.VindexValues)
-	buf, offset := initialQuery(ksidCol, table)
+	buf, offset := initialQuery(ksidCols, table, alias)
 	for i, vindex := range table.ColumnVindexes {
 		vindexValueMap := make(map[string]sqltypes.PlanValue)
 		first := true
@@ -113,17 +114,45 @@ func buildChangedVindexesValues(update *sqlparser.Update, table *vindexes.Table,
 		return nil, "", nil
 	}
 	// generate rest of the owned vindex query.
-	buf.Myprintf(" from %v%v%v%v for update", table.Name, update.Where, update.OrderBy, update.Limit)
+	buf.Myprintf(" from %v%v%v%v for update", dmlSource(update.TableExprs, table, alias), update.Where, update.OrderBy, update.Limit)
 	return changedVindexes, buf.String(), nil
 }
 
-func initialQuery(ksidCol string, table *vindexes.Table) (*sqlparser.TrackedBuffer, int) {
-	buf := sqlparser.NewTrackedBuffer(nil)
-	buf.Myprintf("select %s", ksidCol)
-	offset := 1
+func initialQuery(ksidCols []sqlparser.ColIdent, table *vindexes.Table, alias sqlparser.TableName) (*sqlparser.TrackedBuffer, int) {
+	if alias.IsEmpty() {
+		buf := sqlparser.NewTrackedBuffer(nil)
+		for idx, col := range ksidCols {
+			if idx == 0 {
+				buf.Myprintf("select %v", col)
+			} else {
+				buf.Myprintf(", %v", col)
+			}
+		}
+		offset := len(ksidCols)
+		for _, cv := range table.Owned {
+			for _, column := range cv.Columns {
+				buf.Myprintf(", %v", column)
+				offset++
+			}
+		}
+		return buf, offset
+	}
+	// The statement joins multiple tables: qualify the columns
+	// of the target table, and strip the keyspace names like
+	// the query itself. A row of the target table can match
+	// several rows of the other tables, so the rows are deduped.
+	buf := sqlparser.NewTrackedBuffer(dmlFormatter)
+	for idx, col := range ksidCols {
+		if idx == 0 {
+			buf.Myprintf("select distinct %v.%v", alias, col)
+		} else {
+			buf.Myprintf(", %v.%v", alias, col)
+		}
+	}
+	offset := len(ksidCols)
 	for _, cv := range table.Owned {
 		for _, column := range cv.Columns {
-			buf.Myprintf(", %v", column)
+			buf.Myprintf(", %v.%v", alias, column)
 			offset++
 		}
 	}
@@ -466,6 +466,34 @@ func (vc *vcursorImpl) ResolveDestinations(keyspace string, ids []*querypb.Value
 	return vc.resolver.ResolveDestinations(vc.ctx, keyspace, vc.tabletType, ids, destinations)
 }
 
+// ResolveDMLDestinations implements the VCursor interface.
+// The writes to the table on the shards switched by a partial MoveTables
+// are served by the shards of the same name in the target keyspace. Reads
+// aren't routed: the reverse streams keep the source shards up to date.
+func (vc *vcursorImpl) ResolveDMLDestinations(keyspace, table string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	rss, values, err := vc.ResolveDestinations(keyspace, ids, destinations)
+	if err != nil {
+		return nil, nil, err
+	}
+	if vc.tabletType != topodatapb.TabletType_MASTER || vc.vschema == nil || len(vc.vschema.ShardRoutingRules) == 0 {
+		return rss, values, nil
+	}
+	for i, rs := range rss {
+		if routed := vc.vschema.FindRoutedShard(rs.Target.Keyspace, rs.Target.Shard, table); routed != rs.Target.Keyspace {
+			rss[i] = &srvtopo.ResolvedShard{
+				Target: &querypb.Target{
+					Keyspace:   routed,
+					Shard:      rs.Target.Shard,
+					TabletType: rs.Target.TabletType,
+					Cell:       rs.Target.Cell,
+				},
+				Gateway: rs.Gateway,
+			}
+		}
+	}
+	return rss, values, nil
+}
+
 func (vc *vcursorImpl) Session() engine.SessionActions {
 	return vc
 }
@@ -57,14 +57,19 @@ func (f *fakeTopoServer) GetSrvKeyspaceNames(ctx context.Context, cell string, s
 func (f *fakeTopoServer) GetSrvKeyspace(ctx context.Context, cell, keyspace string) (*topodatapb.SrvKeyspace, error) {
 	zeroHexBytes, _ := hex.DecodeString("")
 	eightyHexBytes, _ := hex.DecodeString("80")
+	shardReferences := []*topodatapb.ShardReference{
+		{Name: "-80", KeyRange: &topodatapb.KeyRange{Start: zeroHexBytes, End: eightyHexBytes}},
+		{Name: "80-", KeyRange: &topodatapb.KeyRange{Start: eightyHexBytes, End: zeroHexBytes}},
+	}
 	ks := &topodatapb.SrvKeyspace{
 		Partitions: []*topodatapb.SrvKeyspace_KeyspacePartition{
 			{
-				ServedType: topodatapb.TabletType_MASTER,
-				ShardReferences: []*topodatapb.ShardReference{
-					{Name: "-80", KeyRange: &topodatapb.KeyRange{Start: zeroHexBytes, End: eightyHexBytes}},
-					{Name: "80-", KeyRange: &topodatapb.KeyRange{Start: eightyHexBytes, End: zeroHexBytes}},
-				},
+				ServedType:      topodatapb.TabletType_MASTER,
+				ShardReferences: shardReferences,
+			},
+			{
+				ServedType:      topodatapb.TabletType_REPLICA,
+				ShardReferences: shardReferences,
 			},
 		},
 	}
@@ -290,6 +295,39 @@ func TestPlanPrefixKey(t *testing.T) {
 	}
 }
 
+func TestResolveDMLDestinationsShardRoutingRules(t *testing.T) {
+	vschema := &vindexes.VSchema{
+		ShardRoutingRules: map[string]string{"ks1.-80.t1": "ks2"},
+		Keyspaces:         vschemaWith2KS.Keyspaces,
+	}
+	resolve := func(target, table string, dml bool) []string {
+		t.Helper()
+		ss := NewSafeSession(&vtgatepb.Session{InTransaction: false, TargetString: target})
+		vc, err := newVCursorImpl(context.Background(), ss, sqlparser.MarginComments{}, nil, nil, &fakeVSchemaOperator{vschema: vschema}, vschema, srvtopo.NewResolver(&fakeTopoServer{}, nil, ""), nil)
+		require.NoError(t, err)
+		var rss []*srvtopo.ResolvedShard
+		if dml {
+			rss, _, err = vc.ResolveDMLDestinations("ks1", table, nil, []key.Destination{key.DestinationAllShards{}})
+		} else {
+			rss, _, err = vc.ResolveDestinations("ks1", nil, []key.Destination{key.DestinationAllShards{}})
+		}
+		require.NoError(t, err)
+		var got []string
+		for _, rs := range rss {
+			got = append(got, rs.Target.Keyspace+"/"+rs.Target.Shard)
+		}
+		return got
+	}
+
+	// The writes to the moved table on the switched shard go to the target keyspace.
+	require.Equal(t, []string{"ks2/-80", "ks1/80-"}, resolve("@master", "t1", true))
+	// A table that is not moved still goes to the source keyspace.
+	require.Equal(t, []string{"ks1/-80", "ks1/80-"}, resolve("@master", "t2", true))
+	// Reads and replica targets are not routed.
+	require.Equal(t, []string{"ks1/-80", "ks1/80-"}, resolve("@master", "t1", false))
+	require.Equal(t, []string{"ks1/-80", "ks1/80-"}, resolve("@replica", "t1", true))
+}
+
 func TestFirstSortedKeyspace(t *testing.T) {
 	ks1Schema := &vindexes.KeyspaceSchema{Keyspace: &vindexes.Keyspace{Name: "xks1"}}
 	ks2Schema := &vindexes.KeyspaceSchema{Keyspace: &vindexes.Keyspace{Name: "aks2"}}
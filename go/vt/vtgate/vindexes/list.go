@@ -0,0 +1,230 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var (
+	_ SingleColumn = (*List)(nil)
+	_ Reversible   = (*List)(nil)
+)
+
+// List is a vindex that maps discrete values, like countries or tiers, to shards.
+// The values are declared in the "values" param as a comma separated list of
+// value:shard entries, like "US:-80,CA:-80,MX:80-". Values are compared like
+// unicode_loose_md5 does: case and accent insensitive, ignoring trailing spaces.
+// Unlisted values map to the optional "default" shard, and are rejected if
+// there's none. The keyspace id of a value is the start of the keyrange of its
+// shard followed by the xxhash of the normalized value, which keeps the keyspace
+// ids of the existing values stable when the list changes. The optional "type"
+// param is the type of the column, like INT64, which ReverseMap returns the
+// values as. It defaults to VARCHAR, and must match the type of the column if
+// the table declares it.
+// It's Unique, and Reversible for the listed values.
+type List struct {
+	name          string
+	typ           querypb.Type
+	prefixes      map[string][]byte
+	defaultPrefix []byte
+	// values maps the keyspace ids of the listed values back to the values.
+	values map[string]sqltypes.Value
+}
+
+// NewList creates a List vindex.
+func NewList(name string, params map[string]string) (Vindex, error) {
+	spec, ok := params["values"]
+	if !ok {
+		return nil, fmt.Errorf("List: could not find `values` param in vschema")
+	}
+	vind := &List{
+		name:     name,
+		typ:      sqltypes.VarChar,
+		prefixes: make(map[string][]byte),
+		values:   make(map[string]sqltypes.Value),
+	}
+	if typ := params["type"]; typ != "" {
+		val, ok := querypb.Type_value[strings.ToUpper(typ)]
+		if !ok {
+			return nil, fmt.Errorf("List: invalid type %s", typ)
+		}
+		vind.typ = querypb.Type(val)
+	}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		// Shard names can't contain colons, values can.
+		idx := strings.LastIndex(entry, ":")
+		if idx == -1 {
+			return nil, fmt.Errorf("List: value should be of the form value:shard: %s", entry)
+		}
+		value, err := sqltypes.NewValue(vind.typ, []byte(strings.TrimSpace(entry[:idx])))
+		if err != nil {
+			return nil, fmt.Errorf("List: %v", err)
+		}
+		norm, err := listNormalize(value)
+		if err != nil {
+			return nil, fmt.Errorf("List: %v", err)
+		}
+		if _, ok := vind.prefixes[norm]; ok {
+			return nil, fmt.Errorf("List: value %s is listed more than once", value.ToString())
+		}
+		prefix, err := shardPrefix(strings.TrimSpace(entry[idx+1:]))
+		if err != nil {
+			return nil, fmt.Errorf("List: %v", err)
+		}
+		vind.prefixes[norm] = prefix
+		vind.values[string(listKeyspaceID(prefix, norm))] = value
+	}
+	if len(vind.prefixes) == 0 {
+		return nil, fmt.Errorf("List: no values specified")
+	}
+	if shard := params["default"]; shard != "" {
+		prefix, err := shardPrefix(shard)
+		if err != nil {
+			return nil, fmt.Errorf("List: %v", err)
+		}
+		vind.defaultPrefix = prefix
+	}
+	return vind, nil
+}
+
+// String returns the name of the vindex.
+func (vind *List) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this vindex as 1.
+func (*List) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (*List) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (*List) NeedsVCursor() bool {
+	return false
+}
+
+// Verify returns true if ids and ksids match.
+func (vind *List) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i := range ids {
+		ksid, err := vind.keyspaceID(ids[i])
+		if err != nil {
+			return nil, fmt.Errorf("List.Verify: %v", err)
+		}
+		out[i] = ksid != nil && bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *List) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(ids))
+	for _, id := range ids {
+		ksid, err := vind.keyspaceID(id)
+		if err != nil {
+			return nil, fmt.Errorf("List.Map: %v", err)
+		}
+		if ksid == nil {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		out = append(out, key.DestinationKeyspaceID(ksid))
+	}
+	return out, nil
+}
+
+// ReverseMap returns the associated ids for the ksids.
+// Only the keyspace ids of the listed values can be reversed.
+func (vind *List) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
+	reverseIds := make([]sqltypes.Value, len(ksids))
+	for i, ksid := range ksids {
+		value, ok := vind.values[string(ksid)]
+		if !ok {
+			return nil, fmt.Errorf("List.ReverseMap: keyspace id %x does not belong to a listed value", ksid)
+		}
+		reverseIds[i] = value
+	}
+	return reverseIds, nil
+}
+
+// checkColumn returns an error if the table declares the type
+// of the column, and it's not the type of the values.
+func (vind *List) checkColumn(table *Table, column sqlparser.ColIdent) error {
+	for _, col := range table.Columns {
+		if col.Name.Equal(column) && col.Type != vind.typ {
+			return fmt.Errorf("List: column %v of table %v is of type %v, but the values of vindex %s are of type %v: set the type param of the vindex", column, table.Name, col.Type, vind.name, vind.typ)
+		}
+	}
+	return nil
+}
+
+// keyspaceID returns the keyspace id of the value, or nil
+// if it's not listed and there's no default shard.
+func (vind *List) keyspaceID(id sqltypes.Value) ([]byte, error) {
+	if id.IsNull() {
+		return nil, nil
+	}
+	norm, err := listNormalize(id)
+	if err != nil {
+		return nil, err
+	}
+	prefix, ok := vind.prefixes[norm]
+	if !ok {
+		if vind.defaultPrefix == nil {
+			return nil, nil
+		}
+		prefix = vind.defaultPrefix
+	}
+	return listKeyspaceID(prefix, norm), nil
+}
+
+// listNormalize returns the collation key of the value, like unicode_loose_md5.
+func listNormalize(value sqltypes.Value) (string, error) {
+	collator := collatorPool.Get().(*pooledCollator)
+	defer collatorPool.Put(collator)
+
+	norm, err := normalize(collator.col, collator.buf, value.ToBytes())
+	if err != nil {
+		return "", err
+	}
+	return string(norm), nil
+}
+
+func listKeyspaceID(prefix []byte, norm string) []byte {
+	return append(append([]byte(nil), prefix...), vXXHash([]byte(norm))...)
+}
+
+func init() {
+	Register("list", NewList)
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+var listVindex SingleColumn
+
+func init() {
+	vindex, err := CreateVindex("list", "list", map[string]string{
+		"values":  "US:-80, CA:-80, MX:80-c0, 7:c0-",
+		"default": "c0-",
+	})
+	if err != nil {
+		panic(err)
+	}
+	listVindex = vindex.(SingleColumn)
+}
+
+func TestListInfo(t *testing.T) {
+	assert.Equal(t, 1, listVindex.Cost())
+	assert.Equal(t, "list", listVindex.String())
+	assert.True(t, listVindex.IsUnique())
+	assert.False(t, listVindex.NeedsVCursor())
+}
+
+func TestListMap(t *testing.T) {
+	ranges, err := key.ParseShardingSpec("-80-c0-")
+	require.NoError(t, err)
+	got, err := listVindex.Map(nil, []sqltypes.Value{
+		sqltypes.NewVarChar("US"),
+		sqltypes.NewVarChar("CA"),
+		sqltypes.NewVarChar("MX"),
+		sqltypes.NewInt64(7),
+		sqltypes.NewVarChar("FR"),
+		sqltypes.NULL,
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 6)
+	for i, shard := range []int{0, 0, 1, 2, 2} {
+		ksid := []byte(got[i].(key.DestinationKeyspaceID))
+		assert.True(t, key.KeyRangeContains(ranges[shard], ksid), "%d: %x not in %v", i, ksid, key.KeyRangeString(ranges[shard]))
+	}
+	// Values of the same shard get different keyspace ids.
+	assert.NotEqual(t, got[0], got[1])
+	assert.Equal(t, key.DestinationNone{}, got[5])
+
+	// Values are compared like unicode_loose_md5 does.
+	loose, err := listVindex.Map(nil, []sqltypes.Value{
+		sqltypes.NewVarChar("us"),
+		sqltypes.NewVarChar("US  "),
+		sqltypes.NewVarBinary("Us"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{got[0], got[0], got[0]}, loose)
+
+	_, err = listVindex.Map(nil, []sqltypes.Value{sqltypes.NewVarBinary("\xff")})
+	assert.EqualError(t, err, `List.Map: cannot normalize string containing invalid UTF-8: "\xff"`)
+
+	// Without a default, unlisted values don't map.
+	vindex, err := CreateVindex("list", "list", map[string]string{"values": "US:-80"})
+	require.NoError(t, err)
+	got, err = vindex.(SingleColumn).Map(nil, []sqltypes.Value{sqltypes.NewVarChar("FR")})
+	require.NoError(t, err)
+	assert.Equal(t, []key.Destination{key.DestinationNone{}}, got)
+}
+
+func TestListVerify(t *testing.T) {
+	dests, err := listVindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar("US")})
+	require.NoError(t, err)
+	ksid := []byte(dests[0].(key.DestinationKeyspaceID))
+	got, err := listVindex.Verify(nil,
+		[]sqltypes.Value{sqltypes.NewVarChar("US"), sqltypes.NewVarChar("CA")},
+		[][]byte{ksid, ksid})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, got)
+}
+
+func TestListReverseMap(t *testing.T) {
+	dests, err := listVindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar("MX"), sqltypes.NewInt64(7), sqltypes.NewVarChar("FR")})
+	require.NoError(t, err)
+	got, err := listVindex.(Reversible).ReverseMap(nil, [][]byte{
+		dests[0].(key.DestinationKeyspaceID),
+		dests[1].(key.DestinationKeyspaceID),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewVarChar("MX"), sqltypes.NewVarChar("7")}, got)
+
+	// Values that map to the default shard can't be reversed.
+	_, err = listVindex.(Reversible).ReverseMap(nil, [][]byte{dests[2].(key.DestinationKeyspaceID)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "does not belong to a listed value")
+
+	// The values are returned with the type of the vindex.
+	vindex, err := CreateVindex("list", "list", map[string]string{"values": "1:-80,2:80-", "type": "int64"})
+	require.NoError(t, err)
+	dests, err = vindex.(SingleColumn).Map(nil, []sqltypes.Value{sqltypes.NewInt64(2)})
+	require.NoError(t, err)
+	got, err = vindex.(Reversible).ReverseMap(nil, [][]byte{dests[0].(key.DestinationKeyspaceID)})
+	require.NoError(t, err)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewInt64(2)}, got)
+}
+
+func TestListColumnType(t *testing.T) {
+	srvVSchema := func(params map[string]string) *vschemapb.SrvVSchema {
+		return &vschemapb.SrvVSchema{
+			Keyspaces: map[string]*vschemapb.Keyspace{
+				"sharded": {
+					Sharded: true,
+					Vindexes: map[string]*vschemapb.Vindex{
+						"list": {Type: "list", Params: params},
+					},
+					Tables: map[string]*vschemapb.Table{
+						"t1": {
+							ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "c1", Name: "list"}},
+							Columns:        []*vschemapb.Column{{Name: "c1", Type: sqltypes.Int64}},
+						},
+					},
+				},
+			},
+		}
+	}
+	got, _ := BuildVSchema(srvVSchema(map[string]string{"values": "1:-80,2:80-"}))
+	assert.EqualError(t, got.Keyspaces["sharded"].Error, "List: column c1 of table t1 is of type INT64, but the values of vindex list are of type VARCHAR: set the type param of the vindex")
+
+	got, _ = BuildVSchema(srvVSchema(map[string]string{"values": "1:-80,2:80-", "type": "INT64"}))
+	assert.NoError(t, got.Keyspaces["sharded"].Error)
+}
+
+func TestListCreateErrors(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{},
+		err:    "List: could not find `values` param in vschema",
+	}, {
+		params: map[string]string{"values": " "},
+		err:    "List: no values specified",
+	}, {
+		params: map[string]string{"values": "US"},
+		err:    "List: value should be of the form value:shard: US",
+	}, {
+		params: map[string]string{"values": "US:-80,US:80-"},
+		err:    "List: value US is listed more than once",
+	}, {
+		params: map[string]string{"values": "US:-80,us :80-"},
+		err:    "List: value us is listed more than once",
+	}, {
+		params: map[string]string{"values": "US:-80", "type": "country"},
+		err:    "List: invalid type country",
+	}, {
+		params: map[string]string{"values": "US:-80", "type": "int64"},
+		err:    "List: strconv.ParseInt",
+	}, {
+		params: map[string]string{"values": "US:abc"},
+		err:    "List: invalid shard abc",
+	}, {
+		params: map[string]string{"values": "US:-80", "default": "x-"},
+		err:    "List: invalid shard x-",
+	}}
+	for _, tcase := range testcases {
+		_, err := CreateVindex("list", "list", tcase.params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), tcase.err)
+	}
+}
@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	_ MultiColumn = (*MultiCol)(nil)
+)
+
+const (
+	paramColumnCount  = "column_count"
+	paramColumnBytes  = "column_bytes"
+	paramColumnVindex = "column_vindex"
+	defaultVindex     = "hash"
+	maxKeyspaceIDSize = 8
+)
+
+func init() {
+	Register("multicol", NewMultiCol)
+}
+
+// MultiCol is a multi-column unique vindex. Every column is hashed
+// by its own functional vindex, and the keyspace id is the concatenation
+// of the first bytes of every hash. Because the leading columns make
+// the leading bytes of the keyspace id, a prefix of the columns maps
+// to a keyrange, which allows subsharding by the leading columns.
+type MultiCol struct {
+	name         string
+	cost         int
+	noOfCols     int
+	columnBytes  []int
+	hashVindexes []SingleColumn
+}
+
+// NewMultiCol creates a MultiCol vindex.
+// The supplied map requires a column_count argument. The optional
+// column_bytes argument is a comma separated list of the number of bytes
+// every column contributes to the keyspace id. They can't add up to more
+// than 8 and default to an even split of the 8 bytes. The optional
+// column_vindex argument is a comma separated list of the functional
+// vindex types used to hash every column, "hash" by default.
+func NewMultiCol(name string, m map[string]string) (Vindex, error) {
+	colCount, err := getColumnCount(m)
+	if err != nil {
+		return nil, err
+	}
+	columnBytes, err := getColumnBytes(m, colCount)
+	if err != nil {
+		return nil, err
+	}
+	hashVindexes, cost, err := getColumnVindex(m, colCount)
+	if err != nil {
+		return nil, err
+	}
+	return &MultiCol{
+		name:         name,
+		cost:         cost,
+		noOfCols:     colCount,
+		columnBytes:  columnBytes,
+		hashVindexes: hashVindexes,
+	}, nil
+}
+
+// String returns the name of the vindex.
+func (m *MultiCol) String() string {
+	return m.name
+}
+
+// Cost returns the highest cost of the column vindexes.
+func (m *MultiCol) Cost() int {
+	return m.cost
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (m *MultiCol) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (m *MultiCol) NeedsVCursor() bool {
+	return false
+}
+
+// PartialVindex returns true since a prefix of the columns maps to a keyrange.
+func (m *MultiCol) PartialVindex() bool {
+	return true
+}
+
+// Map satisfies MultiColumn. A row with all the columns maps to a keyspace id,
+// and a row with only the leading columns maps to a keyrange.
+func (m *MultiCol) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(rowsColValues))
+	for _, colValues := range rowsColValues {
+		partial, ksid, err := m.mapKsid(colValues)
+		if err != nil {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		if partial {
+			out = append(out, key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: ksid, End: addOne(ksid)}})
+			continue
+		}
+		out = append(out, key.DestinationKeyspaceID(ksid))
+	}
+	return out, nil
+}
+
+// Verify satisfies MultiColumn.
+func (m *MultiCol) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(rowsColValues))
+	for idx, colValues := range rowsColValues {
+		partial, ksid, err := m.mapKsid(colValues)
+		if err != nil {
+			return nil, err
+		}
+		out[idx] = !partial && bytes.Equal(ksid, ksids[idx])
+	}
+	return out, nil
+}
+
+func (m *MultiCol) mapKsid(colValues []sqltypes.Value) (bool, []byte, error) {
+	if m.noOfCols < len(colValues) {
+		return false, nil, fmt.Errorf("number of column values provided are more than column count %d: %v", m.noOfCols, colValues)
+	}
+	if len(colValues) == 0 {
+		return false, nil, fmt.Errorf("no column values provided")
+	}
+	var ksid []byte
+	for idx, colVal := range colValues {
+		if colVal.IsNull() {
+			return false, nil, fmt.Errorf("column %d of %s can't be null", idx, m.name)
+		}
+		dests, err := m.hashVindexes[idx].Map(nil, []sqltypes.Value{colVal})
+		if err != nil {
+			return false, nil, err
+		}
+		hash, ok := dests[0].(key.DestinationKeyspaceID)
+		if !ok {
+			return false, nil, fmt.Errorf("column vindex %s could not map %v to a keyspace id", m.hashVindexes[idx].String(), colVal)
+		}
+		if len(hash) < m.columnBytes[idx] {
+			return false, nil, fmt.Errorf("column vindex %s returned %d bytes for %v, %d are needed", m.hashVindexes[idx].String(), len(hash), colVal, m.columnBytes[idx])
+		}
+		ksid = append(ksid, hash[:m.columnBytes[idx]]...)
+	}
+	return len(colValues) < m.noOfCols, ksid, nil
+}
+
+// addOne returns the smallest value that is bigger than all the values
+// that start with the prefix. It returns nil if there is no such value,
+// which is the end of the keyspace.
+func addOne(prefix []byte) []byte {
+	out := make([]byte, len(prefix))
+	copy(out, prefix)
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i]++
+		if out[i] != 0 {
+			return out
+		}
+	}
+	return nil
+}
+
+func getColumnCount(m map[string]string) (int, error) {
+	colCountStr, ok := m[paramColumnCount]
+	if !ok {
+		return 0, fmt.Errorf("number of columns not provided")
+	}
+	colCount, err := strconv.Atoi(colCountStr)
+	if err != nil {
+		return 0, err
+	}
+	if colCount < 1 || colCount > maxKeyspaceIDSize {
+		return 0, fmt.Errorf("number of columns should be between 1 and %d in the parameter '%s'", maxKeyspaceIDSize, paramColumnCount)
+	}
+	return colCount, nil
+}
+
+func getColumnBytes(m map[string]string, colCount int) ([]int, error) {
+	columnBytes := make([]int, colCount)
+	columnBytesStr, ok := m[paramColumnBytes]
+	if !ok {
+		// Split the keyspace id evenly, and give the remainder to the
+		// leading columns.
+		for idx := range columnBytes {
+			columnBytes[idx] = maxKeyspaceIDSize / colCount
+			if idx < maxKeyspaceIDSize%colCount {
+				columnBytes[idx]++
+			}
+		}
+		return columnBytes, nil
+	}
+	colBytes := strings.Split(columnBytesStr, ",")
+	if len(colBytes) != colCount {
+		return nil, fmt.Errorf("number of column bytes provided are more or less than number of columns provided in the parameter '%s'", paramColumnBytes)
+	}
+	totalBytes := 0
+	for idx, byteStr := range colBytes {
+		val, err := strconv.Atoi(strings.TrimSpace(byteStr))
+		if err != nil {
+			return nil, err
+		}
+		if val < 1 {
+			return nil, fmt.Errorf("column bytes must be positive in the parameter '%s': %s", paramColumnBytes, columnBytesStr)
+		}
+		columnBytes[idx] = val
+		totalBytes += val
+	}
+	if totalBytes > maxKeyspaceIDSize {
+		return nil, fmt.Errorf("column bytes count exceeds the keyspace id length (total bytes count cannot exceed %d bytes) in the parameter '%s'", maxKeyspaceIDSize, paramColumnBytes)
+	}
+	return columnBytes, nil
+}
+
+func getColumnVindex(m map[string]string, colCount int) ([]SingleColumn, int, error) {
+	var colVindexStrs []string
+	if colVindexStr, ok := m[paramColumnVindex]; ok {
+		colVindexStrs = strings.Split(colVindexStr, ",")
+		if len(colVindexStrs) != colCount {
+			return nil, 0, fmt.Errorf("number of vindex function provided are more or less than number of columns provided in the parameter '%s'", paramColumnVindex)
+		}
+	}
+	cost := 0
+	columnVindexes := make([]SingleColumn, colCount)
+	for idx := range columnVindexes {
+		vindexType := defaultVindex
+		if colVindexStrs != nil {
+			if vt := strings.TrimSpace(colVindexStrs[idx]); vt != "" {
+				vindexType = vt
+			}
+		}
+		vindex, err := CreateVindex(vindexType, vindexType, nil)
+		if err != nil {
+			return nil, 0, err
+		}
+		colVindex, ok := vindex.(SingleColumn)
+		if !ok || !vindex.IsUnique() || vindex.NeedsVCursor() {
+			return nil, 0, fmt.Errorf("multicol vindex supports only unique functional vindexes for columns, %s is not one in the parameter '%s'", vindexType, paramColumnVindex)
+		}
+		if vindex.Cost() > cost {
+			cost = vindex.Cost()
+		}
+		columnVindexes[idx] = colVindex
+	}
+	return columnVindexes, cost, nil
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestMultiColMisc(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count":  "3",
+		"column_vindex": "hash,binary_md5,xxhash",
+	})
+	require.NoError(t, err)
+	multiColVdx, isMultiColVdx := vindex.(*MultiCol)
+	require.True(t, isMultiColVdx)
+
+	assert.Equal(t, 1, multiColVdx.Cost())
+	assert.Equal(t, "multicol", multiColVdx.String())
+	assert.True(t, multiColVdx.IsUnique())
+	assert.False(t, multiColVdx.NeedsVCursor())
+	assert.True(t, multiColVdx.PartialVindex())
+	assert.Equal(t, []int{3, 3, 2}, multiColVdx.columnBytes)
+}
+
+func TestMultiColParams(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{},
+		err:    "number of columns not provided",
+	}, {
+		params: map[string]string{"column_count": "9"},
+		err:    "number of columns should be between 1 and 8 in the parameter 'column_count'",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "4"},
+		err:    "number of column bytes provided are more or less than number of columns provided in the parameter 'column_bytes'",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "4,5"},
+		err:    "column bytes count exceeds the keyspace id length (total bytes count cannot exceed 8 bytes) in the parameter 'column_bytes'",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "0,5"},
+		err:    "column bytes must be positive in the parameter 'column_bytes': 0,5",
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash"},
+		err:    "number of vindex function provided are more or less than number of columns provided in the parameter 'column_vindex'",
+	}, {
+		params: map[string]string{"column_count": "2", "column_vindex": "hash,lookup_unique"},
+		err:    "multicol vindex supports only unique functional vindexes for columns, lookup_unique is not one in the parameter 'column_vindex'",
+	}, {
+		params: map[string]string{"column_count": "2", "column_bytes": "1,7", "column_vindex": "hash,"},
+	}}
+	for _, tc := range testcases {
+		_, err := CreateVindex("multicol", "multicol", tc.params)
+		if tc.err == "" {
+			assert.NoError(t, err, tc.params)
+			continue
+		}
+		assert.EqualError(t, err, tc.err, tc.params)
+	}
+}
+
+func TestMultiColMap(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count": "3",
+		"column_bytes": "1,3,4",
+	})
+	require.NoError(t, err)
+	multiCol := vindex.(MultiColumn)
+
+	got, err := multiCol.Map(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(255), sqltypes.NewInt64(255), sqltypes.NewInt64(255),
+	}, {
+		sqltypes.NewInt64(255), sqltypes.NewInt64(1), sqltypes.NewInt64(2),
+	}, {
+		// Only the first two columns.
+		sqltypes.NewInt64(255), sqltypes.NewInt64(1),
+	}, {
+		// Only the first column.
+		sqltypes.NewInt64(255),
+	}, {
+		// Too many columns.
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}, {
+		// Null column.
+		sqltypes.NewInt64(1), sqltypes.NULL,
+	}, {
+		// Invalid value.
+		sqltypes.NewVarBinary("abcd"), sqltypes.NewInt64(1), sqltypes.NewInt64(1),
+	}})
+	require.NoError(t, err)
+
+	want := []key.Destination{
+		key.DestinationKeyspaceID("\x25\x25\x4e\x88\x25\x4e\x88\x2e"),
+		key.DestinationKeyspaceID("\x25\x16k@\x06\xe7\xea\""),
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x25\x16k@"), End: []byte("\x25\x16kA")}},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x25"), End: []byte("\x26")}},
+		key.DestinationNone{},
+		key.DestinationNone{},
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestMultiColVerify(t *testing.T) {
+	vindex, err := CreateVindex("multicol", "multicol", map[string]string{
+		"column_count": "2",
+	})
+	require.NoError(t, err)
+	multiCol := vindex.(MultiColumn)
+
+	got, err := multiCol.Verify(nil, [][]sqltypes.Value{{
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2),
+	}, {
+		sqltypes.NewInt64(1), sqltypes.NewInt64(2),
+	}, {
+		// A partial row never verifies.
+		sqltypes.NewInt64(1),
+	}}, [][]byte{
+		[]byte("\x16k@\xb4\x06\xe7\xea\""),
+		[]byte("\x16k@\xb4\x06\xe7\xea\x23"),
+		[]byte("\x16k@\xb4"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, got)
+}
+
+func TestAddOne(t *testing.T) {
+	assert.Equal(t, []byte{0x01, 0x03}, addOne([]byte{0x01, 0x02}))
+	assert.Equal(t, []byte{0x02, 0x00}, addOne([]byte{0x01, 0xff}))
+	assert.Nil(t, addOne([]byte{0xff, 0xff}))
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+var (
+	_ SingleColumn = (*Range)(nil)
+	_ Reversible   = (*Range)(nil)
+)
+
+// Range is a vindex that maps ranges of unsigned integers to shards.
+// The ranges are declared in the "ranges" param as a comma separated list
+// of from-to:shard entries, like "0-1000:-80,1000-:80-". A range includes
+// its lower bound and excludes its upper bound, which can be omitted for
+// an unbounded range. The keyspace id of a value is the start of the keyrange
+// of its shard followed by the 8 byte big-endian representation of the value.
+// It's Unique and Reversible.
+type Range struct {
+	name   string
+	ranges []valueRange
+}
+
+// valueRange is a range of values of a Range vindex.
+type valueRange struct {
+	from, to uint64
+	// unbounded is set if the range has no upper bound.
+	unbounded bool
+	prefix    []byte
+}
+
+// NewRange creates a Range vindex.
+func NewRange(name string, params map[string]string) (Vindex, error) {
+	spec, ok := params["ranges"]
+	if !ok {
+		return nil, fmt.Errorf("Range: could not find `ranges` param in vschema")
+	}
+	vind := &Range{name: name}
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		splits := strings.Split(entry, ":")
+		if len(splits) != 2 {
+			return nil, fmt.Errorf("Range: range should be of the form from-to:shard: %s", entry)
+		}
+		bounds := strings.Split(strings.TrimSpace(splits[0]), "-")
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("Range: range should be of the form from-to:shard: %s", entry)
+		}
+		vr := valueRange{}
+		var err error
+		if vr.from, err = strconv.ParseUint(strings.TrimSpace(bounds[0]), 10, 64); err != nil {
+			return nil, fmt.Errorf("Range: invalid lower bound in %s: %v", entry, err)
+		}
+		if to := strings.TrimSpace(bounds[1]); to == "" {
+			vr.unbounded = true
+		} else {
+			if vr.to, err = strconv.ParseUint(to, 10, 64); err != nil {
+				return nil, fmt.Errorf("Range: invalid upper bound in %s: %v", entry, err)
+			}
+			if vr.to <= vr.from {
+				return nil, fmt.Errorf("Range: range %s is empty", entry)
+			}
+		}
+		if vr.prefix, err = shardPrefix(strings.TrimSpace(splits[1])); err != nil {
+			return nil, fmt.Errorf("Range: %v", err)
+		}
+		for _, other := range vind.ranges {
+			if vr.overlaps(other) {
+				return nil, fmt.Errorf("Range: range %s overlaps with another range", entry)
+			}
+		}
+		vind.ranges = append(vind.ranges, vr)
+	}
+	if len(vind.ranges) == 0 {
+		return nil, fmt.Errorf("Range: no ranges specified")
+	}
+	return vind, nil
+}
+
+// String returns the name of the vindex.
+func (vind *Range) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this vindex as 1.
+func (*Range) Cost() int {
+	return 1
+}
+
+// IsUnique returns true since the Vindex is unique.
+func (*Range) IsUnique() bool {
+	return true
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (*Range) NeedsVCursor() bool {
+	return false
+}
+
+// Verify returns true if ids and ksids match.
+func (vind *Range) Verify(_ VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	for i := range ids {
+		ksid, ok := vind.keyspaceID(ids[i])
+		out[i] = ok && bytes.Equal(ksid, ksids[i])
+	}
+	return out, nil
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *Range) Map(cursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	out := make([]key.Destination, 0, len(ids))
+	for _, id := range ids {
+		ksid, ok := vind.keyspaceID(id)
+		if !ok {
+			out = append(out, key.DestinationNone{})
+			continue
+		}
+		out = append(out, key.DestinationKeyspaceID(ksid))
+	}
+	return out, nil
+}
+
+// ReverseMap returns the associated ids for the ksids.
+func (vind *Range) ReverseMap(_ VCursor, ksids [][]byte) ([]sqltypes.Value, error) {
+	reverseIds := make([]sqltypes.Value, len(ksids))
+outer:
+	for i, ksid := range ksids {
+		for _, vr := range vind.ranges {
+			if len(ksid) != len(vr.prefix)+8 || !bytes.HasPrefix(ksid, vr.prefix) {
+				continue
+			}
+			num := binary.BigEndian.Uint64(ksid[len(vr.prefix):])
+			if vr.contains(num) {
+				reverseIds[i] = sqltypes.NewUint64(num)
+				continue outer
+			}
+		}
+		return nil, fmt.Errorf("Range.ReverseMap: keyspace id %x does not belong to any range", ksid)
+	}
+	return reverseIds, nil
+}
+
+func (vind *Range) keyspaceID(id sqltypes.Value) ([]byte, bool) {
+	num, err := evalengine.ToUint64(id)
+	if err != nil {
+		return nil, false
+	}
+	for _, vr := range vind.ranges {
+		if !vr.contains(num) {
+			continue
+		}
+		ksid := make([]byte, len(vr.prefix)+8)
+		copy(ksid, vr.prefix)
+		binary.BigEndian.PutUint64(ksid[len(vr.prefix):], num)
+		return ksid, true
+	}
+	return nil, false
+}
+
+func (vr valueRange) contains(num uint64) bool {
+	return num >= vr.from && (vr.unbounded || num < vr.to)
+}
+
+func (vr valueRange) overlaps(other valueRange) bool {
+	return (vr.unbounded || other.from < vr.to) && (other.unbounded || vr.from < other.to)
+}
+
+// shardPrefix returns the prefix of the keyspace ids that the static
+// vindexes generate for a shard: the start of its keyrange, or a zero
+// byte for the first shard. Any 8 bytes can follow the prefix without
+// leaving the keyrange of the shard.
+func shardPrefix(shard string) ([]byte, error) {
+	if !key.IsKeyRange(shard) {
+		return nil, fmt.Errorf("invalid shard %s: only keyrange based shard names are supported", shard)
+	}
+	splits := strings.Split(shard, "-")
+	kr, err := key.ParseKeyRangeParts(splits[0], splits[1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid shard %s: %v", shard, err)
+	}
+	prefix := kr.Start
+	if len(prefix) == 0 {
+		prefix = []byte{0}
+	}
+	last := append(append([]byte(nil), prefix...), bytes.Repeat([]byte{0xff}, 8)...)
+	if !key.KeyRangeContains(kr, last) {
+		return nil, fmt.Errorf("shard %s is too narrow to be the target of a static vindex", shard)
+	}
+	return prefix, nil
+}
+
+func init() {
+	Register("range", NewRange)
+}
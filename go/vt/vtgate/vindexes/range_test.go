@@ -0,0 +1,146 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+var rangeVindex SingleColumn
+
+func init() {
+	vindex, err := CreateVindex("range", "range", map[string]string{
+		"ranges": "0-1000:-80, 1000-5000:80-c0, 10000-:c0-",
+	})
+	if err != nil {
+		panic(err)
+	}
+	rangeVindex = vindex.(SingleColumn)
+}
+
+func TestRangeInfo(t *testing.T) {
+	assert.Equal(t, 1, rangeVindex.Cost())
+	assert.Equal(t, "range", rangeVindex.String())
+	assert.True(t, rangeVindex.IsUnique())
+	assert.False(t, rangeVindex.NeedsVCursor())
+}
+
+func TestRangeMap(t *testing.T) {
+	got, err := rangeVindex.Map(nil, []sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(999),
+		sqltypes.NewInt64(1000),
+		sqltypes.NewInt64(5000),
+		sqltypes.NewUint64(18446744073709551615),
+		sqltypes.NewInt64(-1),
+		sqltypes.NewVarChar("abcd"),
+		sqltypes.NULL,
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x00\x01")),
+		key.DestinationKeyspaceID([]byte("\x00\x00\x00\x00\x00\x00\x00\x03\xe7")),
+		key.DestinationKeyspaceID([]byte("\x80\x00\x00\x00\x00\x00\x00\x03\xe8")),
+		key.DestinationNone{},
+		key.DestinationKeyspaceID([]byte("\xc0\xff\xff\xff\xff\xff\xff\xff\xff")),
+		key.DestinationNone{},
+		key.DestinationNone{},
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestRangeVerify(t *testing.T) {
+	got, err := rangeVindex.Verify(nil,
+		[]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(1000), sqltypes.NewInt64(5000)},
+		[][]byte{
+			[]byte("\x00\x00\x00\x00\x00\x00\x00\x00\x01"),
+			[]byte("\x00\x00\x00\x00\x00\x00\x00\x03\xe8"),
+			[]byte("\x80\x00\x00\x00\x00\x00\x00\x13\x88"),
+		})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, false}, got)
+}
+
+func TestRangeReverseMap(t *testing.T) {
+	got, err := rangeVindex.(Reversible).ReverseMap(nil, [][]byte{
+		[]byte("\x00\x00\x00\x00\x00\x00\x00\x00\x01"),
+		[]byte("\x80\x00\x00\x00\x00\x00\x00\x03\xe8"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []sqltypes.Value{sqltypes.NewUint64(1), sqltypes.NewUint64(1000)}, got)
+
+	// 5000 is in the keyrange of 80-c0, but it's not in a range of the shard.
+	_, err = rangeVindex.(Reversible).ReverseMap(nil, [][]byte{[]byte("\x80\x00\x00\x00\x00\x00\x00\x13\x88")})
+	assert.EqualError(t, err, "Range.ReverseMap: keyspace id 800000000000001388 does not belong to any range")
+}
+
+func TestRangeKeyspaceIDsInShard(t *testing.T) {
+	ranges, err := key.ParseShardingSpec("-80-c0-")
+	require.NoError(t, err)
+	for _, tcase := range []struct {
+		value uint64
+		shard int
+	}{{0, 0}, {999, 0}, {1000, 1}, {4999, 1}, {10000, 2}, {18446744073709551615, 2}} {
+		dests, err := rangeVindex.Map(nil, []sqltypes.Value{sqltypes.NewUint64(tcase.value)})
+		require.NoError(t, err)
+		ksid := []byte(dests[0].(key.DestinationKeyspaceID))
+		assert.True(t, key.KeyRangeContains(ranges[tcase.shard], ksid), "value %d: %x not in %v", tcase.value, ksid, key.KeyRangeString(ranges[tcase.shard]))
+	}
+}
+
+func TestRangeCreateErrors(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{},
+		err:    "Range: could not find `ranges` param in vschema",
+	}, {
+		params: map[string]string{"ranges": ""},
+		err:    "Range: no ranges specified",
+	}, {
+		params: map[string]string{"ranges": "0-10"},
+		err:    "Range: range should be of the form from-to:shard: 0-10",
+	}, {
+		params: map[string]string{"ranges": "a-10:-80"},
+		err:    "Range: invalid lower bound in a-10:-80",
+	}, {
+		params: map[string]string{"ranges": "10-10:-80"},
+		err:    "Range: range 10-10:-80 is empty",
+	}, {
+		params: map[string]string{"ranges": "0-10:-80,5-:80-"},
+		err:    "Range: range 5-:80- overlaps with another range",
+	}, {
+		params: map[string]string{"ranges": "0-10:0"},
+		err:    "Range: invalid shard 0: only keyrange based shard names are supported",
+	}, {
+		params: map[string]string{"ranges": "0-10:80-8001"},
+		err:    "Range: shard 80-8001 is too narrow to be the target of a static vindex",
+	}}
+	for _, tcase := range testcases {
+		_, err := CreateVindex("range", "range", tcase.params)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), tcase.err)
+	}
+}
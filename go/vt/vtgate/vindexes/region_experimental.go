@@ -87,6 +87,11 @@ func (ge *RegionExperimental) NeedsVCursor() bool {
 	return false
 }
 
+// PartialVindex returns false since the vindex needs all its columns.
+func (ge *RegionExperimental) PartialVindex() bool {
+	return false
+}
+
 // Map satisfies MultiColumn.
 func (ge *RegionExperimental) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
 	destinations := make([]key.Destination, 0, len(rowsColValues))
@@ -89,6 +89,9 @@ type MultiColumn interface {
 	Vindex
 	Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error)
 	Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value, ksids [][]byte) ([]bool, error)
+	// PartialVindex returns true if the vindex can map the values of a
+	// prefix of its columns. Such a row maps to a keyrange.
+	PartialVindex() bool
 }
 
 // A Reversible vindex is one that can perform a
@@ -57,10 +57,14 @@ const (
 // VSchema represents the denormalized version of SrvVSchema,
 // used for building routing plans.
 type VSchema struct {
-	RoutingRules   map[string]*RoutingRule `json:"routing_rules"`
-	uniqueTables   map[string]*Table
-	uniqueVindexes map[string]Vindex
-	Keyspaces      map[string]*KeyspaceSchema `json:"keyspaces"`
+	RoutingRules map[string]*RoutingRule `json:"routing_rules"`
+	// ShardRoutingRules maps a keyspace.shard.table to the keyspace that
+	// receives the writes to the table on the shard. It's nil if there
+	// are no shard routing rules.
+	ShardRoutingRules map[string]string `json:"shard_routing_rules,omitempty"`
+	uniqueTables      map[string]*Table
+	uniqueVindexes    map[string]Vindex
+	Keyspaces         map[string]*KeyspaceSchema `json:"keyspaces"`
 }
 
 // RoutingRule represents one routing rule.
@@ -173,6 +177,7 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema, err error) {
 	resolveAutoIncrement(source, vschema)
 	addDual(vschema)
 	buildRoutingRule(source, vschema)
+	buildShardRoutingRule(source, vschema)
 	return vschema, nil
 }
 
@@ -304,6 +309,11 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 					columns = append(columns, sqlparser.NewColIdent(indCol))
 				}
 			}
+			if list, ok := vindex.(*List); ok {
+				if err := list.checkColumn(t, columns[0]); err != nil {
+					return err
+				}
+			}
 			columnVindex := &ColumnVindex{
 				Columns: columns,
 				Type:    vindexInfo.Type,
@@ -442,6 +452,18 @@ outer:
 	}
 }
 
+func buildShardRoutingRule(source *vschemapb.SrvVSchema, vschema *VSchema) {
+	if len(source.GetShardRoutingRules().GetRules()) == 0 {
+		return
+	}
+	vschema.ShardRoutingRules = make(map[string]string)
+	for _, rule := range source.ShardRoutingRules.Rules {
+		for _, table := range rule.Tables {
+			vschema.ShardRoutingRules[rule.FromKeyspace+"."+rule.Shard+"."+table] = rule.ToKeyspace
+		}
+	}
+}
+
 // FindTable returns a pointer to the Table. If a keyspace is specified, only tables
 // from that keyspace are searched. If the specified keyspace is unsharded
 // and no tables matched, it's considered valid: FindTable will construct a table
@@ -520,6 +542,16 @@ func (vschema *VSchema) FindRoutedTable(keyspace, tablename string, tabletType t
 	return vschema.findTable(keyspace, tablename)
 }
 
+// FindRoutedShard returns the keyspace that receives the writes to the table
+// on the shard of the keyspace, which is the keyspace itself unless a shard
+// routing rule redirects them.
+func (vschema *VSchema) FindRoutedShard(keyspace, shard, table string) string {
+	if ks, ok := vschema.ShardRoutingRules[keyspace+"."+shard+"."+table]; ok {
+		return ks
+	}
+	return keyspace
+}
+
 // FindTableOrVindex finds a table or a Vindex by name using Find and FindVindex.
 func (vschema *VSchema) FindTableOrVindex(keyspace, name string, tabletType topodatapb.TabletType) (*Table, Vindex, error) {
 	tables, err := vschema.FindRoutedTable(keyspace, name, tabletType)
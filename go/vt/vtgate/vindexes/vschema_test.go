@@ -812,6 +812,37 @@ func TestVSchemaRoutingRules(t *testing.T) {
 	assert.Equal(t, string(wantb), string(gotb), string(gotb))
 }
 
+func TestVSchemaShardRoutingRules(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		ShardRoutingRules: &vschemapb.ShardRoutingRules{
+			Rules: []*vschemapb.ShardRoutingRule{{
+				FromKeyspace: "ks1",
+				ToKeyspace:   "ks2",
+				Shard:        "-80",
+				Tables:       []string{"t1", "t2"},
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {Sharded: true},
+			"ks2": {Sharded: true},
+		},
+	}
+	got, err := BuildVSchema(&input)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"ks1.-80.t1": "ks2", "ks1.-80.t2": "ks2"}, got.ShardRoutingRules)
+	assert.Equal(t, "ks2", got.FindRoutedShard("ks1", "-80", "t1"))
+	assert.Equal(t, "ks2", got.FindRoutedShard("ks1", "-80", "t2"))
+	assert.Equal(t, "ks1", got.FindRoutedShard("ks1", "-80", "t3"))
+	assert.Equal(t, "ks1", got.FindRoutedShard("ks1", "80-", "t1"))
+	assert.Equal(t, "ks2", got.FindRoutedShard("ks2", "-80", "t1"))
+
+	// Without rules, every shard is served by its own keyspace.
+	got, err = BuildVSchema(&vschemapb.SrvVSchema{Keyspaces: input.Keyspaces})
+	require.NoError(t, err)
+	assert.Nil(t, got.ShardRoutingRules)
+	assert.Equal(t, "ks1", got.FindRoutedShard("ks1", "-80", "t1"))
+}
+
 func TestChooseVindexForType(t *testing.T) {
 	testcases := []struct {
 		in  querypb.Type
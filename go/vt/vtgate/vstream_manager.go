@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
 	"context"
 
@@ -29,11 +30,16 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 )
 
+// skewTolerance is the number of seconds by which a shard stream can
+// be ahead of the others before it's held back, if minimize_skew is set.
+var skewTolerance int64 = 1
+
 // vstreamManager manages vstream requests.
 type vstreamManager struct {
 	resolver *srvtopo.Resolver
@@ -63,6 +69,22 @@ type vstream struct {
 	filter     *binlogdatapb.Filter
 	resolver   *srvtopo.Resolver
 
+	// The options set through the VStreamFlags.
+	minimizeSkew      bool
+	heartbeatInterval time.Duration
+	stopOnReshard     bool
+
+	// lastSent is the time at which events were last sent to the client.
+	// It's protected by mu.
+	lastSent time.Time
+
+	// timestamps contains the timestamp of the last event of every
+	// shard stream, and skewCh is closed and replaced every time one
+	// of them changes. They are protected by mu, and are used only
+	// if minimizeSkew is set.
+	timestamps map[*binlogdatapb.ShardGtid]int64
+	skewCh     chan struct{}
+
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 }
@@ -81,18 +103,24 @@ func newVStreamManager(resolver *srvtopo.Resolver, serv srvtopo.Server, cell str
 	}
 }
 
-func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, send func(events []*binlogdatapb.VEvent) error) error {
+func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid, filter *binlogdatapb.Filter, flags *vtgatepb.VStreamFlags, send func(events []*binlogdatapb.VEvent) error) error {
 	vgtid, filter, err := vsm.resolveParams(ctx, tabletType, vgtid, filter)
 	if err != nil {
 		return err
 	}
 	vs := &vstream{
-		vgtid:      vgtid,
-		tabletType: tabletType,
-		filter:     filter,
-		send:       send,
-		resolver:   vsm.resolver,
-		journaler:  make(map[int64]*journalEvent),
+		vgtid:             vgtid,
+		tabletType:        tabletType,
+		filter:            filter,
+		send:              send,
+		resolver:          vsm.resolver,
+		journaler:         make(map[int64]*journalEvent),
+		minimizeSkew:      flags.GetMinimizeSkew(),
+		heartbeatInterval: time.Duration(flags.GetHeartbeatInterval()) * time.Second,
+		stopOnReshard:     flags.GetStopOnReshard(),
+		lastSent:          time.Now(),
+		timestamps:        make(map[*binlogdatapb.ShardGtid]int64),
+		skewCh:            make(chan struct{}),
 	}
 	return vs.stream(ctx)
 }
@@ -146,6 +174,9 @@ func (vsm *vstreamManager) resolveParams(ctx context.Context, tabletType topodat
 					Keyspace: sgtid.Keyspace,
 					Shard:    shard.Name,
 					Gtid:     sgtid.Gtid,
+					// Every shard gets its own list, because it's
+					// updated as the tables get copied.
+					TablePKs: append([]*binlogdatapb.TableLastPK(nil), sgtid.TablePKs...),
 				})
 			}
 		} else {
@@ -163,6 +194,12 @@ func (vs *vstream) stream(ctx context.Context) error {
 
 	// Make a copy first, because the ShardGtids list can change once streaming starts.
 	copylist := append(([]*binlogdatapb.ShardGtid)(nil), vs.vgtid.ShardGtids...)
+	if vs.minimizeSkew {
+		// No stream can go ahead until all of them have sent an event.
+		for _, sgtid := range copylist {
+			vs.timestamps[sgtid] = 0
+		}
+	}
 	for _, sgtid := range copylist {
 		vs.startOneStream(ctx, sgtid)
 	}
@@ -233,6 +270,9 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 
 			sendevents := make([]*binlogdatapb.VEvent, 0, len(events))
 			for _, event := range events {
+				if err := vs.alignStreams(ctx, sgtid, event.Timestamp); err != nil {
+					return err
+				}
 				switch event.Type {
 				case binlogdatapb.VEventType_FIELD:
 					// Update table names and send.
@@ -240,13 +280,19 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 					// duplicate table names.
 					ev := proto.Clone(event).(*binlogdatapb.VEvent)
 					ev.FieldEvent.TableName = sgtid.Keyspace + "." + ev.FieldEvent.TableName
+					ev.Keyspace, ev.Shard = sgtid.Keyspace, sgtid.Shard
 					sendevents = append(sendevents, ev)
 				case binlogdatapb.VEventType_ROW:
 					// Update table names and send.
 					ev := proto.Clone(event).(*binlogdatapb.VEvent)
 					ev.RowEvent.TableName = sgtid.Keyspace + "." + ev.RowEvent.TableName
+					ev.Keyspace, ev.Shard = sgtid.Keyspace, sgtid.Shard
 					sendevents = append(sendevents, ev)
 				case binlogdatapb.VEventType_COMMIT, binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER:
+					if event.Type == binlogdatapb.VEventType_DDL {
+						event = proto.Clone(event).(*binlogdatapb.VEvent)
+						event.Keyspace, event.Shard = sgtid.Keyspace, sgtid.Shard
+					}
 					sendevents = append(sendevents, event)
 					eventss = append(eventss, sendevents)
 					if err := vs.sendAll(sgtid, eventss); err != nil {
@@ -255,12 +301,25 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 					eventss = nil
 					sendevents = nil
 				case binlogdatapb.VEventType_HEARTBEAT:
-					// Remove all heartbeat events for now.
-					// Otherwise they can accumulate indefinitely if there are no real events.
-					// TODO(sougou): figure out a model for this.
+					// Heartbeats are sent only if the client asked for them,
+					// and at most once per interval. Otherwise they can
+					// accumulate indefinitely if there are no real events.
+					if err := vs.sendHeartbeat(event); err != nil {
+						return err
+					}
 				case binlogdatapb.VEventType_JOURNAL:
 					journal := event.Journal
-					// Journal events are not sent to clients.
+					if vs.stopOnReshard && journal.MigrationType == binlogdatapb.MigrationType_SHARDS {
+						// Send the journal, which contains the positions of the
+						// new shards, so that the client can restart from there.
+						sendevents = append(sendevents, event)
+						eventss = append(eventss, sendevents)
+						if err := vs.sendAll(sgtid, eventss); err != nil {
+							return err
+						}
+						return vterrors.Errorf(vtrpcpb.Code_ABORTED, "vstream stopped: %s/%s is being resharded", sgtid.Keyspace, sgtid.Shard)
+					}
+					// Journal events are not sent to clients otherwise.
 					je, err := vs.getJournalEvent(ctx, sgtid, journal)
 					if err != nil {
 						return err
@@ -355,10 +414,67 @@ func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdat
 		if err := vs.send(events); err != nil {
 			return err
 		}
+		vs.lastSent = time.Now()
 	}
 	return nil
 }
 
+// sendHeartbeat sends the heartbeat to the client if heartbeats were
+// requested and no events were sent during the last interval.
+func (vs *vstream) sendHeartbeat(event *binlogdatapb.VEvent) error {
+	if vs.heartbeatInterval == 0 {
+		return nil
+	}
+	vs.mu.Lock()
+	defer vs.mu.Unlock()
+
+	if time.Since(vs.lastSent) < vs.heartbeatInterval {
+		return nil
+	}
+	if err := vs.send([]*binlogdatapb.VEvent{event}); err != nil {
+		return err
+	}
+	vs.lastSent = time.Now()
+	return nil
+}
+
+// alignStreams records the timestamp of the latest event of the shard
+// stream, and holds the stream back while it's ahead of the slowest
+// stream by more than skewTolerance. It does nothing unless minimizeSkew
+// is set. Idle streams keep moving forward because vttablet sends them
+// heartbeats.
+func (vs *vstream) alignStreams(ctx context.Context, sgtid *binlogdatapb.ShardGtid, timestamp int64) error {
+	if !vs.minimizeSkew || timestamp == 0 {
+		return nil
+	}
+	vs.mu.Lock()
+	if vs.timestamps[sgtid] != timestamp {
+		vs.timestamps[sgtid] = timestamp
+		close(vs.skewCh)
+		vs.skewCh = make(chan struct{})
+	}
+	for {
+		min := timestamp
+		for _, ts := range vs.timestamps {
+			if ts < min {
+				min = ts
+			}
+		}
+		if timestamp-min <= skewTolerance {
+			vs.mu.Unlock()
+			return nil
+		}
+		skewCh := vs.skewCh
+		vs.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-skewCh:
+		}
+		vs.mu.Lock()
+	}
+}
+
 // getJournalEvent returns a journalEvent. The caller has to wait on its done channel.
 // Once it closes, the caller has to return (end their stream).
 // The function has three parts:
@@ -442,6 +558,12 @@ func (vs *vstream) getJournalEvent(ctx context.Context, sgtid *binlogdatapb.Shar
 	log.Infof("Removing shard gtids: %v", je.participants)
 	for _, cursgtid := range vs.vgtid.ShardGtids {
 		if je.participants[cursgtid] {
+			// The stream is ending: it must not hold back the others.
+			if _, ok := vs.timestamps[cursgtid]; ok {
+				delete(vs.timestamps, cursgtid)
+				close(vs.skewCh)
+				vs.skewCh = make(chan struct{})
+			}
 			continue
 		}
 		newsgtids = append(newsgtids, cursgtid)
@@ -450,6 +572,9 @@ func (vs *vstream) getJournalEvent(ctx context.Context, sgtid *binlogdatapb.Shar
 	log.Infof("Adding shard gtids: %v", je.journal.ShardGtids)
 	for _, sgtid := range je.journal.ShardGtids {
 		newsgtids = append(newsgtids, sgtid)
+		if vs.minimizeSkew {
+			vs.timestamps[sgtid] = 0
+		}
 		// It's ok to start the streams eventhough ShardGtids is not updated yet.
 		// This is because we're still holding the lock.
 		vs.startOneStream(ctx, sgtid)
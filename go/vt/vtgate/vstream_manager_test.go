@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"context"
 
@@ -31,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/proto/binlogdata"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -60,8 +62,8 @@ func TestVStreamEvents(t *testing.T) {
 				Gtid:     "gtid01",
 			}},
 		}},
-		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.f0"}},
-		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0"}},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.f0"}, Keyspace: "TestVStream", Shard: "-20"},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0"}, Keyspace: "TestVStream", Shard: "-20"},
 		{Type: binlogdatapb.VEventType_COMMIT},
 	}}
 	sbc0.AddVStreamEvents(send1, nil)
@@ -78,7 +80,7 @@ func TestVStreamEvents(t *testing.T) {
 				Gtid:     "gtid02",
 			}},
 		}},
-		{Type: binlogdatapb.VEventType_DDL},
+		{Type: binlogdatapb.VEventType_DDL, Keyspace: "TestVStream", Shard: "-20"},
 	}}
 	sbc0.AddVStreamEvents(send2, nil)
 
@@ -91,7 +93,7 @@ func TestVStreamEvents(t *testing.T) {
 	}
 	ch := make(chan *binlogdatapb.VStreamResponse)
 	go func() {
-		err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+		err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 			ch <- &binlogdatapb.VStreamResponse{Events: events}
 			return nil
 		})
@@ -142,7 +144,7 @@ func TestVStreamChunks(t *testing.T) {
 			Gtid:     "pos",
 		}},
 	}
-	_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 		switch events[0].Type {
 		case binlogdatapb.VEventType_ROW:
 			if doneCounting {
@@ -267,7 +269,7 @@ func TestVStreamRetry(t *testing.T) {
 			Gtid:     "pos",
 		}},
 	}
-	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 		count++
 		return nil
 	})
@@ -309,8 +311,8 @@ func TestVStreamHeartbeat(t *testing.T) {
 				Gtid:     "gtid01",
 			}},
 		}},
-		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.f0"}},
-		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0"}},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.f0"}, Keyspace: "TestVStream", Shard: "-20"},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0"}, Keyspace: "TestVStream", Shard: "-20"},
 		{Type: binlogdatapb.VEventType_COMMIT},
 	}}
 	sbc0.AddVStreamEvents(send1, nil)
@@ -326,6 +328,178 @@ func TestVStreamHeartbeat(t *testing.T) {
 	verifyEvents(t, ch, want)
 }
 
+func TestVStreamHeartbeatInterval(t *testing.T) {
+	var sent [][]*binlogdatapb.VEvent
+	vs := &vstream{
+		send: func(events []*binlogdatapb.VEvent) error {
+			sent = append(sent, events)
+			return nil
+		},
+	}
+	heartbeat := &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_HEARTBEAT, Timestamp: 1}
+
+	// Heartbeats are not sent unless requested.
+	vs.lastSent = time.Now().Add(-time.Hour)
+	require.NoError(t, vs.sendHeartbeat(heartbeat))
+	assert.Empty(t, sent)
+
+	vs.heartbeatInterval = time.Minute
+	require.NoError(t, vs.sendHeartbeat(heartbeat))
+	assert.Equal(t, [][]*binlogdatapb.VEvent{{heartbeat}}, sent)
+
+	// The next one is due only after the interval.
+	require.NoError(t, vs.sendHeartbeat(heartbeat))
+	assert.Len(t, sent, 1)
+
+	// Other events postpone the heartbeats.
+	vs.lastSent = time.Now().Add(-2 * time.Minute)
+	require.NoError(t, vs.sendAll(&binlogdatapb.ShardGtid{}, [][]*binlogdatapb.VEvent{{{Type: binlogdatapb.VEventType_COMMIT}}}))
+	require.NoError(t, vs.sendHeartbeat(heartbeat))
+	assert.Len(t, sent, 2)
+}
+
+func TestVStreamMinimizeSkew(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1.1.1.1", 1002, name, "20-40", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	// The second shard is ahead, and must wait for the first one.
+	sbc1.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid11", Timestamp: 20},
+		{Type: binlogdatapb.VEventType_COMMIT, Timestamp: 20},
+	}, nil)
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01", Timestamp: 10},
+		{Type: binlogdatapb.VEventType_COMMIT, Timestamp: 10},
+	}, nil)
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_HEARTBEAT, Timestamp: 20},
+	}, nil)
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}, {
+			Keyspace: name,
+			Shard:    "20-40",
+			Gtid:     "pos",
+		}},
+	}
+	flags := &vtgatepb.VStreamFlags{MinimizeSkew: true}
+	ch := make(chan *binlogdatapb.VStreamResponse)
+	go func() {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, flags, func(events []*binlogdatapb.VEvent) error {
+			ch <- &binlogdatapb.VStreamResponse{Events: events}
+			return nil
+		})
+	}()
+	want := &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-20",
+				Gtid:     "gtid01",
+			}, {
+				Keyspace: name,
+				Shard:    "20-40",
+				Gtid:     "pos",
+			}},
+		}},
+		{Type: binlogdatapb.VEventType_COMMIT, Timestamp: 10},
+	}}
+	verifyEvents(t, ch, want)
+	want = &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-20",
+				Gtid:     "gtid01",
+			}, {
+				Keyspace: name,
+				Shard:    "20-40",
+				Gtid:     "gtid11",
+			}},
+		}},
+		{Type: binlogdatapb.VEventType_COMMIT, Timestamp: 20},
+	}}
+	verifyEvents(t, ch, want)
+}
+
+func TestVStreamStopOnReshard(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	journal := &binlogdatapb.Journal{
+		Id:            1,
+		MigrationType: binlogdatapb.MigrationType_SHARDS,
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-10",
+			Gtid:     "pos10",
+		}, {
+			Keyspace: name,
+			Shard:    "10-20",
+			Gtid:     "pos1020",
+		}},
+		Participants: []*binlogdatapb.KeyspaceShard{{
+			Keyspace: name,
+			Shard:    "-20",
+		}},
+	}
+	sbc0.AddVStreamEvents([]*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_JOURNAL, Journal: journal},
+	}, nil)
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	flags := &vtgatepb.VStreamFlags{StopOnReshard: true}
+	var got []*binlogdatapb.VEvent
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, flags, func(events []*binlogdatapb.VEvent) error {
+		got = append(got, events...)
+		return nil
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "vstream stopped: TestVStream/-20 is being resharded")
+	assert.Equal(t, vtrpcpb.Code_ABORTED, vterrors.Code(err))
+	want := []*binlogdatapb.VEvent{{
+		Type: binlogdatapb.VEventType_VGTID,
+		Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-20",
+				Gtid:     "gtid01",
+			}},
+		},
+	}, {
+		Type:    binlogdatapb.VEventType_JOURNAL,
+		Journal: journal,
+	}}
+	require.Len(t, got, len(want))
+	for i := range want {
+		assert.True(t, proto.Equal(want[i], got[i]), "event %d: %v, want %v", i, got[i], want[i])
+	}
+}
+
 func TestVStreamJournalOneToMany(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -352,8 +526,8 @@ func TestVStreamJournalOneToMany(t *testing.T) {
 				Gtid:     "gtid01",
 			}},
 		}},
-		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.f0"}},
-		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0"}},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.f0"}, Keyspace: "TestVStream", Shard: "-20"},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0"}, Keyspace: "TestVStream", Shard: "-20"},
 		{Type: binlogdatapb.VEventType_COMMIT},
 	}}
 	sbc0.AddVStreamEvents(send1, nil)
@@ -497,8 +671,8 @@ func TestVStreamJournalManyToOne(t *testing.T) {
 				Gtid:     "gtid01",
 			}},
 		}},
-		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.f0"}},
-		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0"}},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.f0"}, Keyspace: "TestVStream", Shard: "-20"},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0"}, Keyspace: "TestVStream", Shard: "-20"},
 		{Type: binlogdatapb.VEventType_COMMIT},
 	}}
 	sbc0.ExpectVStreamStartPos("pos20")
@@ -564,8 +738,8 @@ func TestVStreamJournalNoMatch(t *testing.T) {
 				Gtid:     "gtid01",
 			}},
 		}},
-		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.f0"}},
-		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0"}},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.f0"}, Keyspace: "TestVStream", Shard: "-20"},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0"}, Keyspace: "TestVStream", Shard: "-20"},
 		{Type: binlogdatapb.VEventType_COMMIT},
 	}}
 	sbc0.AddVStreamEvents(send1, nil)
@@ -602,7 +776,7 @@ func TestVStreamJournalNoMatch(t *testing.T) {
 				Gtid:     "gtid02",
 			}},
 		}},
-		{Type: binlogdatapb.VEventType_DDL},
+		{Type: binlogdatapb.VEventType_DDL, Keyspace: "TestVStream", Shard: "-20"},
 	}}
 	sbc0.AddVStreamEvents(send2, nil)
 
@@ -650,7 +824,7 @@ func TestVStreamJournalNoMatch(t *testing.T) {
 				Gtid:     "gtid03",
 			}},
 		}},
-		{Type: binlogdatapb.VEventType_DDL},
+		{Type: binlogdatapb.VEventType_DDL, Keyspace: "TestVStream", Shard: "-20"},
 	}}
 	sbc0.AddVStreamEvents(send3, nil)
 
@@ -708,7 +882,7 @@ func TestVStreamJournalPartialMatch(t *testing.T) {
 			Gtid:     "pos1020",
 		}},
 	}
-	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 		t.Errorf("unexpected events: %v", events)
 		return nil
 	})
@@ -737,7 +911,7 @@ func TestVStreamJournalPartialMatch(t *testing.T) {
 		}},
 	}
 	sbc2.AddVStreamEvents(send, nil)
-	err = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+	err = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 		t.Errorf("unexpected events: %v", events)
 		return nil
 	})
@@ -872,6 +1046,23 @@ func TestResolveVStreamParams(t *testing.T) {
 	if got, want := len(vgtid.ShardGtids), 8; want >= got {
 		t.Errorf("len(vgtid.ShardGtids): %v, must be >%d", got, want)
 	}
+
+	// Every shard gets its own copy of the tables to copy.
+	input = &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: "TestVStream",
+			Gtid:     "current",
+			TablePKs: []*binlogdatapb.TableLastPK{{TableName: "t1"}},
+		}},
+	}
+	vgtid, _, err = vsm.resolveParams(context.Background(), topodatapb.TabletType_REPLICA, input, nil)
+	require.NoError(t, err, input)
+	require.Len(t, vgtid.ShardGtids, 8)
+	for _, sgtid := range vgtid.ShardGtids {
+		assert.Equal(t, input.ShardGtids[0].TablePKs, sgtid.TablePKs)
+	}
+	vgtid.ShardGtids[0].TablePKs[0] = nil
+	assert.NotNil(t, vgtid.ShardGtids[1].TablePKs[0])
 }
 
 func newTestVStreamManager(hc discovery.HealthCheck, serv srvtopo.Server, cell string) *vstreamManager {
@@ -883,7 +1074,7 @@ func newTestVStreamManager(hc discovery.HealthCheck, serv srvtopo.Server, cell s
 func startVStream(ctx context.Context, t *testing.T, vsm *vstreamManager, vgtid *binlogdatapb.VGtid) <-chan *binlogdatapb.VStreamResponse {
 	ch := make(chan *binlogdatapb.VStreamResponse)
 	go func() {
-		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, func(events []*binlogdatapb.VEvent) error {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, nil, func(events []*binlogdatapb.VEvent) error {
 			ch <- &binlogdatapb.VStreamResponse{Events: events}
 			return nil
 		})
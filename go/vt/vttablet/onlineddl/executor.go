@@ -40,6 +40,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/textutil"
 	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
 	"vitess.io/vitess/go/vt/dbconnpool"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/schema"
@@ -50,12 +51,15 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/google/shlex"
 )
 
@@ -101,6 +105,7 @@ var ghostOverridePath = flag.String("gh-ost-path", "", "override default gh-ost
 var ptOSCOverridePath = flag.String("pt-osc-path", "", "override default pt-online-schema-change binary full path")
 var migrationCheckInterval = flag.Duration("migration_check_interval", 1*time.Minute, "Interval between migration checks")
 var migrationNextCheckInterval = 5 * time.Second
+var vreplicationCutOverThreshold = 5 * time.Second
 
 const (
 	maxPasswordLength             = 32 // MySQL's *replication* password may not exceed 32 characters
@@ -402,6 +407,296 @@ func (e *Executor) executeDirectly(ctx context.Context, onlineDDL *schema.Online
 	return nil
 }
 
+// vreplicationExec runs a vreplication command on this tablet's VReplication engine. We're on TabletServer,
+// and while VREngine lives in the same process, it is simpler to get hold of it via the tablet manager client,
+// just like wrangler does.
+func (e *Executor) vreplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
+	tmClient := tmclient.NewTabletManagerClient()
+	defer tmClient.Close()
+
+	return tmClient.VReplicationExec(ctx, tablet, query)
+}
+
+// readVReplStream reads the _vt.vreplication entry for given workflow (migration UUID)
+func (e *Executor) readVReplStream(ctx context.Context, uuid string, okIfMissing bool) (*VReplStream, error) {
+	query, err := sqlparser.ParseAndBind(sqlReadVReplStream,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return nil, err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(r.Rows) == 0 && okIfMissing {
+		return nil, nil
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "Cannot find unique workflow for UUID: %+v", uuid)
+	}
+	s := &VReplStream{
+		id:                   row.AsInt64("id", 0),
+		workflow:             row.AsString("workflow", ""),
+		source:               row.AsString("source", ""),
+		pos:                  row.AsString("pos", ""),
+		timeUpdated:          row.AsInt64("time_updated", 0),
+		transactionTimestamp: row.AsInt64("transaction_timestamp", 0),
+		state:                row.AsString("state", ""),
+		message:              row.AsString("message", ""),
+		bls:                  &binlogdatapb.BinlogSource{},
+	}
+	if err := proto.UnmarshalText(s.source, s.bls); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// isVReplMigrationReadyToCutOver sees if the vreplication migration has completed the copy phase
+// and is caught up with the source table, so that a cut-over is only expected to take a brief moment.
+func (e *Executor) isVReplMigrationReadyToCutOver(ctx context.Context, s *VReplStream) (isReady bool, err error) {
+	if s.state != binlogplayer.BlpRunning {
+		return false, nil
+	}
+	// vreplication updates time_updated even when idle, so it tells us the stream is alive:
+	now := time.Now()
+	if now.Sub(time.Unix(s.timeUpdated, 0)) > vreplicationCutOverThreshold {
+		return false, nil
+	}
+	// transaction_timestamp is the timestamp of the last applied event; it lags behind time_updated
+	// when vreplication is busy catching up:
+	if s.transactionTimestamp > 0 && time.Unix(s.timeUpdated, 0).Sub(time.Unix(s.transactionTimestamp, 0)) > vreplicationCutOverThreshold {
+		return false, nil
+	}
+	// The copy phase is complete once there are no _vt.copy_state entries for the stream
+	query, err := sqlparser.ParseAndBind(sqlReadCountCopyState, sqltypes.Int64BindVariable(s.id))
+	if err != nil {
+		return false, err
+	}
+	r, err := e.execQuery(ctx, query)
+	if err != nil {
+		return false, err
+	}
+	row := r.Named().Row()
+	if row == nil {
+		return false, nil
+	}
+	countCopyState, err := row.ToInt64("cnt")
+	if err != nil {
+		return false, err
+	}
+	return countCopyState == 0, nil
+}
+
+// cutOverVReplMigration stops writes on the migrated table, waits for vreplication to apply all
+// pending events, and then swaps the migrated table with the vreplication table.
+func (e *Executor) cutOverVReplMigration(ctx context.Context, s *VReplStream) (err error) {
+	tmClient := tmclient.NewTabletManagerClient()
+	defer tmClient.Close()
+
+	tablet, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return err
+	}
+	onlineDDL, err := e.readMigration(ctx, s.workflow)
+	if err != nil {
+		return err
+	}
+	if len(s.bls.Filter.GetRules()) != 1 {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected filter in vreplication stream for migration %s: %v", s.workflow, s.bls.Filter)
+	}
+	vreplTable := s.bls.Filter.Rules[0].Match
+	swapTable := fmt.Sprintf("_swap_%s", onlineDDL.GetGCUUID())
+
+	// We lock the keyspace so that no other topo-based traffic changes (e.g. a MoveTables SwitchWrites)
+	// interleave with the cut-over.
+	lctx, unlockKeyspace, err := e.ts.LockKeyspace(ctx, e.keyspace, "OnlineDDLCutOver")
+	if err != nil {
+		return err
+	}
+	defer unlockKeyspace(&err)
+	ctx = lctx
+
+	toggleWrites := func(allowWrites bool) error {
+		if _, err := e.ts.UpdateShardFields(ctx, e.keyspace, e.shard, func(si *topo.ShardInfo) error {
+			return si.UpdateSourceBlacklistedTables(ctx, topodatapb.TabletType_MASTER, nil, allowWrites, []string{onlineDDL.Table})
+		}); err != nil {
+			return err
+		}
+		return tmClient.RefreshState(ctx, tablet.Tablet)
+	}
+	// stop writes on source table:
+	if err := toggleWrites(false); err != nil {
+		return err
+	}
+	defer toggleWrites(true)
+
+	// Writes are now disabled on the table. Any write from here on is vreplication's own.
+	postWritesPos, err := tmClient.MasterPosition(ctx, tablet.Tablet)
+	if err != nil {
+		return err
+	}
+	waitCtx, cancel := context.WithTimeout(ctx, 2*vreplicationCutOverThreshold)
+	defer cancel()
+	if err := tmClient.VReplicationWaitForPos(waitCtx, tablet.Tablet, int(s.id), postWritesPos); err != nil {
+		return err
+	}
+	// vreplication table is now in sync with source table
+	stopQuery, err := sqlparser.ParseAndBind(sqlStopVReplStream,
+		sqltypes.StringBindVariable("stopped for online DDL cut-over"),
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(s.workflow),
+	)
+	if err != nil {
+		return err
+	}
+	if _, err := e.vreplicationExec(ctx, tablet.Tablet, stopQuery); err != nil {
+		return err
+	}
+
+	// rename tables atomically (remember, writes on source table are stopped)
+	parsed := sqlparser.BuildParsedQuery(sqlSwapTables,
+		onlineDDL.Table, swapTable,
+		vreplTable, onlineDDL.Table,
+		swapTable, vreplTable,
+	)
+	if _, err := e.execQuery(ctx, parsed.Query); err != nil {
+		// The tables were not swapped, and writes are about to be resumed. The stream must
+		// follow the migrated table again, so that the cut-over can be retried later on.
+		// If it can't be restarted, the migration fails.
+		if startErr := e.startVReplStream(ctx, tablet.Tablet, s.workflow); startErr != nil {
+			log.Errorf("cannot restart vreplication stream of migration %s after failed cut-over: %v", s.workflow, startErr)
+			_ = e.terminateVReplMigration(ctx, s.workflow)
+			atomic.StoreInt64(&e.migrationRunning, 0)
+			failedMigrations.Add(1)
+			_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed, false, progressPctStarted)
+		}
+		return err
+	}
+	// Tables are swapped! The original table now goes by the vreplication table's name, and
+	// is listed in the migration's artifacts.
+	go func() {
+		// ReloadSchema does not respect the context deadline, hence we run it asynchronously
+		_ = tmClient.ReloadSchema(context.Background(), tablet.Tablet, "")
+	}()
+
+	atomic.StoreInt64(&e.migrationRunning, 0)
+	successfulMigrations.Add(1)
+	_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusComplete, false, progressPctFull)
+	return nil
+}
+
+// startVReplStream sets the vreplication stream of given migration back to running
+func (e *Executor) startVReplStream(ctx context.Context, tablet *topodatapb.Tablet, uuid string) error {
+	query, err := sqlparser.ParseAndBind(sqlStartVReplStream,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.vreplicationExec(ctx, tablet, query)
+	return err
+}
+
+// terminateVReplMigration stops and deletes the vreplication stream of given migration
+func (e *Executor) terminateVReplMigration(ctx context.Context, uuid string) error {
+	tablet, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return err
+	}
+	query, err := sqlparser.ParseAndBind(sqlDeleteVReplStream,
+		sqltypes.StringBindVariable(e.dbName),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.vreplicationExec(ctx, tablet.Tablet, query)
+	return err
+}
+
+// ExecuteWithVReplication sets up the grounds for a vreplication schema migration: it creates the
+// vreplication table, applies the ALTER statement onto it, and creates and starts a vreplication
+// stream that copies and follows the migrated table into the vreplication table. Cut-over takes place
+// asynchronously, by reviewRunningMigrations, once the stream is caught up.
+func (e *Executor) ExecuteWithVReplication(ctx context.Context, onlineDDL *schema.OnlineDDL) (err error) {
+	e.migrationMutex.Lock()
+	defer e.migrationMutex.Unlock()
+
+	if atomic.LoadInt64(&e.migrationRunning) > 0 {
+		return ErrExecutorMigrationAlreadyRunning
+	}
+
+	if e.tabletTypeFunc() != topodatapb.TabletType_MASTER {
+		return ErrExecutorNotWritableTablet
+	}
+
+	conn, err := dbconnpool.NewDBConnection(ctx, e.env.Config().DB.DbaWithDB())
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	atomic.StoreInt64(&e.migrationRunning, 1)
+	e.lastMigrationUUID = onlineDDL.UUID
+	defer func() {
+		if err != nil {
+			atomic.StoreInt64(&e.migrationRunning, 0)
+		}
+	}()
+
+	if err := e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusRunning, false, progressPctStarted); err != nil {
+		return err
+	}
+	vreplTableName := fmt.Sprintf("_%s_%s_vrepl", onlineDDL.UUID, ReadableTimestamp())
+	if err := e.updateArtifacts(ctx, onlineDDL.UUID, vreplTableName); err != nil {
+		return err
+	}
+	{
+		parsed := sqlparser.BuildParsedQuery(sqlCreateTableLike, vreplTableName, onlineDDL.Table)
+		if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+			return err
+		}
+	}
+	{
+		// Temporary hack, as with gh-ost and pt-osc: sqlparser does not do full blown ALTER TABLE parsing,
+		// so we resort to regexp-based parsing of the query.
+		_, _, alterOptions := schema.ParseAlterTableOptions(onlineDDL.SQL)
+		parsed := sqlparser.BuildParsedQuery(sqlAlterTableOptions, vreplTableName, alterOptions)
+		if _, err := conn.ExecuteFetch(parsed.Query, 0, false); err != nil {
+			return err
+		}
+	}
+	v := NewVRepl(onlineDDL.UUID, e.keyspace, e.shard, e.dbName, onlineDDL.Table, vreplTableName)
+	if err := v.analyze(ctx, conn); err != nil {
+		return err
+	}
+
+	tablet, err := e.ts.GetTablet(ctx, e.tabletAlias)
+	if err != nil {
+		return err
+	}
+	insertQuery, err := v.generateInsertStatement(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := e.vreplicationExec(ctx, tablet.Tablet, insertQuery); err != nil {
+		return err
+	}
+	startQuery, err := v.generateStartStatement(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := e.vreplicationExec(ctx, tablet.Tablet, startQuery); err != nil {
+		return err
+	}
+	startedMigrations.Add(1)
+	return nil
+}
+
 // ExecuteWithGhost validates and runs a gh-ost process.
 // Validation included testing the backend MySQL server and the gh-ost binary itself
 // Execution runs first a dry run, then an actual migration
@@ -870,6 +1165,24 @@ func (e *Executor) terminateMigration(ctx context.Context, onlineDDL *schema.Onl
 		}
 	}
 	switch onlineDDL.Strategy {
+	case schema.DDLStrategyOnline:
+		if onlineDDL.Status != schema.OnlineDDLStatusRunning {
+			// A queued or ready migration has no stream yet, and a completed migration
+			// keeps its stream around: there is nothing to terminate.
+			break
+		}
+		// The migration may have been started by a different tablet, so we don't rely on lastMigrationUUID:
+		// an existing vreplication stream means the migration is running.
+		if s, _ := e.readVReplStream(ctx, onlineDDL.UUID, true); s != nil {
+			foundRunning = true
+		}
+		if err := e.terminateVReplMigration(ctx, onlineDDL.UUID); err != nil {
+			return foundRunning, fmt.Errorf("Error terminating migration, vreplication exec error: %+v", err)
+		}
+		if foundRunning && onlineDDL.UUID == lastMigrationUUID {
+			atomic.StoreInt64(&e.migrationRunning, 0)
+		}
+		_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusFailed, false, progressPctStarted)
 	case schema.DDLStrategyPTOSC:
 		// see if pt-osc is running (could have been executed by this vttablet or one that crashed in the past)
 		if running, pid, _ := e.isPTOSCMigrationRunning(ctx, onlineDDL.UUID); running {
@@ -1065,6 +1378,12 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 		}()
 	case sqlparser.AlterDDLAction:
 		switch onlineDDL.Strategy {
+		case schema.DDLStrategyOnline:
+			go func() {
+				if err := e.ExecuteWithVReplication(ctx, onlineDDL); err != nil {
+					failMigration(err)
+				}
+			}()
 		case schema.DDLStrategyGhost:
 			go func() {
 				if err := e.ExecuteWithGhost(ctx, onlineDDL); err != nil {
@@ -1193,33 +1512,64 @@ func (e *Executor) reviewRunningMigrations(ctx context.Context) (countRunnning i
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
 
-	parsed := sqlparser.BuildParsedQuery(sqlSelectRunningMigrations, "_vt", ":strategy")
-	bindVars := map[string]*querypb.BindVariable{
-		"strategy": sqltypes.StringBindVariable(string(schema.DDLStrategyPTOSC)),
-	}
-	bound, err := parsed.GenerateQuery(bindVars, nil)
-	if err != nil {
-		return countRunnning, runningNotByThisProcess, err
-	}
-	r, err := e.execQuery(ctx, bound)
+	parsed := sqlparser.BuildParsedQuery(sqlSelectRunningMigrations, "_vt")
+	r, err := e.execQuery(ctx, parsed.Query)
 	if err != nil {
 		return countRunnning, runningNotByThisProcess, err
 	}
 	for _, row := range r.Named().Rows {
 		uuid := row["migration_uuid"].ToString()
-		// Since pt-osc doesn't have a "liveness" plugin entry point, we do it externally:
-		// if the process is alive, we update the `liveness_timestamp` for this migration.
-		if running, _, _ := e.isPTOSCMigrationRunning(ctx, uuid); running {
-			_ = e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuid)
-		}
-		countRunnning++
-
-		if uuid != e.lastMigrationUUID {
-			// This executor can only run one migration at a time. And that
-			// migration is identified by e.lastMigrationUUID.
-			// If we find a _running_ migration that does not have this UUID, it _must_
-			// mean the migration was started by a former vttablet (ie vttablet crashed and restarted)
-			runningNotByThisProcess = append(runningNotByThisProcess, uuid)
+		strategy := schema.DDLStrategy(row["strategy"].ToString())
+		switch strategy {
+		case schema.DDLStrategyOnline:
+			// A vreplication stream survives a vttablet restart, so whatever its origin, this executor
+			// adopts the migration, provided its stream is still running.
+			s, err := e.readVReplStream(ctx, uuid, true)
+			if err != nil {
+				return countRunnning, runningNotByThisProcess, err
+			}
+			if s == nil {
+				continue
+			}
+			switch s.state {
+			case binlogplayer.BlpError:
+				_ = e.updateMigrationStatus(ctx, uuid, schema.OnlineDDLStatusFailed)
+				_ = e.updateMigrationTimestamp(ctx, "completed_timestamp", uuid)
+				atomic.StoreInt64(&e.migrationRunning, 0)
+				failedMigrations.Add(1)
+				log.Errorf("vreplication migration %s failed: %s", uuid, s.message)
+				continue
+			case binlogplayer.VReplicationInit, binlogplayer.VReplicationCopying, binlogplayer.BlpRunning:
+				_ = e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuid)
+				atomic.StoreInt64(&e.migrationRunning, 1)
+				e.lastMigrationUUID = uuid
+				countRunnning++
+
+				isReady, err := e.isVReplMigrationReadyToCutOver(ctx, s)
+				if err != nil {
+					return countRunnning, runningNotByThisProcess, err
+				}
+				if isReady {
+					if err := e.cutOverVReplMigration(ctx, s); err != nil {
+						return countRunnning, runningNotByThisProcess, err
+					}
+				}
+			}
+		case schema.DDLStrategyPTOSC:
+			// Since pt-osc doesn't have a "liveness" plugin entry point, we do it externally:
+			// if the process is alive, we update the `liveness_timestamp` for this migration.
+			if running, _, _ := e.isPTOSCMigrationRunning(ctx, uuid); running {
+				_ = e.updateMigrationTimestamp(ctx, "liveness_timestamp", uuid)
+			}
+			countRunnning++
+
+			if uuid != e.lastMigrationUUID {
+				// This executor can only run one migration at a time. And that
+				// migration is identified by e.lastMigrationUUID.
+				// If we find a _running_ migration that does not have this UUID, it _must_
+				// mean the migration was started by a former vttablet (ie vttablet crashed and restarted)
+				runningNotByThisProcess = append(runningNotByThisProcess, uuid)
+			}
 		}
 	}
 	return countRunnning, runningNotByThisProcess, err
@@ -1313,8 +1663,16 @@ func (e *Executor) gcArtifacts(ctx context.Context) error {
 	}
 	for _, row := range r.Named().Rows {
 		uuid := row["migration_uuid"].ToString()
+		strategy := schema.DDLStrategy(row["strategy"].ToString())
 		artifacts := row["artifacts"].ToString()
 
+		if strategy == schema.DDLStrategyOnline {
+			// The vreplication stream has served its purpose
+			if err := e.terminateVReplMigration(ctx, uuid); err != nil {
+				return err
+			}
+		}
+
 		artifactTables := textutil.SplitDelimitedList(artifacts)
 		for _, artifactTable := range artifactTables {
 			if err := e.gcArtifactTable(ctx, artifactTable, uuid); err != nil {
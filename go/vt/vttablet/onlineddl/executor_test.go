@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestCancelMigration(t *testing.T) {
+	ctx := context.Background()
+	db := fakesqldb.New(t)
+	defer db.Close()
+
+	config := tabletenv.NewDefaultConfig()
+	params, _ := db.ConnParams().MysqlParams()
+	cp := *params
+	config.DB = dbconfigs.NewTestDBConfigs(cp, cp, "")
+	env := tabletenv.NewEnv(config, "OnlineDDLExecutorTest")
+	e := NewExecutor(env, topodatapb.TabletAlias{Cell: "cell", Uid: 100}, nil, func() topodatapb.TabletType {
+		return topodatapb.TabletType_MASTER
+	})
+	e.pool.Open(config.DB.AppWithDB(), config.DB.DbaWithDB(), config.DB.AppDebugWithDB())
+	defer e.pool.Close()
+
+	migrationFields := sqltypes.MakeTestFields(
+		"migration_uuid|keyspace|mysql_table|strategy|migration_status",
+		"varchar|varchar|varchar|varchar|varchar",
+	)
+	statusUpdates := map[string]int{}
+	countStatusUpdate := func(status schema.OnlineDDLStatus) func(string) {
+		return func(string) { statusUpdates[string(status)]++ }
+	}
+	for _, status := range []schema.OnlineDDLStatus{schema.OnlineDDLStatusCancelled, schema.OnlineDDLStatusFailed} {
+		db.AddQueryPatternWithCallback(`UPDATE _vt.schema_migrations\s+SET migration_status='`+string(status)+`'.*`, &sqltypes.Result{}, countStatusUpdate(status))
+	}
+	streamReads := 0
+	db.AddQueryPatternWithCallback(`SELECT\s+id,\s+workflow,.*FROM _vt.vreplication.*`, &sqltypes.Result{}, func(string) { streamReads++ })
+
+	tcases := []struct {
+		uuid         string
+		status       schema.OnlineDDLStatus
+		rowsAffected uint64
+	}{
+		{uuid: "queued_migration", status: schema.OnlineDDLStatusQueued, rowsAffected: 1},
+		{uuid: "ready_migration", status: schema.OnlineDDLStatusReady, rowsAffected: 1},
+		{uuid: "complete_migration", status: schema.OnlineDDLStatusComplete, rowsAffected: 0},
+	}
+	for _, tcase := range tcases {
+		t.Run(tcase.uuid, func(t *testing.T) {
+			statusUpdates = map[string]int{}
+			streamReads = 0
+			db.AddQueryPattern(`SELECT\s+id,\s+migration_uuid,.*migration_uuid='`+tcase.uuid+`'.*`, sqltypes.MakeTestResult(migrationFields,
+				tcase.uuid+"|ks|t1|online|"+string(tcase.status),
+			))
+
+			result, err := e.cancelMigration(ctx, tcase.uuid, true)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.rowsAffected, result.RowsAffected)
+			// A migration that isn't running keeps its status, or is cancelled,
+			// and its stream, if any, is left alone.
+			assert.Zero(t, statusUpdates[string(schema.OnlineDDLStatusFailed)])
+			assert.Equal(t, int(tcase.rowsAffected), statusUpdates[string(schema.OnlineDDLStatusCancelled)])
+			assert.Zero(t, streamReads)
+		})
+	}
+}
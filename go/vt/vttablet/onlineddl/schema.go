@@ -126,11 +126,11 @@ const (
 		AND retries=0
 	`
 	sqlSelectRunningMigrations = `SELECT
-			migration_uuid
+			migration_uuid,
+			strategy
 		FROM %s.schema_migrations
 		WHERE
 			migration_status='running'
-			AND strategy=%a
 	`
 	sqlSelectCountReadyMigrations = `SELECT
 			count(*) as count_ready
@@ -153,6 +153,7 @@ const (
 	`
 	sqlSelectUncollectedArtifacts = `SELECT
 			migration_uuid,
+			strategy,
 			artifacts
 		FROM %s.schema_migrations
 		WHERE
@@ -216,8 +217,68 @@ const (
 			AND ACTION_TIMING='AFTER'
 			AND LEFT(TRIGGER_NAME, 7)='pt_osc_'
 		`
-	sqlDropTrigger    = "DROP TRIGGER IF EXISTS `%a`.`%a`"
-	sqlShowTablesLike = "SHOW TABLES LIKE '%a'"
+	sqlDropTrigger       = "DROP TRIGGER IF EXISTS `%a`.`%a`"
+	sqlShowTablesLike    = "SHOW TABLES LIKE '%a'"
+	sqlCreateTableLike   = "CREATE TABLE `%a` LIKE `%a`"
+	sqlAlterTableOptions = "ALTER TABLE `%a` %s"
+	sqlSwapTables        = "RENAME TABLE `%a` TO `%a`, `%a` TO `%a`, `%a` TO `%a`"
+
+	sqlSelectColumnsOfTable = `SELECT
+			COLUMN_NAME as column_name
+		FROM INFORMATION_SCHEMA.COLUMNS
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+		ORDER BY
+			ORDINAL_POSITION
+	`
+	sqlSelectPKColumnsOfTable = `SELECT
+			COLUMN_NAME as column_name
+		FROM INFORMATION_SCHEMA.KEY_COLUMN_USAGE
+		WHERE
+			TABLE_SCHEMA=%a
+			AND TABLE_NAME=%a
+			AND CONSTRAINT_NAME='PRIMARY'
+		ORDER BY
+			ORDINAL_POSITION
+	`
+	sqlReadVReplStream = `SELECT
+			id,
+			workflow,
+			source,
+			pos,
+			time_updated,
+			transaction_timestamp,
+			state,
+			message
+		FROM _vt.vreplication
+		WHERE
+			db_name=%a
+			AND workflow=%a
+	`
+	sqlReadCountCopyState = `SELECT
+			count(*) as cnt
+		FROM
+			_vt.copy_state
+		WHERE vrepl_id=%a
+	`
+	sqlStartVReplStream = `UPDATE _vt.vreplication
+		SET state='Running', stop_pos=NULL
+		WHERE
+			db_name=%a
+			AND workflow=%a
+	`
+	sqlStopVReplStream = `UPDATE _vt.vreplication
+		SET state='Stopped', message=%a
+		WHERE
+			db_name=%a
+			AND workflow=%a
+	`
+	sqlDeleteVReplStream = `DELETE FROM _vt.vreplication
+		WHERE
+			db_name=%a
+			AND workflow=%a
+	`
 )
 
 const (
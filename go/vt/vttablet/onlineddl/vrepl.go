@@ -0,0 +1,217 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// VReplStream represents a row in _vt.vreplication table
+type VReplStream struct {
+	id                   int64
+	workflow             string
+	source               string
+	pos                  string
+	timeUpdated          int64
+	transactionTimestamp int64
+	state                string
+	message              string
+	bls                  *binlogdatapb.BinlogSource
+}
+
+// VRepl is an online DDL helper for VReplication based migrations (ddl_strategy="online")
+type VRepl struct {
+	workflow    string
+	keyspace    string
+	shard       string
+	dbName      string
+	sourceTable string
+	targetTable string
+
+	sharedColumns []string
+	filterQuery   string
+	bls           *binlogdatapb.BinlogSource
+}
+
+// NewVRepl creates a VReplication handler for Online DDL
+func NewVRepl(workflow, keyspace, shard, dbName, sourceTable, targetTable string) *VRepl {
+	return &VRepl{
+		workflow:    workflow,
+		keyspace:    keyspace,
+		shard:       shard,
+		dbName:      dbName,
+		sourceTable: sourceTable,
+		targetTable: targetTable,
+	}
+}
+
+// readTableColumns reads the column names of given table, in ordinal order
+func (v *VRepl) readTableColumns(ctx context.Context, conn *dbconnpool.DBConnection, tableName string) (columns []string, err error) {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectColumnsOfTable, ":mysql_schema", ":mysql_table")
+	return v.readColumnNames(conn, parsed, tableName)
+}
+
+// readTablePKColumns reads the PRIMARY KEY column names of given table, in key order
+func (v *VRepl) readTablePKColumns(ctx context.Context, conn *dbconnpool.DBConnection, tableName string) (columns []string, err error) {
+	parsed := sqlparser.BuildParsedQuery(sqlSelectPKColumnsOfTable, ":mysql_schema", ":mysql_table")
+	return v.readColumnNames(conn, parsed, tableName)
+}
+
+func (v *VRepl) readColumnNames(conn *dbconnpool.DBConnection, parsed *sqlparser.ParsedQuery, tableName string) (columns []string, err error) {
+	bindVars := map[string]*querypb.BindVariable{
+		"mysql_schema": sqltypes.StringBindVariable(v.dbName),
+		"mysql_table":  sqltypes.StringBindVariable(tableName),
+	}
+	bound, err := parsed.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return nil, err
+	}
+	rs, err := conn.ExecuteFetch(bound, math.MaxInt32, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rs.Named().Rows {
+		columns = append(columns, row.AsString("column_name", ""))
+	}
+	return columns, nil
+}
+
+// analyze reads the source and target table structures, and computes the columns which are to be
+// copied/streamed from source to target, as well as the vreplication filter
+func (v *VRepl) analyze(ctx context.Context, conn *dbconnpool.DBConnection) error {
+	sourceColumns, err := v.readTableColumns(ctx, conn, v.sourceTable)
+	if err != nil {
+		return err
+	}
+	targetColumns, err := v.readTableColumns(ctx, conn, v.targetTable)
+	if err != nil {
+		return err
+	}
+	sourcePKColumns, err := v.readTablePKColumns(ctx, conn, v.sourceTable)
+	if err != nil {
+		return err
+	}
+	targetPKColumns, err := v.readTablePKColumns(ctx, conn, v.targetTable)
+	if err != nil {
+		return err
+	}
+	if err := validatePKColumns(sourcePKColumns, targetPKColumns); err != nil {
+		return err
+	}
+	v.sharedColumns = getSharedColumns(sourceColumns, targetColumns)
+	if len(v.sharedColumns) == 0 {
+		return fmt.Errorf("Found no shared columns between %s and %s", v.sourceTable, v.targetTable)
+	}
+	v.filterQuery = generateFilterQuery(v.sourceTable, v.sharedColumns)
+	v.bls = v.generateBinlogSource()
+	return nil
+}
+
+// generateBinlogSource creates the source for the vreplication stream: a single rule, which reads the
+// shared columns from the source table, and writes them into the target table
+func (v *VRepl) generateBinlogSource() *binlogdatapb.BinlogSource {
+	rule := &binlogdatapb.Rule{
+		Match:  v.targetTable,
+		Filter: v.filterQuery,
+	}
+	return &binlogdatapb.BinlogSource{
+		Keyspace: v.keyspace,
+		Shard:    v.shard,
+		Filter: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{rule},
+		},
+	}
+}
+
+// generateInsertStatement generates the INSERT INTO _vt.vreplication statement that creates the migration's
+// stream. The stream is created in stopped state, and reads from this shard's master.
+func (v *VRepl) generateInsertStatement(ctx context.Context) (string, error) {
+	if v.bls == nil {
+		return "", fmt.Errorf("VRepl: binlog source not analyzed for %s", v.workflow)
+	}
+	ig := vreplication.NewInsertGenerator(binlogplayer.BlpStopped, v.dbName)
+	ig.AddRow(v.workflow, v.bls, "", "", strings.ToLower(topodatapb.TabletType_MASTER.String()))
+	return ig.String(), nil
+}
+
+// generateStartStatement generates the statement that starts the migration's stream
+func (v *VRepl) generateStartStatement(ctx context.Context) (string, error) {
+	return sqlparser.ParseAndBind(sqlStartVReplStream,
+		sqltypes.StringBindVariable(v.dbName),
+		sqltypes.StringBindVariable(v.workflow),
+	)
+}
+
+// getSharedColumns returns the columns, in source table order, which exist in both source and target tables.
+// Column names are compared case-insensitively, as in MySQL.
+func getSharedColumns(sourceColumns, targetColumns []string) (sharedColumns []string) {
+	targetColumnsMap := map[string]bool{}
+	for _, column := range targetColumns {
+		targetColumnsMap[strings.ToLower(column)] = true
+	}
+	for _, column := range sourceColumns {
+		if targetColumnsMap[strings.ToLower(column)] {
+			sharedColumns = append(sharedColumns, column)
+		}
+	}
+	return sharedColumns
+}
+
+// validatePKColumns makes sure the PRIMARY KEY is unchanged by the migration. VReplication copies rows
+// in PRIMARY KEY order and identifies rows by PRIMARY KEY when applying binlog events.
+func validatePKColumns(sourcePKColumns, targetPKColumns []string) error {
+	if len(sourcePKColumns) == 0 {
+		return fmt.Errorf("online strategy requires a PRIMARY KEY on the migrated table")
+	}
+	if len(sourcePKColumns) != len(targetPKColumns) {
+		return fmt.Errorf("online strategy does not support changing the PRIMARY KEY: (%s) vs (%s)", strings.Join(sourcePKColumns, ","), strings.Join(targetPKColumns, ","))
+	}
+	for i := range sourcePKColumns {
+		if !strings.EqualFold(sourcePKColumns[i], targetPKColumns[i]) {
+			return fmt.Errorf("online strategy does not support changing the PRIMARY KEY: (%s) vs (%s)", strings.Join(sourcePKColumns, ","), strings.Join(targetPKColumns, ","))
+		}
+	}
+	return nil
+}
+
+// generateFilterQuery creates a SELECT query used by vreplication as a filter. It SELECTs all
+// shared columns from the source table
+func generateFilterQuery(sourceTable string, sharedColumns []string) string {
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("select ")
+	for i, column := range sharedColumns {
+		if i > 0 {
+			buf.Myprintf(", ")
+		}
+		buf.Myprintf("%v", sqlparser.NewColIdent(column))
+	}
+	buf.Myprintf(" from %v", sqlparser.NewTableIdent(sourceTable))
+	return buf.String()
+}
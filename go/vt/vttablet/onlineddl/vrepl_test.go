@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package onlineddl
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSharedColumns(t *testing.T) {
+	tt := []struct {
+		source []string
+		target []string
+		shared []string
+	}{
+		{
+			source: []string{"id", "name", "ts"},
+			target: []string{"id", "name", "ts"},
+			shared: []string{"id", "name", "ts"},
+		},
+		{
+			source: []string{"id", "name", "ts"},
+			target: []string{"id", "ts"},
+			shared: []string{"id", "ts"},
+		},
+		{
+			source: []string{"id", "name"},
+			target: []string{"ID", "extra", "name"},
+			shared: []string{"id", "name"},
+		},
+		{
+			source: []string{"id"},
+			target: []string{"other"},
+		},
+	}
+	for _, tc := range tt {
+		assert.Equal(t, tc.shared, getSharedColumns(tc.source, tc.target))
+	}
+}
+
+func TestValidatePKColumns(t *testing.T) {
+	assert.NoError(t, validatePKColumns([]string{"id"}, []string{"id"}))
+	assert.NoError(t, validatePKColumns([]string{"id", "ts"}, []string{"ID", "ts"}))
+	assert.Error(t, validatePKColumns(nil, nil))
+	assert.Error(t, validatePKColumns([]string{"id"}, []string{"id", "ts"}))
+	assert.Error(t, validatePKColumns([]string{"id", "ts"}, []string{"ts", "id"}))
+}
+
+func TestGenerateFilterQuery(t *testing.T) {
+	assert.Equal(t, "select id, `name`, `order` from t1", generateFilterQuery("t1", []string{"id", "name", "order"}))
+	assert.Equal(t, "select id from `my-table`", generateFilterQuery("my-table", []string{"id"}))
+}
+
+func TestVReplInsertStatement(t *testing.T) {
+	v := NewVRepl("3b9aa2d9_6153_11eb_9917_f875a4d24e90", "ks", "0", "vt_ks", "t1", "_3b9aa2d9_6153_11eb_9917_f875a4d24e90_20210128122816_vrepl")
+	_, err := v.generateInsertStatement(context.Background())
+	assert.Error(t, err)
+
+	v.sharedColumns = []string{"id", "c"}
+	v.filterQuery = generateFilterQuery(v.sourceTable, v.sharedColumns)
+	v.bls = v.generateBinlogSource()
+	require.Len(t, v.bls.Filter.Rules, 1)
+	assert.Equal(t, "_3b9aa2d9_6153_11eb_9917_f875a4d24e90_20210128122816_vrepl", v.bls.Filter.Rules[0].Match)
+	assert.Equal(t, "select id, c from t1", v.bls.Filter.Rules[0].Filter)
+
+	query, err := v.generateInsertStatement(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, query, "'3b9aa2d9_6153_11eb_9917_f875a4d24e90'")
+	assert.Contains(t, query, "'Stopped', 'vt_ks')")
+
+	query, err = v.generateStartStatement(context.Background())
+	require.NoError(t, err)
+	assert.Contains(t, query, "db_name='vt_ks'")
+	assert.Contains(t, query, "workflow='3b9aa2d9_6153_11eb_9917_f875a4d24e90'")
+}
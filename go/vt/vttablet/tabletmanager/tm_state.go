@@ -265,6 +265,16 @@ func (ts *tmState) updateLocked(ctx context.Context) {
 		}
 	}
 
+	// The vdiff engine depends on the vreplication engine,
+	// which it uses to stop and synchronize the streams.
+	if ts.tm.VDiffEngine != nil {
+		if ts.tablet.Type == topodatapb.TabletType_MASTER {
+			ts.tm.VDiffEngine.Open(ts.tm.BatchCtx)
+		} else {
+			ts.tm.VDiffEngine.Close()
+		}
+	}
+
 	if ts.isShardServing[ts.tablet.Type] {
 		ts.isInSrvKeyspace = true
 		statsIsInSrvKeyspace.Set(1)
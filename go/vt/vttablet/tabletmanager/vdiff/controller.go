@@ -0,0 +1,655 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/log"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+)
+
+// checkpointRows is the number of rows compared between
+// two checkpoints of the progress of a table.
+var checkpointRows int64 = 10000
+
+// Options are the options of a vdiff job. They are saved
+// as JSON in the options column of _vt.vdiff.
+type Options struct {
+	// SourceCell is the cell of the source tablets. It defaults
+	// to the cell of the target master.
+	SourceCell string `json:"source_cell,omitempty"`
+	// TabletTypes are the types of the source tablets to pick from.
+	TabletTypes string `json:"tablet_types,omitempty"`
+	// Tables are the tables to diff. All the tables of the
+	// workflow are diffed if it's empty.
+	Tables []string `json:"tables,omitempty"`
+	// FilteredReplicationWaitTime is the time to wait for the
+	// source tablets and the streams to catch up.
+	FilteredReplicationWaitTime time.Duration `json:"filtered_replication_wait_time,omitempty"`
+	// MaxRows is the number of rows of a table to compare. All the
+	// rows are compared if it's zero.
+	MaxRows int64 `json:"max_rows,omitempty"`
+	// MaxMismatchRows is the number of mismatched rows of a table
+	// that are saved in _vt.vdiff_mismatch. The rest are only counted.
+	MaxMismatchRows int64 `json:"max_mismatch_rows,omitempty"`
+}
+
+// parseOptions parses the options of a job, and fills in the defaults.
+func parseOptions(in string) (*Options, error) {
+	options := &Options{}
+	if in != "" {
+		if err := json.Unmarshal([]byte(in), options); err != nil {
+			return nil, vterrors.Wrapf(err, "invalid vdiff options: %s", in)
+		}
+	}
+	if options.TabletTypes == "" {
+		options.TabletTypes = "master,replica,rdonly"
+	}
+	if options.FilteredReplicationWaitTime == 0 {
+		options.FilteredReplicationWaitTime = 30 * time.Second
+	}
+	if options.MaxMismatchRows == 0 {
+		options.MaxMismatchRows = 1000
+	}
+	return options, nil
+}
+
+// controller runs one vdiff job.
+type controller struct {
+	id       int64
+	uuid     string
+	workflow string
+	options  *Options
+	vde      *Engine
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// newController creates a new controller and starts the job.
+// The row must contain the id, vdiff_uuid, workflow and options
+// columns of _vt.vdiff.
+func newController(ctx context.Context, row sqltypes.RowNamedValues, vde *Engine) (*controller, error) {
+	id, err := row.ToInt64("id")
+	if err != nil {
+		return nil, err
+	}
+	options, err := parseOptions(row.AsString("options", ""))
+	if err != nil {
+		return nil, err
+	}
+	if options.SourceCell == "" {
+		options.SourceCell = vde.tablet.Alias.Cell
+	}
+	ct := &controller{
+		id:       id,
+		uuid:     row.AsString("vdiff_uuid", ""),
+		workflow: row.AsString("workflow", ""),
+		options:  options,
+		vde:      vde,
+		done:     make(chan struct{}),
+	}
+	ctx, ct.cancel = context.WithCancel(ctx)
+	go ct.run(ctx)
+	return ct, nil
+}
+
+// run runs the job and saves its outcome. A job that gets canceled
+// keeps its state, so that it can be picked up again.
+func (ct *controller) run(ctx context.Context) {
+	defer close(ct.done)
+
+	err := ct.runDiff(ctx)
+	if ctx.Err() != nil {
+		log.Infof("vdiff %s was interrupted: %v", ct.uuid, err)
+		return
+	}
+
+	dbClient := ct.vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		log.Errorf("vdiff %s: could not save the outcome: %v", ct.uuid, err)
+		return
+	}
+	defer dbClient.Close()
+
+	var query string
+	if err != nil {
+		log.Errorf("vdiff %s failed: %v", ct.uuid, err)
+		query = fmt.Sprintf(sqlUpdateVDiffState, encodeString(StateError), encodeString(binlogplayer.MessageTruncate(err.Error())), ct.id)
+	} else {
+		log.Infof("vdiff %s completed", ct.uuid)
+		query = fmt.Sprintf(sqlUpdateVDiffCompleted, ct.id)
+	}
+	if _, err := dbClient.ExecuteFetch(query, 1); err != nil {
+		log.Errorf("vdiff %s: could not save the outcome: %v", ct.uuid, err)
+	}
+}
+
+// Stop stops the job and waits for it to exit.
+func (ct *controller) Stop() {
+	ct.cancel()
+	<-ct.done
+}
+
+// tableProgress is the saved progress of a table.
+type tableProgress struct {
+	state  string
+	lastpk []sqltypes.Value
+	report DiffReport
+}
+
+func (ct *controller) runDiff(ctx context.Context) error {
+	dbClient := ct.vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return err
+	}
+	defer dbClient.Close()
+
+	if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlUpdateVDiffStarted, ct.id), 1); err != nil {
+		return err
+	}
+
+	streams, err := ct.readStreams(dbClient)
+	if err != nil {
+		return err
+	}
+	if len(streams) == 0 {
+		return fmt.Errorf("workflow %s has no streams", ct.workflow)
+	}
+	differs, err := ct.buildDiffers(ctx, streams[0].bls)
+	if err != nil {
+		return err
+	}
+
+	for _, td := range differs {
+		if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlInsertVDiffTable, ct.id, encodeString(td.table)), 1); err != nil {
+			return err
+		}
+	}
+	progress, err := ct.readProgress(dbClient)
+	if err != nil {
+		return err
+	}
+
+	for _, td := range differs {
+		tp := progress[td.table]
+		if tp == nil {
+			return fmt.Errorf("missing progress for table %s", td.table)
+		}
+		if tp.state == StateCompleted {
+			continue
+		}
+		if err := ct.diffTable(ctx, dbClient, td, tp); err != nil {
+			return vterrors.Wrapf(err, "table %s", td.table)
+		}
+	}
+	return nil
+}
+
+// stream is a vreplication stream of the workflow.
+type stream struct {
+	id  int64
+	bls *binlogdatapb.BinlogSource
+	pos string
+}
+
+func (ct *controller) readStreams(dbClient binlogplayer.DBClient) ([]*stream, error) {
+	query := fmt.Sprintf(sqlSelectWorkflowStreams, encodeString(ct.vde.dbName), encodeString(ct.workflow))
+	qr, err := dbClient.ExecuteFetch(query, 10000)
+	if err != nil {
+		return nil, err
+	}
+	var streams []*stream
+	for _, row := range qr.Named().Rows {
+		id, err := row.ToInt64("id")
+		if err != nil {
+			return nil, err
+		}
+		var bls binlogdatapb.BinlogSource
+		if err := proto.UnmarshalText(row.AsString("source", ""), &bls); err != nil {
+			return nil, vterrors.Wrapf(err, "UnmarshalText: %v", row)
+		}
+		streams = append(streams, &stream{id: id, bls: &bls, pos: row.AsString("pos", "")})
+	}
+	return streams, nil
+}
+
+// buildDiffers builds the differs of the tables of the workflow, sorted by table name.
+func (ct *controller) buildDiffers(ctx context.Context, bls *binlogdatapb.BinlogSource) ([]*tableDiffer, error) {
+	schm, err := ct.vde.mysqld.GetSchema(ctx, ct.vde.dbName, nil, nil, false)
+	if err != nil {
+		return nil, vterrors.Wrap(err, "GetSchema")
+	}
+	ksSchema, err := ct.sourceKeyspaceSchema(ctx, bls.Keyspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var differs []*tableDiffer
+	for _, table := range schm.TableDefinitions {
+		if len(ct.options.Tables) != 0 && !containsString(ct.options.Tables, table.Name) {
+			continue
+		}
+		query, err := tableQuery(table, bls.Filter)
+		if err != nil {
+			return nil, err
+		}
+		if query == "" {
+			continue
+		}
+		td, err := buildTableDiffer(table, query, ksSchema)
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "table %s", table.Name)
+		}
+		differs = append(differs, td)
+	}
+	if len(ct.options.Tables) != 0 && len(ct.options.Tables) != len(differs) {
+		return nil, fmt.Errorf("one or more tables provided are not present in the workflow: %v", ct.options.Tables)
+	}
+	sort.Slice(differs, func(i, j int) bool {
+		return differs[i].table < differs[j].table
+	})
+	return differs, nil
+}
+
+// tableQuery returns the select of the source for the table,
+// or an empty string if the table is not part of the workflow.
+func tableQuery(table *tabletmanagerdatapb.TableDefinition, filter *binlogdatapb.Filter) (string, error) {
+	rule, err := vreplication.MatchTable(table.Name, filter)
+	if err != nil {
+		return "", err
+	}
+	if rule == nil || rule.Filter == vreplication.ExcludeStr {
+		return "", nil
+	}
+	switch {
+	case rule.Filter == "":
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select * from %v", sqlparser.NewTableIdent(table.Name))
+		return buf.String(), nil
+	case key.IsKeyRange(rule.Filter):
+		buf := sqlparser.NewTrackedBuffer(nil)
+		buf.Myprintf("select * from %v where in_keyrange(%v)", sqlparser.NewTableIdent(table.Name), sqlparser.NewStrLiteral([]byte(rule.Filter)))
+		return buf.String(), nil
+	}
+	return rule.Filter, nil
+}
+
+// sourceKeyspaceSchema returns the vschema of the source keyspace,
+// or nil if it has none.
+func (ct *controller) sourceKeyspaceSchema(ctx context.Context, keyspace string) (*vindexes.KeyspaceSchema, error) {
+	vs, err := ct.vde.ts.GetVSchema(ctx, keyspace)
+	if topo.IsErrType(err, topo.NoNode) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return vindexes.BuildKeyspaceSchema(vs, keyspace)
+}
+
+func (ct *controller) readProgress(dbClient binlogplayer.DBClient) (map[string]*tableProgress, error) {
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlSelectVDiffTables, ct.id), 10000)
+	if err != nil {
+		return nil, err
+	}
+	progress := make(map[string]*tableProgress)
+	for _, row := range qr.Named().Rows {
+		tp := &tableProgress{
+			state: row.AsString("state", ""),
+		}
+		if lastpk := row.AsString("lastpk", ""); lastpk != "" {
+			tp.lastpk, err = decodeLastPK(lastpk)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if report := row.AsString("report", ""); report != "" {
+			if err := json.Unmarshal([]byte(report), &tp.report); err != nil {
+				return nil, err
+			}
+		}
+		progress[row.AsString("table_name", "")] = tp
+	}
+	return progress, nil
+}
+
+// diffTable diffs the table from where it was left off, and checkpoints
+// the progress every checkpointRows rows.
+func (ct *controller) diffTable(ctx context.Context, dbClient binlogplayer.DBClient, td *tableDiffer, tp *tableProgress) error {
+	log.Infof("vdiff %s: starting table %s", ct.uuid, td.table)
+	if _, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlUpdateTableState, encodeString(StateStarted), ct.id, encodeString(td.table)), 1); err != nil {
+		return err
+	}
+
+	// The streams are canceled once the diff returns.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	sources, target, err := ct.startQueryStreams(ctx, td, tp.lastpk)
+	if err != nil {
+		return err
+	}
+
+	report := &tp.report
+	checkpoint := func(lastpk []sqltypes.Value, mismatches []mismatch) error {
+		return ct.saveProgress(dbClient, td, lastpk, report, mismatches)
+	}
+	if err := td.diff(ctx, td.sourcePrimitive(sources), newMergeSorter([]*shardStreamer{target}, td.comparePKs), report, ct.options.MaxRows, ct.options.MaxMismatchRows, checkpointRows, checkpoint); err != nil {
+		return err
+	}
+	log.Infof("vdiff %s: completed table %s: %+v", ct.uuid, td.table, report)
+	_, err = dbClient.ExecuteFetch(fmt.Sprintf(sqlUpdateTableState, encodeString(StateCompleted), ct.id, encodeString(td.table)), 1)
+	return err
+}
+
+// saveProgress saves the lastpk and the report of the table along
+// with the mismatches found since the previous checkpoint.
+func (ct *controller) saveProgress(dbClient binlogplayer.DBClient, td *tableDiffer, lastpk []sqltypes.Value, dr *DiffReport, mismatches []mismatch) error {
+	report, err := json.Marshal(dr)
+	if err != nil {
+		return err
+	}
+	var lastpkBV *querypb.BindVariable
+	if lastpk == nil {
+		lastpkBV = sqltypes.NullBindVariable
+	} else {
+		encoded, err := encodeLastPK(td.pkFields, lastpk)
+		if err != nil {
+			return err
+		}
+		lastpkBV = sqltypes.StringBindVariable(encoded)
+	}
+	mismatch := int64(0)
+	if dr.MismatchedRows+dr.ExtraRowsSource+dr.ExtraRowsTarget != 0 {
+		mismatch = 1
+	}
+	update, err := sqlparser.ParseAndBind(sqlUpdateTableProgress,
+		lastpkBV,
+		sqltypes.Int64BindVariable(dr.ProcessedRows),
+		sqltypes.Int64BindVariable(mismatch),
+		sqltypes.StringBindVariable(string(report)),
+		sqltypes.Int64BindVariable(ct.id),
+		sqltypes.StringBindVariable(td.table),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := dbClient.Begin(); err != nil {
+		return err
+	}
+	for _, m := range mismatches {
+		insert, err := sqlparser.ParseAndBind(sqlInsertMismatch,
+			sqltypes.Int64BindVariable(ct.id),
+			sqltypes.StringBindVariable(td.table),
+			sqltypes.StringBindVariable(m.kind),
+			sqltypes.StringBindVariable(m.rowKey),
+		)
+		if err != nil {
+			dbClient.Rollback()
+			return err
+		}
+		if _, err := dbClient.ExecuteFetch(insert, 1); err != nil {
+			dbClient.Rollback()
+			return err
+		}
+	}
+	if _, err := dbClient.ExecuteFetch(update, 1); err != nil {
+		dbClient.Rollback()
+		return err
+	}
+	return dbClient.Commit()
+}
+
+// startQueryStreams stops the streams of the workflow, starts the query
+// streams on the sources, fast forwards the streams to the positions of
+// the source queries, and starts the query stream on this tablet. The
+// streams of the workflow are restarted before returning.
+func (ct *controller) startQueryStreams(ctx context.Context, td *tableDiffer, lastpk []sqltypes.Value) ([]*shardStreamer, *shardStreamer, error) {
+	vre := ct.vde.vre
+	dbName := encodeString(ct.vde.dbName)
+	workflow := encodeString(ct.workflow)
+
+	defer func() {
+		if _, err := vre.Exec(fmt.Sprintf(sqlRestartStreams, dbName, workflow)); err != nil {
+			log.Errorf("vdiff %s: error restarting the streams of workflow %s: %v", ct.uuid, ct.workflow, err)
+		}
+	}()
+	// Stop the streams and record their source positions.
+	if _, err := vre.Exec(fmt.Sprintf(sqlStopWorkflowStreams, dbName, workflow)); err != nil {
+		return nil, nil, vterrors.Wrap(err, "stopping the streams")
+	}
+	streams, err := func() ([]*stream, error) {
+		dbClient := ct.vde.dbClientFactory()
+		if err := dbClient.Connect(); err != nil {
+			return nil, err
+		}
+		defer dbClient.Close()
+		return ct.readStreams(dbClient)
+	}()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sourceQuery, err := td.sourceQuery(lastpk)
+	if err != nil {
+		return nil, nil, err
+	}
+	targetQuery, err := td.targetQuery(lastpk)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, ct.options.FilteredReplicationWaitTime)
+	defer cancel()
+
+	// Make sure the sources are past the positions of the streams, and
+	// start the source query streams, which record the current source positions.
+	sources := make([]*shardStreamer, len(streams))
+	snapshots := make([]string, len(streams))
+	err = forAll(len(streams), func(i int) error {
+		bls := streams[i].bls
+		if streams[i].pos == "" {
+			return fmt.Errorf("workflow %s: stream %d has not started", ct.workflow, streams[i].id)
+		}
+		tp, err := discovery.NewTabletPicker(ct.vde.ts, []string{ct.options.SourceCell}, bls.Keyspace, bls.Shard, ct.options.TabletTypes)
+		if err != nil {
+			return err
+		}
+		tablet, err := tp.PickForStreaming(ctx)
+		if err != nil {
+			return err
+		}
+		if err := ct.vde.tmc.WaitForPosition(waitCtx, tablet, streams[i].pos); err != nil {
+			return vterrors.Wrapf(err, "WaitForPosition for tablet %v", topoproto.TabletAliasString(tablet.Alias))
+		}
+		sources[i] = &shardStreamer{filter: td.inKeyrange}
+		snapshots[i], err = startStream(ctx, sources[i], func(ctx context.Context, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+			conn, err := tabletconn.GetDialer()(tablet, grpcclient.FailFast(false))
+			if err != nil {
+				return err
+			}
+			defer conn.Close(ctx)
+			target := &querypb.Target{
+				Keyspace:   bls.Keyspace,
+				Shard:      bls.Shard,
+				TabletType: tablet.Type,
+			}
+			return conn.VStreamResults(ctx, target, sourceQuery, send)
+		})
+		return err
+	})
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "starting the source query streams")
+	}
+
+	// Fast forward the streams to the positions of the source queries.
+	err = forAll(len(streams), func(i int) error {
+		if _, err := vre.Exec(fmt.Sprintf(sqlSyncStream, encodeString(snapshots[i]), streams[i].id)); err != nil {
+			return err
+		}
+		return vre.WaitForPos(waitCtx, int(streams[i].id), snapshots[i])
+	})
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "synchronizing the streams")
+	}
+
+	// The sources and this tablet are in sync. Start the query on this tablet.
+	target := &shardStreamer{}
+	_, err = startStream(ctx, target, func(ctx context.Context, send func(*binlogdatapb.VStreamResultsResponse) error) error {
+		tabletTarget := &querypb.Target{
+			Keyspace:   ct.vde.tablet.Keyspace,
+			Shard:      ct.vde.tablet.Shard,
+			TabletType: topodatapb.TabletType_MASTER,
+		}
+		return ct.vde.qs.VStreamResults(ctx, tabletTarget, targetQuery, send)
+	})
+	if err != nil {
+		return nil, nil, vterrors.Wrap(err, "starting the target query stream")
+	}
+	// Now that the queries are running, the streams can be restarted.
+	return sources, target, nil
+}
+
+// startStream starts the query stream in a goroutine, and returns the
+// gtid of its snapshot. The rows are sent to the result channel of the
+// participant, which gets closed when the stream ends. The error of
+// the stream is set in participant.err before that.
+func startStream(ctx context.Context, participant *shardStreamer, stream func(context.Context, func(*binlogdatapb.VStreamResultsResponse) error) error) (string, error) {
+	participant.result = make(chan *sqltypes.Result, 1)
+	gtidch := make(chan string, 1)
+	go func() {
+		defer close(participant.result)
+		defer close(gtidch)
+
+		var fields []*querypb.Field
+		participant.err = stream(ctx, func(vrs *binlogdatapb.VStreamResultsResponse) error {
+			if vrs.Fields != nil {
+				fields = vrs.Fields
+				gtidch <- vrs.Gtid
+			}
+			result := sqltypes.Proto3ToResult(&querypb.QueryResult{
+				Fields: fields,
+				Rows:   vrs.Rows,
+			})
+			// Fields should be received only once, and sent only once.
+			if vrs.Fields == nil {
+				result.Fields = nil
+			}
+			select {
+			case participant.result <- result:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "VStreamResults")
+			}
+			return nil
+		})
+	}()
+
+	// Wait for the gtid to be sent. If it's not received, there was an error.
+	gtid, ok := <-gtidch
+	if !ok {
+		// Drain the result channel so the error is set.
+		for range participant.result {
+		}
+		if participant.err == nil {
+			return "", fmt.Errorf("query stream ended before sending fields")
+		}
+		return "", participant.err
+	}
+	return gtid, nil
+}
+
+// forAll invokes f concurrently for 0 to n-1, and aggregates the errors.
+func forAll(n int, f func(int) error) error {
+	allErrors := &concurrency.AllErrorRecorder{}
+	done := make(chan struct{})
+	for i := 0; i < n; i++ {
+		go func(i int) {
+			defer func() { done <- struct{}{} }()
+			if err := f(i); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(i)
+	}
+	for i := 0; i < n; i++ {
+		<-done
+	}
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+// encodeLastPK encodes the pk values as the text of a QueryResult,
+// like the lastpk of the copy phase of vreplication.
+func encodeLastPK(fields []*querypb.Field, lastpk []sqltypes.Value) (string, error) {
+	pkFields := make([]*querypb.Field, 0, len(fields))
+	for _, field := range fields {
+		pkFields = append(pkFields, &querypb.Field{Name: field.Name, Type: field.Type})
+	}
+	var buf strings.Builder
+	if err := proto.CompactText(&buf, &querypb.QueryResult{
+		Fields: pkFields,
+		Rows:   []*querypb.Row{sqltypes.RowToProto3(lastpk)},
+	}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func decodeLastPK(in string) ([]sqltypes.Value, error) {
+	var qr querypb.QueryResult
+	if err := proto.UnmarshalText(in, &qr); err != nil {
+		return nil, vterrors.Wrapf(err, "invalid lastpk: %s", in)
+	}
+	result := sqltypes.Proto3ToResult(&qr)
+	if len(result.Rows) != 1 {
+		return nil, fmt.Errorf("invalid lastpk: %s", in)
+	}
+	return result.Rows[0], nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,393 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+	"vitess.io/vitess/go/vt/withddl"
+)
+
+var withDDL = withddl.New([]string{
+	createVDiffTable,
+	createVDiffTableTable,
+	createVDiffMismatchTable,
+})
+
+var openRetryInterval = sync2.NewAtomicDuration(1 * time.Second)
+
+// Engine runs the vdiff jobs of the target master tablet.
+// A vdiff job is created by inserting a row into _vt.vdiff
+// through VExec, which makes every target master that has streams
+// for the workflow diff its own shard against the sources.
+// The progress of every table is checkpointed in _vt.vdiff_table,
+// which allows a job to continue from where it left off after
+// it is stopped, or after the tablet restarts or gets reparented.
+type Engine struct {
+	// mu synchronizes isOpen, controllers and the root context.
+	mu          sync.Mutex
+	isOpen      bool
+	controllers map[int64]*controller
+	// wg is used by the goroutine that picks up the jobs
+	// that were running when the engine was last closed.
+	wg sync.WaitGroup
+
+	// ctx is the root context for all controllers.
+	ctx context.Context
+	// cancel will cancel the root context, thereby all controllers.
+	cancel context.CancelFunc
+
+	ts              *topo.Server
+	tablet          *topodatapb.Tablet
+	mysqld          mysqlctl.MysqlDaemon
+	vre             *vreplication.Engine
+	qs              queryservice.QueryService
+	tmc             tmclient.TabletManagerClient
+	dbClientFactory func() binlogplayer.DBClient
+	dbName          string
+}
+
+// NewEngine creates a new Engine.
+// A nil ts means that the Engine is disabled.
+func NewEngine(ts *topo.Server, tablet *topodatapb.Tablet, mysqld mysqlctl.MysqlDaemon, vre *vreplication.Engine, qs queryservice.QueryService) *Engine {
+	return &Engine{
+		controllers: make(map[int64]*controller),
+		ts:          ts,
+		tablet:      tablet,
+		mysqld:      mysqld,
+		vre:         vre,
+		qs:          qs,
+	}
+}
+
+// NewTestEngine creates a new Engine for testing.
+func NewTestEngine(ts *topo.Server, tablet *topodatapb.Tablet, dbClientFactory func() binlogplayer.DBClient, dbName string) *Engine {
+	return &Engine{
+		controllers:     make(map[int64]*controller),
+		ts:              ts,
+		tablet:          tablet,
+		dbClientFactory: dbClientFactory,
+		dbName:          dbName,
+	}
+}
+
+// InitDBConfig should be invoked after the db name is computed.
+func (vde *Engine) InitDBConfig(dbcfgs *dbconfigs.DBConfigs) {
+	// If we're already initilized, it's a test engine. Ignore the call.
+	if vde.dbClientFactory != nil {
+		return
+	}
+	vde.dbClientFactory = func() binlogplayer.DBClient {
+		return binlogplayer.NewDBClient(dbcfgs.DbaWithDB())
+	}
+	vde.dbName = dbcfgs.DBName
+}
+
+// Open starts the Engine service, and picks up the jobs
+// that were pending or running.
+func (vde *Engine) Open(ctx context.Context) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+
+	if vde.ts == nil || vde.isOpen {
+		return
+	}
+	log.Infof("VDiff Engine: opening")
+
+	if vde.tmc == nil {
+		vde.tmc = tmclient.NewTabletManagerClient()
+	}
+	vde.ctx, vde.cancel = context.WithCancel(ctx)
+	vde.isOpen = true
+	vde.wg.Add(1)
+	go vde.resumeJobs(vde.ctx)
+}
+
+// resumeJobs starts a controller for every job that has not
+// finished. It keeps retrying until it succeeds or the engine
+// gets closed, because mysql may not be reachable yet when
+// the tablet becomes a master.
+func (vde *Engine) resumeJobs(ctx context.Context) {
+	defer vde.wg.Done()
+
+	for {
+		err := vde.startRunnableJobs(ctx)
+		if err == nil {
+			return
+		}
+		log.Errorf("Error resuming vdiff jobs: %v, will keep retrying.", err)
+		timer := time.NewTimer(openRetryInterval.Get())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+func (vde *Engine) startRunnableJobs(ctx context.Context) error {
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return err
+	}
+	defer dbClient.Close()
+
+	query := fmt.Sprintf(sqlSelectRunnableVDiffs, encodeString(vde.dbName))
+	qr, err := withDDL.Exec(ctx, query, dbClient.ExecuteFetch)
+	if err != nil {
+		return err
+	}
+
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	// Recheck the context within the lock. This guarantees
+	// that we will not start controllers after Close.
+	if ctx.Err() != nil {
+		return nil
+	}
+	for _, row := range qr.Named().Rows {
+		if err := vde.startControllerLocked(row); err != nil {
+			log.Errorf("Controller could not be initialized for vdiff %v: %v", row, err)
+		}
+	}
+	return nil
+}
+
+// IsOpen returns true if Engine is open.
+func (vde *Engine) IsOpen() bool {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	return vde.isOpen
+}
+
+// Close closes the Engine service. The running jobs are
+// interrupted, but keep their state. They get picked up
+// again the next time the engine is opened.
+func (vde *Engine) Close() {
+	vde.mu.Lock()
+	if !vde.isOpen {
+		vde.mu.Unlock()
+		return
+	}
+	vde.cancel()
+	vde.mu.Unlock()
+
+	// resumeJobs needs the lock to start the controllers.
+	// It won't start any once the context is canceled.
+	vde.wg.Wait()
+
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	for _, ct := range vde.controllers {
+		ct.Stop()
+	}
+	vde.controllers = make(map[int64]*controller)
+	vde.isOpen = false
+	log.Infof("VDiff Engine: closed")
+}
+
+// VExec executes the VExec request for the vdiff tables.
+// Example insert statement, which creates a new vdiff job:
+// insert into _vt.vdiff (vdiff_uuid, workflow, options, state) values ('e6ac4de8_8f1c_11eb_8bcc_f875a4d24e90', 'wf', '{}', 'pending')
+// The insert is ignored if the tablet has no streams for the workflow.
+// Example update statements, which stop and resume a job:
+// update _vt.vdiff set state='stopped' where vdiff_uuid='e6ac4de8_8f1c_11eb_8bcc_f875a4d24e90'
+// update _vt.vdiff set state='pending' where vdiff_uuid='e6ac4de8_8f1c_11eb_8bcc_f875a4d24e90'
+// Select statements on any of the vdiff tables are passed through.
+func (vde *Engine) VExec(ctx context.Context, vx *vexec.TabletVExec) (*querypb.QueryResult, error) {
+	vde.mu.Lock()
+	defer vde.mu.Unlock()
+	if !vde.isOpen {
+		return nil, vterrors.New(vtrpcpb.Code_UNAVAILABLE, "vdiff engine is closed")
+	}
+
+	dbClient := vde.dbClientFactory()
+	if err := dbClient.Connect(); err != nil {
+		return nil, err
+	}
+	defer dbClient.Close()
+
+	var qr *sqltypes.Result
+	var err error
+	switch vx.Stmt.(type) {
+	case *sqlparser.Select:
+		qr, err = withDDL.Exec(ctx, vx.Query, dbClient.ExecuteFetch)
+	case *sqlparser.Insert:
+		qr, err = vde.createJob(ctx, dbClient, vx)
+	case *sqlparser.Update:
+		qr, err = vde.updateJob(ctx, dbClient, vx)
+	default:
+		return nil, fmt.Errorf("unsupported query for vdiff: %s", vx.Query)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.ResultToProto3(qr), nil
+}
+
+func (vde *Engine) createJob(ctx context.Context, dbClient binlogplayer.DBClient, vx *vexec.TabletVExec) (*sqltypes.Result, error) {
+	if vx.TableName != VDiffTableName {
+		return nil, fmt.Errorf("invalid table name: %s", vx.TableName)
+	}
+	workflow, err := vx.ColumnStringVal(vx.InsertCols, "workflow")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := vx.ColumnStringVal(vx.InsertCols, "vdiff_uuid"); err != nil {
+		return nil, err
+	}
+	options, err := vx.ColumnStringVal(vx.InsertCols, "options")
+	if err != nil {
+		return nil, err
+	}
+	if _, err := parseOptions(options); err != nil {
+		return nil, err
+	}
+
+	// The job is created on every master of the keyspace,
+	// but only the ones with streams for the workflow run it.
+	query := fmt.Sprintf(sqlSelectWorkflowStreams, encodeString(vde.dbName), encodeString(workflow))
+	streams, err := withDDL.ExecIgnore(ctx, query, dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	if len(streams.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+
+	vx.AddOrReplaceInsertColumnVal("keyspace", vx.ToStringVal(vde.tablet.Keyspace))
+	vx.AddOrReplaceInsertColumnVal("shard", vx.ToStringVal(vde.tablet.Shard))
+	vx.AddOrReplaceInsertColumnVal("db_name", vx.ToStringVal(vde.dbName))
+	vx.AddOrReplaceInsertColumnVal("state", vx.ToStringVal(StatePending))
+	qr, err := withDDL.Exec(ctx, vx.Query, dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	if qr.InsertID == 0 {
+		return nil, fmt.Errorf("insert failed to generate an id")
+	}
+	if err := vde.startJob(dbClient, int64(qr.InsertID)); err != nil {
+		return nil, err
+	}
+	return qr, nil
+}
+
+func (vde *Engine) updateJob(ctx context.Context, dbClient binlogplayer.DBClient, vx *vexec.TabletVExec) (*sqltypes.Result, error) {
+	if vx.TableName != VDiffTableName {
+		return nil, fmt.Errorf("invalid table name: %s", vx.TableName)
+	}
+	uuid, err := vx.ColumnStringVal(vx.WhereCols, "vdiff_uuid")
+	if err != nil {
+		return nil, err
+	}
+	newState, err := vx.ColumnStringVal(vx.UpdateCols, "state")
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf(sqlSelectVDiffByUUID, encodeString(uuid), encodeString(vde.dbName))
+	qr, err := withDDL.Exec(ctx, query, dbClient.ExecuteFetch)
+	if err != nil {
+		return nil, err
+	}
+	if len(qr.Rows) == 0 {
+		return &sqltypes.Result{}, nil
+	}
+	id, err := evalengine.ToInt64(qr.Rows[0][0])
+	if err != nil {
+		return nil, err
+	}
+	state := qr.Rows[0][1].ToString()
+
+	switch newState {
+	case StateStopped:
+		if state != StatePending && state != StateStarted {
+			return &sqltypes.Result{}, nil
+		}
+		if ct := vde.controllers[id]; ct != nil {
+			ct.Stop()
+			delete(vde.controllers, id)
+		}
+		return dbClient.ExecuteFetch(fmt.Sprintf(sqlUpdateVDiffState, encodeString(StateStopped), encodeString(""), id), 1)
+	case StatePending:
+		if state != StateStopped && state != StateError {
+			return &sqltypes.Result{}, nil
+		}
+		qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlUpdateVDiffState, encodeString(StatePending), encodeString(""), id), 1)
+		if err != nil {
+			return nil, err
+		}
+		if err := vde.startJob(dbClient, id); err != nil {
+			return nil, err
+		}
+		return qr, nil
+	}
+	return nil, fmt.Errorf("invalid state for vdiff %s: %s, the state can only be changed to %s or %s", uuid, newState, StateStopped, StatePending)
+}
+
+// startJob reads the job and starts its controller.
+func (vde *Engine) startJob(dbClient binlogplayer.DBClient, id int64) error {
+	qr, err := dbClient.ExecuteFetch(fmt.Sprintf(sqlSelectVDiffByID, id), 1)
+	if err != nil {
+		return err
+	}
+	if len(qr.Rows) != 1 {
+		return fmt.Errorf("vdiff %d not found", id)
+	}
+	return vde.startControllerLocked(qr.Named().Row())
+}
+
+func (vde *Engine) startControllerLocked(row sqltypes.RowNamedValues) error {
+	ct, err := newController(vde.ctx, row, vde)
+	if err != nil {
+		return err
+	}
+	if old := vde.controllers[ct.id]; old != nil {
+		// Unreachable. Just a failsafe.
+		old.Stop()
+	}
+	vde.controllers[ct.id] = ct
+	return nil
+}
+
+func encodeString(in string) string {
+	var buf strings.Builder
+	sqltypes.NewVarChar(in).EncodeSQL(&buf)
+	return buf.String()
+}
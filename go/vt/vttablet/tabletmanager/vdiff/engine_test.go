@@ -0,0 +1,128 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/vttablet/vexec"
+)
+
+// fakeTMClient is only used to prevent the engine from creating
+// a real tablet manager client. None of its methods are called.
+type fakeTMClient struct {
+	tmclient.TabletManagerClient
+}
+
+func newTestEngine(t *testing.T) (*Engine, *binlogplayer.MockDBClient) {
+	dbClient := binlogplayer.NewMockDBClient(t)
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: 100},
+		Keyspace: "ks",
+		Shard:    "-80",
+	}
+	vde := NewTestEngine(memorytopo.NewServer("cell1"), tablet, func() binlogplayer.DBClient { return dbClient }, "vt_ks")
+	vde.tmc = &fakeTMClient{}
+	return vde, dbClient
+}
+
+func testVExec(t *testing.T, vde *Engine, query string) (*sqltypes.Result, error) {
+	t.Helper()
+	vx := vexec.NewTabletVExec("wf", "ks")
+	require.NoError(t, vx.AnalyzeQuery(context.Background(), query))
+	qr, err := vde.VExec(context.Background(), vx)
+	if err != nil {
+		return nil, err
+	}
+	return sqltypes.Proto3ToResult(qr), nil
+}
+
+func TestEngineVExec(t *testing.T) {
+	vde, dbClient := newTestEngine(t)
+
+	_, err := testVExec(t, vde, "select * from _vt.vdiff")
+	require.EqualError(t, err, "vdiff engine is closed")
+
+	dbClient.ExpectRequest("select id, vdiff_uuid, workflow, options from _vt.vdiff where db_name='vt_ks' and state in ('pending', 'started')", &sqltypes.Result{}, nil)
+	vde.Open(context.Background())
+	defer vde.Close()
+	dbClient.Wait()
+
+	// The job is not created if the tablet has no streams for the workflow.
+	dbClient.ExpectRequest("select id, source, pos from _vt.vreplication where db_name='vt_ks' and workflow='wf'", &sqltypes.Result{}, nil)
+	qr, err := testVExec(t, vde, "insert into _vt.vdiff (vdiff_uuid, workflow, options, state) values ('u1', 'wf', '{}', 'pending')")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), qr.RowsAffected)
+	dbClient.Wait()
+
+	_, err = testVExec(t, vde, "insert into _vt.vdiff (vdiff_uuid, workflow, options, state) values ('u1', 'wf', 'bad', 'pending')")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid vdiff options: bad")
+
+	// Stopping a pending job.
+	dbClient.ExpectRequest("select id, state from _vt.vdiff where vdiff_uuid='u1' and db_name='vt_ks'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|state", "int64|varbinary"),
+		"1|pending",
+	), nil)
+	dbClient.ExpectRequest("update _vt.vdiff set state='stopped', last_error='' where id=1", &sqltypes.Result{RowsAffected: 1}, nil)
+	qr, err = testVExec(t, vde, "update _vt.vdiff set state='stopped' where vdiff_uuid='u1'")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), qr.RowsAffected)
+	dbClient.Wait()
+
+	// A completed job can't be resumed.
+	dbClient.ExpectRequest("select id, state from _vt.vdiff where vdiff_uuid='u1' and db_name='vt_ks'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|state", "int64|varbinary"),
+		"1|completed",
+	), nil)
+	qr, err = testVExec(t, vde, "update _vt.vdiff set state='pending' where vdiff_uuid='u1'")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), qr.RowsAffected)
+	dbClient.Wait()
+
+	dbClient.ExpectRequest("select id, state from _vt.vdiff where vdiff_uuid='u1' and db_name='vt_ks'", sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("id|state", "int64|varbinary"),
+		"1|stopped",
+	), nil)
+	_, err = testVExec(t, vde, "update _vt.vdiff set state='completed' where vdiff_uuid='u1'")
+	require.EqualError(t, err, "invalid state for vdiff u1: completed, the state can only be changed to stopped or pending")
+	dbClient.Wait()
+
+	_, err = testVExec(t, vde, "delete from _vt.vdiff where vdiff_uuid='u1'")
+	require.EqualError(t, err, "unsupported query for vdiff: delete from _vt.vdiff where vdiff_uuid='u1'")
+}
+
+func TestParseOptions(t *testing.T) {
+	options, err := parseOptions(`{"tables":["t1"],"max_rows":100,"max_mismatch_rows":10}`)
+	require.NoError(t, err)
+	assert.Equal(t, &Options{
+		TabletTypes:                 "master,replica,rdonly",
+		Tables:                      []string{"t1"},
+		FilteredReplicationWaitTime: 30000000000,
+		MaxRows:                     100,
+		MaxMismatchRows:             10,
+	}, options)
+}
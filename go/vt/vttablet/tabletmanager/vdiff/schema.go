@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+const (
+	// VDiffTableName is the table that holds one row per vdiff job.
+	VDiffTableName = "_vt.vdiff"
+	// VDiffTableTableName is the table that holds the progress of every table of a vdiff job.
+	VDiffTableTableName = "_vt.vdiff_table"
+	// VDiffMismatchTableName is the table that holds the keys of the rows that did not match.
+	VDiffMismatchTableName = "_vt.vdiff_mismatch"
+
+	createVDiffTable = `create table if not exists _vt.vdiff (
+  id bigint(20) unsigned not null auto_increment,
+  vdiff_uuid varchar(64) not null,
+  workflow varbinary(1000) not null,
+  keyspace varbinary(256) not null,
+  shard varchar(255) not null,
+  db_name varbinary(255) not null,
+  state varbinary(64) not null,
+  options varbinary(4096) not null,
+  last_error varbinary(1024) not null default '',
+  created_at timestamp not null default current_timestamp,
+  started_at timestamp null default null,
+  completed_at timestamp null default null,
+  primary key (id),
+  unique key uuid_idx (vdiff_uuid),
+  key workflow_idx (db_name(64), workflow(64)))`
+
+	createVDiffTableTable = `create table if not exists _vt.vdiff_table (
+  vdiff_id bigint(20) unsigned not null,
+  table_name varbinary(128) not null,
+  state varbinary(64) not null,
+  lastpk varbinary(2000),
+  rows_compared bigint(20) not null default 0,
+  mismatch tinyint(1) not null default 0,
+  report varbinary(2048) not null default '',
+  updated_at timestamp not null default current_timestamp on update current_timestamp,
+  primary key (vdiff_id, table_name))`
+
+	createVDiffMismatchTable = `create table if not exists _vt.vdiff_mismatch (
+  id bigint(20) unsigned not null auto_increment,
+  vdiff_id bigint(20) unsigned not null,
+  table_name varbinary(128) not null,
+  kind varbinary(32) not null,
+  row_key varbinary(2000) not null,
+  primary key (id),
+  key vdiff_table_idx (vdiff_id, table_name))`
+)
+
+// The states of a vdiff job. A job starts as pending, and becomes
+// started once a tablet picks it up. A started job that gets interrupted
+// by a restart or a reparent goes back to being picked up from where it
+// left off. Stopped and errored jobs can be resumed.
+const (
+	StatePending   = "pending"
+	StateStarted   = "started"
+	StateStopped   = "stopped"
+	StateCompleted = "completed"
+	StateError     = "error"
+)
+
+// The kinds of mismatches recorded in _vt.vdiff_mismatch.
+const (
+	MismatchExtraSource = "extra_source"
+	MismatchExtraTarget = "extra_target"
+	MismatchContent     = "content"
+)
+
+const (
+	sqlSelectRunnableVDiffs = "select id, vdiff_uuid, workflow, options from _vt.vdiff where db_name=%s and state in ('pending', 'started')"
+	sqlSelectVDiffByUUID    = "select id, state from _vt.vdiff where vdiff_uuid=%s and db_name=%s"
+	sqlSelectVDiffByID      = "select id, vdiff_uuid, workflow, options from _vt.vdiff where id=%d"
+	sqlUpdateVDiffState     = "update _vt.vdiff set state=%s, last_error=%s where id=%d"
+	sqlUpdateVDiffStarted   = "update _vt.vdiff set state='started', started_at=ifnull(started_at, now()) where id=%d"
+	sqlUpdateVDiffCompleted = "update _vt.vdiff set state='completed', completed_at=now() where id=%d"
+
+	sqlSelectWorkflowStreams = "select id, source, pos from _vt.vreplication where db_name=%s and workflow=%s"
+	sqlStopWorkflowStreams   = "update _vt.vreplication set state='Stopped', message='for vdiff' where db_name=%s and workflow=%s"
+	sqlSyncStream            = "update _vt.vreplication set state='Running', stop_pos=%s, message='synchronizing for vdiff' where id=%d"
+	sqlRestartStreams        = "update _vt.vreplication set state='Running', message='', stop_pos='' where db_name=%s and workflow=%s"
+
+	sqlInsertVDiffTable    = "insert ignore into _vt.vdiff_table(vdiff_id, table_name, state) values (%d, %s, 'pending')"
+	sqlSelectVDiffTables   = "select table_name, state, lastpk, report from _vt.vdiff_table where vdiff_id=%d"
+	sqlUpdateTableState    = "update _vt.vdiff_table set state=%s where vdiff_id=%d and table_name=%s"
+	sqlUpdateTableProgress = "update _vt.vdiff_table set lastpk=%a, rows_compared=%a, mismatch=%a, report=%a where vdiff_id=%a and table_name=%a"
+	sqlInsertMismatch      = "insert into _vt.vdiff_mismatch(vdiff_id, table_name, kind, row_key) values (%a, %a, %a, %a)"
+)
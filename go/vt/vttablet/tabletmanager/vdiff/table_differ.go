@@ -0,0 +1,741 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+// DiffReport is the summary of differences for one table.
+// It is saved as JSON in _vt.vdiff_table.
+type DiffReport struct {
+	ProcessedRows   int64
+	MatchingRows    int64
+	MismatchedRows  int64
+	ExtraRowsSource int64
+	ExtraRowsTarget int64
+}
+
+// mismatch is a row that differs between the source and the target.
+type mismatch struct {
+	kind   string
+	rowKey string
+}
+
+// tableDiffer performs a diff for one table of the workflow.
+type tableDiffer struct {
+	table string
+	// sourceSelect and targetSelect are the select queries,
+	// without the condition that skips the rows before lastpk.
+	sourceSelect *sqlparser.Select
+	targetSelect *sqlparser.Select
+
+	// compareCols is the list of non-pk columns to compare.
+	// If the value is -1, it's a pk column and should not be
+	// compared.
+	compareCols []int
+	// comparePKs is the list of pk columns to compare. The logic
+	// for comparing pk columns is different from compareCols
+	comparePKs []int
+	// pkCols are the columns of the pk values, as opposed to their
+	// weight strings. They are used for the checkpoints and the
+	// row keys of the mismatches.
+	pkCols []int
+	// pkFields are the fields of the pk columns of the target table.
+	pkFields []*querypb.Field
+
+	// aggregates contains the list if Aggregate functions, if any.
+	aggregates []engine.AggregateParams
+
+	// keyrange is set if the source rows must be filtered to
+	// the key range of the target shard.
+	keyrange *keyrangeFilter
+}
+
+// keyrangeFilter keeps the source rows that map to a key range.
+// The vindex columns are appended to the source select list.
+type keyrangeFilter struct {
+	vindex   vindexes.Vindex
+	cols     []int
+	keyRange *topodatapb.KeyRange
+}
+
+// buildTableDiffer builds the tableDiffer for a table of the workflow. The query
+// is the select that was generated from the rule that matched the table.
+// ksSchema is the vschema of the source keyspace. It is needed to find the
+// vindex of the table if the in_keyrange of the query does not specify one.
+func buildTableDiffer(table *tabletmanagerdatapb.TableDefinition, query string, ksSchema *vindexes.KeyspaceSchema) (*tableDiffer, error) {
+	statement, err := sqlparser.Parse(query)
+	if err != nil {
+		return nil, err
+	}
+	sel, ok := statement.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+	}
+	td := &tableDiffer{
+		table: table.Name,
+	}
+	sourceSelect := &sqlparser.Select{}
+	targetSelect := &sqlparser.Select{}
+	for _, selExpr := range sel.SelectExprs {
+		switch selExpr := selExpr.(type) {
+		case *sqlparser.StarExpr:
+			// If it's a '*' expression, expand column list from the schema.
+			for _, fld := range table.Fields {
+				aliased := &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: sqlparser.NewColIdent(fld.Name)}}
+				sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, aliased)
+				targetSelect.SelectExprs = append(targetSelect.SelectExprs, aliased)
+			}
+		case *sqlparser.AliasedExpr:
+			var targetCol *sqlparser.ColName
+			if !selExpr.As.IsEmpty() {
+				targetCol = &sqlparser.ColName{Name: selExpr.As}
+			} else {
+				if colAs, ok := selExpr.Expr.(*sqlparser.ColName); ok {
+					targetCol = colAs
+				} else {
+					return nil, fmt.Errorf("expression needs an alias: %v", sqlparser.String(selExpr))
+				}
+			}
+			// If the input was "select a as b", then source will use "a" and target will use "b".
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, selExpr)
+			targetSelect.SelectExprs = append(targetSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: targetCol})
+
+			// Check if it's an aggregate expression
+			if expr, ok := selExpr.Expr.(*sqlparser.FuncExpr); ok {
+				switch fname := expr.Name.Lowered(); fname {
+				case "count", "sum":
+					td.aggregates = append(td.aggregates, engine.AggregateParams{
+						Opcode: engine.SupportedAggregates[fname],
+						Col:    len(sourceSelect.SelectExprs) - 1,
+					})
+				}
+			}
+		default:
+			return nil, fmt.Errorf("unexpected: %v", sqlparser.String(statement))
+		}
+	}
+	fields := make(map[string]*querypb.Field)
+	for _, field := range table.Fields {
+		fields[strings.ToLower(field.Name)] = field
+	}
+
+	// Start with adding all columns for comparison.
+	td.compareCols = make([]int, len(sourceSelect.SelectExprs))
+	for i := range td.compareCols {
+		colname := targetSelect.SelectExprs[i].(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName).Name.Lowered()
+		field, ok := fields[colname]
+		if !ok {
+			return nil, fmt.Errorf("column %v not found in table %v", colname, table.Name)
+		}
+		td.compareCols[i] = i
+		if sqltypes.IsText(field.Type) {
+			// For text columns, we need to additionally pull their weight string values for lexical comparisons.
+			sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, wrapWeightString(sourceSelect.SelectExprs[i]))
+			targetSelect.SelectExprs = append(targetSelect.SelectExprs, wrapWeightString(targetSelect.SelectExprs[i]))
+			// Update the column number to point at the weight_string column instead.
+			td.compareCols[i] = len(sourceSelect.SelectExprs) - 1
+		}
+	}
+
+	sourceSelect.From = sel.From
+	// The target table name should the one that matched the rule.
+	// It can be different from the source table.
+	targetSelect.From = sqlparser.TableExprs{
+		&sqlparser.AliasedTableExpr{
+			Expr: &sqlparser.TableName{
+				Name: sqlparser.NewTableIdent(table.Name),
+			},
+		},
+	}
+
+	orderby, err := td.findPKs(table, targetSelect, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	// The source rows that belong to the other target shards must
+	// be skipped. in_keyrange is not understood by mysql, so the
+	// rows are filtered by the differ instead.
+	if kr := findKeyrange(sel.Where); kr != nil {
+		if err := td.buildKeyrangeFilter(sourceSelect, kr.Exprs, ksSchema); err != nil {
+			return nil, err
+		}
+	}
+
+	sourceSelect.Where = removeKeyrange(sel.Where)
+	// The source should also perform the group by.
+	sourceSelect.GroupBy = sel.GroupBy
+	sourceSelect.OrderBy = orderby
+
+	// The target should perform the order by, but not the group by.
+	targetSelect.OrderBy = orderby
+
+	td.sourceSelect = sourceSelect
+	td.targetSelect = targetSelect
+	return td, nil
+}
+
+// findPKs identifies PKs and removes them from the columns to do data comparison
+func (td *tableDiffer) findPKs(table *tabletmanagerdatapb.TableDefinition, targetSelect *sqlparser.Select, fields map[string]*querypb.Field) (sqlparser.OrderBy, error) {
+	var orderby sqlparser.OrderBy
+	for _, pk := range table.PrimaryKeyColumns {
+		found := false
+		for i, selExpr := range targetSelect.SelectExprs {
+			colname := ""
+			if col, ok := selExpr.(*sqlparser.AliasedExpr).Expr.(*sqlparser.ColName); ok {
+				colname = col.Name.String()
+			}
+			if strings.EqualFold(pk, colname) {
+				td.comparePKs = append(td.comparePKs, td.compareCols[i])
+				td.pkCols = append(td.pkCols, i)
+				td.pkFields = append(td.pkFields, fields[strings.ToLower(pk)])
+				// We'll be comparing pks separately. So, remove them from compareCols.
+				td.compareCols[i] = -1
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("primary key column %v not found in the select list of table %v", pk, table.Name)
+		}
+		orderby = append(orderby, &sqlparser.Order{
+			Expr:      &sqlparser.ColName{Name: sqlparser.NewColIdent(pk)},
+			Direction: sqlparser.AscOrder,
+		})
+	}
+	if len(orderby) == 0 {
+		return nil, fmt.Errorf("table %v has no primary key", table.Name)
+	}
+	return orderby, nil
+}
+
+// buildKeyrangeFilter builds the filter for the arguments of in_keyrange, which can be
+// "in_keyrange('-80')" or "in_keyrange(col, 'hash', '-80')". The vindex columns are
+// appended to the source select list.
+func (td *tableDiffer) buildKeyrangeFilter(sourceSelect *sqlparser.Select, exprs sqlparser.SelectExprs, ksSchema *vindexes.KeyspaceSchema) error {
+	kf := &keyrangeFilter{}
+	var colnames []sqlparser.ColIdent
+	var krExpr sqlparser.SelectExpr
+	switch {
+	case len(exprs) == 1:
+		tableName := sqlparser.String(sourceSelect.From)
+		if ksSchema == nil || ksSchema.Tables[tableName] == nil || len(ksSchema.Tables[tableName].ColumnVindexes) == 0 {
+			return fmt.Errorf("could not find the primary vindex of table %s in the source keyspace", tableName)
+		}
+		cv := ksSchema.Tables[tableName].ColumnVindexes[0]
+		colnames = cv.Columns
+		kf.vindex = cv.Vindex
+		krExpr = exprs[0]
+	case len(exprs) >= 3:
+		for _, expr := range exprs[:len(exprs)-2] {
+			aexpr, ok := expr.(*sqlparser.AliasedExpr)
+			if !ok {
+				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			}
+			col, ok := aexpr.Expr.(*sqlparser.ColName)
+			if !ok {
+				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			}
+			colnames = append(colnames, col.Name)
+		}
+		vtype, err := selString(exprs[len(exprs)-2])
+		if err != nil {
+			return err
+		}
+		kf.vindex, err = vindexes.CreateVindex(vtype, vtype, map[string]string{})
+		if err != nil {
+			return err
+		}
+		krExpr = exprs[len(exprs)-1]
+	default:
+		return fmt.Errorf("unexpected in_keyrange parameters: %v", sqlparser.String(exprs))
+	}
+	spec, err := selString(krExpr)
+	if err != nil {
+		return err
+	}
+	keyranges, err := key.ParseShardingSpec(spec)
+	if err != nil {
+		return err
+	}
+	if len(keyranges) != 1 {
+		return fmt.Errorf("unexpected in_keyrange parameter: %v", sqlparser.String(krExpr))
+	}
+	if !key.KeyRangeIsPartial(keyranges[0]) {
+		// All the source rows belong to the target shard.
+		return nil
+	}
+	kf.keyRange = keyranges[0]
+	for _, colname := range colnames {
+		sourceSelect.SelectExprs = append(sourceSelect.SelectExprs, &sqlparser.AliasedExpr{Expr: &sqlparser.ColName{Name: colname}})
+		kf.cols = append(kf.cols, len(sourceSelect.SelectExprs)-1)
+	}
+	td.keyrange = kf
+	return nil
+}
+
+// sourceQuery returns the source query for the rows after lastpk.
+func (td *tableDiffer) sourceQuery(lastpk []sqltypes.Value) (string, error) {
+	return td.query(td.sourceSelect, lastpk)
+}
+
+// targetQuery returns the target query for the rows after lastpk.
+func (td *tableDiffer) targetQuery(lastpk []sqltypes.Value) (string, error) {
+	return td.query(td.targetSelect, lastpk)
+}
+
+// query adds a condition to the select that skips the rows up to and including
+// lastpk. For a composite pk of (1,2), the condition is:
+// (pk1 = 1 and pk2 > 2) or (pk1 > 1)
+func (td *tableDiffer) query(sel *sqlparser.Select, lastpk []sqltypes.Value) (string, error) {
+	if len(lastpk) == 0 {
+		return sqlparser.String(sel), nil
+	}
+	if len(lastpk) != len(td.pkCols) {
+		return "", fmt.Errorf("primary key values don't match length: %v vs %v", lastpk, td.pkFields)
+	}
+	var cond sqlparser.Expr
+	bindVars := make(map[string]*querypb.BindVariable)
+	for lastcol := len(td.pkCols) - 1; lastcol >= 0; lastcol-- {
+		var colCond sqlparser.Expr
+		for i, col := range td.pkCols[:lastcol+1] {
+			op := sqlparser.EqualOp
+			if i == lastcol {
+				op = sqlparser.GreaterThanOp
+			}
+			name := fmt.Sprintf("lastpk%d", i)
+			bindVars[name] = sqltypes.ValueBindVariable(lastpk[i])
+			cmp := &sqlparser.ComparisonExpr{
+				Operator: op,
+				Left:     sel.SelectExprs[col].(*sqlparser.AliasedExpr).Expr,
+				Right:    sqlparser.NewArgument([]byte(":" + name)),
+			}
+			if colCond == nil {
+				colCond = cmp
+			} else {
+				colCond = &sqlparser.AndExpr{Left: colCond, Right: cmp}
+			}
+		}
+		if cond == nil {
+			cond = colCond
+		} else {
+			cond = &sqlparser.OrExpr{Left: cond, Right: colCond}
+		}
+	}
+
+	where := sel.Where
+	defer func() { sel.Where = where }()
+	if where == nil {
+		sel.Where = &sqlparser.Where{Type: sqlparser.WhereClause, Expr: cond}
+	} else {
+		sel.Where = &sqlparser.Where{Type: sqlparser.WhereClause, Expr: &sqlparser.AndExpr{Left: where.Expr, Right: cond}}
+	}
+	buf := sqlparser.NewTrackedBuffer(nil)
+	buf.Myprintf("%v", sel)
+	return buf.ParsedQuery().GenerateQuery(bindVars, nil)
+}
+
+// inKeyrange returns true if the source row belongs to the target shard.
+func (td *tableDiffer) inKeyrange(row []sqltypes.Value) (bool, error) {
+	if td.keyrange == nil {
+		return true, nil
+	}
+	values := make([]sqltypes.Value, 0, len(td.keyrange.cols))
+	for _, col := range td.keyrange.cols {
+		values = append(values, row[col])
+	}
+	destinations, err := vindexes.Map(td.keyrange.vindex, nil, [][]sqltypes.Value{values})
+	if err != nil {
+		return false, err
+	}
+	if len(destinations) != 1 {
+		return false, fmt.Errorf("mapping row to keyspace id returned an invalid array of destinations: %v", key.DestinationsString(destinations))
+	}
+	ksid, ok := destinations[0].(key.DestinationKeyspaceID)
+	if !ok || len(ksid) == 0 {
+		return false, fmt.Errorf("could not map %v to a keyspace id, got destination %v", values, destinations[0])
+	}
+	return key.KeyRangeContains(td.keyrange.keyRange, ksid), nil
+}
+
+// newMergeSorter creates an engine.MergeSort based on the shard streamers and pk columns.
+func newMergeSorter(participants []*shardStreamer, comparePKs []int) *engine.MergeSort {
+	prims := make([]engine.StreamExecutor, 0, len(participants))
+	for _, participant := range participants {
+		prims = append(prims, participant)
+	}
+	ob := make([]engine.OrderbyParams, 0, len(comparePKs))
+	for _, cpk := range comparePKs {
+		ob = append(ob, engine.OrderbyParams{Col: cpk})
+	}
+	return &engine.MergeSort{
+		Primitives: prims,
+		OrderBy:    ob,
+	}
+}
+
+// sourcePrimitive returns the primitive that merges the rows of the sources.
+func (td *tableDiffer) sourcePrimitive(sources []*shardStreamer) engine.Primitive {
+	var prim engine.Primitive = newMergeSorter(sources, td.comparePKs)
+	// If there were aggregate expressions, we have to re-aggregate
+	// the results, which engine.OrderedAggregate can do.
+	if len(td.aggregates) != 0 {
+		prim = &engine.OrderedAggregate{
+			Aggregates: td.aggregates,
+			Keys:       td.comparePKs,
+			Input:      prim,
+		}
+	}
+	return prim
+}
+
+// diff compares the source rows with the target rows, starting with the
+// counts of dr. The rows of both sides are ordered by pk. checkpoint is
+// invoked every checkpointRows rows, and once more at the end, with the
+// pk of the last row compared on both sides and the mismatches found
+// since the previous checkpoint. If maxRows is positive, the diff stops
+// once dr counts maxRows processed rows. Only the first maxMismatches
+// mismatches are reported, the rest are only counted.
+func (td *tableDiffer) diff(ctx context.Context, source, target engine.Primitive, dr *DiffReport, maxRows, maxMismatches int64, checkpointRows int64, checkpoint func(lastpk []sqltypes.Value, mismatches []mismatch) error) error {
+	sourceExecutor := newPrimitiveExecutor(ctx, source)
+	targetExecutor := newPrimitiveExecutor(ctx, target)
+	var mismatches []mismatch
+	reported := dr.MismatchedRows + dr.ExtraRowsSource + dr.ExtraRowsTarget
+	report := func(kind string, row []sqltypes.Value) {
+		if reported >= maxMismatches {
+			return
+		}
+		reported++
+		mismatches = append(mismatches, mismatch{kind: kind, rowKey: td.rowKey(row)})
+	}
+	var lastRow []sqltypes.Value
+	var sourceRow, targetRow []sqltypes.Value
+	var err error
+	advanceSource := true
+	advanceTarget := true
+	sinceCheckpoint := int64(0)
+	for {
+		if sinceCheckpoint >= checkpointRows {
+			if err := checkpoint(td.lastPK(lastRow), mismatches); err != nil {
+				return err
+			}
+			sinceCheckpoint = 0
+			mismatches = nil
+		}
+		if maxRows > 0 && dr.ProcessedRows >= maxRows {
+			return checkpoint(td.lastPK(lastRow), mismatches)
+		}
+		if advanceSource {
+			sourceRow, err = sourceExecutor.next()
+			if err != nil {
+				return err
+			}
+		}
+		if advanceTarget {
+			targetRow, err = targetExecutor.next()
+			if err != nil {
+				return err
+			}
+		}
+
+		if sourceRow == nil && targetRow == nil {
+			return checkpoint(td.lastPK(lastRow), mismatches)
+		}
+
+		advanceSource = true
+		advanceTarget = true
+		dr.ProcessedRows++
+		sinceCheckpoint++
+
+		if sourceRow == nil {
+			dr.ExtraRowsTarget++
+			report(MismatchExtraTarget, targetRow)
+			lastRow = targetRow
+			advanceSource = false
+			continue
+		}
+		if targetRow == nil {
+			dr.ExtraRowsSource++
+			report(MismatchExtraSource, sourceRow)
+			lastRow = sourceRow
+			advanceTarget = false
+			continue
+		}
+
+		// Compare pk values.
+		c, err := td.compare(sourceRow, targetRow, td.comparePKs)
+		switch {
+		case err != nil:
+			return err
+		case c < 0:
+			dr.ExtraRowsSource++
+			report(MismatchExtraSource, sourceRow)
+			lastRow = sourceRow
+			advanceTarget = false
+			continue
+		case c > 0:
+			dr.ExtraRowsTarget++
+			report(MismatchExtraTarget, targetRow)
+			lastRow = targetRow
+			advanceSource = false
+			continue
+		}
+
+		// c == 0
+		// Compare non-pk values.
+		lastRow = targetRow
+		c, err = td.compare(sourceRow, targetRow, td.compareCols)
+		switch {
+		case err != nil:
+			return err
+		case c != 0:
+			dr.MismatchedRows++
+			report(MismatchContent, targetRow)
+		default:
+			dr.MatchingRows++
+		}
+	}
+}
+
+func (td *tableDiffer) compare(sourceRow, targetRow []sqltypes.Value, cols []int) (int, error) {
+	for _, col := range cols {
+		if col == -1 {
+			continue
+		}
+		c, err := evalengine.NullsafeCompare(sourceRow[col], targetRow[col])
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+// lastPK returns the pk values of the row. The pk columns
+// are at the same position in the source and target rows.
+func (td *tableDiffer) lastPK(row []sqltypes.Value) []sqltypes.Value {
+	if row == nil {
+		return nil
+	}
+	lastpk := make([]sqltypes.Value, 0, len(td.pkCols))
+	for _, col := range td.pkCols {
+		lastpk = append(lastpk, row[col])
+	}
+	return lastpk
+}
+
+// rowKey formats the pk values of the row, like "id=1, name='a'".
+func (td *tableDiffer) rowKey(row []sqltypes.Value) string {
+	var buf strings.Builder
+	for i, col := range td.pkCols {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(td.pkFields[i].Name)
+		buf.WriteString("=")
+		row[col].EncodeSQL(&buf)
+	}
+	return buf.String()
+}
+
+//-----------------------------------------------------------------
+// shardStreamer
+
+// shardStreamer streams rows from one shard. This works for
+// the source as well as the target.
+// shardStreamer satisfies engine.StreamExecutor, and can be
+// added to Primitives of engine.MergeSort.
+type shardStreamer struct {
+	// filter is set for the sources. It returns false
+	// for the rows that belong to other target shards.
+	filter func(row []sqltypes.Value) (bool, error)
+	result chan *sqltypes.Result
+	err    error
+}
+
+// StreamExecute satisfies engine.StreamExecutor.
+func (sm *shardStreamer) StreamExecute(vcursor engine.VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	for result := range sm.result {
+		if sm.filter != nil {
+			rows := result.Rows[:0]
+			for _, row := range result.Rows {
+				ok, err := sm.filter(row)
+				if err != nil {
+					return err
+				}
+				if ok {
+					rows = append(rows, row)
+				}
+			}
+			result.Rows = rows
+		}
+		if err := callback(result); err != nil {
+			return err
+		}
+	}
+	return sm.err
+}
+
+//-----------------------------------------------------------------
+// primitiveExecutor
+
+// primitiveExecutor starts execution on the top level primitive
+// and provides convenience functions for row-by-row iteration.
+type primitiveExecutor struct {
+	prim     engine.Primitive
+	rows     [][]sqltypes.Value
+	resultch chan *sqltypes.Result
+	err      error
+}
+
+func newPrimitiveExecutor(ctx context.Context, prim engine.Primitive) *primitiveExecutor {
+	pe := &primitiveExecutor{
+		prim:     prim,
+		resultch: make(chan *sqltypes.Result, 1),
+	}
+	vcursor := &contextVCursor{ctx: ctx}
+	go func() {
+		defer close(pe.resultch)
+		pe.err = pe.prim.StreamExecute(vcursor, make(map[string]*querypb.BindVariable), false, func(qr *sqltypes.Result) error {
+			select {
+			case pe.resultch <- qr:
+			case <-ctx.Done():
+				return vterrors.Wrap(ctx.Err(), "Outer Stream")
+			}
+			return nil
+		})
+	}()
+	return pe
+}
+
+func (pe *primitiveExecutor) next() ([]sqltypes.Value, error) {
+	for len(pe.rows) == 0 {
+		qr, ok := <-pe.resultch
+		if !ok {
+			return nil, pe.err
+		}
+		pe.rows = qr.Rows
+	}
+
+	row := pe.rows[0]
+	pe.rows = pe.rows[1:]
+	return row, nil
+}
+
+//-----------------------------------------------------------------
+// contextVCursor
+
+// contextVCursor satisfies VCursor, but only implements Context().
+// MergeSort only requires Context to be implemented.
+type contextVCursor struct {
+	engine.VCursor
+	ctx context.Context
+}
+
+func (vc *contextVCursor) Context() context.Context {
+	return vc.ctx
+}
+
+//-----------------------------------------------------------------
+// Utility functions
+
+func findKeyrange(where *sqlparser.Where) *sqlparser.FuncExpr {
+	if where == nil {
+		return nil
+	}
+	for _, expr := range sqlparser.SplitAndExpression(nil, where.Expr) {
+		if isFuncKeyrange(expr) {
+			return expr.(*sqlparser.FuncExpr)
+		}
+	}
+	return nil
+}
+
+func removeKeyrange(where *sqlparser.Where) *sqlparser.Where {
+	if where == nil {
+		return nil
+	}
+	if isFuncKeyrange(where.Expr) {
+		return nil
+	}
+	where.Expr = removeExprKeyrange(where.Expr)
+	return where
+}
+
+func removeExprKeyrange(node sqlparser.Expr) sqlparser.Expr {
+	switch node := node.(type) {
+	case *sqlparser.AndExpr:
+		if isFuncKeyrange(node.Left) {
+			return removeExprKeyrange(node.Right)
+		}
+		if isFuncKeyrange(node.Right) {
+			return removeExprKeyrange(node.Left)
+		}
+		return &sqlparser.AndExpr{
+			Left:  removeExprKeyrange(node.Left),
+			Right: removeExprKeyrange(node.Right),
+		}
+	}
+	return node
+}
+
+func isFuncKeyrange(expr sqlparser.Expr) bool {
+	funcExpr, ok := expr.(*sqlparser.FuncExpr)
+	return ok && funcExpr.Name.EqualString("in_keyrange")
+}
+
+func selString(expr sqlparser.SelectExpr) (string, error) {
+	aexpr, ok := expr.(*sqlparser.AliasedExpr)
+	if !ok {
+		return "", fmt.Errorf("unsupported: %v", sqlparser.String(expr))
+	}
+	val, ok := aexpr.Expr.(*sqlparser.Literal)
+	if !ok || val.Type != sqlparser.StrVal {
+		return "", fmt.Errorf("unsupported: %v", sqlparser.String(expr))
+	}
+	return string(val.Val), nil
+}
+
+func wrapWeightString(expr sqlparser.SelectExpr) *sqlparser.AliasedExpr {
+	return &sqlparser.AliasedExpr{
+		Expr: &sqlparser.FuncExpr{
+			Name: sqlparser.NewColIdent("weight_string"),
+			Exprs: []sqlparser.SelectExpr{
+				&sqlparser.AliasedExpr{
+					Expr: expr.(*sqlparser.AliasedExpr).Expr,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,342 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vdiff
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+var testTables = map[string]*tabletmanagerdatapb.TableDefinition{
+	"t1": {
+		Name:              "t1",
+		Columns:           []string{"c1", "c2"},
+		PrimaryKeyColumns: []string{"c1"},
+		Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+	},
+	"nonpktext": {
+		Name:              "nonpktext",
+		Columns:           []string{"c1", "textcol"},
+		PrimaryKeyColumns: []string{"c1"},
+		Fields:            sqltypes.MakeTestFields("c1|textcol", "int64|varchar"),
+	},
+	"multipk": {
+		Name:              "multipk",
+		Columns:           []string{"c1", "c2", "c3"},
+		PrimaryKeyColumns: []string{"c1", "c2"},
+		Fields:            sqltypes.MakeTestFields("c1|c2|c3", "int64|int64|int64"),
+	},
+	"aggr": {
+		Name:              "aggr",
+		Columns:           []string{"c1", "c2", "c3"},
+		PrimaryKeyColumns: []string{"c1"},
+		Fields:            sqltypes.MakeTestFields("c1|c2|c3", "int64|int64|int64"),
+	},
+}
+
+func TestBuildTableDiffer(t *testing.T) {
+	testcases := []struct {
+		table       string
+		query       string
+		sourceQuery string
+		targetQuery string
+		compareCols []int
+		comparePKs  []int
+		pkCols      []int
+		err         string
+	}{{
+		table:       "t1",
+		query:       "select * from t1",
+		sourceQuery: "select c1, c2 from t1 order by c1 asc",
+		targetQuery: "select c1, c2 from t1 order by c1 asc",
+		compareCols: []int{-1, 1},
+		comparePKs:  []int{0},
+		pkCols:      []int{0},
+	}, {
+		table:       "t1",
+		query:       "select c2, c1 from t1",
+		sourceQuery: "select c2, c1 from t1 order by c1 asc",
+		targetQuery: "select c2, c1 from t1 order by c1 asc",
+		compareCols: []int{0, -1},
+		comparePKs:  []int{1},
+		pkCols:      []int{1},
+	}, {
+		table:       "t1",
+		query:       "select c1, c2+1 as c2 from t1 where in_keyrange('-80')",
+		sourceQuery: "select c1, c2 + 1 as c2 from t1 order by c1 asc",
+		targetQuery: "select c1, c2 from t1 order by c1 asc",
+		compareCols: []int{-1, 1},
+		comparePKs:  []int{0},
+		pkCols:      []int{0},
+	}, {
+		table:       "nonpktext",
+		query:       "select * from nonpktext",
+		sourceQuery: "select c1, textcol, weight_string(textcol) from nonpktext order by c1 asc",
+		targetQuery: "select c1, textcol, weight_string(textcol) from nonpktext order by c1 asc",
+		compareCols: []int{-1, 2},
+		comparePKs:  []int{0},
+		pkCols:      []int{0},
+	}, {
+		table:       "multipk",
+		query:       "select * from multipk",
+		sourceQuery: "select c1, c2, c3 from multipk order by c1 asc, c2 asc",
+		targetQuery: "select c1, c2, c3 from multipk order by c1 asc, c2 asc",
+		compareCols: []int{-1, -1, 2},
+		comparePKs:  []int{0, 1},
+		pkCols:      []int{0, 1},
+	}, {
+		table:       "aggr",
+		query:       "select c1, c2, count(*) as c3 from aggr group by c1",
+		sourceQuery: "select c1, c2, count(*) as c3 from aggr group by c1 order by c1 asc",
+		targetQuery: "select c1, c2, c3 from aggr order by c1 asc",
+		compareCols: []int{-1, 1, 2},
+		comparePKs:  []int{0},
+		pkCols:      []int{0},
+	}, {
+		table: "t1",
+		query: "select c2 from t1",
+		err:   "primary key column c1 not found in the select list of table t1",
+	}, {
+		table: "t1",
+		query: "select c1, c2+1 from t1",
+		err:   "expression needs an alias: c2 + 1",
+	}, {
+		table: "t1",
+		query: "select c1, c3 from t1",
+		err:   "column c3 not found in table t1",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			td, err := buildTableDiffer(testTables[tcase.table], tcase.query, testKeyspaceSchema(t))
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			sourceQuery, err := td.sourceQuery(nil)
+			require.NoError(t, err)
+			targetQuery, err := td.targetQuery(nil)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.targetQuery, targetQuery)
+			assert.Equal(t, tcase.compareCols, td.compareCols)
+			assert.Equal(t, tcase.comparePKs, td.comparePKs)
+			assert.Equal(t, tcase.pkCols, td.pkCols)
+			if td.keyrange == nil {
+				assert.Equal(t, tcase.sourceQuery, sourceQuery)
+			}
+		})
+	}
+}
+
+func TestTableDifferLastPK(t *testing.T) {
+	td, err := buildTableDiffer(testTables["multipk"], "select * from multipk where c3 > 0", nil)
+	require.NoError(t, err)
+
+	lastpk := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}
+	sourceQuery, err := td.sourceQuery(lastpk)
+	require.NoError(t, err)
+	assert.Equal(t, "select c1, c2, c3 from multipk where c3 > 0 and (c1 = 1 and c2 > 2 or c1 > 1) order by c1 asc, c2 asc", sourceQuery)
+	targetQuery, err := td.targetQuery(lastpk)
+	require.NoError(t, err)
+	assert.Equal(t, "select c1, c2, c3 from multipk where c1 = 1 and c2 > 2 or c1 > 1 order by c1 asc, c2 asc", targetQuery)
+
+	// The selects must not be modified.
+	sourceQuery, err = td.sourceQuery(nil)
+	require.NoError(t, err)
+	assert.Equal(t, "select c1, c2, c3 from multipk where c3 > 0 order by c1 asc, c2 asc", sourceQuery)
+
+	encoded, err := encodeLastPK(td.pkFields, lastpk)
+	require.NoError(t, err)
+	decoded, err := decodeLastPK(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, lastpk, decoded)
+}
+
+func TestTableDifferKeyrange(t *testing.T) {
+	ksSchema := testKeyspaceSchema(t)
+	testcases := []struct {
+		query       string
+		sourceQuery string
+		inKeyrange  []int64
+		err         string
+	}{{
+		query:       "select * from t1 where in_keyrange('-80')",
+		sourceQuery: "select c1, c2, c1 from t1 order by c1 asc",
+		// hash(1) and hash(2) are in -80, hash(4) is in 80-.
+		inKeyrange: []int64{1, 2},
+	}, {
+		query:       "select * from t1 where in_keyrange(c1, 'hash', '-80')",
+		sourceQuery: "select c1, c2, c1 from t1 order by c1 asc",
+		inKeyrange:  []int64{1, 2},
+	}, {
+		query:       "select * from t1 where in_keyrange('-')",
+		sourceQuery: "select c1, c2 from t1 order by c1 asc",
+		inKeyrange:  []int64{1, 2, 4},
+	}, {
+		query: "select * from multipk where in_keyrange('-80')",
+		err:   "could not find the primary vindex of table multipk in the source keyspace",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			table := "t1"
+			if tcase.err != "" {
+				table = "multipk"
+			}
+			td, err := buildTableDiffer(testTables[table], tcase.query, ksSchema)
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			sourceQuery, err := td.sourceQuery(nil)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.sourceQuery, sourceQuery)
+
+			var got []int64
+			for _, id := range []int64{1, 2, 4} {
+				ok, err := td.inKeyrange([]sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewInt64(0), sqltypes.NewInt64(id)})
+				require.NoError(t, err)
+				if ok {
+					got = append(got, id)
+				}
+			}
+			assert.Equal(t, tcase.inKeyrange, got)
+		})
+	}
+}
+
+func TestTableDifferDiff(t *testing.T) {
+	td, err := buildTableDiffer(testTables["t1"], "select * from t1", nil)
+	require.NoError(t, err)
+
+	fields := sqltypes.MakeTestFields("c1|c2", "int64|int64")
+	source := newTestStreamer(sqltypes.MakeTestResult(fields, "1|1", "2|2", "3|3", "5|5"))
+	target := newTestStreamer(sqltypes.MakeTestResult(fields, "1|1", "2|3", "4|4", "5|5"))
+
+	type checkpoint struct {
+		lastpk     []sqltypes.Value
+		mismatches []mismatch
+	}
+	var checkpoints []checkpoint
+	dr := &DiffReport{}
+	err = td.diff(context.Background(), td.sourcePrimitive([]*shardStreamer{source}), newMergeSorter([]*shardStreamer{target}, td.comparePKs), dr, 0, 2, 2, func(lastpk []sqltypes.Value, mismatches []mismatch) error {
+		checkpoints = append(checkpoints, checkpoint{lastpk: lastpk, mismatches: mismatches})
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &DiffReport{
+		ProcessedRows:   5,
+		MatchingRows:    2,
+		MismatchedRows:  1,
+		ExtraRowsSource: 1,
+		ExtraRowsTarget: 1,
+	}, dr)
+	// Only the first two mismatches are reported.
+	assert.Equal(t, []checkpoint{{
+		lastpk:     []sqltypes.Value{sqltypes.NewInt64(2)},
+		mismatches: []mismatch{{kind: MismatchContent, rowKey: "c1=2"}},
+	}, {
+		lastpk:     []sqltypes.Value{sqltypes.NewInt64(4)},
+		mismatches: []mismatch{{kind: MismatchExtraSource, rowKey: "c1=3"}},
+	}, {
+		lastpk: []sqltypes.Value{sqltypes.NewInt64(5)},
+	}}, checkpoints)
+
+	// The diff stops after maxRows rows.
+	source = newTestStreamer(sqltypes.MakeTestResult(fields, "1|1", "2|2", "3|3", "5|5"))
+	target = newTestStreamer(sqltypes.MakeTestResult(fields, "1|1", "2|3", "4|4", "5|5"))
+	checkpoints = nil
+	dr = &DiffReport{}
+	err = td.diff(context.Background(), td.sourcePrimitive([]*shardStreamer{source}), newMergeSorter([]*shardStreamer{target}, td.comparePKs), dr, 3, 10, 2, func(lastpk []sqltypes.Value, mismatches []mismatch) error {
+		checkpoints = append(checkpoints, checkpoint{lastpk: lastpk, mismatches: mismatches})
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &DiffReport{
+		ProcessedRows:   3,
+		MatchingRows:    1,
+		MismatchedRows:  1,
+		ExtraRowsSource: 1,
+	}, dr)
+	assert.Equal(t, []checkpoint{{
+		lastpk:     []sqltypes.Value{sqltypes.NewInt64(2)},
+		mismatches: []mismatch{{kind: MismatchContent, rowKey: "c1=2"}},
+	}, {
+		lastpk:     []sqltypes.Value{sqltypes.NewInt64(3)},
+		mismatches: []mismatch{{kind: MismatchExtraSource, rowKey: "c1=3"}},
+	}}, checkpoints)
+}
+
+func TestTableQuery(t *testing.T) {
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:  "t1",
+			Filter: "-80",
+		}, {
+			Match:  "multipk",
+			Filter: "exclude",
+		}, {
+			Match:  "/.*",
+			Filter: "",
+		}},
+	}
+	query, err := tableQuery(testTables["t1"], filter)
+	require.NoError(t, err)
+	assert.Equal(t, "select * from t1 where in_keyrange('-80')", query)
+	query, err = tableQuery(testTables["multipk"], filter)
+	require.NoError(t, err)
+	assert.Equal(t, "", query)
+	query, err = tableQuery(testTables["aggr"], filter)
+	require.NoError(t, err)
+	assert.Equal(t, "select * from aggr", query)
+}
+
+func newTestStreamer(result *sqltypes.Result) *shardStreamer {
+	sm := &shardStreamer{result: make(chan *sqltypes.Result, 1)}
+	sm.result <- result
+	close(sm.result)
+	return sm
+}
+
+func testKeyspaceSchema(t *testing.T) *vindexes.KeyspaceSchema {
+	t.Helper()
+	ksSchema, err := vindexes.BuildKeyspaceSchema(&vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {Type: "hash"},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{
+					Column: "c1",
+					Name:   "hash",
+				}},
+			},
+		},
+	}, "ks")
+	require.NoError(t, err)
+	return ksSchema
+}
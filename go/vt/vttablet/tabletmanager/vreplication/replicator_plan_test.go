@@ -514,6 +514,56 @@ func TestBuildPlayerPlan(t *testing.T) {
 				},
 			},
 		},
+	}, {
+		// renames, conversions, functions and constants
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select a as c1, cast(b as char) as c2, concat(c, '-', d) as c3, if(e > 1, 'x', 'y') as c4, coalesce(f, 0) as c5, 'k' as c6 from t2",
+			}},
+		},
+		plan: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select a, b, c, d, e, f from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"a"},
+					InsertFront:  "insert into t1(c1,c2,c3,c4,c5,c6)",
+					InsertValues: "(:a_a,convert(:a_b, char),concat(:a_c, '-', :a_d),if(:a_e > 1, 'x', 'y'),coalesce(:a_f, 0),'k')",
+					Insert:       "insert into t1(c1,c2,c3,c4,c5,c6) values (:a_a,convert(:a_b, char),concat(:a_c, '-', :a_d),if(:a_e > 1, 'x', 'y'),coalesce(:a_f, 0),'k')",
+					Update:       "update t1 set c2=convert(:a_b, char), c3=concat(:a_c, '-', :a_d), c4=if(:a_e > 1, 'x', 'y'), c5=coalesce(:a_f, 0), c6='k' where c1=:b_a",
+					Delete:       "delete from t1 where c1=:b_a",
+				},
+			},
+		},
+		planpk: &TestReplicatorPlan{
+			VStreamFilter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t2",
+					Filter: "select a, b, c, d, e, f, pk1, pk2 from t2",
+				}},
+			},
+			TargetTables: []string{"t1"},
+			TablePlans: map[string]*TestTablePlan{
+				"t2": {
+					TargetName:   "t1",
+					SendRule:     "t2",
+					PKReferences: []string{"a", "pk1", "pk2"},
+					InsertFront:  "insert into t1(c1,c2,c3,c4,c5,c6)",
+					InsertValues: "(:a_a,convert(:a_b, char),concat(:a_c, '-', :a_d),if(:a_e > 1, 'x', 'y'),coalesce(:a_f, 0),'k')",
+					Insert:       "insert into t1(c1,c2,c3,c4,c5,c6) select :a_a, convert(:a_b, char), concat(:a_c, '-', :a_d), if(:a_e > 1, 'x', 'y'), coalesce(:a_f, 0), 'k' from dual where (:a_pk1,:a_pk2) <= (1,'aaa')",
+					Update:       "update t1 set c2=convert(:a_b, char), c3=concat(:a_c, '-', :a_d), c4=if(:a_e > 1, 'x', 'y'), c5=coalesce(:a_f, 0), c6='k' where c1=:b_a and (:b_pk1,:b_pk2) <= (1,'aaa')",
+					Delete:       "delete from t1 where c1=:b_a and (:b_pk1,:b_pk2) <= (1,'aaa')",
+				},
+			},
+		},
 	}, {
 		// syntax error
 		input: &binlogdatapb.Filter{
@@ -667,6 +717,33 @@ func TestBuildPlayerPlan(t *testing.T) {
 			}},
 		},
 		err: "group by expression is not allowed to reference an aggregate expression: a",
+	}, {
+		// non-deterministic function
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, now() as c2 from t1",
+			}},
+		},
+		err: "non-deterministic function is not supported: now()",
+	}, {
+		// non-deterministic time function
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, current_timestamp(3) as c2 from t1",
+			}},
+		},
+		err: "non-deterministic function is not supported: current_timestamp(3)",
+	}, {
+		// column selected twice
+		input: &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{
+				Match:  "t1",
+				Filter: "select c1, a as c1 from t1",
+			}},
+		},
+		err: "column c1 is selected more than once",
 	}}
 
 	PrimaryKeyInfos := map[string][]*PrimaryKeyInfo{
@@ -291,6 +291,52 @@ func (st *vrStats) register() {
 			return result
 		})
 
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationTableCopyRowCount",
+		"vreplication rows copied in copy phase per stream and table",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts", "table"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				for table, count := range ct.blpStats.TableCopyRowCounts.Counts() {
+					result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)+"."+table] = count
+				}
+			}
+			return result
+		})
+
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationTableCopyTimings",
+		"vreplication time spent copying in copy phase per stream and table",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts", "table"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				for table, t := range ct.blpStats.TableCopyTimings.Histograms() {
+					result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)+"."+table] = t.Total()
+				}
+			}
+			return result
+		})
+
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationCopyRowsPerSecond",
+		"vreplication rows copied per second in copy phase per stream",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)] = ct.blpStats.CopyRowsPerSecond()
+			}
+			return result
+		})
+
 	stats.NewGaugesFuncWithMultiLabels(
 		"VReplicationCopyLoopCount",
 		"Number of times the copy phase looped per stream",
@@ -392,6 +438,8 @@ func (st *vrStats) status() *EngineStatus {
 			PhaseTimings:        ct.blpStats.PhaseTimings.Counts(),
 			CopyRowCount:        ct.blpStats.CopyRowCount.Get(),
 			CopyLoopCount:       ct.blpStats.CopyLoopCount.Get(),
+			TableCopyRowCounts:  ct.blpStats.TableCopyRowCounts.Counts(),
+			CopyRowsPerSecond:   ct.blpStats.CopyRowsPerSecond(),
 			NoopQueryCounts:     ct.blpStats.NoopQueryCount.Counts(),
 		}
 		i++
@@ -424,6 +472,8 @@ type ControllerStatus struct {
 	PhaseTimings        map[string]int64
 	CopyRowCount        int64
 	CopyLoopCount       int64
+	TableCopyRowCounts  map[string]int64
+	CopyRowsPerSecond   int64
 	NoopQueryCounts     map[string]int64
 }
 
@@ -160,6 +160,14 @@ func TestVReplicationStats(t *testing.T) {
 	blpStats.CopyRowCount.Add(200)
 	require.Equal(t, int64(100), testStats.status().Controllers[0].CopyLoopCount)
 	require.Equal(t, int64(200), testStats.status().Controllers[0].CopyRowCount)
+	// The copy phase has run for less than a second.
+	require.Equal(t, int64(0), testStats.status().Controllers[0].CopyRowsPerSecond)
+	blpStats.PhaseTimings.Add("copy", 2*time.Second)
+	require.InDelta(t, 100, testStats.status().Controllers[0].CopyRowsPerSecond, 1)
+
+	blpStats.TableCopyRowCounts.Add("t1", 150)
+	blpStats.TableCopyRowCounts.Add("t2", 50)
+	require.Equal(t, map[string]int64{"t1": 150, "t2": 50}, testStats.status().Controllers[0].TableCopyRowCounts)
 
 	var tm int64 = 1234567890
 	blpStats.RecordHeartbeat(tm)
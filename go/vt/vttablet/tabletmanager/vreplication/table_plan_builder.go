@@ -87,6 +87,34 @@ const (
 	opSum
 )
 
+// nonDeterministicFuncs are the functions that can't be used in a
+// filter: the expressions are evaluated by the target when the rows are
+// applied, and these functions would yield different values for the rows
+// applied by the copy and the replication phases, or by different shards.
+var nonDeterministicFuncs = map[string]bool{
+	"connection_id":     true,
+	"curdate":           true,
+	"current_date":      true,
+	"current_time":      true,
+	"current_timestamp": true,
+	"current_user":      true,
+	"curtime":           true,
+	"found_rows":        true,
+	"last_insert_id":    true,
+	"localtime":         true,
+	"localtimestamp":    true,
+	"now":               true,
+	"rand":              true,
+	"row_count":         true,
+	"sysdate":           true,
+	"user":              true,
+	"utc_date":          true,
+	"utc_time":          true,
+	"utc_timestamp":     true,
+	"uuid":              true,
+	"uuid_short":        true,
+}
+
 // insertType describes the type of insert statement to generate.
 // Please refer to TestBuildPlayerPlan for examples.
 type insertType int
@@ -341,11 +369,20 @@ func (tpb *tablePlanBuilder) analyzeExprs(selExprs sqlparser.SelectExprs) error
 		if err != nil {
 			return err
 		}
+		if tpb.findCol(cexpr.colName) != nil {
+			return fmt.Errorf("column %v is selected more than once", sqlparser.String(cexpr.colName))
+		}
 		tpb.colExprs = append(tpb.colExprs, cexpr)
 	}
 	return nil
 }
 
+// analyzeExpr builds the colExpr of a target column. Besides the supported
+// aggregates and keyspace_id(), the expression can be any expression of the
+// source columns, like a rename, a cast, a function call like concat, if or
+// coalesce, or a constant. The source columns are requested from the source,
+// and the expression is evaluated by the target when rows are copied or
+// replicated.
 func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr, error) {
 	aliased, ok := selExpr.(*sqlparser.AliasedExpr)
 	if !ok {
@@ -421,6 +458,12 @@ func (tpb *tablePlanBuilder) analyzeExpr(selExpr sqlparser.SelectExpr) (*colExpr
 			if node.IsAggregate() {
 				return false, fmt.Errorf("unexpected: %v", sqlparser.String(node))
 			}
+			fname := node.Name.Lowered()
+			if nonDeterministicFuncs[fname] || (fname == "unix_timestamp" && len(node.Exprs) == 0) {
+				return false, fmt.Errorf("non-deterministic function is not supported: %v", sqlparser.String(node))
+			}
+		case *sqlparser.CurTimeFuncExpr:
+			return false, fmt.Errorf("non-deterministic function is not supported: %v", sqlparser.String(node))
 		}
 		return true, nil
 	}, aliased.Expr)
@@ -196,6 +196,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	defer vc.vr.dbClient.Rollback()
 	defer vc.vr.stats.PhaseTimings.Record("copy", time.Now())
 	defer vc.vr.stats.CopyLoopCount.Add(1)
+	defer vc.vr.stats.TableCopyTimings.Record(tableName, time.Now())
 
 	log.Infof("Copying table %s, lastpk: %v", tableName, copyState[tableName])
 
@@ -266,6 +267,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			vc.vr.stats.QueryTimings.Record("copy", start)
 
 			vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
+			vc.vr.stats.TableCopyRowCounts.Add(tableName, int64(qr.RowsAffected))
 			vc.vr.stats.QueryCount.Add("copy", 1)
 
 			return qr, err
@@ -23,6 +23,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"context"
@@ -58,6 +60,10 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodatapb.TabletType
+
+	// rule is the query rule that fired for the query, if its action
+	// is applied by applyRule.
+	rule *rules.Rule
 }
 
 const streamRowsSize = 256
@@ -125,6 +131,11 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	if err := qre.checkPermissions(); err != nil {
 		return nil, err
 	}
+	release, err := qre.applyRule()
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
 	switch qre.plan.PlanID {
 	case planbuilder.PlanNextval:
@@ -269,6 +280,11 @@ func (qre *QueryExecutor) Stream(callback StreamCallback) error {
 	if err := qre.checkPermissions(); err != nil {
 		return err
 	}
+	release, err := qre.applyRule()
+	if err != nil {
+		return err
+	}
+	defer release()
 
 	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
 	if err != nil {
@@ -362,6 +378,48 @@ func (qre *QueryExecutor) MessageStream(callback StreamCallback) error {
 	return nil
 }
 
+// applyRule applies the action of the query rule that fired for the query,
+// if any: it rate limits, delays or rewrites the query. The returned function
+// must be called when the query is done.
+func (qre *QueryExecutor) applyRule() (release func(), err error) {
+	release = func() {}
+	if qre.rule == nil {
+		return release, nil
+	}
+	switch qre.rule.Action() {
+	case rules.QRRateLimit:
+		release, ok := qre.rule.Admit()
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "rate limited due to rule: %s", qre.rule.Description)
+		}
+		return release, nil
+	case rules.QRDelay:
+		tmr := time.NewTimer(qre.rule.Delay())
+		defer tmr.Stop()
+		select {
+		case <-tmr.C:
+		case <-qre.ctx.Done():
+			return nil, vterrors.Wrapf(qre.ctx.Err(), "query delayed due to rule: %s", qre.rule.Description)
+		}
+	case rules.QRRewrite:
+		if workload := qre.rule.Workload(); workload != querypb.ExecuteOptions_UNSPECIFIED {
+			options := &querypb.ExecuteOptions{}
+			if qre.options != nil {
+				options = proto.Clone(qre.options).(*querypb.ExecuteOptions)
+			}
+			options.Workload = workload
+			qre.options = options
+		}
+		if timeout := qre.rule.Timeout(); timeout != 0 {
+			// The timeout can only shorten the deadline of the request.
+			var cancel context.CancelFunc
+			qre.ctx, cancel = context.WithTimeout(qre.ctx, timeout)
+			release = cancel
+		}
+	}
+	return release, nil
+}
+
 // checkPermissions returns an error if the query does not pass all checks
 // (query blacklisting, table ACL).
 func (qre *QueryExecutor) checkPermissions() error {
@@ -378,12 +436,15 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars, qre.marginComments)
-	switch action {
-	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
-	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+	if qr := qre.plan.Rules.GetMatchingRule(remoteAddr, username, qre.bindVars, qre.marginComments); qr != nil {
+		switch qr.Action() {
+		case rules.QRFail:
+			return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", qr.Description)
+		case rules.QRFailRetry:
+			return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", qr.Description)
+		default:
+			qre.rule = qr
+		}
 	}
 
 	// Skip ACL check for queries against the dummy dual table
@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -1096,6 +1097,103 @@ func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorRuleActions(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	ctx := callinfo.NewContext(context.Background(), &fakecallinfo.FakeCallInfo{
+		Remote: "127.0.0.1",
+		User:   "u1",
+	})
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	rulesName := "ruleActions"
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	setRule := func(qr *rules.Rule) {
+		t.Helper()
+		qrs := rules.New()
+		qrs.Add(qr)
+		require.NoError(t, tsv.SetQueryRules(rulesName, qrs))
+	}
+
+	// The queries beyond the rate limit fail.
+	rateRule := rules.NewQueryRule("limit test_table", "limit", rules.QRRateLimit)
+	require.NoError(t, rateRule.SetRateLimit(1, 0))
+	setRule(rateRule)
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.EqualError(t, err, "rate limited due to rule: limit test_table")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	// The queries are delayed, unless the request is canceled.
+	delayRule := rules.NewQueryRule("delay test_table", "delay", rules.QRDelay)
+	require.NoError(t, delayRule.SetDelay(50*time.Millisecond))
+	setRule(delayRule)
+	start := time.Now()
+	_, err = newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(50*time.Millisecond))
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	qre := newTestQueryExecutor(cancelCtx, tsv, query, 0)
+	_, err = qre.Execute()
+	assert.Equal(t, vtrpcpb.Code_CANCELED, vterrors.Code(err))
+
+	// The queries run with the workload of the rule, and with its timeout
+	// if it's shorter than the timeout of the request.
+	timeoutCtx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+	requestDeadline, _ := timeoutCtx.Deadline()
+	rewriteRule := rules.NewQueryRule("rewrite test_table", "rewrite", rules.QRRewrite)
+	require.NoError(t, rewriteRule.SetRewrite(querypb.ExecuteOptions_OLAP, time.Second))
+	setRule(rewriteRule)
+	start = time.Now()
+	qre = newTestQueryExecutor(timeoutCtx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, querypb.ExecuteOptions_OLAP, qre.options.Workload)
+	deadline, ok := qre.ctx.Deadline()
+	require.True(t, ok)
+	assert.False(t, deadline.Before(start.Add(time.Second)), "deadline %v is before %v", deadline, start.Add(time.Second))
+	assert.False(t, deadline.After(time.Now().Add(time.Second)), "deadline %v is after %v", deadline, time.Now().Add(time.Second))
+
+	// The options of the request are not modified, and a timeout longer
+	// than the timeout of the request has no effect.
+	require.NoError(t, rewriteRule.SetRewrite(querypb.ExecuteOptions_DBA, 2*time.Minute))
+	setRule(rewriteRule)
+	options := &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLTP, IncludedFields: querypb.ExecuteOptions_TYPE_ONLY}
+	qre = newTestQueryExecutor(timeoutCtx, tsv, query, 0)
+	qre.options = options
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, querypb.ExecuteOptions_DBA, qre.options.Workload)
+	assert.Equal(t, querypb.ExecuteOptions_TYPE_ONLY, qre.options.IncludedFields)
+	assert.Equal(t, querypb.ExecuteOptions_OLTP, options.Workload)
+	deadline, ok = qre.ctx.Deadline()
+	require.True(t, ok)
+	assert.Equal(t, requestDeadline, deadline)
+
+	// A rule without a timeout leaves the deadline of the request alone.
+	require.NoError(t, rewriteRule.SetRewrite(querypb.ExecuteOptions_OLAP, 0))
+	setRule(rewriteRule)
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, querypb.ExecuteOptions_OLAP, qre.options.Workload)
+	_, ok = qre.ctx.Deadline()
+	assert.False(t, ok)
+
+	assert.EqualValues(t, 2, rateRule.Hits())
+	assert.EqualValues(t, 2, delayRule.Hits())
+	assert.EqualValues(t, 3, rewriteRule.Hits())
+}
+
 type executorFlags int64
 
 const (
@@ -1105,6 +1203,7 @@ const (
 	noTwopc
 	shortTwopcAge
 	smallResultSize
+	noTwopcCoordinator
 )
 
 // newTestQueryExecutor uses a package level variable testTabletServer defined in tabletserver_test.go
@@ -1126,7 +1225,9 @@ func newTestTabletServer(ctx context.Context, flags executorFlags, db *fakesqldb
 	} else {
 		config.TwoPCEnable = true
 	}
-	config.TwoPCCoordinatorAddress = "fake"
+	if flags&noTwopcCoordinator == 0 {
+		config.TwoPCCoordinatorAddress = "fake"
+	}
 	if flags&shortTwopcAge > 0 {
 		config.TwoPCAbandonAge = 0.5
 	} else {
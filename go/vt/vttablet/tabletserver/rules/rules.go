@@ -23,10 +23,13 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
+	"vitess.io/vitess/go/ratelimiter"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
@@ -173,12 +176,27 @@ func (qrs *Rules) GetAction(
 	bindVars map[string]*querypb.BindVariable,
 	marginComments sqlparser.MarginComments,
 ) (action Action, desc string) {
+	if qr := qrs.GetMatchingRule(ip, user, bindVars, marginComments); qr != nil {
+		return qr.act, qr.Description
+	}
+	return QRContinue, ""
+}
+
+// GetMatchingRule runs the input against the rules engine and returns the
+// first rule that fires, or nil if the query can continue. The caller uses
+// the rule to perform actions that need parameters, like QRRateLimit.
+func (qrs *Rules) GetMatchingRule(
+	ip,
+	user string,
+	bindVars map[string]*querypb.BindVariable,
+	marginComments sqlparser.MarginComments,
+) *Rule {
 	for _, qr := range qrs.rules {
 		if act := qr.GetAction(ip, user, bindVars, marginComments); act != QRContinue {
-			return act, qr.Description
+			return qr
 		}
 	}
-	return QRContinue, ""
+	return nil
 }
 
 //-----------------------------------------------
@@ -211,6 +229,24 @@ type Rule struct {
 
 	// Action to be performed on trigger
 	act Action
+
+	// Parameters of the QRRateLimit, QRDelay and QRRewrite actions.
+	maxQPS, maxConcurrency int
+	delay                  time.Duration
+	workload               querypb.ExecuteOptions_Workload
+	timeout                time.Duration
+
+	// state is shared by all the copies of the rule.
+	state *ruleState
+}
+
+// ruleState is the runtime state of a Rule. It's shared by the copies of
+// the rule, including the ones that FilterByPlan makes for the query plans,
+// so that the hits and limits apply to all the queries matched by the rule.
+type ruleState struct {
+	hits        sync2.AtomicInt64
+	limiter     *ratelimiter.RateLimiter
+	concurrency *sync2.Semaphore
 }
 
 type namedRegexp struct {
@@ -233,8 +269,7 @@ func (nr namedRegexp) Equal(other namedRegexp) bool {
 
 // NewQueryRule creates a new Rule.
 func NewQueryRule(description, name string, act Action) (qr *Rule) {
-	// We ignore act because there's only one action right now
-	return &Rule{Description: description, Name: name, act: act}
+	return &Rule{Description: description, Name: name, act: act, state: &ruleState{}}
 }
 
 // Equal returns true if other is equal to this Rule, otherwise false.
@@ -252,7 +287,12 @@ func (qr *Rule) Equal(other *Rule) bool {
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.act == other.act &&
+		qr.maxQPS == other.maxQPS &&
+		qr.maxConcurrency == other.maxConcurrency &&
+		qr.delay == other.delay &&
+		qr.workload == other.workload &&
+		qr.timeout == other.timeout)
 }
 
 // Copy performs a deep copy of a Rule.
@@ -266,6 +306,12 @@ func (qr *Rule) Copy() (newqr *Rule) {
 		leadingComment:  qr.leadingComment,
 		trailingComment: qr.trailingComment,
 		act:             qr.act,
+		maxQPS:          qr.maxQPS,
+		maxConcurrency:  qr.maxConcurrency,
+		delay:           qr.delay,
+		workload:        qr.workload,
+		timeout:         qr.timeout,
+		state:           qr.state,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -314,10 +360,119 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.maxQPS != 0 {
+		safeEncode(b, `,"MaxQPS":`, qr.maxQPS)
+	}
+	if qr.maxConcurrency != 0 {
+		safeEncode(b, `,"MaxConcurrency":`, qr.maxConcurrency)
+	}
+	if qr.delay != 0 {
+		safeEncode(b, `,"Delay":`, qr.delay.String())
+	}
+	if qr.workload != querypb.ExecuteOptions_UNSPECIFIED {
+		safeEncode(b, `,"Workload":`, qr.workload.String())
+	}
+	if qr.timeout != 0 {
+		safeEncode(b, `,"Timeout":`, qr.timeout.String())
+	}
+	if hits := qr.Hits(); hits != 0 {
+		safeEncode(b, `,"Hits":`, hits)
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
 
+// SetRateLimit sets the limits of the QRRateLimit action: the matching
+// queries are allowed up to maxQPS per second, and up to maxConcurrency
+// at the same time. A zero value means no limit.
+func (qr *Rule) SetRateLimit(maxQPS, maxConcurrency int) error {
+	if maxQPS < 0 || maxConcurrency < 0 || (maxQPS == 0 && maxConcurrency == 0) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid rate limit: MaxQPS %d, MaxConcurrency %d", maxQPS, maxConcurrency)
+	}
+	qr.maxQPS = maxQPS
+	qr.maxConcurrency = maxConcurrency
+	if qr.state == nil {
+		qr.state = &ruleState{}
+	}
+	qr.state.limiter = nil
+	if maxQPS != 0 {
+		qr.state.limiter = ratelimiter.NewRateLimiter(maxQPS, time.Second)
+	}
+	qr.state.concurrency = nil
+	if maxConcurrency != 0 {
+		qr.state.concurrency = sync2.NewSemaphore(maxConcurrency, 0)
+	}
+	return nil
+}
+
+// SetDelay sets the delay of the QRDelay action.
+func (qr *Rule) SetDelay(delay time.Duration) error {
+	if delay <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid delay: %v", delay)
+	}
+	qr.delay = delay
+	return nil
+}
+
+// SetRewrite sets the workload and the timeout that the QRRewrite action
+// forces on the matching queries. Zero values leave them unchanged, and
+// the timeout can only shorten the deadline of a query.
+func (qr *Rule) SetRewrite(workload querypb.ExecuteOptions_Workload, timeout time.Duration) error {
+	if timeout < 0 || (workload == querypb.ExecuteOptions_UNSPECIFIED && timeout == 0) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid rewrite: Workload %v, Timeout %v", workload, timeout)
+	}
+	qr.workload = workload
+	qr.timeout = timeout
+	return nil
+}
+
+// Action returns the action of the rule.
+func (qr *Rule) Action() Action {
+	return qr.act
+}
+
+// Delay returns the delay of the QRDelay action.
+func (qr *Rule) Delay() time.Duration {
+	return qr.delay
+}
+
+// Workload returns the workload forced by the QRRewrite action.
+func (qr *Rule) Workload() querypb.ExecuteOptions_Workload {
+	return qr.workload
+}
+
+// Timeout returns the timeout forced by the QRRewrite action.
+func (qr *Rule) Timeout() time.Duration {
+	return qr.timeout
+}
+
+// Hits returns the number of times the rule fired.
+func (qr *Rule) Hits() int64 {
+	if qr.state == nil {
+		return 0
+	}
+	return qr.state.hits.Get()
+}
+
+// Admit enforces the limits of the QRRateLimit action. If the query is
+// within the limits, it returns true and a function that the caller must
+// call when the query is done. Otherwise, it returns false.
+func (qr *Rule) Admit() (release func(), ok bool) {
+	if qr.state == nil {
+		return func() {}, true
+	}
+	if qr.state.limiter != nil && !qr.state.limiter.Allow() {
+		return nil, false
+	}
+	if qr.state.concurrency == nil {
+		return func() {}, true
+	}
+	if !qr.state.concurrency.TryAcquire() {
+		return nil, false
+	}
+	return qr.state.concurrency.Release, true
+}
+
 // SetIPCond adds a regular expression condition for the client IP.
 // It has to be a full match (not substring).
 func (qr *Rule) SetIPCond(pattern string) (err error) {
@@ -479,6 +634,9 @@ func (qr *Rule) GetAction(
 			return QRContinue
 		}
 	}
+	if qr.act != QRContinue && qr.state != nil {
+		qr.state.hits.Add(1)
+	}
 	return qr.act
 }
 
@@ -529,22 +687,30 @@ func bvMatch(bvcond BindVarCond, bindVars map[string]*querypb.BindVariable) bool
 type Action int
 
 // These are actions.
+// QRRateLimit fails the queries that exceed the MaxQPS or MaxConcurrency
+// of the rule, QRDelay delays the queries by the Delay of the rule, and
+// QRRewrite runs the queries with the Workload or Timeout of the rule.
 const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	QRRateLimit
+	QRDelay
+	QRRewrite
 )
 
+var actionNames = map[Action]string{
+	QRFail:      "FAIL",
+	QRFailRetry: "FAIL_RETRY",
+	QRRateLimit: "RATE_LIMIT",
+	QRDelay:     "DELAY",
+	QRRewrite:   "REWRITE",
+}
+
 // MarshalJSON marshals to JSON.
 func (act Action) MarshalJSON() ([]byte, error) {
-	// If we add more actions, we'll need to use a map.
-	var str string
-	switch act {
-	case QRFail:
-		str = "FAIL"
-	case QRFailRetry:
-		str = "FAIL_RETRY"
-	default:
+	str, ok := actionNames[act]
+	if !ok {
 		str = "INVALID"
 	}
 	return json.Marshal(str)
@@ -829,16 +995,28 @@ func MapStrOperator(strop string) (op Operator, err error) {
 // BuildQueryRule builds a query rule from a ruleInfo.
 func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	qr = NewQueryRule("", "", QRFail)
+	var maxQPS, maxConcurrency int
+	var delay, timeout time.Duration
+	var workload querypb.ExecuteOptions_Workload
 	for k, v := range ruleInfo {
 		var sv string
+		var iv int64
 		var lv []interface{}
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "LeadingComment", "TrailingComment", "Delay", "Workload", "Timeout":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
 			}
+		case "MaxQPS", "MaxConcurrency", "Hits":
+			nv, ok := v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for %s", k)
+			}
+			if iv, err = nv.Int64(); err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int for %s: %v", k, nv)
+			}
 		case "Plans", "BindVarConds", "TableNames":
 			lv, ok = v.([]interface{})
 			if !ok {
@@ -914,14 +1092,70 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				qr.act = QRFail
 			case "FAIL_RETRY":
 				qr.act = QRFailRetry
+			case "RATE_LIMIT":
+				qr.act = QRRateLimit
+			case "DELAY":
+				qr.act = QRDelay
+			case "REWRITE":
+				qr.act = QRRewrite
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
+		case "MaxQPS":
+			maxQPS = int(iv)
+		case "MaxConcurrency":
+			maxConcurrency = int(iv)
+		case "Delay":
+			delay, err = time.ParseDuration(sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Delay %s", sv)
+			}
+		case "Workload":
+			wv, ok := querypb.ExecuteOptions_Workload_value[sv]
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Workload %s", sv)
+			}
+			workload = querypb.ExecuteOptions_Workload(wv)
+		case "Timeout":
+			timeout, err = time.ParseDuration(sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Timeout %s", sv)
+			}
+		case "Hits":
+			// Hits is reported by MarshalJSON, and is ignored so that
+			// the reported rules can be loaded back.
 		}
 	}
+	if err := qr.setActionParams(maxQPS, maxConcurrency, delay, workload, timeout); err != nil {
+		return nil, err
+	}
 	return qr, nil
 }
 
+// setActionParams validates and sets the parameters of the action of a
+// rule built from JSON. The parameters are only allowed for the action
+// that uses them.
+func (qr *Rule) setActionParams(maxQPS, maxConcurrency int, delay time.Duration, workload querypb.ExecuteOptions_Workload, timeout time.Duration) error {
+	if qr.act != QRRateLimit && (maxQPS != 0 || maxConcurrency != 0) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "MaxQPS and MaxConcurrency are only allowed for the RATE_LIMIT action")
+	}
+	if qr.act != QRDelay && delay != 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Delay is only allowed for the DELAY action")
+	}
+	if qr.act != QRRewrite && (workload != querypb.ExecuteOptions_UNSPECIFIED || timeout != 0) {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Workload and Timeout are only allowed for the REWRITE action")
+	}
+	switch qr.act {
+	case QRRateLimit:
+		return qr.SetRateLimit(maxQPS, maxConcurrency)
+	case QRDelay:
+		return qr.SetDelay(delay)
+	case QRRewrite:
+		return qr.SetRewrite(workload, timeout)
+	}
+	return nil
+}
+
 func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch bool, op Operator, value interface{}, err error) {
 	bvcinfo, ok := bvc.(map[string]interface{})
 	if !ok {
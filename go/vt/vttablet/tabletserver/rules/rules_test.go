@@ -23,6 +23,7 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -601,6 +602,128 @@ func TestImport(t *testing.T) {
 	}
 }
 
+func TestImportActions(t *testing.T) {
+	var qrs = New()
+	jsondata := `[{
+		"Description": "desc1",
+		"Name": "name1",
+		"Action": "RATE_LIMIT",
+		"MaxQPS": 100,
+		"MaxConcurrency": 10
+	},{
+		"Description": "desc2",
+		"Name": "name2",
+		"Action": "DELAY",
+		"Delay": "100ms"
+	},{
+		"Description": "desc3",
+		"Name": "name3",
+		"Action": "REWRITE",
+		"Workload": "OLAP",
+		"Timeout": "5s"
+	}]`
+	err := qrs.UnmarshalJSON([]byte(jsondata))
+	if err != nil {
+		t.Error(err)
+		return
+	}
+	got := marshalled(qrs)
+	want := compacted(jsondata)
+	if got != want {
+		t.Errorf("qrs:\n%s, want\n%s", got, want)
+	}
+	if qr := qrs.Find("name2"); qr.Delay() != 100*time.Millisecond {
+		t.Errorf("Delay: %v, want 100ms", qr.Delay())
+	}
+	qr := qrs.Find("name3")
+	if qr.Workload() != querypb.ExecuteOptions_OLAP || qr.Timeout() != 5*time.Second {
+		t.Errorf("Workload, Timeout: %v, %v, want OLAP, 5s", qr.Workload(), qr.Timeout())
+	}
+}
+
+func TestRuleHits(t *testing.T) {
+	qrs := New()
+	qr := NewQueryRule("rule 1", "r1", QRDelay)
+	if err := qr.SetDelay(time.Second); err != nil {
+		t.Fatal(err)
+	}
+	qr.AddPlanCond(planbuilder.PlanSelect)
+	qr.AddBindVarCond("a", false, false, QREqual, int64(1))
+	qrs.Add(qr)
+
+	// The hits of the rules filtered for a plan are counted in the original rule.
+	planqrs := qrs.FilterByPlan("select * from a", planbuilder.PlanSelect, "a")
+	bv := map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(1)}
+	for i := 0; i < 3; i++ {
+		if got := planqrs.GetMatchingRule("", "", bv, sqlparser.MarginComments{}); got == nil || got.Action() != QRDelay {
+			t.Fatalf("GetMatchingRule: %v, want rule r1", got)
+		}
+	}
+	if got := planqrs.GetMatchingRule("", "", nil, sqlparser.MarginComments{}); got != nil {
+		t.Errorf("GetMatchingRule: %v, want nil", got)
+	}
+	if got := qr.Hits(); got != 3 {
+		t.Errorf("Hits: %d, want 3", got)
+	}
+	if got, want := marshalled(qrs), `[{"Description":"rule 1","Name":"r1","Plans":["Select"],"BindVarConds":[{"Name":"a","OnAbsent":false,"OnMismatch":false,"Operator":"==","Value":1}],"Action":"DELAY","Delay":"1s","Hits":3}]`; got != want {
+		t.Errorf("qrs:\n%s, want\n%s", got, want)
+	}
+
+	// Reported hits can be loaded back.
+	loaded := New()
+	if err := loaded.UnmarshalJSON([]byte(marshalled(qrs))); err != nil {
+		t.Fatal(err)
+	}
+	if !loaded.Equal(qrs) {
+		t.Errorf("loaded: %s, want %s", marshalled(loaded), marshalled(qrs))
+	}
+	if got := loaded.Find("r1").Hits(); got != 0 {
+		t.Errorf("Hits: %d, want 0", got)
+	}
+}
+
+func TestRuleAdmit(t *testing.T) {
+	qr := NewQueryRule("rule 1", "r1", QRRateLimit)
+	if err := qr.SetRateLimit(0, 2); err != nil {
+		t.Fatal(err)
+	}
+	cpy := qr.Copy()
+	release1, ok := qr.Admit()
+	if !ok {
+		t.Fatalf("Admit: false, want true")
+	}
+	release2, ok := cpy.Admit()
+	if !ok {
+		t.Fatalf("Admit: false, want true")
+	}
+	// The copies share the concurrency limit.
+	if _, ok := qr.Admit(); ok {
+		t.Errorf("Admit: true, want false")
+	}
+	release1()
+	release3, ok := cpy.Admit()
+	if !ok {
+		t.Errorf("Admit: false, want true")
+	}
+	release2()
+	release3()
+
+	qr = NewQueryRule("rule 2", "r2", QRRateLimit)
+	if err := qr.SetRateLimit(2, 0); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		release, ok := qr.Admit()
+		if !ok {
+			t.Fatalf("Admit %d: false, want true", i)
+		}
+		release()
+	}
+	if _, ok := qr.Admit(); ok {
+		t.Errorf("Admit: true, want false")
+	}
+}
+
 type ValidJSONCase struct {
 	input string
 	op    Operator
@@ -710,6 +833,20 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"MaxQPS": "1" }]`, "want number for MaxQPS"},
+	{`[{"MaxConcurrency": 1.5 }]`, "want int for MaxConcurrency: 1.5"},
+	{`[{"Delay": 1 }]`, "want string for Delay"},
+	{`[{"Action": "DELAY", "Delay": "1" }]`, "invalid Delay 1"},
+	{`[{"Action": "REWRITE", "Workload": "foo" }]`, "invalid Workload foo"},
+	{`[{"Action": "REWRITE", "Timeout": "foo" }]`, "invalid Timeout foo"},
+	{`[{"Action": "FAIL", "MaxQPS": 1 }]`, "MaxQPS and MaxConcurrency are only allowed for the RATE_LIMIT action"},
+	{`[{"Action": "FAIL", "Delay": "1s" }]`, "Delay is only allowed for the DELAY action"},
+	{`[{"Action": "DELAY", "Timeout": "1s" }]`, "Workload and Timeout are only allowed for the REWRITE action"},
+	{`[{"Action": "FAIL", "Workload": "OLAP" }]`, "Workload and Timeout are only allowed for the REWRITE action"},
+	{`[{"Action": "RATE_LIMIT" }]`, "invalid rate limit: MaxQPS 0, MaxConcurrency 0"},
+	{`[{"Action": "RATE_LIMIT", "MaxQPS": -1 }]`, "invalid rate limit: MaxQPS -1, MaxConcurrency 0"},
+	{`[{"Action": "DELAY" }]`, "invalid delay: 0s"},
+	{`[{"Action": "REWRITE" }]`, "invalid rewrite: Workload UNSPECIFIED, Timeout 0s"},
 }
 
 func TestInvalidJSON(t *testing.T) {
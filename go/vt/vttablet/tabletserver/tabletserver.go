@@ -66,6 +66,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txthrottler"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
+	"vitess.io/vitess/go/vt/vttablet/txresolver"
 )
 
 // logPoolFull is for throttling transaction / query pool full messages in the log.
@@ -240,6 +241,7 @@ func (tsv *TabletServer) InitDBConfig(target querypb.Target, dbcfgs *dbconfigs.D
 	tsv.onlineDDLExecutor.InitDBConfig(target.Keyspace, target.Shard, dbcfgs.DBName)
 	tsv.lagThrottler.InitDBConfig(target.Keyspace, target.Shard)
 	tsv.tableGC.InitDBConfig(target.Keyspace, target.Shard, dbcfgs.DBName)
+	tsv.te.InitResolver(txresolver.NewLocalResolver(tsv.topoServer, target.Keyspace, target.Shard, tsv))
 	return nil
 }
 
@@ -1580,6 +1582,7 @@ func (tsv *TabletServer) registerThrottlerCheckHandler() {
 		}
 		flags := &throttle.CheckFlags{
 			LowPriority: (r.URL.Query().Get("p") == "low"),
+			MetricName:  r.URL.Query().Get("m"),
 		}
 		checkResult := tsv.lagThrottler.Check(ctx, appName, remoteAddr, flags)
 		if checkResult.StatusCode == http.StatusNotFound && flags.OKIfNotExists {
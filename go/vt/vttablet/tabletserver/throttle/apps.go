@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"flag"
+	"strings"
+
+	"vitess.io/vitess/go/vt/log"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var (
+	throttleLowPriorityThresholdRatio  = flag.Float64("throttle_low_priority_threshold_ratio", 0.5, "Ratio of the throttle threshold at which apps registered with a low priority are throttled")
+	throttleHighPriorityThresholdRatio = flag.Float64("throttle_high_priority_threshold_ratio", 2.0, "Ratio of the throttle threshold at which apps registered with a high priority are throttled")
+)
+
+// refreshApps reloads the app table of the shard from the topo.
+func (throttler *Throttler) refreshApps(ctx context.Context) error {
+	apps, err := throttler.ts.GetThrottlerApps(ctx, throttler.keyspace, throttler.shard)
+	if err != nil {
+		log.Errorf("Throttler: error reading app table: %v", err)
+		return err
+	}
+	throttler.setApps(apps)
+	return nil
+}
+
+func (throttler *Throttler) setApps(apps *topodatapb.ThrottlerApps) {
+	appsMap := make(map[string]*topodatapb.ThrottlerApp, len(apps.Apps))
+	for _, app := range apps.Apps {
+		appsMap[app.Name] = app
+	}
+	throttler.appsMutex.Lock()
+	defer throttler.appsMutex.Unlock()
+	throttler.apps = appsMap
+}
+
+// registeredApp returns the registration of an app. Like IsAppThrottled, it
+// tries the full app name first, then each of its ":" separated tokens.
+func (throttler *Throttler) registeredApp(appName string) *topodatapb.ThrottlerApp {
+	throttler.appsMutex.RLock()
+	defer throttler.appsMutex.RUnlock()
+
+	if app, ok := throttler.apps[appName]; ok {
+		return app
+	}
+	for _, singleAppName := range strings.Split(appName, ":") {
+		if app, ok := throttler.apps[singleAppName]; ok {
+			return app
+		}
+	}
+	return nil
+}
+
+// appPriority returns the priority of an app. Apps that are not registered
+// have the normal priority.
+func (throttler *Throttler) appPriority(appName string) topodatapb.ThrottlerApp_Priority {
+	if app := throttler.registeredApp(appName); app != nil {
+		return app.Priority
+	}
+	return topodatapb.ThrottlerApp_NORMAL
+}
+
+// appThresholdRatio returns the ratio of the threshold at which an app is throttled.
+func (throttler *Throttler) appThresholdRatio(appName string) float64 {
+	app := throttler.registeredApp(appName)
+	if app == nil {
+		return 1
+	}
+	if app.ThresholdRatio > 0 {
+		return app.ThresholdRatio
+	}
+	switch app.Priority {
+	case topodatapb.ThrottlerApp_LOW:
+		return *throttleLowPriorityThresholdRatio
+	case topodatapb.ThrottlerApp_HIGH:
+		return *throttleHighPriorityThresholdRatio
+	}
+	return 1
+}
+
+// AppsMap returns a (copy) map of the apps registered in the app table
+func (throttler *Throttler) AppsMap() map[string]*topodatapb.ThrottlerApp {
+	throttler.appsMutex.RLock()
+	defer throttler.appsMutex.RUnlock()
+
+	result := make(map[string]*topodatapb.ThrottlerApp, len(throttler.apps))
+	for appName, app := range throttler.apps {
+		result[appName] = app
+	}
+	return result
+}
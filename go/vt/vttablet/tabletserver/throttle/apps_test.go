@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func newTestThrottler(ts *topo.Server) *Throttler {
+	throttler := &Throttler{
+		keyspace:                           "ks",
+		shard:                              "0",
+		ts:                                 ts,
+		throttledApps:                      cache.New(cache.NoExpiration, 10*time.Second),
+		mysqlClusterThresholds:             cache.New(cache.NoExpiration, 0),
+		nonLowPriorityAppRequestsThrottled: cache.New(nonDeprioritizedAppMapExpiration, nonDeprioritizedAppMapInterval),
+	}
+	throttler.check = NewThrottlerCheck(throttler)
+	return throttler
+}
+
+// updateApp sets an app in the app table of the shard, like vtctl UpdateThrottlerApp does.
+func updateApp(t *testing.T, ts *topo.Server, appName string, priority topodatapb.ThrottlerApp_Priority, thresholdRatio float64) {
+	t.Helper()
+	_, err := ts.UpdateThrottlerApps(context.Background(), "ks", "0", func(apps *topodatapb.ThrottlerApps) error {
+		topoproto.SetThrottlerApp(apps, &topodatapb.ThrottlerApp{
+			Name:           appName,
+			Priority:       priority,
+			ThresholdRatio: thresholdRatio,
+		})
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestRefreshApps(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	throttler := newTestThrottler(ts)
+
+	// There is no app table yet.
+	require.NoError(t, throttler.refreshApps(ctx))
+	assert.Empty(t, throttler.AppsMap())
+	assert.Equal(t, topodatapb.ThrottlerApp_NORMAL, throttler.appPriority("backfill"))
+
+	updateApp(t, ts, "backfill", topodatapb.ThrottlerApp_LOW, 0)
+	updateApp(t, ts, "vreplication", topodatapb.ThrottlerApp_HIGH, 0)
+	updateApp(t, ts, "backfill", topodatapb.ThrottlerApp_LOW, 0.2)
+	assert.Empty(t, throttler.AppsMap())
+
+	require.NoError(t, throttler.refreshApps(ctx))
+	assert.Len(t, throttler.AppsMap(), 2)
+	assert.Equal(t, topodatapb.ThrottlerApp_HIGH, throttler.appPriority("vreplication"))
+	assert.Equal(t, topodatapb.ThrottlerApp_HIGH, throttler.appPriority("vreplication:workflow"))
+	assert.Equal(t, topodatapb.ThrottlerApp_NORMAL, throttler.appPriority("online-ddl"))
+	assert.Equal(t, 0.2, throttler.appThresholdRatio("backfill"))
+	assert.Equal(t, *throttleHighPriorityThresholdRatio, throttler.appThresholdRatio("vreplication"))
+	assert.Equal(t, 1.0, throttler.appThresholdRatio("online-ddl"))
+
+	_, err := ts.UpdateThrottlerApps(ctx, "ks", "0", func(apps *topodatapb.ThrottlerApps) error {
+		topoproto.RemoveThrottlerApp(apps, "backfill")
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, throttler.refreshApps(ctx))
+	assert.Len(t, throttler.AppsMap(), 1)
+	assert.Equal(t, topodatapb.ThrottlerApp_NORMAL, throttler.appPriority("backfill"))
+}
+
+func TestCheckAppPriorities(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	throttler := newTestThrottler(ts)
+	updateApp(t, ts, "backfill", topodatapb.ThrottlerApp_LOW, 0)
+	updateApp(t, ts, "vreplication", topodatapb.ThrottlerApp_HIGH, 0)
+	updateApp(t, ts, "analytics", topodatapb.ThrottlerApp_NORMAL, 0.9)
+	require.NoError(t, throttler.refreshApps(ctx))
+
+	lag := 0.0
+	metricResultFunc := func() (base.MetricResult, float64) {
+		return base.NewSimpleMetricResult(lag), 1.0
+	}
+	check := func(appName string, flags *CheckFlags) int {
+		return throttler.check.checkAppMetricResult(ctx, appName, "mysql", "local", metricResultFunc, flags).StatusCode
+	}
+
+	// As lag approaches the threshold, the low priority apps are throttled first.
+	lag = 0.7
+	assert.Equal(t, http.StatusTooManyRequests, check("backfill", StandardCheckFlags))
+	assert.Equal(t, http.StatusOK, check("analytics", StandardCheckFlags))
+	assert.Equal(t, http.StatusOK, check("online-ddl", StandardCheckFlags))
+	assert.Equal(t, http.StatusOK, check("vreplication", StandardCheckFlags))
+
+	// Above the threshold, the high priority apps keep running.
+	lag = 1.5
+	assert.Equal(t, http.StatusTooManyRequests, check("analytics", StandardCheckFlags))
+	assert.Equal(t, http.StatusTooManyRequests, check("online-ddl", StandardCheckFlags))
+	assert.Equal(t, http.StatusOK, check("vreplication", StandardCheckFlags))
+
+	// An override threshold applies to all the apps.
+	assert.Equal(t, http.StatusOK, check("backfill", &CheckFlags{OverrideThreshold: 2}))
+	assert.Equal(t, http.StatusTooManyRequests, check("vreplication", &CheckFlags{OverrideThreshold: 1}))
+
+	// Once a normal app is throttled, the low priority apps are denied.
+	lag = 0.1
+	require.Eventually(t, func() bool {
+		return check("backfill", StandardCheckFlags) == http.StatusExpectationFailed
+	}, time.Second, 10*time.Millisecond)
+	assert.Equal(t, http.StatusOK, check("online-ddl", StandardCheckFlags))
+}
@@ -16,6 +16,8 @@ import (
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
 
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+
 	metrics "github.com/rcrowley/go-metrics"
 )
 
@@ -33,6 +35,7 @@ type CheckFlags struct {
 	OverrideThreshold float64
 	LowPriority       bool
 	OKIfNotExists     bool
+	MetricName        string // a configured metric, or empty for replication lag
 }
 
 // StandardCheckFlags have no special hints
@@ -52,10 +55,12 @@ func NewThrottlerCheck(throttler *Throttler) *ThrottlerCheck {
 
 // checkAppMetricResult allows an app to check on a metric
 func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName string, storeType string, storeName string, metricResultFunc base.MetricResultFunc, flags *CheckFlags) (checkResult *CheckResult) {
-	// Handle deprioritized app logic
+	// Handle deprioritized app logic. Apps registered with a low priority are
+	// deprioritized even when they don't say so.
 	denyApp := false
 	metricName := fmt.Sprintf("%s/%s", storeType, storeName)
-	if flags.LowPriority {
+	lowPriority := flags.LowPriority || check.throttler.appPriority(appName) == topodatapb.ThrottlerApp_LOW
+	if lowPriority {
 		if _, exists := check.throttler.nonLowPriorityAppRequestsThrottled.Get(metricName); exists {
 			// a non-deprioritized app, ie a "normal" app, has recently been throttled.
 			// This is now a deprioritized app. Deny access to this request.
@@ -66,11 +71,18 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 	metricResult, threshold := check.throttler.AppRequestMetricResult(ctx, appName, metricResultFunc, denyApp)
 	if flags.OverrideThreshold > 0 {
 		threshold = flags.OverrideThreshold
+	} else {
+		// registered apps are throttled at the ratio of their priority
+		threshold *= check.throttler.appThresholdRatio(appName)
 	}
 	value, err := metricResult.Get()
 	if appName == "" {
 		return NewCheckResult(http.StatusExpectationFailed, value, threshold, fmt.Errorf("no app indicated"))
 	}
+	if check.throttler.isAppExempted(appName) {
+		// app is exempted from throttling by the keyspace config
+		return NewCheckResult(http.StatusOK, value, threshold, nil)
+	}
 
 	var statusCode int
 
@@ -88,7 +100,7 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 		statusCode = http.StatusTooManyRequests // 429
 		err = base.ErrThresholdExceeded
 
-		if !flags.LowPriority && !flags.ReadCheck && appName != frenoAppName {
+		if !lowPriority && !flags.ReadCheck && appName != frenoAppName {
 			// low priority requests will henceforth be denied
 			go check.throttler.nonLowPriorityAppRequestsThrottled.SetDefault(metricName, true)
 		}
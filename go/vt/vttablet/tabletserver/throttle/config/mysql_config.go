@@ -24,6 +24,7 @@ type MySQLClusterConfigurationSettings struct {
 	HTTPCheckPort        int      // Specify if different than specified by MySQLConfigurationSettings. -1 to disable HTTP check
 	HTTPCheckPath        string   // Specify if different than specified by MySQLConfigurationSettings
 	IgnoreHosts          []string // override MySQLConfigurationSettings's, or leave empty to inherit those settings
+	ProbePrimary         bool     // Probe only the primary, rather than the throttled replicas
 }
 
 // Hook to implement adjustments after reading each configuration file.
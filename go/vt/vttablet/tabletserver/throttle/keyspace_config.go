@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/patrickmn/go-cache"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/config"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// keyspaceConfigRetryInterval is how long the throttler waits before
+// watching the throttler config of its keyspace again, when the config
+// doesn't exist or the watch failed.
+const keyspaceConfigRetryInterval = 10 * time.Second
+
+// watchKeyspaceConfig watches the throttler config of the keyspace in the
+// topo, and applies it live. Without a config in the topo, the throttler
+// runs with its command line configuration.
+func (throttler *Throttler) watchKeyspaceConfig(ctx context.Context) {
+	for {
+		current, changes, cancel := throttler.ts.WatchThrottlerConfig(ctx, throttler.keyspace)
+		if current.Err == nil {
+			throttler.applyKeyspaceConfig(current.Value)
+			for change := range changes {
+				if change.Err != nil {
+					current = change
+					break
+				}
+				throttler.applyKeyspaceConfig(change.Value)
+			}
+			cancel()
+		}
+		switch {
+		case topo.IsErrType(current.Err, topo.NoNode):
+			throttler.applyKeyspaceConfig(nil)
+		case current.Err != nil:
+			log.Errorf("Throttler: error watching throttler config of keyspace %s: %v", throttler.keyspace, current.Err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(keyspaceConfigRetryInterval):
+		}
+	}
+}
+
+// applyKeyspaceConfig applies the throttler config of the keyspace. A nil
+// config reverts to the command line configuration.
+func (throttler *Throttler) applyKeyspaceConfig(keyspaceConfig *topodatapb.ThrottlerConfig) {
+	throttler.keyspaceConfigMutex.Lock()
+	changed := !proto.Equal(throttler.keyspaceConfig, keyspaceConfig)
+	throttler.keyspaceConfig = keyspaceConfig
+	throttler.keyspaceConfigMutex.Unlock()
+
+	if !changed {
+		return
+	}
+	log.Infof("Throttler: applying throttler config of keyspace %s: %v", throttler.keyspace, keyspaceConfig)
+	for clusterName, clusterSettings := range config.Settings().Stores.MySQL.Clusters {
+		throttler.mysqlClusterThresholds.Set(clusterName, throttler.clusterThreshold(clusterName, clusterSettings), cache.DefaultExpiration)
+	}
+}
+
+func (throttler *Throttler) getKeyspaceConfig() *topodatapb.ThrottlerConfig {
+	throttler.keyspaceConfigMutex.Lock()
+	defer throttler.keyspaceConfigMutex.Unlock()
+	return throttler.keyspaceConfig
+}
+
+// isEnabled tells whether the checks are enforced, per the keyspace config.
+// The keyspace config is only watched by a running throttler, so it can't
+// enable the checks of a tablet that runs without -enable-lag-throttler.
+func (throttler *Throttler) isEnabled() bool {
+	keyspaceConfig := throttler.getKeyspaceConfig()
+	return keyspaceConfig == nil || keyspaceConfig.Enabled
+}
+
+// defaultMetricName returns the metric checked by apps that don't name one.
+func (throttler *Throttler) defaultMetricName() string {
+	if keyspaceConfig := throttler.getKeyspaceConfig(); keyspaceConfig != nil && keyspaceConfig.MetricName != "" {
+		return keyspaceConfig.MetricName
+	}
+	return localStoreName
+}
+
+// clusterThreshold returns the threshold of a cluster. The threshold of the
+// keyspace config overrides the configured threshold of its metric.
+func (throttler *Throttler) clusterThreshold(clusterName string, clusterSettings *config.MySQLClusterConfigurationSettings) float64 {
+	keyspaceConfig := throttler.getKeyspaceConfig()
+	if keyspaceConfig == nil || keyspaceConfig.Threshold <= 0 {
+		return clusterSettings.ThrottleThreshold
+	}
+	metricName := keyspaceConfig.MetricName
+	if metricName == "" {
+		metricName = localStoreName
+	}
+	if metricName != clusterName {
+		return clusterSettings.ThrottleThreshold
+	}
+	return keyspaceConfig.Threshold
+}
+
+// isAppExempted tells whether an app is exempted from throttling by the
+// keyspace config. Like IsAppThrottled, it matches the full app name and
+// each of its ":" separated tokens.
+func (throttler *Throttler) isAppExempted(appName string) bool {
+	keyspaceConfig := throttler.getKeyspaceConfig()
+	if keyspaceConfig == nil {
+		return false
+	}
+	for _, exemptedApp := range keyspaceConfig.ExemptedApps {
+		if exemptedApp == appName {
+			return true
+		}
+		for _, singleAppName := range strings.Split(appName, ":") {
+			if exemptedApp == singleAppName {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/config"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestWatchKeyspaceConfig(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ts := memorytopo.NewServer("cell1")
+	throttler := newTestThrottler(ts)
+
+	// A new config is enabled, even if it only sets the threshold.
+	_, err := ts.UpdateThrottlerConfig(ctx, "ks", func(keyspaceConfig *topodatapb.ThrottlerConfig) error {
+		keyspaceConfig.Threshold = 5
+		keyspaceConfig.ExemptedApps = []string{"vreplication"}
+		return nil
+	})
+	require.NoError(t, err)
+	go throttler.watchKeyspaceConfig(ctx)
+
+	waitForKeyspaceConfig := func(want *topodatapb.ThrottlerConfig) {
+		t.Helper()
+		require.Eventually(t, func() bool {
+			return proto.Equal(throttler.getKeyspaceConfig(), want)
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+	waitForKeyspaceConfig(&topodatapb.ThrottlerConfig{Enabled: true, Threshold: 5, ExemptedApps: []string{"vreplication"}})
+
+	lagSettings := &config.MySQLClusterConfigurationSettings{ThrottleThreshold: 1}
+	metricSettings := &config.MySQLClusterConfigurationSettings{ThrottleThreshold: 100}
+	assert.True(t, throttler.isEnabled())
+	assert.Equal(t, localStoreName, throttler.defaultMetricName())
+	assert.Equal(t, 5.0, throttler.clusterThreshold(localStoreName, lagSettings))
+	assert.Equal(t, 100.0, throttler.clusterThreshold("threads_running", metricSettings))
+	assert.True(t, throttler.isAppExempted("vreplication"))
+	assert.True(t, throttler.isAppExempted("vreplication:workflow"))
+	assert.False(t, throttler.isAppExempted("online-ddl"))
+
+	metricResultFunc := func() (base.MetricResult, float64) {
+		return base.NewSimpleMetricResult(10), 5
+	}
+	checkResult := throttler.check.checkAppMetricResult(ctx, "vreplication", "mysql", localStoreName, metricResultFunc, StandardCheckFlags)
+	assert.Equal(t, http.StatusOK, checkResult.StatusCode)
+	checkResult = throttler.check.checkAppMetricResult(ctx, "online-ddl", "mysql", localStoreName, metricResultFunc, StandardCheckFlags)
+	assert.Equal(t, http.StatusTooManyRequests, checkResult.StatusCode)
+
+	// Changes are applied live.
+	_, err = ts.UpdateThrottlerConfig(ctx, "ks", func(keyspaceConfig *topodatapb.ThrottlerConfig) error {
+		keyspaceConfig.Enabled = false
+		keyspaceConfig.MetricName = "threads_running"
+		keyspaceConfig.Threshold = 50
+		return nil
+	})
+	require.NoError(t, err)
+	waitForKeyspaceConfig(&topodatapb.ThrottlerConfig{Threshold: 50, MetricName: "threads_running", ExemptedApps: []string{"vreplication"}})
+	assert.False(t, throttler.isEnabled())
+	assert.Equal(t, "threads_running", throttler.defaultMetricName())
+	assert.Equal(t, 1.0, throttler.clusterThreshold(localStoreName, lagSettings))
+	assert.Equal(t, 50.0, throttler.clusterThreshold("threads_running", metricSettings))
+
+	// Without a config, the throttler goes back to its command line configuration.
+	require.NoError(t, ts.DeleteThrottlerConfig(ctx, "ks"))
+	waitForKeyspaceConfig(nil)
+	assert.True(t, throttler.isEnabled())
+	assert.Equal(t, localStoreName, throttler.defaultMetricName())
+	assert.Equal(t, 1.0, throttler.clusterThreshold(localStoreName, lagSettings))
+	assert.False(t, throttler.isAppExempted("vreplication"))
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/mysql"
+)
+
+var throttleMetricsConfig = flag.String("throttle_metrics_config", "", "Path to a JSON file with the metrics that the throttler probes in addition to replication lag, keyed by metric name. Example: {\"threads_running\": {\"Threshold\": 100}, \"my_metric\": {\"Query\": \"select count(*) from my_queue\", \"Threshold\": 5000}}")
+
+// MetricConfig is the configuration of a metric probed by the throttler,
+// besides replication lag.
+type MetricConfig struct {
+	// Query returns the metric value. It can be a select returning a number,
+	// a "show global" statement returning a name and a number, or "loadavg",
+	// which reads the 1 minute load average of the host from /proc/loadavg.
+	// Query is optional for the builtin metrics.
+	Query string
+	// Threshold is the value above which the checks of the metric are throttled.
+	Threshold float64
+	// ProbePrimary probes only the primary, rather than the throttled replicas.
+	// It's implied for the loadavg query, because the load average is read
+	// from the host of the throttler.
+	ProbePrimary bool
+	// CacheMillis optionally caches the probed value.
+	CacheMillis int
+}
+
+// builtinMetrics are the metrics that can be configured by name only.
+var builtinMetrics = map[string]MetricConfig{
+	"threads_running": {
+		Query:        "show global status like 'threads_running'",
+		ProbePrimary: true,
+	},
+	"history_list_length": {
+		Query:        "select count from information_schema.innodb_metrics where name='trx_rseg_history_len'",
+		ProbePrimary: true,
+	},
+	"loadavg": {
+		Query: mysql.LoadAvgMetricQuery,
+	},
+}
+
+// loadMetricsConfig reads the configuration of the metrics from the given
+// file. An empty path means no metrics.
+func loadMetricsConfig(path string) (map[string]*MetricConfig, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return parseMetricsConfig(data)
+}
+
+// parseMetricsConfig parses and validates the configuration of the metrics,
+// and fills in the defaults of the builtin metrics.
+func parseMetricsConfig(data []byte) (map[string]*MetricConfig, error) {
+	metrics := make(map[string]*MetricConfig)
+	if err := json.Unmarshal(data, &metrics); err != nil {
+		return nil, fmt.Errorf("cannot parse throttler metrics config: %v", err)
+	}
+	for name, metric := range metrics {
+		if name == "" || strings.Contains(name, "/") {
+			return nil, fmt.Errorf("invalid throttler metric name: %q", name)
+		}
+		if name == localStoreName {
+			return nil, fmt.Errorf("throttler metric name %s is reserved for replication lag", localStoreName)
+		}
+		if metric == nil {
+			metric = &MetricConfig{}
+			metrics[name] = metric
+		}
+		if builtin, ok := builtinMetrics[name]; ok && metric.Query == "" {
+			metric.Query = builtin.Query
+			metric.ProbePrimary = metric.ProbePrimary || builtin.ProbePrimary
+		}
+		query := strings.ToLower(metric.Query)
+		switch {
+		case query == mysql.LoadAvgMetricQuery:
+			metric.ProbePrimary = true
+		case strings.HasPrefix(query, "select"), strings.HasPrefix(query, "show global"):
+		default:
+			return nil, fmt.Errorf("throttler metric %s: query must be a select, a show global statement or %s: %q", name, mysql.LoadAvgMetricQuery, metric.Query)
+		}
+		if metric.Threshold <= 0 {
+			return nil, fmt.Errorf("throttler metric %s: threshold must be positive", name)
+		}
+	}
+	return metrics, nil
+}
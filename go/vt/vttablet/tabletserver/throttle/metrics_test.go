@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMetricsConfig(t *testing.T) {
+	metrics, err := parseMetricsConfig([]byte(`{
+		"threads_running": {"Threshold": 100},
+		"history_list_length": {"Threshold": 1000000, "CacheMillis": 1000},
+		"loadavg": {"Threshold": 8},
+		"queue_size": {"Query": "select count(*) from my_queue", "Threshold": 5000},
+		"primary_queue_size": {"Query": "select count(*) from my_queue", "Threshold": 5000, "ProbePrimary": true},
+		"host_load": {"Query": "LOADAVG", "Threshold": 4}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*MetricConfig{
+		"threads_running": {
+			Query:        "show global status like 'threads_running'",
+			Threshold:    100,
+			ProbePrimary: true,
+		},
+		"history_list_length": {
+			Query:        "select count from information_schema.innodb_metrics where name='trx_rseg_history_len'",
+			Threshold:    1000000,
+			ProbePrimary: true,
+			CacheMillis:  1000,
+		},
+		"loadavg": {
+			Query:        "loadavg",
+			Threshold:    8,
+			ProbePrimary: true,
+		},
+		"queue_size": {
+			Query:     "select count(*) from my_queue",
+			Threshold: 5000,
+		},
+		"primary_queue_size": {
+			Query:        "select count(*) from my_queue",
+			Threshold:    5000,
+			ProbePrimary: true,
+		},
+		"host_load": {
+			Query:        "LOADAVG",
+			Threshold:    4,
+			ProbePrimary: true,
+		},
+	}, metrics)
+
+	metrics, err = loadMetricsConfig("")
+	require.NoError(t, err)
+	assert.Nil(t, metrics)
+}
+
+func TestParseMetricsConfigErrors(t *testing.T) {
+	testcases := []struct {
+		config string
+		err    string
+	}{{
+		config: `[]`,
+		err:    "cannot parse throttler metrics config: json: cannot unmarshal array into Go value of type map[string]*throttle.MetricConfig",
+	}, {
+		config: `{"local": {"Query": "select 1", "Threshold": 1}}`,
+		err:    "throttler metric name local is reserved for replication lag",
+	}, {
+		config: `{"a/b": {"Query": "select 1", "Threshold": 1}}`,
+		err:    `invalid throttler metric name: "a/b"`,
+	}, {
+		config: `{"custom": {"Threshold": 1}}`,
+		err:    `throttler metric custom: query must be a select, a show global statement or loadavg: ""`,
+	}, {
+		config: `{"custom": {"Query": "delete from t", "Threshold": 1}}`,
+		err:    `throttler metric custom: query must be a select, a show global statement or loadavg: "delete from t"`,
+	}, {
+		config: `{"threads_running": {}}`,
+		err:    "throttler metric threads_running: threshold must be positive",
+	}}
+	for _, tcase := range testcases {
+		_, err := parseMetricsConfig([]byte(tcase.config))
+		assert.EqualError(t, err, tcase.err, tcase.config)
+	}
+}
@@ -8,6 +8,8 @@ package mysql
 
 import (
 	"fmt"
+	"io/ioutil"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,6 +19,12 @@ import (
 	"vitess.io/vitess/go/vt/orchestrator/external/golib/sqlutils"
 )
 
+// LoadAvgMetricQuery is the metric query that reads the 1 minute load average
+// of the local host, rather than querying MySQL.
+const LoadAvgMetricQuery = "loadavg"
+
+var loadAvgFile = "/proc/loadavg"
+
 var mysqlMetricCache = cache.New(cache.NoExpiration, 10*time.Millisecond)
 
 func getMySQLMetricCacheKey(probe *Probe) string {
@@ -90,6 +98,11 @@ func ReadThrottleMetric(probe *Probe, clusterName string) (mySQLThrottleMetric *
 		}()
 	}(mySQLThrottleMetric, started)
 
+	if strings.ToLower(probe.MetricQuery) == LoadAvgMetricQuery {
+		mySQLThrottleMetric.Value, mySQLThrottleMetric.Err = readLoadAvg()
+		return cacheMySQLThrottleMetric(probe, mySQLThrottleMetric)
+	}
+
 	dbURI := probe.GetDBUri("information_schema")
 	db, fromCache, err := sqlutils.GetDB(dbURI)
 
@@ -131,3 +144,21 @@ func ReadThrottleMetric(probe *Probe, clusterName string) (mySQLThrottleMetric *
 	})
 	return cacheMySQLThrottleMetric(probe, mySQLThrottleMetric)
 }
+
+// readLoadAvg returns the 1 minute load average of the local host
+func readLoadAvg() (float64, error) {
+	content, err := ioutil.ReadFile(loadAvgFile)
+	if err != nil {
+		return 0, err
+	}
+	return parseLoadAvg(string(content))
+}
+
+// parseLoadAvg parses the 1 minute load average out of /proc/loadavg content
+func parseLoadAvg(content string) (float64, error) {
+	fields := strings.Fields(content)
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("unexpected loadavg content: %q", content)
+	}
+	return strconv.ParseFloat(fields[0], 64)
+}
@@ -0,0 +1,55 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLoadAvg(t *testing.T) {
+	value, err := parseLoadAvg("0.52 0.58 0.59 1/389 12345\n")
+	require.NoError(t, err)
+	assert.Equal(t, 0.52, value)
+
+	_, err = parseLoadAvg("")
+	assert.EqualError(t, err, `unexpected loadavg content: ""`)
+	_, err = parseLoadAvg("x 0.58 0.59")
+	assert.Error(t, err)
+}
+
+func TestReadThrottleMetricLoadAvg(t *testing.T) {
+	dir, err := ioutil.TempDir("", "loadavg")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	file := path.Join(dir, "loadavg")
+	require.NoError(t, ioutil.WriteFile(file, []byte("3.25 2.00 1.00 2/400 100\n"), 0644))
+	defer func(saved string) { loadAvgFile = saved }(loadAvgFile)
+	loadAvgFile = file
+
+	probe := &Probe{Key: InstanceKey{Hostname: "localhost", Port: 3306}, MetricQuery: LoadAvgMetricQuery}
+	metric := ReadThrottleMetric(probe, "loadavg")
+	value, err := metric.Get()
+	require.NoError(t, err)
+	assert.Equal(t, 3.25, value)
+	assert.Equal(t, "loadavg", metric.ClusterName)
+}
@@ -43,6 +43,7 @@ const (
 	aggregatedMetricsExpiration   = 5 * time.Second
 	aggregatedMetricsCleanup      = 1 * time.Second
 	throttledAppsSnapshotInterval = 5 * time.Second
+	appsRefreshInterval           = 10 * time.Second
 	recentAppsExpiration          = time.Hour * 24
 
 	nonDeprioritizedAppMapExpiration = time.Second
@@ -70,6 +71,8 @@ var (
 	}
 	sqlGrantThrottlerUser = []string{
 		`GRANT SELECT ON _vt.heartbeat TO %s`,
+		// PROCESS is needed by the history_list_length metric, which reads information_schema.innodb_metrics
+		`GRANT PROCESS ON *.* TO %s`,
 	}
 	replicationLagQuery = `select unix_timestamp(now(6))-max(ts/1000000000) from _vt.heartbeat`
 )
@@ -94,6 +97,7 @@ type Throttler struct {
 	ts             *topo.Server
 
 	throttleTabletTypesMap map[topodatapb.TabletType]bool
+	metrics                map[string]*MetricConfig
 
 	mysqlThrottleMetricChan chan *mysql.MySQLThrottleMetric
 	mysqlInventoryChan      chan *mysql.Inventory
@@ -106,12 +110,16 @@ type Throttler struct {
 	throttledApps          *cache.Cache
 	recentApps             *cache.Cache
 	metricsHealth          *cache.Cache
+	apps                   map[string]*topodatapb.ThrottlerApp
+	keyspaceConfig         *topodatapb.ThrottlerConfig
 
 	lastCheckTimeNano int64
 
-	initMutex          sync.Mutex
-	throttledAppsMutex sync.Mutex
-	tickers            [](*timer.SuspendableTicker)
+	initMutex           sync.Mutex
+	throttledAppsMutex  sync.Mutex
+	appsMutex           sync.RWMutex
+	keyspaceConfigMutex sync.Mutex
+	tickers             [](*timer.SuspendableTicker)
 
 	nonLowPriorityAppRequestsThrottled *cache.Cache
 	httpClient                         *http.Client
@@ -128,6 +136,8 @@ type ThrottlerStatus struct {
 
 	AggregatedMetrics map[string]base.MetricResult
 	MetricsHealth     base.MetricHealthMap
+	Apps              map[string]*topodatapb.ThrottlerApp
+	KeyspaceConfig    *topodatapb.ThrottlerConfig
 }
 
 // NewThrottler creates a Throttler
@@ -163,6 +173,11 @@ func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topo
 		httpClient: base.SetupHTTPClient(0),
 	}
 	throttler.initThrottleTabletTypes()
+	metrics, err := loadMetricsConfig(*throttleMetricsConfig)
+	if err != nil {
+		log.Errorf("Throttler: error loading metrics config, only replication lag will be probed: %v", err)
+	}
+	throttler.metrics = metrics
 	throttler.ThrottleApp("abusing-app", time.Now().Add(time.Hour*24*365*10), defaultThrottleRatio)
 	throttler.check = NewThrottlerCheck(throttler)
 
@@ -195,19 +210,31 @@ func (throttler *Throttler) InitDBConfig(keyspace, shard string) {
 // initThrottler initializes config
 func (throttler *Throttler) initConfig(password string) {
 	log.Infof("Throttler: initializing config")
+	clusters := map[string](*config.MySQLClusterConfigurationSettings){
+		localStoreName: &config.MySQLClusterConfigurationSettings{
+			User:              throttlerUser,
+			Password:          password,
+			ThrottleThreshold: throttleThreshold.Seconds(),
+			MetricQuery:       replicationLagQuery,
+			IgnoreHostsCount:  0,
+		},
+	}
+	// Each additional metric is probed as a cluster of its own
+	for metricName, metric := range throttler.metrics {
+		clusters[metricName] = &config.MySQLClusterConfigurationSettings{
+			User:              throttlerUser,
+			Password:          password,
+			ThrottleThreshold: metric.Threshold,
+			MetricQuery:       metric.Query,
+			CacheMillis:       metric.CacheMillis,
+			ProbePrimary:      metric.ProbePrimary,
+		}
+	}
 	config.Instance = &config.ConfigurationSettings{
 		Stores: config.StoresSettings{
 			MySQL: config.MySQLConfigurationSettings{
 				IgnoreDialTCPErrors: true,
-				Clusters: map[string](*config.MySQLClusterConfigurationSettings){
-					localStoreName: &config.MySQLClusterConfigurationSettings{
-						User:              throttlerUser,
-						Password:          password,
-						ThrottleThreshold: throttleThreshold.Seconds(),
-						MetricQuery:       replicationLagQuery,
-						IgnoreHostsCount:  0,
-					},
-				},
+				Clusters:            clusters,
 			},
 		},
 	}
@@ -338,6 +365,12 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 	mysqlRefreshTicker := addTicker(mysqlRefreshInterval)
 	mysqlAggregateTicker := addTicker(mysqlAggregateInterval)
 	throttledAppsTicker := addTicker(throttledAppsSnapshotInterval)
+	appsRefreshTicker := addTicker(appsRefreshInterval)
+
+	go throttler.watchKeyspaceConfig(ctx)
+	// Load the app table right away rather than on the first appsRefreshTicker tick,
+	// so that the apps are not all checked with the normal priority until then.
+	throttler.refreshApps(ctx)
 
 	shouldCreateThrottlerUser := false
 	for {
@@ -424,6 +457,12 @@ func (throttler *Throttler) Operate(ctx context.Context) {
 					go throttler.expireThrottledApps()
 				}
 			}
+		case <-appsRefreshTicker.C:
+			{
+				if atomic.LoadInt64(&throttler.isOpen) > 0 {
+					go throttler.refreshApps(ctx)
+				}
+			}
 		}
 	}
 }
@@ -489,7 +528,7 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 		// is immutable and can only be _replaced_. Hence, it's safe to read in a goroutine:
 		go func() {
 			err := func() error {
-				throttler.mysqlClusterThresholds.Set(clusterName, clusterSettings.ThrottleThreshold, cache.DefaultExpiration)
+				throttler.mysqlClusterThresholds.Set(clusterName, throttler.clusterThreshold(clusterName, clusterSettings), cache.DefaultExpiration)
 
 				tabletAliases, err := throttler.ts.FindAllTabletAliasesInShard(ctx, throttler.keyspace, throttler.shard)
 				if err != nil {
@@ -505,7 +544,11 @@ func (throttler *Throttler) refreshMySQLInventory(ctx context.Context) error {
 					if err != nil {
 						return err
 					}
-					if throttler.throttleTabletTypesMap[tablet.Type] {
+					probeTablet := throttler.throttleTabletTypesMap[tablet.Type]
+					if clusterSettings.ProbePrimary {
+						probeTablet = (tablet.Type == topodatapb.TabletType_MASTER)
+					}
+					if probeTablet {
 						key := mysql.InstanceKey{Hostname: tablet.MysqlHostname, Port: int(tablet.MysqlPort)}
 						addInstanceKey(&key, clusterName, clusterSettings, clusterProbes.InstanceProbes)
 					}
@@ -707,12 +750,17 @@ func (throttler *Throttler) AppRequestMetricResult(ctx context.Context, appName
 	return metricResultFunc()
 }
 
-// Check is the main serving function of the throttler, and returns a check result for this cluster's lag
+// Check is the main serving function of the throttler, and returns a check result for this cluster's lag,
+// or for the metric indicated by flags.MetricName
 func (throttler *Throttler) Check(ctx context.Context, appName string, remoteAddr string, flags *CheckFlags) (checkResult *CheckResult) {
-	if !throttler.env.Config().EnableLagThrottler {
+	if !throttler.env.Config().EnableLagThrottler || !throttler.isEnabled() {
 		return okMetricCheckResult
 	}
-	return throttler.check.Check(ctx, appName, "mysql", localStoreName, remoteAddr, flags)
+	storeName := flags.MetricName
+	if storeName == "" {
+		storeName = throttler.defaultMetricName()
+	}
+	return throttler.check.Check(ctx, appName, "mysql", storeName, remoteAddr, flags)
 }
 
 // Status exports a status breakdown
@@ -727,5 +775,7 @@ func (throttler *Throttler) Status() *ThrottlerStatus {
 
 		AggregatedMetrics: throttler.aggregatedMetricsSnapshot(),
 		MetricsHealth:     throttler.metricsHealthSnapshot(),
+		Apps:              throttler.AppsMap(),
+		KeyspaceConfig:    throttler.getKeyspaceConfig(),
 	}
 }
@@ -38,6 +38,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txlimiter"
+	"vitess.io/vitess/go/vt/vttablet/txresolver"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
@@ -90,6 +91,10 @@ type TxEngine struct {
 	txPool       *TxPool
 	preparedPool *TxPreparedPool
 	twoPC        *TwoPC
+
+	// resolver resolves the abandoned transactions when there's
+	// no coordinator address.
+	resolver *txresolver.Resolver
 }
 
 // NewTxEngine creates a new TxEngine.
@@ -104,10 +109,6 @@ func NewTxEngine(env tabletenv.Env) *TxEngine {
 	te.txPool = NewTxPool(env, limiter)
 	te.twopcEnabled = config.TwoPCEnable
 	if te.twopcEnabled {
-		if config.TwoPCCoordinatorAddress == "" {
-			log.Error("Coordinator address not specified: Disabling 2PC")
-			te.twopcEnabled = false
-		}
 		if config.TwoPCAbandonAge <= 0 {
 			log.Error("2PC abandon age not specified: Disabling 2PC")
 			te.twopcEnabled = false
@@ -424,8 +425,14 @@ func (te *TxEngine) rollbackPrepared() {
 	}
 }
 
+// InitResolver sets the resolver of the abandoned transactions.
+func (te *TxEngine) InitResolver(resolver *txresolver.Resolver) {
+	te.resolver = resolver
+}
+
 // startWatchdog starts the watchdog goroutine, which looks for abandoned
-// transactions and calls the notifier on them.
+// transactions and resolves them. If a coordinator address is specified,
+// the coordinator is notified instead.
 func (te *TxEngine) startWatchdog() {
 	te.ticks.Start(func() {
 		ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), te.abandonAge/4)
@@ -451,6 +458,11 @@ func (te *TxEngine) startWatchdog() {
 			return
 		}
 
+		if te.coordinatorAddress == "" {
+			te.resolveAbandoned(ctx, txs)
+			return
+		}
+
 		coordConn, err := vtgateconn.Dial(ctx, te.coordinatorAddress)
 		if err != nil {
 			te.env.Stats().InternalErrors.Add("WatchdogFail", 1)
@@ -474,6 +486,27 @@ func (te *TxEngine) startWatchdog() {
 	})
 }
 
+// resolveAbandoned resolves the abandoned transactions, whose
+// records are owned by this tablet.
+func (te *TxEngine) resolveAbandoned(ctx context.Context, txs map[string]time.Time) {
+	if te.resolver == nil {
+		te.env.Stats().InternalErrors.Add("WatchdogFail", 1)
+		log.Errorf("No coordinator address or resolver for 2pc watchdog")
+		return
+	}
+	// The transactions are resolved one at a time, so that a backlog of
+	// abandoned transactions doesn't flood the participants.
+	for dtid := range txs {
+		if ctx.Err() != nil {
+			return
+		}
+		if err := te.resolver.Resolve(ctx, dtid); err != nil {
+			te.env.Stats().InternalErrors.Add("WatchdogFail", 1)
+			log.Errorf("Error resolving dtid %s: %v", dtid, err)
+		}
+	}
+}
+
 // stopWatchdog stops the watchdog goroutine.
 func (te *TxEngine) stopWatchdog() {
 	te.ticks.Stop()
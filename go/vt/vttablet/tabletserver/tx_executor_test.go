@@ -443,6 +443,56 @@ func TestExecutorResolveTransaction(t *testing.T) {
 	}
 }
 
+func TestExecutorResolveAbandonedTransaction(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	tsv := newTestTabletServer(ctx, smallTxPool|shortTwopcAge|noTwopcCoordinator, db)
+	defer tsv.StopService()
+
+	// The tablet owns the record of the transaction, which has no
+	// participants left to commit, so it only needs to be concluded.
+	dtid := "::1"
+	db.AddQueryPattern(
+		"select dtid, time_created from _vt\\.dt_state where time_created.*",
+		&sqltypes.Result{
+			Fields: []*querypb.Field{
+				{Type: sqltypes.VarChar},
+				{Type: sqltypes.Int64},
+			},
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewVarBinary(dtid),
+				sqltypes.NewVarBinary("1"),
+			}},
+		})
+	db.AddQuery("select dtid, state, time_created from _vt.dt_state where dtid = '::1'", &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary(dtid),
+			sqltypes.NewInt64(int64(querypb.TransactionState_COMMIT)),
+			sqltypes.NewVarBinary("1"),
+		}},
+	})
+	db.AddQuery("select keyspace, shard from _vt.dt_participant where dtid = '::1'", &sqltypes.Result{})
+	db.AddQuery("delete from _vt.dt_participant where dtid = '::1'", &sqltypes.Result{})
+	concluded := make(chan string, 1)
+	db.AddQueryPatternWithCallback("delete from _vt\\.dt_state where dtid = '::1'", &sqltypes.Result{}, func(query string) {
+		select {
+		case concluded <- query:
+		default:
+		}
+	})
+
+	select {
+	case <-concluded:
+	case <-time.After(10 * time.Second):
+		t.Fatal("abandoned transaction was not resolved")
+	}
+}
+
 func TestNoTwopc(t *testing.T) {
 	txe, tsv, db := newNoTwopcExecutor(t)
 	defer db.Close()
@@ -58,6 +58,10 @@ const (
 	Equal = Opcode(iota)
 	// VindexMatch is used for an in_keyrange() construct
 	VindexMatch
+	// Expression is used for any other supported construct, like
+	// comparisons, IN, OR, IS NULL or scalar functions. The row
+	// matches if the expression evaluates to true.
+	Expression
 )
 
 // Filter contains opcodes for filtering.
@@ -74,6 +78,10 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the expression evaluated by the Expression opcode.
+	// Its columns are the column numbers of the table.
+	Expr evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -88,6 +96,10 @@ type ColExpr struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 
+	// Expr, if set, is evaluated against the row of the table
+	// to generate the value. If so, ColNum is ignored.
+	Expr evalengine.Expr
+
 	Field *querypb.Field
 
 	FixedValue sqltypes.Value
@@ -111,6 +123,10 @@ func (plan *Plan) fields() []*querypb.Field {
 // filter filters the row against the plan. It returns false if the row did not match.
 // If the row matched, it returns the columns to be sent.
 func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error) {
+	env := evalengine.ExpressionEnv{
+		Row:    values,
+		Fields: plan.Table.Fields,
+	}
 	for _, filter := range plan.Filters {
 		switch filter.Opcode {
 		case Equal:
@@ -129,11 +145,27 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil, nil
 			}
+		case Expression:
+			result, err := filter.Expr.Evaluate(env)
+			if err != nil {
+				return false, nil, err
+			}
+			if !result.ToBoolean() {
+				return false, nil, nil
+			}
 		}
 	}
 
 	result := make([]sqltypes.Value, len(plan.ColExprs))
 	for i, colExpr := range plan.ColExprs {
+		if colExpr.Expr != nil {
+			evalResult, err := colExpr.Expr.Evaluate(env)
+			if err != nil {
+				return false, nil, err
+			}
+			result[i] = evalResult.Value()
+			continue
+		}
 		if colExpr.ColNum == -1 {
 			result[i] = colExpr.FixedValue
 			continue
@@ -366,52 +398,108 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
+			filter, ok, err := plan.analyzeEqual(expr)
 			if err != nil {
 				return err
 			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			pv, err := sqlparser.NewPlanValue(val)
-			if err != nil {
-				return err
+			if ok {
+				plan.Filters = append(plan.Filters, filter)
+				continue
 			}
-			resolved, err := pv.ResolveValue(nil)
-			if err != nil {
+			if err := plan.analyzeExpression(expr); err != nil {
 				return err
 			}
-			plan.Filters = append(plan.Filters, Filter{
-				Opcode: Equal,
-				ColNum: colnum,
-				Value:  resolved,
-			})
 		case *sqlparser.FuncExpr:
-			if !expr.Name.EqualString("in_keyrange") {
-				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			if expr.Name.EqualString("in_keyrange") {
+				if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
+					return err
+				}
+				continue
 			}
-			if err := plan.analyzeInKeyRange(vschema, expr.Exprs); err != nil {
+			if err := plan.analyzeExpression(expr); err != nil {
 				return err
 			}
 		default:
-			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			if err := plan.analyzeExpression(expr); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// analyzeEqual returns an Equal filter if the expression compares
+// a column with an integer literal. Strings are compared by the
+// evalengine, which knows the collation of the column.
+func (plan *Plan) analyzeEqual(expr *sqlparser.ComparisonExpr) (Filter, bool, error) {
+	if expr.Operator != sqlparser.EqualOp {
+		return Filter{}, false, nil
+	}
+	qualifiedName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok || !qualifiedName.Qualifier.IsEmpty() {
+		return Filter{}, false, nil
+	}
+	val, ok := expr.Right.(*sqlparser.Literal)
+	if !ok || val.Type != sqlparser.IntVal {
+		return Filter{}, false, nil
+	}
+	colnum, err := findColumn(plan.Table, qualifiedName.Name)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	pv, err := sqlparser.NewPlanValue(val)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	resolved, err := pv.ResolveValue(nil)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	return Filter{
+		Opcode: Equal,
+		ColNum: colnum,
+		Value:  resolved,
+	}, true, nil
+}
+
+// analyzeExpression adds an Expression filter for the constructs
+// the evalengine can evaluate against the row of the table.
+func (plan *Plan) analyzeExpression(expr sqlparser.Expr) error {
+	evalExpr, err := plan.convertExpr(expr)
+	if err == sqlparser.ErrExprNotSupported {
+		return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+	}
+	if err != nil {
+		return err
+	}
+	plan.Filters = append(plan.Filters, Filter{
+		Opcode: Expression,
+		Expr:   evalExpr,
+	})
+	return nil
+}
+
+// convertExpr converts the expression into an evalengine expression
+// that references the columns of the table by their column numbers.
+// Strings can only be compared to the text columns whose collation
+// is implemented by the evalengine, and to binary columns.
+func (plan *Plan) convertExpr(expr sqlparser.Expr) (evalengine.Expr, error) {
+	return sqlparser.ConvertWith(expr, func(e sqlparser.Expr) (evalengine.Expr, error) {
+		col, ok := e.(*sqlparser.ColName)
+		if !ok {
+			return nil, nil
+		}
+		if !col.Qualifier.IsEmpty() {
+			return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(col))
+		}
+		colnum, err := findColumn(plan.Table, col.Name)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewColumnWithField(colnum, plan.Table.Fields[colnum]), nil
+	})
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -473,7 +561,10 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 		}, nil
 	case *sqlparser.FuncExpr:
 		if inner.Name.Lowered() != "keyspace_id" {
-			return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			if !evalengine.SupportedFunction(inner.Name.String()) {
+				return ColExpr{}, fmt.Errorf("unsupported function: %v", sqlparser.String(inner))
+			}
+			return plan.analyzeColExpression(aliased)
 		}
 		if len(inner.Exprs) != 0 {
 			return ColExpr{}, fmt.Errorf("unexpected: %v", sqlparser.String(inner))
@@ -515,9 +606,36 @@ func (plan *Plan) analyzeExpr(vschema *localVSchema, selExpr sqlparser.SelectExp
 			FixedValue: sqltypes.NewInt64(num),
 		}, nil
 	default:
-		log.Infof("Unsupported expression: %v", inner)
+		return plan.analyzeColExpression(aliased)
+	}
+}
+
+// analyzeColExpression builds a column expression that is evaluated
+// against the row of the table, like "lower(val)" or "id + 1".
+func (plan *Plan) analyzeColExpression(aliased *sqlparser.AliasedExpr) (ColExpr, error) {
+	evalExpr, err := plan.convertExpr(aliased.Expr)
+	if err == sqlparser.ErrExprNotSupported {
+		log.Infof("Unsupported expression: %v", aliased.Expr)
 		return ColExpr{}, fmt.Errorf("unsupported: %v", sqlparser.String(aliased.Expr))
 	}
+	if err != nil {
+		return ColExpr{}, err
+	}
+	typ, err := evalExpr.Type(evalengine.ExpressionEnv{Fields: plan.Table.Fields})
+	if err != nil {
+		return ColExpr{}, err
+	}
+	as := aliased.As
+	if as.IsEmpty() {
+		as = sqlparser.NewColIdent(sqlparser.String(aliased.Expr))
+	}
+	return ColExpr{
+		Field: &querypb.Field{
+			Name: as.String(),
+			Type: typ,
+		},
+		Expr: evalExpr,
+	}, nil
 }
 
 // analyzeInKeyRange allows the following constructs: "in_keyrange('-80')",
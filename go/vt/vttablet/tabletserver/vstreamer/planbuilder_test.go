@@ -21,9 +21,13 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -393,6 +397,113 @@ func TestPlanbuilder(t *testing.T) {
 				KeyRange:      nil,
 			}},
 		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select val, id from t1 where id > 1 and val in ('a', 'b')"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 1,
+				Field: &querypb.Field{
+					Name: "val",
+					Type: sqltypes.VarBinary,
+				},
+			}, {
+				ColNum: 0,
+				Field: &querypb.Field{
+					Name: "id",
+					Type: sqltypes.Int64,
+				},
+			}},
+			Filters: []Filter{{
+				Opcode: Expression,
+				Expr: &evalengine.ComparisonExpr{
+					Op:    &evalengine.GreaterThanOp{},
+					Left:  evalengine.NewColumnWithField(0, t1.Fields[0]),
+					Right: evalengine.NewLiteralInt(1),
+				},
+			}, {
+				Opcode: Expression,
+				Expr: &evalengine.InExpr{
+					Left:  evalengine.NewColumnWithField(1, t1.Fields[1]),
+					Right: evalengine.TupleExpr{evalengine.NewLiteralString([]byte("a")), evalengine.NewLiteralString([]byte("b"))},
+				},
+			}},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id from t1 where id = 1 or val is null"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Field: &querypb.Field{
+					Name: "id",
+					Type: sqltypes.Int64,
+				},
+			}},
+			Filters: []Filter{{
+				Opcode: Expression,
+				Expr: &evalengine.LogicalExpr{
+					Op: evalengine.OrOp,
+					Left: &evalengine.ComparisonExpr{
+						Op:    &evalengine.EqualOp{},
+						Left:  evalengine.NewColumnWithField(0, t1.Fields[0]),
+						Right: evalengine.NewLiteralInt(1),
+					},
+					Right: &evalengine.IsExpr{
+						Inner: evalengine.NewColumnWithField(1, t1.Fields[1]),
+						Op:    evalengine.IsNullOp,
+					},
+				},
+			}},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, upper(val) as uval from t1 where length(val) < 3"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Field: &querypb.Field{
+					Name: "id",
+					Type: sqltypes.Int64,
+				},
+			}, {
+				Field: &querypb.Field{
+					Name: "uval",
+					Type: sqltypes.VarChar,
+				},
+				Expr: mustCallExpr("upper", evalengine.NewColumnWithField(1, t1.Fields[1])),
+			}},
+			Filters: []Filter{{
+				Opcode: Expression,
+				Expr: &evalengine.ComparisonExpr{
+					Op:    &evalengine.LessThanOp{},
+					Left:  mustCallExpr("length", evalengine.NewColumnWithField(1, t1.Fields[1])),
+					Right: evalengine.NewLiteralInt(3),
+				},
+			}},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				Field: &querypb.Field{
+					Name: "id + 1",
+					Type: sqltypes.Int64,
+				},
+				Expr: &evalengine.BinaryOp{
+					Expr:  &evalengine.Addition{},
+					Left:  evalengine.NewColumnWithField(0, t1.Fields[0]),
+					Right: evalengine.NewLiteralInt(1),
+				},
+			}, {
+				ColNum: 1,
+				Field: &querypb.Field{
+					Name: "val",
+					Type: sqltypes.VarBinary,
+				},
+			}},
+		},
 	}, {
 		inTable: t2,
 		inRule:  &binlogdatapb.Rule{Match: "/t1/"},
@@ -493,6 +604,22 @@ func TestPlanbuilder(t *testing.T) {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where max(id)"},
 		outErr:  `unsupported constraint: max(id)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id in (select id from t2)"},
+		outErr:  `unsupported constraint: id in (select id from t2)`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id > 1 or in_keyrange('-80')"},
+		outErr:  `unsupported constraint: id > 1 or in_keyrange('-80')`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where t1.id > 1"},
+		outErr:  `unsupported qualifier for column: t1.id`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where none is null"},
+		outErr:  "column `none` not found in table t1",
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange(id)"},
@@ -532,8 +659,12 @@ func TestPlanbuilder(t *testing.T) {
 		outErr:  `unsupported function: max(val)`,
 	}, {
 		inTable: t1,
-		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id+1, val from t1"},
-		outErr:  `unsupported: id + 1`,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id & 1, val from t1"},
+		outErr:  `unsupported: id & 1`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select lower(t1.val) from t1"},
+		outErr:  `unsupported qualifier for column: t1.val`,
 	}, {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select t1.id, val from t1"},
@@ -577,3 +708,78 @@ func TestPlanbuilder(t *testing.T) {
 		}
 	}
 }
+
+func mustCallExpr(name string, args ...evalengine.Expr) evalengine.Expr {
+	call, err := evalengine.NewCallExpr(name, args)
+	if err != nil {
+		panic(err)
+	}
+	return call
+}
+
+func TestPlanFilterText(t *testing.T) {
+	table := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			// utf8mb4_bin
+			Name:    "val",
+			Type:    sqltypes.VarChar,
+			Charset: 46,
+		}, {
+			Name:    "bval",
+			Type:    sqltypes.VarBinary,
+			Charset: mysql.CharacterSetBinary,
+		}, {
+			// utf8mb4_general_ci
+			Name:    "cival",
+			Type:    sqltypes.VarChar,
+			Charset: 45,
+		}},
+	}
+	testcases := []struct {
+		where string
+		// want is whether each of the values of val and bval matches.
+		want []bool
+	}{
+		{"val = 'abc'", []bool{true, true, false, false}},
+		{"val != 'abc'", []bool{false, false, true, true}},
+		{"val in ('abc', 'x')", []bool{true, true, false, false}},
+		{"val like 'ab_'", []bool{true, false, false, true}},
+		{"bval = 'abc'", []bool{true, false, false, false}},
+		{"bval != 'abc'", []bool{false, true, true, true}},
+		{"bval in ('abc', 'x')", []bool{true, false, false, false}},
+	}
+	values := []string{"abc", "abc  ", "ABC", "abd"}
+	for _, tcase := range testcases {
+		t.Run(tcase.where, func(t *testing.T) {
+			plan, err := buildPlan(table, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select id from t1 where " + tcase.where}},
+			})
+			require.NoError(t, err)
+			require.Len(t, plan.Filters, 1)
+			assert.Equal(t, Expression, plan.Filters[0].Opcode)
+			for i, value := range values {
+				row := []sqltypes.Value{
+					sqltypes.NewInt64(1),
+					sqltypes.NewVarChar(value),
+					sqltypes.NewVarBinary(value),
+					sqltypes.NewVarChar(value),
+				}
+				matched, _, err := plan.filter(row)
+				require.NoError(t, err)
+				assert.Equal(t, tcase.want[i], matched, value)
+			}
+		})
+	}
+
+	// The evalengine doesn't implement the collation of cival.
+	for _, where := range []string{"cival = 'abc'", "cival != 'abc'", "cival in ('abc', 'x')"} {
+		_, err := buildPlan(table, testLocalVSchema, &binlogdatapb.Filter{
+			Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select id from t1 where " + where}},
+		})
+		assert.EqualError(t, err, "unsupported constraint: "+where)
+	}
+}
@@ -296,6 +296,40 @@ func TestStreamRowsFilterInt(t *testing.T) {
 	require.Less(t, int64(0), engine.vstreamerPacketSize.Get())
 }
 
+func TestStreamRowsFilterExpression(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+	engine.rowStreamerNumPackets.Reset()
+	engine.rowStreamerNumRows.Reset()
+
+	if err := env.SetVSchema(shardedVSchema); err != nil {
+		t.Fatal(err)
+	}
+	defer env.SetVSchema("{}")
+
+	execStatements(t, []string{
+		"create table t1(id1 int, id2 int, val varbinary(128), primary key(id1))",
+		"insert into t1 values (1, 100, 'aaa'), (2, 200, 'bbb'), (3, 300, 'ccc'), (4, 100, 'ddd'), (5, 200, 'eee')",
+	})
+
+	defer execStatements(t, []string{
+		"drop table t1",
+	})
+	engine.se.Reload(context.Background())
+
+	time.Sleep(1 * time.Second)
+
+	wantStream := []string{
+		`fields:<name:"id1" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id1" column_length:11 charset:63 > fields:<name:"val" type:VARBINARY table:"t1" org_table:"t1" database:"vttest" org_name:"val" column_length:128 charset:63 > pkfields:<name:"id1" type:INT32 > `,
+		`rows:<lengths:1 lengths:3 values:"1aaa" > rows:<lengths:1 lengths:3 values:"3ccc" > lastpk:<lengths:1 values:"5" > `,
+	}
+	wantQuery := "select id1, id2, val from t1 order by id1"
+	checkStream(t, "select id1, val from t1 where id2 in (100, 300) and val != 'ddd'", nil, wantQuery, wantStream)
+	require.Equal(t, int64(0), engine.rowStreamerNumPackets.Get())
+	require.Equal(t, int64(2), engine.rowStreamerNumRows.Get())
+}
+
 func TestStreamRowsFilterVarBinary(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
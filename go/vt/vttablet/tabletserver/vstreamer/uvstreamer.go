@@ -120,6 +120,7 @@ func newUVStreamer(ctx context.Context, vse *Engine, cp dbconfigs.Connector, se
 // it can be called
 //		the first time, with just the filter and an empty pos
 //		during a restart, with both the filter and list of TableLastPK from the vgtid
+//		with a pos and a list of TableLastPK, in which case only the tables in the list are copied
 func (uvs *uvstreamer) buildTablePlan() error {
 	uvs.plans = make(map[string]*tablePlan)
 	tableLastPKs := make(map[string]*binlogdatapb.TableLastPK)
@@ -154,6 +155,11 @@ func (uvs *uvstreamer) buildTablePlan() error {
 		}
 		tablePK, ok := tableLastPKs[tableName]
 		if !ok {
+			if uvs.startPos != "" {
+				// The stream has a position: the tables that are not
+				// in the list don't need to be copied.
+				continue
+			}
 			tablePK = &binlogdatapb.TableLastPK{
 				TableName: tableName,
 				Lastpk:    nil,
@@ -164,6 +170,13 @@ func (uvs *uvstreamer) buildTablePlan() error {
 		uvs.tablesToCopy = append(uvs.tablesToCopy, tableName)
 
 	}
+	if uvs.startPos != "" {
+		for tableName := range tableLastPKs {
+			if _, ok := uvs.plans[tableName]; !ok {
+				return fmt.Errorf("table %s does not match any rule of the filter", tableName)
+			}
+		}
+	}
 	sort.Strings(uvs.tablesToCopy)
 	return nil
 }
@@ -344,7 +357,8 @@ func (uvs *uvstreamer) init() error {
 		if err := uvs.setStreamStartPosition(); err != nil {
 			return err
 		}
-	} else if uvs.startPos == "" || len(uvs.inTablePKs) > 0 {
+	}
+	if uvs.startPos == "" || len(uvs.inTablePKs) > 0 {
 		if err := uvs.buildTablePlan(); err != nil {
 			return err
 		}
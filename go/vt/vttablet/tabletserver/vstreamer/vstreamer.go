@@ -101,15 +101,19 @@ type streamerPlan struct {
 // startPos: a flavor compliant position to stream from. This can also contain the special
 //   value "current", which means start from the current position.
 // filter: the list of filtering rules. If a rule has a select expression for its filter,
-//   the select list can only reference direct columns. No other expressions are allowed.
+//   the select list can reference columns, or expressions of columns that use arithmetic
+//   and the scalar functions supported by the evalengine.
 //   The select expression is allowed to contain the special 'keyspace_id()' function which
 //   will return the keyspace id of the row. Examples:
 //   "select * from t", same as an empty Filter,
 //   "select * from t where in_keyrange('-80')", same as "-80",
 //   "select * from t where in_keyrange(col1, 'hash', '-80')",
 //   "select col1, col2 from t where...",
-//   "select col1, keyspace_id() from t where...".
-//   Only "in_keyrange" expressions are supported in the where clause.
+//   "select col1, keyspace_id() from t where...",
+//   "select col1, lower(col2) from t where col3 in ('a', 'b') and col4 is not null".
+//   The where clause supports "in_keyrange" expressions, comparisons, IN, AND, OR, NOT,
+//   IS NULL and the scalar functions supported by the evalengine. Strings can only be compared
+//   to binary columns and to the text columns that use the utf8_bin or utf8mb4_bin collation.
 //   Other constructs like joins, group by, etc. are not supported.
 // vschema: the current vschema. This value can later be changed through the SetVSchema method.
 // send: callback function to send events.
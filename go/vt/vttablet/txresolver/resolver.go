@@ -0,0 +1,187 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package txresolver drives distributed (2PC) transactions to their
+// conclusion, by talking directly to the primary tablets of their shards.
+// It's used by the primary that owns the transaction record to resolve
+// abandoned transactions, and by the operator tooling.
+package txresolver
+
+import (
+	"context"
+	"sync"
+
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/dtids"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Resolver resolves distributed transactions.
+type Resolver struct {
+	ts *topo.Server
+
+	// local, if set, serves the requests to localKeyspace/localShard,
+	// instead of dialing the primary tablet of the shard.
+	local         queryservice.QueryService
+	localKeyspace string
+	localShard    string
+}
+
+// NewResolver creates a Resolver that dials the primary tablets of the
+// shards, as found in the topo.
+func NewResolver(ts *topo.Server) *Resolver {
+	return &Resolver{ts: ts}
+}
+
+// NewLocalResolver creates a Resolver that uses the local query service for
+// the requests to its own shard.
+func NewLocalResolver(ts *topo.Server, keyspace, shard string, local queryservice.QueryService) *Resolver {
+	return &Resolver{
+		ts:            ts,
+		local:         local,
+		localKeyspace: keyspace,
+		localShard:    shard,
+	}
+}
+
+// ReadTransaction returns the metadata of the distributed transaction,
+// read from the primary of the shard that owns the transaction record.
+// It returns nil if the transaction was concluded.
+func (r *Resolver) ReadTransaction(ctx context.Context, dtid string) (*querypb.TransactionMetadata, error) {
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return nil, err
+	}
+	var transaction *querypb.TransactionMetadata
+	err = r.withQueryService(ctx, mmShard.Target, func(qs queryservice.QueryService) error {
+		transaction, err = qs.ReadTransaction(ctx, mmShard.Target, dtid)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if transaction == nil || transaction.Dtid == "" {
+		return nil, nil
+	}
+	return transaction, nil
+}
+
+// Resolve drives the distributed transaction to its conclusion. A transaction
+// that didn't get to commit is rolled back. Otherwise, its prepared
+// transactions are committed on all the participants. The transaction
+// record is then deleted. Resolve is idempotent.
+func (r *Resolver) Resolve(ctx context.Context, dtid string) error {
+	mmShard, err := dtids.ShardSession(dtid)
+	if err != nil {
+		return err
+	}
+	transaction, err := r.ReadTransaction(ctx, dtid)
+	if err != nil || transaction == nil {
+		// Either an error, or it was already resolved.
+		return err
+	}
+
+	switch transaction.State {
+	case querypb.TransactionState_PREPARE:
+		// If state is PREPARE, make a decision to rollback and
+		// fallthrough to the rollback workflow.
+		err := r.withQueryService(ctx, mmShard.Target, func(qs queryservice.QueryService) error {
+			return qs.SetRollback(ctx, mmShard.Target, dtid, mmShard.TransactionId)
+		})
+		if err != nil {
+			return err
+		}
+		fallthrough
+	case querypb.TransactionState_ROLLBACK:
+		err = r.runTargets(ctx, transaction.Participants, func(qs queryservice.QueryService, target *querypb.Target) error {
+			return qs.RollbackPrepared(ctx, target, dtid, 0)
+		})
+	case querypb.TransactionState_COMMIT:
+		err = r.runTargets(ctx, transaction.Participants, func(qs queryservice.QueryService, target *querypb.Target) error {
+			return qs.CommitPrepared(ctx, target, dtid)
+		})
+	default:
+		// Should never happen.
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "invalid state: %v", transaction.State)
+	}
+	if err != nil {
+		return err
+	}
+	return r.withQueryService(ctx, mmShard.Target, func(qs queryservice.QueryService) error {
+		return qs.ConcludeTransaction(ctx, mmShard.Target, dtid)
+	})
+}
+
+// runTargets runs the action on the primary of each target, in parallel.
+func (r *Resolver) runTargets(ctx context.Context, targets []*querypb.Target, action func(queryservice.QueryService, *querypb.Target) error) error {
+	allErrors := new(concurrency.AllErrorRecorder)
+	var wg sync.WaitGroup
+	for _, target := range targets {
+		wg.Add(1)
+		go func(target *querypb.Target) {
+			defer wg.Done()
+			// The participants are always resolved on their primary.
+			target = &querypb.Target{
+				Keyspace:   target.Keyspace,
+				Shard:      target.Shard,
+				TabletType: topodatapb.TabletType_MASTER,
+			}
+			err := r.withQueryService(ctx, target, func(qs queryservice.QueryService) error {
+				return action(qs, target)
+			})
+			if err != nil {
+				allErrors.RecordError(err)
+			}
+		}(target)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+// withQueryService calls f with a query service to the primary of the target.
+func (r *Resolver) withQueryService(ctx context.Context, target *querypb.Target, f func(queryservice.QueryService) error) error {
+	if r.local != nil && target.Keyspace == r.localKeyspace && target.Shard == r.localShard {
+		return f(r.local)
+	}
+	if r.ts == nil {
+		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "no topo server to find the primary of shard %v/%v", target.Keyspace, target.Shard)
+	}
+	si, err := r.ts.GetShard(ctx, target.Keyspace, target.Shard)
+	if err != nil {
+		return err
+	}
+	if !si.HasMaster() {
+		return vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no primary tablet for shard %v/%v", target.Keyspace, target.Shard)
+	}
+	ti, err := r.ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return err
+	}
+	conn, err := tabletconn.GetDialer()(ti.Tablet, grpcclient.FailFast(false))
+	if err != nil {
+		return err
+	}
+	defer conn.Close(ctx)
+	return f(conn)
+}
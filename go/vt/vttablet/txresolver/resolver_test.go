@@ -0,0 +1,166 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package txresolver
+
+import (
+	"context"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+	"vitess.io/vitess/go/vt/vttablet/tabletconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// testConns maps the tablet uids to their connections.
+var testConns = make(map[uint32]*sandboxconn.SandboxConn)
+
+func init() {
+	tabletconn.RegisterDialer("txresolver_test", func(tablet *topodatapb.Tablet, failFast grpcclient.FailFast) (queryservice.QueryService, error) {
+		return testConns[tablet.Alias.Uid], nil
+	})
+	flag.Set("tablet_protocol", "txresolver_test")
+}
+
+// newTestShard creates a shard with a primary tablet, and returns
+// the connection to the primary.
+func newTestShard(t *testing.T, ts *topo.Server, shard string, uid uint32) *sandboxconn.SandboxConn {
+	t.Helper()
+	ctx := context.Background()
+	tablet := &topodatapb.Tablet{
+		Alias:    &topodatapb.TabletAlias{Cell: "cell1", Uid: uid},
+		Keyspace: "ks",
+		Shard:    shard,
+		Type:     topodatapb.TabletType_MASTER,
+	}
+	require.NoError(t, ts.CreateTablet(ctx, tablet))
+	require.NoError(t, ts.CreateShard(ctx, "ks", shard))
+	_, err := ts.UpdateShardFields(ctx, "ks", shard, func(si *topo.ShardInfo) error {
+		si.MasterAlias = tablet.Alias
+		return nil
+	})
+	require.NoError(t, err)
+	sbc := sandboxconn.NewSandboxConn(tablet)
+	testConns[uid] = sbc
+	return sbc
+}
+
+func newTestTopo(t *testing.T) (*topo.Server, *sandboxconn.SandboxConn, *sandboxconn.SandboxConn) {
+	t.Helper()
+	ts := memorytopo.NewServer("cell1")
+	require.NoError(t, ts.CreateKeyspace(context.Background(), "ks", &topodatapb.Keyspace{}))
+	return ts, newTestShard(t, ts, "-80", 1), newTestShard(t, ts, "80-", 2)
+}
+
+func TestResolveCommit(t *testing.T) {
+	ts, sbc0, sbc1 := newTestTopo(t)
+	sbc0.ReadTransactionResults = []*querypb.TransactionMetadata{{
+		Dtid:  "ks:-80:1234",
+		State: querypb.TransactionState_COMMIT,
+		Participants: []*querypb.Target{{
+			Keyspace: "ks",
+			Shard:    "80-",
+		}},
+	}}
+
+	err := NewResolver(ts).Resolve(context.Background(), "ks:-80:1234")
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, sbc0.SetRollbackCount.Get())
+	assert.EqualValues(t, 1, sbc1.CommitPreparedCount.Get())
+	assert.EqualValues(t, 0, sbc1.RollbackPreparedCount.Get())
+	assert.EqualValues(t, 1, sbc0.ConcludeTransactionCount.Get())
+}
+
+func TestResolvePrepare(t *testing.T) {
+	ts, sbc0, sbc1 := newTestTopo(t)
+	sbc0.ReadTransactionResults = []*querypb.TransactionMetadata{{
+		Dtid:  "ks:-80:1234",
+		State: querypb.TransactionState_PREPARE,
+		Participants: []*querypb.Target{{
+			Keyspace: "ks",
+			Shard:    "80-",
+		}},
+	}}
+
+	err := NewResolver(ts).Resolve(context.Background(), "ks:-80:1234")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc0.SetRollbackCount.Get())
+	assert.EqualValues(t, 0, sbc1.CommitPreparedCount.Get())
+	assert.EqualValues(t, 1, sbc1.RollbackPreparedCount.Get())
+	assert.EqualValues(t, 1, sbc0.ConcludeTransactionCount.Get())
+}
+
+func TestResolveConcluded(t *testing.T) {
+	ts, sbc0, sbc1 := newTestTopo(t)
+
+	err := NewResolver(ts).Resolve(context.Background(), "ks:-80:1234")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbc0.ReadTransactionCount.Get())
+	assert.EqualValues(t, 0, sbc1.CommitPreparedCount.Get())
+	assert.EqualValues(t, 0, sbc1.RollbackPreparedCount.Get())
+	assert.EqualValues(t, 0, sbc0.ConcludeTransactionCount.Get())
+}
+
+func TestResolveParticipantError(t *testing.T) {
+	ts, sbc0, sbc1 := newTestTopo(t)
+	sbc0.ReadTransactionResults = []*querypb.TransactionMetadata{{
+		Dtid:  "ks:-80:1234",
+		State: querypb.TransactionState_COMMIT,
+		Participants: []*querypb.Target{{
+			Keyspace: "ks",
+			Shard:    "80-",
+		}},
+	}}
+	sbc1.MustFailCommitPrepared = 1
+
+	err := NewResolver(ts).Resolve(context.Background(), "ks:-80:1234")
+	require.Error(t, err)
+	// The record must be kept, so that the transaction is resolved again.
+	assert.EqualValues(t, 0, sbc0.ConcludeTransactionCount.Get())
+}
+
+func TestLocalResolver(t *testing.T) {
+	ts, _, sbc1 := newTestTopo(t)
+	local := sandboxconn.NewSandboxConn(&topodatapb.Tablet{})
+	local.ReadTransactionResults = []*querypb.TransactionMetadata{{
+		Dtid:  "ks:-80:1234",
+		State: querypb.TransactionState_COMMIT,
+		Participants: []*querypb.Target{{
+			Keyspace: "ks",
+			Shard:    "-80",
+		}, {
+			Keyspace: "ks",
+			Shard:    "80-",
+		}},
+	}}
+
+	err := NewLocalResolver(ts, "ks", "-80", local).Resolve(context.Background(), "ks:-80:1234")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, local.CommitPreparedCount.Get())
+	assert.EqualValues(t, 1, sbc1.CommitPreparedCount.Get())
+	assert.EqualValues(t, 1, local.ConcludeTransactionCount.Get())
+	assert.EqualValues(t, 0, testConns[1].ConcludeTransactionCount.Get())
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/txresolver"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const sqlReadDistributedTransactions = "select dtid from _vt.dt_state order by dtid"
+
+// ListDistributedTransactions returns the unresolved distributed
+// transactions whose records are owned by the shards of the keyspace,
+// or of all the keyspaces if keyspace is empty. Shards whose transactions
+// can't be read don't stop the listing: the transactions of the other
+// shards are returned along with the errors of those shards.
+func (wr *Wrangler) ListDistributedTransactions(ctx context.Context, keyspace string) ([]*querypb.TransactionMetadata, error) {
+	keyspaces := []string{keyspace}
+	if keyspace == "" {
+		var err error
+		if keyspaces, err = wr.ts.GetKeyspaces(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	resolver := txresolver.NewResolver(wr.ts)
+	rec := concurrency.AllErrorRecorder{}
+	var transactions []*querypb.TransactionMetadata
+	for _, keyspace := range keyspaces {
+		shards, err := wr.ts.GetShardNames(ctx, keyspace)
+		if err != nil {
+			rec.RecordError(vterrors.Wrapf(err, "cannot read the shards of keyspace %v", keyspace))
+			continue
+		}
+		for _, shard := range shards {
+			shardTransactions, err := wr.listShardDistributedTransactions(ctx, resolver, keyspace, shard)
+			if err != nil {
+				rec.RecordError(vterrors.Wrapf(err, "cannot read distributed transactions of shard %v/%v", keyspace, shard))
+				continue
+			}
+			transactions = append(transactions, shardTransactions...)
+		}
+	}
+	return transactions, rec.Error()
+}
+
+// listShardDistributedTransactions returns the unresolved distributed
+// transactions whose records are owned by a shard. A shard that doesn't
+// run with 2PC has no _vt.dt_state table, and so no transactions.
+func (wr *Wrangler) listShardDistributedTransactions(ctx context.Context, resolver *txresolver.Resolver, keyspace, shard string) ([]*querypb.TransactionMetadata, error) {
+	si, err := wr.ts.GetShard(ctx, keyspace, shard)
+	if err != nil {
+		return nil, err
+	}
+	if !si.HasMaster() {
+		return nil, fmt.Errorf("no primary tablet for shard %v/%v", keyspace, shard)
+	}
+	p3qr, err := wr.ExecuteFetchAsDba(ctx, si.MasterAlias, sqlReadDistributedTransactions, 10000, false, false)
+	if err != nil {
+		if sqlErr, ok := mysql.NewSQLErrorFromError(err).(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERNoSuchTable {
+			wr.Logger().Infof("Skipping shard %v/%v, which doesn't run with 2PC", keyspace, shard)
+			return nil, nil
+		}
+		return nil, err
+	}
+	var transactions []*querypb.TransactionMetadata
+	for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+		// The transaction may have been resolved in the meantime.
+		transaction, err := resolver.ReadTransaction(ctx, row[0].ToString())
+		if err != nil {
+			return nil, err
+		}
+		if transaction != nil {
+			transactions = append(transactions, transaction)
+		}
+	}
+	return transactions, nil
+}
+
+// GetDistributedTransaction returns the metadata of an unresolved
+// distributed transaction.
+func (wr *Wrangler) GetDistributedTransaction(ctx context.Context, dtid string) (*querypb.TransactionMetadata, error) {
+	transaction, err := txresolver.NewResolver(wr.ts).ReadTransaction(ctx, dtid)
+	if err != nil {
+		return nil, err
+	}
+	if transaction == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "distributed transaction %v not found", dtid)
+	}
+	return transaction, nil
+}
+
+// ConcludeDistributedTransaction drives an unresolved distributed
+// transaction to its conclusion: it's committed on all the participants
+// if the commit decision was made, and rolled back otherwise.
+func (wr *Wrangler) ConcludeDistributedTransaction(ctx context.Context, dtid string) error {
+	return txresolver.NewResolver(wr.ts).Resolve(ctx, dtid)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// testDistributedTxTMClient answers the reads of _vt.dt_state with
+// an empty result, or with the error set for the tablet.
+type testDistributedTxTMClient struct {
+	tmclient.TabletManagerClient
+	errors map[uint32]error
+}
+
+func (tmc *testDistributedTxTMClient) ExecuteFetchAsDba(ctx context.Context, tablet *topodatapb.Tablet, usePool bool, query []byte, maxRows int, disableBinlogs, reloadSchema bool) (*querypb.QueryResult, error) {
+	if err := tmc.errors[tablet.Alias.Uid]; err != nil {
+		return nil, err
+	}
+	return &querypb.QueryResult{}, nil
+}
+
+func TestListDistributedTransactions(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell")
+	tmc := &testDistributedTxTMClient{errors: make(map[uint32]error)}
+	wr := New(logutil.NewConsoleLogger(), ts, tmc)
+
+	addShard := func(keyspace, shard string, uid uint32) {
+		t.Helper()
+		require.NoError(t, ts.CreateShard(ctx, keyspace, shard))
+		if uid == 0 {
+			return
+		}
+		alias := &topodatapb.TabletAlias{Cell: "cell", Uid: uid}
+		require.NoError(t, ts.CreateTablet(ctx, &topodatapb.Tablet{
+			Alias:    alias,
+			Keyspace: keyspace,
+			Shard:    shard,
+			Type:     topodatapb.TabletType_MASTER,
+		}))
+		_, err := ts.UpdateShardFields(ctx, keyspace, shard, func(si *topo.ShardInfo) error {
+			si.MasterAlias = alias
+			return nil
+		})
+		require.NoError(t, err)
+	}
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks1", &topodatapb.Keyspace{}))
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks2", &topodatapb.Keyspace{}))
+	addShard("ks1", "-80", 100)
+	addShard("ks1", "80-", 110)
+	addShard("ks2", "0", 200)
+
+	transactions, err := wr.ListDistributedTransactions(ctx, "")
+	require.NoError(t, err)
+	assert.Empty(t, transactions)
+
+	// A shard that doesn't run with 2PC has no _vt.dt_state table, and is skipped.
+	tmc.errors[110] = fmt.Errorf("rpc error: %v", mysql.NewSQLError(mysql.ERNoSuchTable, mysql.SSUnknownSQLState, "Table '_vt.dt_state' doesn't exist"))
+	_, err = wr.ListDistributedTransactions(ctx, "ks1")
+	require.NoError(t, err)
+
+	// The failures of the shards are all reported, and don't stop the listing.
+	tmc.errors[100] = errors.New("tablet is unreachable")
+	addShard("ks2", "1", 0)
+	_, err = wr.ListDistributedTransactions(ctx, "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot read distributed transactions of shard ks1/-80: tablet is unreachable")
+	assert.Contains(t, err.Error(), "no primary tablet for shard ks2/1")
+	assert.NotContains(t, err.Error(), "ks1/80-")
+}
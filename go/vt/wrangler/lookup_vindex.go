@@ -0,0 +1,721 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	"vitess.io/vitess/go/vt/key"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+)
+
+var (
+	// lookupBackfillPollInterval is how often the progress of a
+	// lookup vindex backfill is checked.
+	lookupBackfillPollInterval = 10 * time.Second
+	// lookupVerifyWaitTime is how long the verification of a lookup vindex
+	// waits for the backfill streams to catch up with the source snapshot.
+	lookupVerifyWaitTime = 30 * time.Second
+)
+
+// BackfillLookupVindex takes a lookup vindex through its whole lifecycle: it creates
+// the lookup table and the vindex in write_only mode, waits for the backfill to complete,
+// verifies the lookup table against the table that uses the vindex, and finally switches
+// the vindex to read mode. Steps that were already performed are skipped, which allows
+// resuming the workflow after a failure by issuing the same command again.
+func (wr *Wrangler) BackfillLookupVindex(ctx context.Context, keyspace string, specs *vschemapb.Keyspace, cell, tabletTypes string) error {
+	if len(specs.Vindexes) != 1 {
+		return fmt.Errorf("only one vindex must be specified in the specs: %v", specs.Vindexes)
+	}
+	var vindexName string
+	var vindex *vschemapb.Vindex
+	for name, vi := range specs.Vindexes {
+		vindexName = name
+		vindex = vi
+	}
+	qualifiedName := keyspace + "." + vindexName
+
+	sourceVSchema, err := wr.ts.GetVSchema(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	existing := sourceVSchema.Vindexes[vindexName]
+	switch {
+	case existing == nil:
+		if err := wr.createOrResumeLookupVindex(ctx, keyspace, specs, cell, tabletTypes); err != nil {
+			return err
+		}
+	case existing.Type != vindex.Type || existing.Params["table"] != vindex.Params["table"]:
+		return fmt.Errorf("a conflicting vindex named %s already exists in the source vschema", vindexName)
+	case existing.Params["write_only"] != "true":
+		wr.Logger().Printf("Lookup vindex %s is already backfilled and in read mode\n", qualifiedName)
+		return nil
+	default:
+		wr.Logger().Printf("Resuming the backfill of lookup vindex %s\n", qualifiedName)
+	}
+
+	if err := wr.waitForLookupBackfill(ctx, qualifiedName); err != nil {
+		return err
+	}
+	wr.Logger().Printf("Verifying lookup vindex %s\n", qualifiedName)
+	dr, err := wr.verifyLookupVindex(ctx, qualifiedName)
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("Verification of %s: %+v\n", qualifiedName, *dr)
+	if dr.ExtraRowsSource != 0 || dr.MismatchedRows != 0 {
+		return fmt.Errorf("lookup vindex %s is incomplete: %d rows are missing and %d rows are mismatched, the vindex was left in write_only mode: fix the differences and retry", qualifiedName, dr.ExtraRowsSource, dr.MismatchedRows)
+	}
+
+	wr.Logger().Printf("Switching lookup vindex %s to read mode\n", qualifiedName)
+	if err := wr.ExternalizeVindex(ctx, qualifiedName); err != nil {
+		return err
+	}
+	return wr.ts.RebuildSrvVSchema(ctx, nil)
+}
+
+// createOrResumeLookupVindex creates the lookup vindex like CreateLookupVindex does. If a previous
+// attempt created the backfill workflow but failed before adding the vindex to the source vschema,
+// the workflow is kept, its streams are started if they weren't, and the vschema is updated.
+func (wr *Wrangler) createOrResumeLookupVindex(ctx context.Context, keyspace string, specs *vschemapb.Keyspace, cell, tabletTypes string) error {
+	ms, sourceVSchema, targetVSchema, err := wr.prepareCreateLookup(ctx, keyspace, specs)
+	if err != nil {
+		return err
+	}
+	var qualifiedName string
+	for name := range specs.Vindexes {
+		qualifiedName = keyspace + "." + name
+	}
+	targetShards, err := wr.ts.GetServingShards(ctx, ms.TargetKeyspace)
+	if err != nil {
+		return err
+	}
+	exists := false
+	for _, targetShard := range targetShards {
+		targetMaster, qr, err := wr.lookupBackfillStreams(ctx, targetShard, ms.Workflow)
+		if err != nil {
+			return err
+		}
+		if len(qr.Rows) == 0 {
+			continue
+		}
+		exists = true
+		// Streams are created stopped, and started once they all exist.
+		query := fmt.Sprintf("update _vt.vreplication set state='Running' where db_name=%s and workflow=%s and state=%s and message=''", encodeString(targetMaster.DbName()), encodeString(ms.Workflow), encodeString(binlogplayer.BlpStopped))
+		if _, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, query); err != nil {
+			return err
+		}
+	}
+	if err := wr.ts.SaveVSchema(ctx, ms.TargetKeyspace, targetVSchema); err != nil {
+		return err
+	}
+	if exists {
+		wr.Logger().Printf("Resuming the creation of lookup vindex %s: workflow %s.%s already exists\n", qualifiedName, ms.TargetKeyspace, ms.Workflow)
+	} else {
+		wr.Logger().Printf("Creating lookup vindex %s and starting the backfill\n", qualifiedName)
+		ms.Cell = cell
+		ms.TabletTypes = tabletTypes
+		if err := wr.Materialize(ctx, ms); err != nil {
+			return err
+		}
+	}
+	if err := wr.ts.SaveVSchema(ctx, keyspace, sourceVSchema); err != nil {
+		return err
+	}
+	return wr.ts.RebuildSrvVSchema(ctx, nil)
+}
+
+// lookupBackfillStreams returns the master of the target shard and the id, state
+// and message of the backfill streams of the workflow on that shard.
+func (wr *Wrangler) lookupBackfillStreams(ctx context.Context, targetShard *topo.ShardInfo, workflow string) (*topo.TabletInfo, *sqltypes.Result, error) {
+	if targetShard.MasterAlias == nil {
+		return nil, nil, fmt.Errorf("shard %v.%v has no master", targetShard.Keyspace(), targetShard.ShardName())
+	}
+	targetMaster, err := wr.ts.GetTablet(ctx, targetShard.MasterAlias)
+	if err != nil {
+		return nil, nil, err
+	}
+	p3qr, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, fmt.Sprintf("select id, state, message from _vt.vreplication where workflow=%s and db_name=%s", encodeString(workflow), encodeString(targetMaster.DbName())))
+	if err != nil {
+		return nil, nil, err
+	}
+	return targetMaster, sqltypes.Proto3ToResult(p3qr), nil
+}
+
+// waitForLookupBackfill waits for the backfill streams of a lookup vindex to
+// finish copying, and reports their progress whenever it changes.
+func (wr *Wrangler) waitForLookupBackfill(ctx context.Context, qualifiedVindexName string) error {
+	lv, err := wr.getLookupVindex(ctx, qualifiedVindexName)
+	if err != nil {
+		return err
+	}
+	lastProgress := ""
+	for {
+		progress, done, err := wr.lookupBackfillProgress(ctx, lv)
+		if err != nil {
+			return err
+		}
+		if progress != lastProgress {
+			wr.Logger().Printf("%s\n", progress)
+			lastProgress = progress
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(lookupBackfillPollInterval):
+		}
+	}
+}
+
+// lookupBackfillProgress returns a summary of the state of the backfill streams,
+// and whether they're done. The streams of an owned vindex stop after the copy,
+// the streams of an unowned one keep running after the copy.
+func (wr *Wrangler) lookupBackfillProgress(ctx context.Context, lv *lookupVindex) (string, bool, error) {
+	targetShards, err := wr.ts.GetServingShards(ctx, lv.targetKeyspace)
+	if err != nil {
+		return "", false, err
+	}
+	workflow := lv.targetTable + "_vdx"
+	total, done := 0, 0
+	var pending []string
+	for _, targetShard := range targetShards {
+		targetMaster, qr, err := wr.lookupBackfillStreams(ctx, targetShard, workflow)
+		if err != nil {
+			return "", false, err
+		}
+		var ids []string
+		for _, row := range qr.Rows {
+			ids = append(ids, row[0].ToString())
+		}
+		copying := make(map[string]bool)
+		if len(ids) != 0 && lv.vindex.Owner == "" {
+			p3qr, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, fmt.Sprintf("select vrepl_id from _vt.copy_state where vrepl_id in (%s)", strings.Join(ids, ", ")))
+			if err != nil {
+				return "", false, err
+			}
+			for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+				copying[row[0].ToString()] = true
+			}
+		}
+		for _, row := range qr.Rows {
+			id, state, message := row[0].ToString(), row[1].ToString(), row[2].ToString()
+			total++
+			if state == binlogplayer.BlpError {
+				return "", false, fmt.Errorf("stream %s for %v.%v failed: %s, fix the error and retry", id, targetShard.Keyspace(), targetShard.ShardName(), message)
+			}
+			finished := state == binlogplayer.BlpRunning && !copying[id]
+			if lv.vindex.Owner != "" {
+				finished = state == binlogplayer.BlpStopped && strings.Contains(message, "Stopped after copy")
+			}
+			if finished {
+				done++
+				continue
+			}
+			pending = append(pending, fmt.Sprintf("stream %s for %v.%v: %s %s", id, targetShard.Keyspace(), targetShard.ShardName(), state, message))
+		}
+	}
+	if total == 0 {
+		return "", false, fmt.Errorf("no backfill streams found for lookup vindex %s.%s: workflow %s not found in keyspace %s", lv.keyspace, lv.name, workflow, lv.targetKeyspace)
+	}
+	progress := fmt.Sprintf("Backfill of %s.%s: %d/%d streams done", lv.targetKeyspace, lv.targetTable, done, total)
+	if len(pending) != 0 {
+		progress += ", waiting for " + strings.Join(pending, "; ")
+	}
+	return progress, done == total, nil
+}
+
+// lookupVindex contains the metadata of a lookup vindex.
+type lookupVindex struct {
+	keyspace       string
+	name           string
+	vindex         *vschemapb.Vindex
+	targetKeyspace string
+	targetTable    string
+	fromCols       []string
+	toCol          string
+}
+
+func (wr *Wrangler) getLookupVindex(ctx context.Context, qualifiedVindexName string) (*lookupVindex, error) {
+	splits := strings.Split(qualifiedVindexName, ".")
+	if len(splits) != 2 {
+		return nil, fmt.Errorf("vindex name should be of the form keyspace.vindex: %s", qualifiedVindexName)
+	}
+	lv := &lookupVindex{keyspace: splits[0], name: splits[1]}
+	vschema, err := wr.ts.GetVSchema(ctx, lv.keyspace)
+	if err != nil {
+		return nil, err
+	}
+	lv.vindex = vschema.Vindexes[lv.name]
+	if lv.vindex == nil {
+		return nil, fmt.Errorf("vindex %s not found in vschema", qualifiedVindexName)
+	}
+	splits = strings.Split(lv.vindex.Params["table"], ".")
+	if len(splits) != 2 {
+		return nil, fmt.Errorf("table name in vindex should be of the form keyspace.table: %s", lv.vindex.Params["table"])
+	}
+	lv.targetKeyspace, lv.targetTable = splits[0], splits[1]
+	for _, col := range strings.Split(lv.vindex.Params["from"], ",") {
+		lv.fromCols = append(lv.fromCols, strings.TrimSpace(col))
+	}
+	lv.toCol = lv.vindex.Params["to"]
+	return lv, nil
+}
+
+// verifyLookupVindex compares the lookup table of a vindex with the table that
+// uses the vindex, like VDiff does for the tables of a workflow. The keyspace ids
+// of the rows of the table are computed with its primary vindex. Extra rows in
+// the lookup table are reported, but are harmless.
+func (wr *Wrangler) verifyLookupVindex(ctx context.Context, qualifiedVindexName string) (*DiffReport, error) {
+	lv, err := wr.getLookupVindex(ctx, qualifiedVindexName)
+	if err != nil {
+		return nil, err
+	}
+	vschema, err := wr.ts.GetVSchema(ctx, lv.keyspace)
+	if err != nil {
+		return nil, err
+	}
+	// The owner is the table that uses the vindex. An unowned vindex
+	// is verified against the first table that uses it.
+	var sourceTable string
+	var sourceCols []string
+	for name, table := range vschema.Tables {
+		if lv.vindex.Owner != "" && name != lv.vindex.Owner {
+			continue
+		}
+		for _, colVindex := range table.ColumnVindexes {
+			if colVindex.Name != lv.name {
+				continue
+			}
+			if sourceTable != "" && name > sourceTable {
+				continue
+			}
+			sourceTable = name
+			sourceCols = colVindex.Columns
+			if len(sourceCols) == 0 {
+				sourceCols = []string{colVindex.Column}
+			}
+		}
+	}
+	if sourceTable == "" {
+		return nil, fmt.Errorf("no table of keyspace %s uses vindex %s", lv.keyspace, lv.name)
+	}
+	if len(sourceCols) != len(lv.fromCols) {
+		return nil, fmt.Errorf("length of table columns differes from length of vindex columns: %v vs %v", sourceCols, lv.fromCols)
+	}
+	kschema, err := vindexes.BuildKeyspaceSchema(vschema, lv.keyspace)
+	if err != nil {
+		return nil, err
+	}
+	if !kschema.Keyspace.Sharded {
+		return nil, fmt.Errorf("keyspace %s is not sharded: lookup vindex %s can't be verified", lv.keyspace, lv.name)
+	}
+	primary := kschema.Tables[sourceTable].ColumnVindexes[0]
+	if primary.Vindex.NeedsVCursor() {
+		return nil, fmt.Errorf("primary vindex %s of table %s is not functional: lookup vindex %s can't be verified", primary.Name, sourceTable, lv.name)
+	}
+
+	sourceShards, err := wr.ts.GetServingShards(ctx, lv.keyspace)
+	if err != nil {
+		return nil, err
+	}
+	targetShards, err := wr.ts.GetServingShards(ctx, lv.targetKeyspace)
+	if err != nil {
+		return nil, err
+	}
+	sources, err := wr.lookupStreamers(ctx, sourceShards)
+	if err != nil {
+		return nil, err
+	}
+	targets, err := wr.lookupStreamers(ctx, targetShards)
+	if err != nil {
+		return nil, err
+	}
+	schm, err := wr.GetSchema(ctx, sourceShards[0].MasterAlias, []string{sourceTable}, nil, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(schm.TableDefinitions) != 1 {
+		return nil, fmt.Errorf("unexpected number of tables returned from schema: %v", schm.TableDefinitions)
+	}
+	textCols := make(map[string]bool)
+	for _, field := range schm.TableDefinitions[0].Fields {
+		if sqltypes.IsText(field.Type) {
+			textCols[strings.ToLower(field.Name)] = true
+		}
+	}
+
+	// The rows of both sides start with the lookup columns, followed by
+	// their weight strings for the text columns. The source rows then have
+	// the primary vindex columns, and the target rows the keyspace id.
+	ld := &lookupDiffer{
+		name:        qualifiedVindexName,
+		primary:     primary.Vindex,
+		ignoreNulls: lv.vindex.Params["ignore_nulls"] == "true",
+	}
+	if strings.Contains(lv.vindex.Type, "lookup_hash") {
+		if ld.toVindex, err = vindexes.CreateVindex("hash", "hash", nil); err != nil {
+			return nil, err
+		}
+	}
+	sourceBuf := sqlparser.NewTrackedBuffer(nil)
+	targetBuf := sqlparser.NewTrackedBuffer(nil)
+	sourceBuf.Myprintf("select ")
+	targetBuf.Myprintf("select ")
+	for i := range sourceCols {
+		sourceBuf.Myprintf("%v, ", sqlparser.NewColIdent(sourceCols[i]))
+		targetBuf.Myprintf("%v, ", sqlparser.NewColIdent(lv.fromCols[i]))
+		ld.keyCols = append(ld.keyCols, i)
+	}
+	ld.valueCol = len(sourceCols)
+	for i := range sourceCols {
+		if !textCols[strings.ToLower(sourceCols[i])] {
+			continue
+		}
+		sourceBuf.Myprintf("weight_string(%v), ", sqlparser.NewColIdent(sourceCols[i]))
+		targetBuf.Myprintf("weight_string(%v), ", sqlparser.NewColIdent(lv.fromCols[i]))
+		ld.keyCols[i] = ld.valueCol
+		ld.valueCol++
+	}
+	prefix := ""
+	for _, col := range primary.Columns {
+		sourceBuf.Myprintf("%s%v", prefix, col)
+		prefix = ", "
+	}
+	targetBuf.Myprintf("%v", sqlparser.NewColIdent(lv.toCol))
+	sourceBuf.Myprintf(" from %v order by ", sqlparser.NewTableIdent(sourceTable))
+	targetBuf.Myprintf(" from %v order by ", sqlparser.NewTableIdent(lv.targetTable))
+	prefix = ""
+	for i := range sourceCols {
+		sourceBuf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(sourceCols[i]))
+		targetBuf.Myprintf("%s%v", prefix, sqlparser.NewColIdent(lv.fromCols[i]))
+		prefix = ", "
+	}
+	ld.primaryCols = len(primary.Columns)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	if lv.vindex.Owner == "" {
+		// The backfill streams of an unowned vindex keep running: the snapshots
+		// of both sides are synchronized like VDiff does, by catching up the
+		// streams with the source snapshot before reading the lookup table.
+		ts, err := wr.buildTrafficSwitcher(ctx, lv.targetKeyspace, lv.targetTable+"_vdx")
+		if err != nil {
+			return nil, err
+		}
+		df := &vdiff{
+			ts:             ts,
+			sources:        sources,
+			targets:        targets,
+			workflow:       ts.workflow,
+			targetKeyspace: lv.targetKeyspace,
+		}
+		td := &tableDiffer{
+			targetTable:      lv.targetTable,
+			sourceExpression: sourceBuf.String(),
+			targetExpression: targetBuf.String(),
+		}
+		if err := df.diffTable(ctx, wr, lv.targetTable, td, lookupVerifyWaitTime); err != nil {
+			return nil, err
+		}
+	} else {
+		// The backfill streams of an owned vindex are stopped after the copy,
+		// and vtgate keeps the lookup table up to date.
+		if err := startLookupStreams(ctx, lv.keyspace, sources, sourceBuf.String()); err != nil {
+			return nil, err
+		}
+		if err := startLookupStreams(ctx, lv.targetKeyspace, targets, targetBuf.String()); err != nil {
+			return nil, err
+		}
+	}
+	ld.sourcePrimitive = newMergeSorter(sources, ld.keyCols)
+	ld.targetPrimitive = newMergeSorter(targets, ld.keyCols)
+	return ld.diff(ctx, wr)
+}
+
+// lookupStreamers returns the streamers for the masters of the shards.
+// The lookup table is updated on the masters, so reading from replicas
+// could report differences because of replication lag.
+func (wr *Wrangler) lookupStreamers(ctx context.Context, shards []*topo.ShardInfo) (map[string]*shardStreamer, error) {
+	streamers := make(map[string]*shardStreamer)
+	for _, shard := range shards {
+		if shard.MasterAlias == nil {
+			return nil, fmt.Errorf("shard %v.%v has no master", shard.Keyspace(), shard.ShardName())
+		}
+		master, err := wr.ts.GetTablet(ctx, shard.MasterAlias)
+		if err != nil {
+			return nil, err
+		}
+		streamers[shard.ShardName()] = &shardStreamer{
+			master: master,
+			tablet: master.Tablet,
+		}
+	}
+	return streamers, nil
+}
+
+// startLookupStreams starts the query streams of all the participants.
+func startLookupStreams(ctx context.Context, keyspace string, participants map[string]*shardStreamer, query string) error {
+	for shard, participant := range participants {
+		participant.result = make(chan *sqltypes.Result, 1)
+		gtidch := make(chan string, 1)
+		go streamOne(ctx, keyspace, shard, participant, query, gtidch)
+		if _, ok := <-gtidch; !ok {
+			return participant.err
+		}
+	}
+	return nil
+}
+
+// lookupDiffer compares the rows of a table with the rows of the lookup table of one of its vindexes.
+// The rows of both sides are grouped by the values of the lookup columns, and the keyspace ids of
+// a group of the table are compared with the ones of the lookup table. This works for unique
+// as well as non-unique lookup vindexes.
+type lookupDiffer struct {
+	name string
+	// keyCols are the columns that identify a group on both sides.
+	keyCols []int
+	// valueCol is where the primary vindex columns start in the source rows,
+	// and the column of the keyspace id in the target rows.
+	valueCol    int
+	primaryCols int
+	primary     vindexes.Vindex
+	// toVindex maps the values of the lookup table to keyspace ids. It's
+	// only set for the lookup_hash types, which store a hash of the id.
+	toVindex    vindexes.Vindex
+	ignoreNulls bool
+
+	sourcePrimitive engine.Primitive
+	targetPrimitive engine.Primitive
+}
+
+// lookupGroup is a group of rows with the same lookup values.
+type lookupGroup struct {
+	row  []sqltypes.Value
+	ids  map[string]bool
+	rows int
+}
+
+// groupReader reads the rows of a side in groups.
+type groupReader struct {
+	ld     *lookupDiffer
+	pe     *primitiveExecutor
+	peeked []sqltypes.Value
+	source bool
+}
+
+func (gr *groupReader) next() (*lookupGroup, error) {
+	var group *lookupGroup
+	for {
+		row := gr.peeked
+		gr.peeked = nil
+		if row == nil {
+			var err error
+			row, err = gr.pe.next()
+			if err != nil {
+				return nil, err
+			}
+			if row == nil {
+				return group, nil
+			}
+		}
+		if gr.ld.ignoreNulls && gr.ld.hasNull(row) {
+			continue
+		}
+		if group != nil {
+			c, err := gr.ld.compare(group.row, row)
+			if err != nil {
+				return nil, err
+			}
+			if c != 0 {
+				gr.peeked = row
+				return group, nil
+			}
+		} else {
+			group = &lookupGroup{row: row, ids: make(map[string]bool)}
+		}
+		var ksid []byte
+		var err error
+		if gr.source {
+			ksid, err = gr.ld.keyspaceID(gr.ld.primary, row[gr.ld.valueCol:gr.ld.valueCol+gr.ld.primaryCols])
+		} else {
+			ksid, err = gr.ld.targetKeyspaceID(row[gr.ld.valueCol])
+		}
+		if err != nil {
+			return nil, err
+		}
+		group.ids[string(ksid)] = true
+		group.rows++
+	}
+}
+
+func (ld *lookupDiffer) diff(ctx context.Context, wr *Wrangler) (*DiffReport, error) {
+	source := &groupReader{ld: ld, pe: newPrimitiveExecutor(ctx, ld.sourcePrimitive), source: true}
+	target := &groupReader{ld: ld, pe: newPrimitiveExecutor(ctx, ld.targetPrimitive)}
+	dr := &DiffReport{}
+	var sourceGroup, targetGroup *lookupGroup
+	var err error
+	advanceSource := true
+	advanceTarget := true
+	for {
+		if s := logSteps(int64(dr.ProcessedRows)); s != "" {
+			wr.Logger().Infof("Lookup vindex verification progress:: %s: %s rows", ld.name, s)
+		}
+		if advanceSource {
+			sourceGroup, err = source.next()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if advanceTarget {
+			targetGroup, err = target.next()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if sourceGroup == nil && targetGroup == nil {
+			return dr, nil
+		}
+		advanceSource = true
+		advanceTarget = true
+
+		c := 0
+		switch {
+		case sourceGroup == nil:
+			c = 1
+		case targetGroup == nil:
+			c = -1
+		default:
+			c, err = ld.compare(sourceGroup.row, targetGroup.row)
+			if err != nil {
+				return nil, err
+			}
+		}
+		switch {
+		case c < 0:
+			ld.reportMissing(wr, dr, sourceGroup, len(sourceGroup.ids))
+			dr.ProcessedRows += len(sourceGroup.ids)
+			advanceTarget = false
+			continue
+		case c > 0:
+			ld.reportExtra(wr, dr, targetGroup, targetGroup.rows)
+			dr.ProcessedRows += targetGroup.rows
+			advanceSource = false
+			continue
+		}
+
+		// The lookup values are on both sides: compare the keyspace ids.
+		missing, extra := 0, 0
+		for id := range sourceGroup.ids {
+			if !targetGroup.ids[id] {
+				missing++
+			}
+		}
+		for id := range targetGroup.ids {
+			if !sourceGroup.ids[id] {
+				extra++
+			}
+		}
+		// A keyspace id that's missing, along with one that shouldn't be
+		// there, is a lookup row that points to the wrong keyspace id.
+		mismatched := missing
+		if extra < mismatched {
+			mismatched = extra
+		}
+		if mismatched != 0 {
+			if dr.MismatchedRows < 10 {
+				wr.Logger().Errorf("[vindex=%v] Different keyspace ids for %v: %v", ld.name, sourceGroup.row[:len(ld.keyCols)], targetGroup.row)
+			}
+			dr.MismatchedRows += mismatched
+		}
+		if missing > mismatched {
+			ld.reportMissing(wr, dr, sourceGroup, missing-mismatched)
+		}
+		if extra > mismatched {
+			ld.reportExtra(wr, dr, targetGroup, extra-mismatched)
+		}
+		dr.MatchingRows += len(sourceGroup.ids) - missing
+		dr.ProcessedRows += len(sourceGroup.ids) + extra - mismatched
+	}
+}
+
+func (ld *lookupDiffer) reportMissing(wr *Wrangler, dr *DiffReport, group *lookupGroup, count int) {
+	if dr.ExtraRowsSource < 10 {
+		wr.Logger().Errorf("[vindex=%v] Rows missing from the lookup table for: %v", ld.name, group.row[:len(ld.keyCols)])
+	}
+	dr.ExtraRowsSource += count
+}
+
+func (ld *lookupDiffer) reportExtra(wr *Wrangler, dr *DiffReport, group *lookupGroup, count int) {
+	if dr.ExtraRowsTarget < 10 {
+		wr.Logger().Warningf("[vindex=%v] Extra rows in the lookup table: %v", ld.name, group.row)
+	}
+	dr.ExtraRowsTarget += count
+}
+
+func (ld *lookupDiffer) hasNull(row []sqltypes.Value) bool {
+	for i := range ld.keyCols {
+		if row[i].IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+func (ld *lookupDiffer) compare(sourceRow, targetRow []sqltypes.Value) (int, error) {
+	for _, col := range ld.keyCols {
+		c, err := evalengine.NullsafeCompare(sourceRow[col], targetRow[col])
+		if err != nil {
+			return 0, err
+		}
+		if c != 0 {
+			return c, nil
+		}
+	}
+	return 0, nil
+}
+
+func (ld *lookupDiffer) targetKeyspaceID(val sqltypes.Value) ([]byte, error) {
+	if ld.toVindex == nil {
+		return val.ToBytes(), nil
+	}
+	return ld.keyspaceID(ld.toVindex, []sqltypes.Value{val})
+}
+
+func (ld *lookupDiffer) keyspaceID(vindex vindexes.Vindex, values []sqltypes.Value) ([]byte, error) {
+	dests, err := vindexes.Map(vindex, nil, [][]sqltypes.Value{values})
+	if err != nil {
+		return nil, err
+	}
+	ksid, ok := dests[0].(key.DestinationKeyspaceID)
+	if !ok {
+		return nil, fmt.Errorf("could not map %v to a keyspace id", values)
+	}
+	return ksid, nil
+}
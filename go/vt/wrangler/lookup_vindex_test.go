@@ -0,0 +1,384 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+)
+
+func TestBackfillLookupVindex(t *testing.T) {
+	ksid := func(h string) sqltypes.Value {
+		b, err := hex.DecodeString(h)
+		require.NoError(t, err)
+		return sqltypes.MakeTrusted(sqltypes.VarBinary, b)
+	}
+	// hash(1), hash(2) and hash(3).
+	ksid1, ksid2, ksid3 := ksid("166b40b44aba4bd6"), ksid("06e7ea22ce92708f"), ksid("4eb190c9a2fa169c")
+	row := func(vals ...sqltypes.Value) []sqltypes.Value {
+		return vals
+	}
+
+	lkp := func(vindexType string, owned, writeOnly bool) *vschemapb.Vindex {
+		vindex := &vschemapb.Vindex{
+			Type: vindexType,
+			Params: map[string]string{
+				"table": "target.lkp",
+				"from":  "c1",
+				"to":    "keyspace_id",
+			},
+		}
+		if owned {
+			vindex.Owner = "t1"
+		}
+		if writeOnly {
+			vindex.Params["write_only"] = "true"
+		}
+		return vindex
+	}
+	vrFields := sqltypes.MakeTestFields("id|state|message", "int64|varbinary|varbinary")
+	stopped := sqltypes.MakeTestResult(vrFields, "1|Stopped|Stopped after copy")
+	running := sqltypes.MakeTestResult(vrFields, "1|Running|")
+	failed := sqltypes.MakeTestResult(vrFields, "1|Error|duplicate key")
+
+	testcases := []struct {
+		name         string
+		vindex       *vschemapb.Vindex
+		vrResponse   *sqltypes.Result
+		copyState    *sqltypes.Result
+		sourceRows   [][]sqltypes.Value
+		targetRows   [][]sqltypes.Value
+		externalized bool
+		err          string
+	}{{
+		name:       "owned",
+		vindex:     lkp("lookup_unique", true, true),
+		vrResponse: stopped,
+		sourceRows: [][]sqltypes.Value{
+			row(sqltypes.NewInt64(10), sqltypes.NewInt64(1)),
+			row(sqltypes.NewInt64(20), sqltypes.NewInt64(2)),
+		},
+		targetRows: [][]sqltypes.Value{
+			row(sqltypes.NewInt64(10), ksid1),
+			row(sqltypes.NewInt64(20), ksid2),
+			// Extra rows are harmless.
+			row(sqltypes.NewInt64(30), ksid3),
+		},
+		externalized: true,
+	}, {
+		name:       "unowned non-unique",
+		vindex:     lkp("lookup", false, true),
+		vrResponse: running,
+		copyState:  &sqltypes.Result{},
+		sourceRows: [][]sqltypes.Value{
+			row(sqltypes.NewInt64(10), sqltypes.NewInt64(1)),
+			row(sqltypes.NewInt64(10), sqltypes.NewInt64(2)),
+			row(sqltypes.NewInt64(20), sqltypes.NewInt64(3)),
+		},
+		targetRows: [][]sqltypes.Value{
+			row(sqltypes.NewInt64(10), ksid2),
+			row(sqltypes.NewInt64(10), ksid1),
+			row(sqltypes.NewInt64(20), ksid3),
+		},
+		externalized: true,
+	}, {
+		name:   "already in read mode",
+		vindex: lkp("lookup_unique", true, false),
+	}, {
+		name:       "mismatch",
+		vindex:     lkp("lookup_unique", true, true),
+		vrResponse: stopped,
+		sourceRows: [][]sqltypes.Value{
+			row(sqltypes.NewInt64(10), sqltypes.NewInt64(1)),
+			row(sqltypes.NewInt64(20), sqltypes.NewInt64(2)),
+		},
+		targetRows: [][]sqltypes.Value{
+			row(sqltypes.NewInt64(10), ksid1),
+			row(sqltypes.NewInt64(20), ksid3),
+		},
+		err: "lookup vindex source.lkp is incomplete: 0 rows are missing and 1 rows are mismatched",
+	}, {
+		name:       "missing",
+		vindex:     lkp("lookup_unique", true, true),
+		vrResponse: stopped,
+		sourceRows: [][]sqltypes.Value{
+			row(sqltypes.NewInt64(10), sqltypes.NewInt64(1)),
+			row(sqltypes.NewInt64(20), sqltypes.NewInt64(2)),
+		},
+		targetRows: [][]sqltypes.Value{
+			row(sqltypes.NewInt64(20), ksid2),
+		},
+		err: "lookup vindex source.lkp is incomplete: 1 rows are missing and 0 rows are mismatched",
+	}, {
+		name:       "stream error",
+		vindex:     lkp("lookup_unique", true, true),
+		vrResponse: failed,
+		err:        "stream 1 for target.0 failed: duplicate key, fix the error and retry",
+	}, {
+		name:       "no streams",
+		vindex:     lkp("lookup_unique", true, true),
+		vrResponse: &sqltypes.Result{},
+		err:        "no backfill streams found for lookup vindex source.lkp: workflow lkp_vdx not found in keyspace target",
+	}, {
+		name:   "conflict",
+		vindex: &vschemapb.Vindex{Type: "lookup_unique", Params: map[string]string{"table": "target.other"}},
+		err:    "a conflicting vindex named lkp already exists in the source vschema",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "", nil)
+			defer env.close()
+			ctx := context.Background()
+
+			specs := &vschemapb.Keyspace{
+				Vindexes: map[string]*vschemapb.Vindex{
+					"lkp": lkp(tcase.vindex.Type, tcase.vindex.Owner != "", false),
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:   "lkp",
+							Column: "c1",
+						}},
+					},
+				},
+			}
+			sourceVSchema := &vschemapb.Keyspace{
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+					"lkp":  tcase.vindex,
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:   "hash",
+							Column: "id",
+						}, {
+							Name:   "lkp",
+							Column: "c1",
+						}},
+					},
+				},
+			}
+			require.NoError(t, env.topoServ.SaveVSchema(ctx, "source", sourceVSchema))
+			env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+				TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+					Name:              "t1",
+					Columns:           []string{"id", "c1"},
+					PrimaryKeyColumns: []string{"id"},
+					Fields:            sqltypes.MakeTestFields("id|c1", "int64|int64"),
+				}},
+			}
+
+			target := env.tablets[200].tablet
+			if tcase.vrResponse != nil {
+				env.tmc.setVRResults(target, "select id, state, message from _vt.vreplication where workflow='lkp_vdx' and db_name='vt_target'", tcase.vrResponse)
+			}
+			if tcase.copyState != nil {
+				env.tmc.setVRResults(target, "select vrepl_id from _vt.copy_state where vrepl_id in (1)", tcase.copyState)
+			}
+			env.tmc.setVRResults(target, "delete from _vt.vreplication where db_name='vt_target' and workflow='lkp_vdx'", &sqltypes.Result{})
+			// The verification of an unowned vindex synchronizes the streams like VDiff.
+			bls := &binlogdatapb.BinlogSource{
+				Keyspace: "source",
+				Shard:    "0",
+				Filter: &binlogdatapb.Filter{
+					Rules: []*binlogdatapb.Rule{{
+						Match:  "lkp",
+						Filter: "select c1, keyspace_id() as keyspace_id from t1",
+					}},
+				},
+			}
+			env.tmc.setVRResults(
+				target,
+				"select id, source, message, cell, tablet_types from _vt.vreplication where workflow='lkp_vdx' and db_name='vt_target'",
+				sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|source|message|cell|tablet_types", "int64|varchar|varchar|varchar|varchar"), fmt.Sprintf("1|%v|||", bls)),
+			)
+			env.tmc.setVRResults(target, "update _vt.vreplication set state='Stopped', message='for vdiff' where db_name='vt_target' and workflow='lkp_vdx'", &sqltypes.Result{})
+			env.tmc.setVRResults(
+				target,
+				"select source, pos from _vt.vreplication where db_name='vt_target' and workflow='lkp_vdx'",
+				sqltypes.MakeTestResult(sqltypes.MakeTestFields("source|pos", "varchar|varchar"), fmt.Sprintf("%v|%s", bls, vdiffStopPosition)),
+			)
+			env.tmc.setVRResults(target, "update _vt.vreplication set state='Running', message='', stop_pos='' where db_name='vt_target' and workflow='lkp_vdx'", &sqltypes.Result{})
+			env.tmc.waitpos[100] = vdiffStopPosition
+			env.tmc.waitpos[200] = vdiffTargetMasterPosition
+			env.tablets[100].setResults(
+				"select c1, id from t1 order by c1",
+				vdiffSourceGtid,
+				[]*sqltypes.Result{
+					{Fields: sqltypes.MakeTestFields("c1|id", "int64|int64")},
+					{Rows: tcase.sourceRows},
+				},
+			)
+			env.tablets[200].setResults(
+				"select c1, keyspace_id from lkp order by c1",
+				vdiffTargetMasterPosition,
+				[]*sqltypes.Result{
+					{Fields: sqltypes.MakeTestFields("c1|keyspace_id", "int64|varbinary")},
+					{Rows: tcase.targetRows},
+				},
+			)
+
+			err := env.wr.BackfillLookupVindex(ctx, "source", specs, "", "")
+			if tcase.err != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tcase.err)
+			} else {
+				require.NoError(t, err)
+			}
+
+			got, err := env.topoServ.GetVSchema(ctx, "source")
+			require.NoError(t, err)
+			want := proto.Clone(tcase.vindex).(*vschemapb.Vindex)
+			if tcase.externalized {
+				delete(want.Params, "write_only")
+				srvVSchema, err := env.topoServ.GetSrvVSchema(ctx, env.cell)
+				require.NoError(t, err)
+				assert.True(t, proto.Equal(want, srvVSchema.Keyspaces["source"].Vindexes["lkp"]), "srv vschema: %v", srvVSchema.Keyspaces["source"].Vindexes["lkp"])
+			}
+			assert.True(t, proto.Equal(want, got.Vindexes["lkp"]), "vindex: %v, want %v", got.Vindexes["lkp"], want)
+		})
+	}
+}
+
+func TestBackfillLookupVindexCreate(t *testing.T) {
+	selectStreams := "select id, state, message from _vt.vreplication where workflow='lkp_vdx' and db_name='vt_targetks'"
+	vrFields := sqltypes.MakeTestFields("id|state|message", "int64|varbinary|varbinary")
+	testcases := []struct {
+		name    string
+		streams *sqltypes.Result
+		queries []string
+	}{{
+		name:    "create",
+		streams: &sqltypes.Result{},
+		queries: []string{
+			"select 1 from _vt.vreplication where db_name='vt_targetks' and workflow='lkp_vdx'",
+			mzSelectFrozenQuery,
+			"/CREATE TABLE `lkp`",
+			insertPrefix,
+			"update _vt.vreplication set state='Running' where db_name='vt_targetks' and workflow='lkp_vdx'",
+		},
+	}, {
+		// A previous attempt created the workflow, but failed before starting
+		// the streams and adding the vindex to the vschema.
+		name:    "resume",
+		streams: sqltypes.MakeTestResult(vrFields, "1|Stopped|"),
+		queries: []string{
+			"update _vt.vreplication set state='Running' where db_name='vt_targetks' and workflow='lkp_vdx' and state='Stopped' and message=''",
+		},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			// The workflow isn't set in the settings: the check for an existing
+			// workflow comes before the validation of the new one.
+			ms := &vtctldatapb.MaterializeSettings{
+				SourceKeyspace: "sourceks",
+				TargetKeyspace: "targetks",
+			}
+			env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+			defer env.close()
+			ctx := context.Background()
+
+			specs := &vschemapb.Keyspace{
+				Vindexes: map[string]*vschemapb.Vindex{
+					"v": {
+						Type: "lookup_unique",
+						Params: map[string]string{
+							"table": "targetks.lkp",
+							"from":  "c1",
+							"to":    "c2",
+						},
+						Owner: "t1",
+					},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:   "v",
+							Column: "col2",
+						}},
+					},
+				},
+			}
+			env.tmc.schema[ms.SourceKeyspace+".t1"] = &tabletmanagerdatapb.SchemaDefinition{
+				TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+					Fields: []*querypb.Field{{
+						Name: "col1",
+						Type: querypb.Type_INT64,
+					}, {
+						Name: "col2",
+						Type: querypb.Type_INT64,
+					}},
+					Schema: "CREATE TABLE `t1` (\n" +
+						"  `col1` int(11) NOT NULL AUTO_INCREMENT,\n" +
+						"  `col2` int(11) DEFAULT NULL,\n" +
+						"  PRIMARY KEY (`id`)\n" +
+						") ENGINE=InnoDB AUTO_INCREMENT=3 DEFAULT CHARSET=latin1",
+				}},
+			}
+			require.NoError(t, env.topoServ.SaveVSchema(ctx, ms.TargetKeyspace, &vschemapb.Keyspace{}))
+			require.NoError(t, env.topoServ.SaveVSchema(ctx, ms.SourceKeyspace, &vschemapb.Keyspace{
+				Sharded: true,
+				Vindexes: map[string]*vschemapb.Vindex{
+					"hash": {Type: "hash"},
+				},
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:   "hash",
+							Column: "col1",
+						}},
+					},
+				},
+			}))
+
+			env.tmc.expectVRQuery(200, selectStreams, tcase.streams)
+			for _, query := range tcase.queries {
+				env.tmc.expectVRQuery(200, query, &sqltypes.Result{})
+			}
+			// The backfill is then stopped by a failing stream.
+			env.tmc.expectVRQuery(200, selectStreams, sqltypes.MakeTestResult(vrFields, "1|Error|duplicate key"))
+
+			err := env.wr.BackfillLookupVindex(ctx, ms.SourceKeyspace, specs, "", "")
+			require.EqualError(t, err, "stream 1 for targetks.0 failed: duplicate key, fix the error and retry")
+			env.tmc.verifyQueries(t)
+
+			vschema, err := env.topoServ.GetVSchema(ctx, ms.SourceKeyspace)
+			require.NoError(t, err)
+			assert.Equal(t, map[string]string{"table": "targetks.lkp", "from": "c1", "to": "c2", "write_only": "true"}, vschema.Vindexes["v"].Params)
+			assert.Len(t, vschema.Tables["t1"].ColumnVindexes, 2)
+			vschema, err = env.topoServ.GetVSchema(ctx, ms.TargetKeyspace)
+			require.NoError(t, err)
+			assert.NotNil(t, vschema.Tables["lkp"])
+		})
+	}
+}
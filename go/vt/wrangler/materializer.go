@@ -913,6 +913,9 @@ func (mz *materializer) deploySchema(ctx context.Context) error {
 						return fmt.Errorf("source and target table names must match for copying schema: %v vs %v", sqlparser.String(sourceTableName), ts.TargetTable)
 
 					}
+					if err := checkCopiedColumns(ts.SourceExpression); err != nil {
+						return err
+					}
 				}
 
 				ddl, ok := sourceDDLs[ts.TargetTable]
@@ -951,6 +954,34 @@ func (mz *materializer) deploySchema(ctx context.Context) error {
 	})
 }
 
+// checkCopiedColumns verifies that the columns of a SourceExpression can be
+// materialized into a copy of the source table: renamed or transformed
+// columns need a target table that is created explicitly.
+func checkCopiedColumns(sourceExpression string) error {
+	stmt, err := sqlparser.Parse(sourceExpression)
+	if err != nil {
+		return err
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return fmt.Errorf("unrecognized statement: %s", sourceExpression)
+	}
+	for _, selExpr := range sel.SelectExprs {
+		switch selExpr := selExpr.(type) {
+		case *sqlparser.StarExpr:
+			continue
+		case *sqlparser.AliasedExpr:
+			if colName, ok := selExpr.Expr.(*sqlparser.ColName); ok {
+				if selExpr.As.IsEmpty() || selExpr.As.Equal(colName.Name) {
+					continue
+				}
+			}
+		}
+		return fmt.Errorf("cannot copy schema when columns are renamed or transformed: %v", sqlparser.String(selExpr))
+	}
+	return nil
+}
+
 func stripTableConstraints(ddl string) (string, error) {
 	ast, err := sqlparser.ParseStrictDDL(ddl)
 	if err != nil {
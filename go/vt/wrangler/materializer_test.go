@@ -2147,6 +2147,27 @@ func TestMaterializerTableMismatchCopy(t *testing.T) {
 	require.EqualError(t, err, "source and target table names must match for copying schema: t2 vs t1")
 }
 
+func TestMaterializerTransformedColumnsCopy(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		Workflow:       "workflow",
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+		TableSettings: []*vtctldatapb.TableMaterializeSettings{{
+			TargetTable:      "t1",
+			SourceExpression: "select c1, concat(c2, '-', c3) as c2 from t1",
+			CreateDdl:        "copy",
+		}},
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"0"})
+	defer env.close()
+
+	delete(env.tmc.schema, "targetks.t1")
+
+	env.tmc.expectVRQuery(200, mzSelectFrozenQuery, &sqltypes.Result{})
+	err := env.wr.Materialize(context.Background(), ms)
+	require.EqualError(t, err, "cannot copy schema when columns are renamed or transformed: concat(c2, '-', c3) as c2")
+}
+
 func TestMaterializerNoSourceTable(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -0,0 +1,373 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+)
+
+// SwitchShardWrites switches the writes of some shards of a MoveTables workflow
+// to the target keyspace, or back to the source keyspace if reverse is set.
+// The source and target keyspaces must have the same shards: the writes of a
+// switched shard of the source keyspace are routed to the shard with the same
+// name in the target keyspace by a shard routing rule, while the other shards
+// keep being served by the source keyspace. A reverse stream keeps the source
+// shard up to date with the target shard while it's switched. Once all the
+// shards are switched, the workflow is completed the same way SwitchWrites does
+// it: the tables are routed to the target keyspace and the workflow is frozen.
+func (wr *Wrangler) SwitchShardWrites(ctx context.Context, targetKeyspace, workflow string, shards []string, timeout time.Duration, reverse bool) (err error) {
+	if len(shards) == 0 {
+		return fmt.Errorf("no shards specified")
+	}
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
+	if err != nil {
+		wr.Logger().Errorf("buildTrafficSwitcher failed: %v", err)
+		return err
+	}
+	if ts.frozen {
+		return fmt.Errorf("writes have already been switched for workflow %s", workflow)
+	}
+	if ts.migrationType != binlogdatapb.MigrationType_TABLES || ts.sourceKeyspace == ts.targetKeyspace {
+		return fmt.Errorf("switching the writes of some shards is only supported for MoveTables workflows")
+	}
+	if err := ts.validate(ctx); err != nil {
+		wr.Logger().Errorf("validate failed: %v", err)
+		return err
+	}
+	allShards := ts.sourceShards()
+	if err := ts.restrictToShards(shards); err != nil {
+		return err
+	}
+
+	ctx, sourceUnlock, lockErr := wr.ts.LockKeyspace(ctx, ts.sourceKeyspace, "SwitchShardWrites")
+	if lockErr != nil {
+		wr.Logger().Errorf("LockKeyspace failed: %v", lockErr)
+		return lockErr
+	}
+	defer sourceUnlock(&err)
+	ctx, targetUnlock, lockErr := wr.ts.LockKeyspace(ctx, ts.targetKeyspace, "SwitchShardWrites")
+	if lockErr != nil {
+		wr.Logger().Errorf("LockKeyspace failed: %v", lockErr)
+		return lockErr
+	}
+	defer targetUnlock(&err)
+
+	switched, err := ts.getSwitchedShards(ctx)
+	if err != nil {
+		return err
+	}
+	for _, shard := range shards {
+		if switched[shard] == !reverse {
+			if reverse {
+				return fmt.Errorf("writes of shard %s have not been switched for workflow %s", shard, workflow)
+			}
+			return fmt.Errorf("writes of shard %s have already been switched for workflow %s", shard, workflow)
+		}
+	}
+
+	if reverse {
+		return ts.switchShardWritesBackward(ctx, timeout)
+	}
+	if err := ts.switchShardWritesForward(ctx, timeout); err != nil {
+		return err
+	}
+	for _, shard := range shards {
+		switched[shard] = true
+	}
+	for _, si := range allShards {
+		if !switched[si.ShardName()] {
+			return nil
+		}
+	}
+	wr.Logger().Infof("Writes of all shards have been switched, completing workflow %s", workflow)
+	return wr.completeShardWrites(ctx, targetKeyspace, workflow)
+}
+
+// restrictToShards makes the traffic switcher act only on the specified shards.
+// Every target shard must replicate from the source shard of the same name only.
+func (ts *trafficSwitcher) restrictToShards(shards []string) error {
+	sources := make(map[string]*tsSource)
+	targets := make(map[string]*tsTarget)
+	for _, shard := range shards {
+		source, ok := ts.sources[shard]
+		if !ok {
+			return fmt.Errorf("shard %s not found in source keyspace %s", shard, ts.sourceKeyspace)
+		}
+		target, ok := ts.targets[shard]
+		if !ok {
+			return fmt.Errorf("shard %s not found in target keyspace %s", shard, ts.targetKeyspace)
+		}
+		for _, bls := range target.sources {
+			if bls.Shard != shard {
+				return fmt.Errorf("target shard %s replicates from source shard %s: the source and target keyspaces must have the same shards", shard, bls.Shard)
+			}
+		}
+		sources[shard] = source
+		targets[shard] = target
+	}
+	ts.sources = sources
+	ts.targets = targets
+	return nil
+}
+
+// getSwitchedShards returns the shards of the source keyspace whose writes
+// are routed to the target keyspace.
+func (ts *trafficSwitcher) getSwitchedShards(ctx context.Context) (map[string]bool, error) {
+	srr, err := ts.wr.ts.GetShardRoutingRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+	switched := make(map[string]bool)
+	for _, rule := range srr.Rules {
+		if ts.isShardRoutingRuleOf(rule) {
+			switched[rule.Shard] = true
+		}
+	}
+	return switched, nil
+}
+
+// isShardRoutingRuleOf returns true if the shard routing rule routes the
+// writes to tables of the workflow.
+func (ts *trafficSwitcher) isShardRoutingRuleOf(rule *vschemapb.ShardRoutingRule) bool {
+	if rule.FromKeyspace != ts.sourceKeyspace || rule.ToKeyspace != ts.targetKeyspace {
+		return false
+	}
+	for _, table := range rule.Tables {
+		for _, tsTable := range ts.tables {
+			if table == tsTable {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (ts *trafficSwitcher) switchShardWritesForward(ctx context.Context, timeout time.Duration) error {
+	ts.wr.Logger().Infof("Stopping source writes")
+	if err := ts.stopSourceWrites(ctx); err != nil {
+		ts.wr.Logger().Errorf("stopSourceWrites failed: %v", err)
+		ts.cancelShardWrites(ctx)
+		return err
+	}
+	ts.wr.Logger().Infof("Waiting for streams to catchup")
+	if err := ts.waitForCatchup(ctx, timeout); err != nil {
+		ts.wr.Logger().Errorf("waitForCatchup failed: %v", err)
+		ts.cancelShardWrites(ctx)
+		return err
+	}
+	ts.wr.Logger().Infof("Creating reverse streams")
+	if err := ts.createReverseVReplication(ctx); err != nil {
+		ts.wr.Logger().Errorf("createReverseVReplication failed: %v", err)
+		ts.cancelShardWrites(ctx)
+		return err
+	}
+	if err := ts.allowTableTargetWrites(ctx); err != nil {
+		ts.wr.Logger().Errorf("allowTableTargetWrites failed: %v", err)
+		return err
+	}
+	if err := ts.changeShardRoutingRules(ctx, DirectionForward); err != nil {
+		ts.wr.Logger().Errorf("changeShardRoutingRules failed: %v", err)
+		return err
+	}
+	return ts.forAllSources(func(source *tsSource) error {
+		query := fmt.Sprintf("update _vt.vreplication set state='Running', message='' where db_name=%s and workflow=%s", encodeString(source.master.DbName()), encodeString(ts.reverseWorkflow))
+		_, err := ts.wr.tmc.VReplicationExec(ctx, source.master.Tablet, query)
+		return err
+	})
+}
+
+// cancelShardWrites undoes a failed forward switch of some shards.
+func (ts *trafficSwitcher) cancelShardWrites(ctx context.Context) {
+	if err := ts.changeTableSourceWrites(ctx, allowWrites); err != nil {
+		ts.wr.Logger().Errorf("Cancel migration failed: %v", err)
+	}
+	err := ts.forAllTargets(func(target *tsTarget) error {
+		query := fmt.Sprintf("update _vt.vreplication set state='Running', message='' where db_name=%s and workflow=%s", encodeString(target.master.DbName()), encodeString(ts.workflow))
+		_, err := ts.wr.tmc.VReplicationExec(ctx, target.master.Tablet, query)
+		return err
+	})
+	if err != nil {
+		ts.wr.Logger().Errorf("Cancel migration failed: could not restart vreplication: %v", err)
+	}
+	if err := ts.deleteReverseVReplication(ctx); err != nil {
+		ts.wr.Logger().Errorf("Cancel migration failed: could not delete reverse vreplication entries: %v", err)
+	}
+}
+
+func (ts *trafficSwitcher) switchShardWritesBackward(ctx context.Context, timeout time.Duration) error {
+	ts.wr.Logger().Infof("Stopping target writes")
+	if err := ts.changeTableTargetWrites(ctx, disallowWrites); err != nil {
+		ts.wr.Logger().Errorf("changeTableTargetWrites failed: %v", err)
+		return err
+	}
+	if err := ts.forAllTargets(func(target *tsTarget) error {
+		var err error
+		target.position, err = ts.wr.tmc.MasterPosition(ctx, target.master.Tablet)
+		return err
+	}); err != nil {
+		ts.wr.Logger().Errorf("MasterPosition failed: %v", err)
+		return err
+	}
+	ts.wr.Logger().Infof("Waiting for reverse streams to catchup")
+	if err := ts.waitForReverseCatchup(ctx, timeout); err != nil {
+		ts.wr.Logger().Errorf("waitForReverseCatchup failed: %v", err)
+		if err := ts.changeTableTargetWrites(ctx, allowWrites); err != nil {
+			ts.wr.Logger().Errorf("Cancel migration failed: %v", err)
+		}
+		return err
+	}
+	if err := ts.deleteReverseVReplication(ctx); err != nil {
+		ts.wr.Logger().Errorf("deleteReverseVReplication failed: %v", err)
+		return err
+	}
+	// The source shards have received the writes of the target shards
+	// through the reverse streams: the forward streams must resume from
+	// the current positions of the source shards to not apply them again.
+	if err := ts.forAllSources(func(source *tsSource) error {
+		var err error
+		source.position, err = ts.wr.tmc.MasterPosition(ctx, source.master.Tablet)
+		if err != nil {
+			return err
+		}
+		target := ts.targets[source.si.ShardName()]
+		query := fmt.Sprintf("update _vt.vreplication set pos=%s, state='Running', message='' where db_name=%s and workflow=%s",
+			encodeString(source.position), encodeString(target.master.DbName()), encodeString(ts.workflow))
+		_, err = ts.wr.tmc.VReplicationExec(ctx, target.master.Tablet, query)
+		return err
+	}); err != nil {
+		ts.wr.Logger().Errorf("restarting the streams failed: %v", err)
+		return err
+	}
+	if err := ts.changeShardRoutingRules(ctx, DirectionBackward); err != nil {
+		ts.wr.Logger().Errorf("changeShardRoutingRules failed: %v", err)
+		return err
+	}
+	return ts.changeTableSourceWrites(ctx, allowWrites)
+}
+
+// waitForReverseCatchup waits for the reverse streams on the source shards
+// to reach the positions of the target shards.
+func (ts *trafficSwitcher) waitForReverseCatchup(ctx context.Context, filteredReplicationWaitTime time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, filteredReplicationWaitTime)
+	defer cancel()
+	return ts.forAllSources(func(source *tsSource) error {
+		target := ts.targets[source.si.ShardName()]
+		query := fmt.Sprintf("select id from _vt.vreplication where db_name=%s and workflow=%s", encodeString(source.master.DbName()), encodeString(ts.reverseWorkflow))
+		p3qr, err := ts.wr.tmc.VReplicationExec(ctx, source.master.Tablet, query)
+		if err != nil {
+			return err
+		}
+		qr := sqltypes.Proto3ToResult(p3qr)
+		if len(qr.Rows) == 0 {
+			return fmt.Errorf("no reverse streams found for workflow %s on source shard %s", ts.reverseWorkflow, source.si.ShardName())
+		}
+		for _, row := range qr.Rows {
+			id, err := evalengine.ToInt64(row[0])
+			if err != nil {
+				return err
+			}
+			ts.wr.Logger().Infof("Waiting for keyspace:shard: %v:%v to reach target position %v, uid %d",
+				ts.sourceKeyspace, source.si.ShardName(), target.position, id)
+			if err := ts.wr.tmc.VReplicationWaitForPos(ctx, source.master.Tablet, int(id), target.position); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (ts *trafficSwitcher) changeTableTargetWrites(ctx context.Context, access accessType) error {
+	return ts.forAllTargets(func(target *tsTarget) error {
+		if _, err := ts.wr.ts.UpdateShardFields(ctx, ts.targetKeyspace, target.si.ShardName(), func(si *topo.ShardInfo) error {
+			return si.UpdateSourceBlacklistedTables(ctx, topodatapb.TabletType_MASTER, nil, access == allowWrites /* remove */, ts.tables)
+		}); err != nil {
+			return err
+		}
+		return ts.wr.RefreshTabletsByShard(ctx, target.si, nil, nil)
+	})
+}
+
+// changeShardRoutingRules routes the writes to the tables of the workflow on the
+// shards to the target keyspace for a forward switch, and back to the source
+// keyspace for a backward switch.
+func (ts *trafficSwitcher) changeShardRoutingRules(ctx context.Context, direction TrafficSwitchDirection) error {
+	srr, err := ts.wr.ts.GetShardRoutingRules(ctx)
+	if err != nil {
+		return err
+	}
+	rules := make([]*vschemapb.ShardRoutingRule, 0, len(srr.Rules)+len(ts.sources))
+	for _, rule := range srr.Rules {
+		if _, ok := ts.sources[rule.Shard]; ok && ts.isShardRoutingRuleOf(rule) {
+			continue
+		}
+		rules = append(rules, rule)
+	}
+	if direction == DirectionForward {
+		for shard := range ts.sources {
+			ts.wr.Logger().Infof("Add shard routing: %v/%v -> %v", ts.sourceKeyspace, shard, ts.targetKeyspace)
+			rules = append(rules, &vschemapb.ShardRoutingRule{
+				FromKeyspace: ts.sourceKeyspace,
+				ToKeyspace:   ts.targetKeyspace,
+				Shard:        shard,
+				Tables:       ts.tables,
+			})
+		}
+	}
+	if err := ts.wr.saveShardRoutingRules(ctx, rules); err != nil {
+		return err
+	}
+	return ts.wr.ts.RebuildSrvVSchema(ctx, nil)
+}
+
+// completeShardWrites completes a workflow whose shards have all been switched:
+// the tables are routed to the target keyspace, the shard routing rules are
+// deleted and the workflow is frozen.
+func (wr *Wrangler) completeShardWrites(ctx context.Context, targetKeyspace, workflow string) error {
+	ts, err := wr.buildTrafficSwitcher(ctx, targetKeyspace, workflow)
+	if err != nil {
+		return err
+	}
+	if err := ts.changeWriteRoute(ctx); err != nil {
+		wr.Logger().Errorf("changeWriteRoute failed: %v", err)
+		return err
+	}
+	if err := ts.changeShardRoutingRules(ctx, DirectionBackward); err != nil {
+		wr.Logger().Errorf("changeShardRoutingRules failed: %v", err)
+		return err
+	}
+	return ts.freezeTargetVReplication(ctx)
+}
+
+func (wr *Wrangler) saveShardRoutingRules(ctx context.Context, rules []*vschemapb.ShardRoutingRule) error {
+	sort.Slice(rules, func(i, j int) bool {
+		if rules[i].FromKeyspace != rules[j].FromKeyspace {
+			return rules[i].FromKeyspace < rules[j].FromKeyspace
+		}
+		return rules[i].Shard < rules[j].Shard
+	})
+	return wr.ts.SaveShardRoutingRules(ctx, &vschemapb.ShardRoutingRules{Rules: rules})
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// newTestPartialTableMigrater creates a MoveTables test env where the source
+// and target keyspaces have the same shards, and every target shard replicates
+// from the source shard of the same name.
+func newTestPartialTableMigrater(ctx context.Context, t *testing.T) *testMigraterEnv {
+	shards := []string{"-80", "80-"}
+	tme := newTestTableMigraterCustom(ctx, t, shards, shards, "select * %s")
+	for i, shard := range shards {
+		bls := &binlogdatapb.BinlogSource{
+			Keyspace: "ks1",
+			Shard:    shard,
+			Filter: &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{
+					Match:  "t1",
+					Filter: fmt.Sprintf("select * from t1 where in_keyrange('%s')", shard),
+				}, {
+					Match:  "t2",
+					Filter: fmt.Sprintf("select * from t2 where in_keyrange('%s')", shard),
+				}},
+			},
+		}
+		tme.dbTargetClients[i].addInvariant(vreplQueryks2, sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+			"id|source|message|cell|tablet_types",
+			"int64|varchar|varchar|varchar|varchar"),
+			fmt.Sprintf("1|%v|||", bls)),
+		)
+	}
+	return tme
+}
+
+func (tme *testMigraterEnv) expectSwitchShardWritesForward(i int) {
+	state := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"pos|state|message",
+		"varchar|varchar|varchar"),
+		"MariaDB/5-456-892|Running",
+	)
+	target := tme.dbTargetClients[i]
+	target.addQuery("select pos, state, message from _vt.vreplication where id=1", state, nil)
+	target.addQuery("select id from _vt.vreplication where id = 1", resultid1, nil)
+	target.addQuery("update _vt.vreplication set state = 'Stopped', message = 'stopped for cutover' where id in (1)", &sqltypes.Result{}, nil)
+	target.addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+
+	source := tme.dbSourceClients[i]
+	source.addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", &sqltypes.Result{}, nil)
+	source.addQueryRE(fmt.Sprintf("insert into _vt.vreplication.*test_reverse.*ks2.*%s.*t1.*in_keyrange.*c1.*hash.*%s.*t2.*MariaDB/5-456-893.*Stopped", tme.targetShards[i], tme.sourceShards[i]), &sqltypes.Result{InsertID: 3}, nil)
+	source.addQuery("select * from _vt.vreplication where id = 3", stoppedResult(3), nil)
+	source.addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", resultid3, nil)
+	source.addQuery("update _vt.vreplication set state = 'Running', message = '' where id in (3)", &sqltypes.Result{}, nil)
+	source.addQuery("select * from _vt.vreplication where id = 3", stoppedResult(3), nil)
+}
+
+func (tme *testMigraterEnv) expectSwitchShardWritesBackward(i int) {
+	state := sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"pos|state|message",
+		"varchar|varchar|varchar"),
+		"MariaDB/5-456-893|Running",
+	)
+	source := tme.dbSourceClients[i]
+	source.addQuery("select id from _vt.vreplication where db_name='vt_ks1' and workflow='test_reverse'", sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "3"), nil)
+	source.addQuery("select pos, state, message from _vt.vreplication where id=3", state, nil)
+	source.addQuery("select id from _vt.vreplication where db_name = 'vt_ks1' and workflow = 'test_reverse'", resultid3, nil)
+	source.addQuery("delete from _vt.vreplication where id in (3)", &sqltypes.Result{}, nil)
+	source.addQuery("delete from _vt.copy_state where vrepl_id in (3)", &sqltypes.Result{}, nil)
+
+	target := tme.dbTargetClients[i]
+	target.addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'test'", resultid1, nil)
+	target.addQuery("update _vt.vreplication set pos = 'MariaDB/5-456-892', state = 'Running', message = '' where id in (1)", &sqltypes.Result{}, nil)
+	target.addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+}
+
+func checkShardRouting(t *testing.T, tme *testMigraterEnv, shards ...string) {
+	t.Helper()
+	ctx := context.Background()
+	want := &vschemapb.ShardRoutingRules{}
+	for _, shard := range shards {
+		want.Rules = append(want.Rules, &vschemapb.ShardRoutingRule{
+			FromKeyspace: "ks1",
+			ToKeyspace:   "ks2",
+			Shard:        shard,
+			Tables:       []string{"t1", "t2"},
+		})
+	}
+	got, err := tme.ts.GetShardRoutingRules(ctx)
+	require.NoError(t, err)
+	assert.True(t, proto.Equal(want, got), "got: %v, want: %v", got, want)
+	svs, err := tme.ts.GetSrvVSchema(ctx, "cell1")
+	require.NoError(t, err)
+	if len(shards) == 0 {
+		want = nil
+	}
+	assert.True(t, proto.Equal(want, svs.ShardRoutingRules), "got: %v, want: %v", svs.ShardRoutingRules, want)
+}
+
+func TestSwitchShardWrites(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestPartialTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+
+	// Switch the writes of -80.
+	tme.expectSwitchShardWritesForward(0)
+	err := tme.wr.SwitchShardWrites(ctx, tme.targetKeyspace, "test", []string{"-80"}, 1*time.Second, false)
+	require.NoError(t, err)
+	verifyQueries(t, tme.allDBClients)
+	checkShardRouting(t, tme, "-80")
+	checkBlacklist(t, tme.ts, "ks1:-80", []string{"t1", "t2"})
+	checkBlacklist(t, tme.ts, "ks1:80-", nil)
+	checkRouting(t, tme.wr, map[string][]string{
+		"t1":     {"ks1.t1"},
+		"ks2.t1": {"ks1.t1"},
+		"t2":     {"ks1.t2"},
+		"ks2.t2": {"ks1.t2"},
+	})
+
+	err = tme.wr.SwitchShardWrites(ctx, tme.targetKeyspace, "test", []string{"-80"}, 1*time.Second, false)
+	require.EqualError(t, err, "writes of shard -80 have already been switched for workflow test")
+	err = tme.wr.SwitchShardWrites(ctx, tme.targetKeyspace, "test", []string{"80-"}, 1*time.Second, true)
+	require.EqualError(t, err, "writes of shard 80- have not been switched for workflow test")
+	_, _, err = tme.wr.SwitchWrites(ctx, tme.targetKeyspace, "test", 1*time.Second, false, false, true, false)
+	require.EqualError(t, err, "writes of some shards have been switched for workflow test: switch the remaining shards or reverse them")
+
+	// Switch them back.
+	tme.expectSwitchShardWritesBackward(0)
+	err = tme.wr.SwitchShardWrites(ctx, tme.targetKeyspace, "test", []string{"-80"}, 1*time.Second, true)
+	require.NoError(t, err)
+	verifyQueries(t, tme.allDBClients)
+	checkShardRouting(t, tme)
+	checkBlacklist(t, tme.ts, "ks1:-80", nil)
+	checkBlacklist(t, tme.ts, "ks2:-80", []string{"t1", "t2"})
+
+	// Switch -80 again, and then 80-, which completes the workflow.
+	tme.expectSwitchShardWritesForward(0)
+	err = tme.wr.SwitchShardWrites(ctx, tme.targetKeyspace, "test", []string{"-80"}, 1*time.Second, false)
+	require.NoError(t, err)
+	verifyQueries(t, tme.allDBClients)
+	checkBlacklist(t, tme.ts, "ks2:-80", nil)
+
+	tme.expectSwitchShardWritesForward(1)
+	for _, dbclient := range tme.dbTargetClients {
+		dbclient.addQuery("select id from _vt.vreplication where db_name = 'vt_ks2' and workflow = 'test'", resultid1, nil)
+		dbclient.addQuery("update _vt.vreplication set message = 'FROZEN' where id in (1)", &sqltypes.Result{}, nil)
+		dbclient.addQuery("select * from _vt.vreplication where id = 1", stoppedResult(1), nil)
+	}
+	err = tme.wr.SwitchShardWrites(ctx, tme.targetKeyspace, "test", []string{"80-"}, 1*time.Second, false)
+	require.NoError(t, err)
+	verifyQueries(t, tme.allDBClients)
+	checkShardRouting(t, tme)
+	checkBlacklist(t, tme.ts, "ks1:-80", []string{"t1", "t2"})
+	checkBlacklist(t, tme.ts, "ks1:80-", []string{"t1", "t2"})
+	checkRouting(t, tme.wr, map[string][]string{
+		"t1":     {"ks2.t1"},
+		"ks1.t1": {"ks2.t1"},
+		"t2":     {"ks2.t2"},
+		"ks1.t2": {"ks2.t2"},
+	})
+}
+
+func TestSwitchShardWritesErrors(t *testing.T) {
+	ctx := context.Background()
+	tme := newTestPartialTableMigrater(ctx, t)
+	err := tme.wr.SwitchShardWrites(ctx, tme.targetKeyspace, "test", nil, 1*time.Second, false)
+	require.EqualError(t, err, "no shards specified")
+	err = tme.wr.SwitchShardWrites(ctx, tme.targetKeyspace, "test", []string{"-40"}, 1*time.Second, false)
+	require.EqualError(t, err, "shard -40 not found in source keyspace ks1")
+	tme.stopTablets(t)
+
+	// Target shards that replicate from other source shards can't be switched alone.
+	tme = newTestTableMigraterCustom(ctx, t, []string{"-80", "80-"}, []string{"-80", "80-"}, "select * %s")
+	defer tme.stopTablets(t)
+	err = tme.wr.SwitchShardWrites(ctx, tme.targetKeyspace, "test", []string{"-80"}, 1*time.Second, false)
+	require.EqualError(t, err, "target shard -80 replicates from source shard 80-: the source and target keyspaces must have the same shards")
+}
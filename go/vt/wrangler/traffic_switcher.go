@@ -498,6 +498,14 @@ func (wr *Wrangler) SwitchWrites(ctx context.Context, targetKeyspace, workflow s
 		ts.wr.Logger().Warningf("Writes have already been switched for workflow %s, nothing to do here", ts.workflow)
 		return 0, sw.logs(), nil
 	}
+	switchedShards, err := ts.getSwitchedShards(ctx)
+	if err != nil {
+		ts.wr.Logger().Errorf("getSwitchedShards failed: %v", err)
+		return 0, nil, err
+	}
+	if len(switchedShards) > 0 {
+		return 0, nil, fmt.Errorf("writes of some shards have been switched for workflow %s: switch the remaining shards or reverse them", ts.workflow)
+	}
 
 	ts.wr.Logger().Infof("Built switching metadata: %+v", ts)
 	if err := ts.validate(ctx); err != nil {
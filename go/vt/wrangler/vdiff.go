@@ -618,7 +618,7 @@ func (df *vdiff) startQueryStreams(ctx context.Context, keyspace string, partici
 		gtidch := make(chan string, 1)
 
 		// Start the stream in a separate goroutine.
-		go df.streamOne(ctx, keyspace, shard, participant, query, gtidch)
+		go streamOne(ctx, keyspace, shard, participant, query, gtidch)
 
 		// Wait for the gtid to be sent. If it's not received, there was an error
 		// which would be stored in participant.err.
@@ -638,7 +638,7 @@ func (df *vdiff) startQueryStreams(ctx context.Context, keyspace string, partici
 // Before returning, it sets participant.err, and closes all channels.
 // If any channel is closed, then participant.err can be checked if there was an error.
 // The shardStreamer's StreamExecute consumes the result channel.
-func (df *vdiff) streamOne(ctx context.Context, keyspace, shard string, participant *shardStreamer, query string, gtidch chan string) {
+func streamOne(ctx context.Context, keyspace, shard string, participant *shardStreamer, query string, gtidch chan string) {
 	defer close(participant.result)
 	defer close(gtidch)
 
@@ -57,6 +57,8 @@ type testVDiffEnv struct {
 	cell       string
 	tabletType topodatapb.TabletType
 	tmc        *testVDiffTMClient
+	// protocol is the tablet protocol to restore on close.
+	protocol string
 
 	mu      sync.Mutex
 	tablets map[int]*testVDiffTablet
@@ -80,8 +82,10 @@ func init() {
 // testVDiffEnv
 
 func newTestVDiffEnv(sourceShards, targetShards []string, query string, positions map[string]string) *testVDiffEnv {
+	protocol := *tabletconn.TabletProtocol
 	flag.Set("tablet_protocol", "VDiffTest")
 	env := &testVDiffEnv{
+		protocol:   protocol,
 		workflow:   "vdiffTest",
 		tablets:    make(map[int]*testVDiffTablet),
 		topoServ:   memorytopo.NewServer("cell"),
@@ -178,6 +182,7 @@ func (env *testVDiffEnv) close() {
 		env.topoServ.DeleteTablet(context.Background(), t.tablet.Alias)
 	}
 	env.tablets = nil
+	flag.Set("tablet_protocol", env.protocol)
 }
 
 func (env *testVDiffEnv) addTablet(id int, keyspace, shard string, tabletType topodatapb.TabletType) *testVDiffTablet {
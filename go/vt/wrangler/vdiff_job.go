@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	tabletvdiff "vitess.io/vitess/go/vt/vttablet/tabletmanager/vdiff"
+)
+
+// VDiffJobReport is the state of a vdiff job that runs on the target masters.
+type VDiffJobReport struct {
+	// Jobs has one row per target master that runs the job.
+	Jobs *sqltypes.Result
+	// Tables has one row per table and target master.
+	Tables *sqltypes.Result
+	// Mismatches has the keys of the rows that did not match.
+	Mismatches *sqltypes.Result
+}
+
+// VDiffStart creates a vdiff job on the target masters of the workflow, and
+// returns its uuid. Every target master diffs its own shard against the
+// sources, and saves the progress, which allows the job to be stopped and
+// resumed, and to survive restarts and reparents.
+func (wr *Wrangler) VDiffStart(ctx context.Context, targetKeyspace, workflow string, options *tabletvdiff.Options) (string, error) {
+	uuid, err := schema.CreateUUID()
+	if err != nil {
+		return "", err
+	}
+	optionsJSON, err := json.Marshal(options)
+	if err != nil {
+		return "", err
+	}
+	query, err := sqlparser.ParseAndBind("insert into _vt.vdiff (vdiff_uuid, workflow, options, state) values (%a, %a, %a, %a)",
+		sqltypes.StringBindVariable(uuid),
+		sqltypes.StringBindVariable(workflow),
+		sqltypes.StringBindVariable(string(optionsJSON)),
+		sqltypes.StringBindVariable(tabletvdiff.StatePending),
+	)
+	if err != nil {
+		return "", err
+	}
+	results, err := wr.VExec(ctx, workflow, targetKeyspace, query, false)
+	if err != nil {
+		return "", err
+	}
+	started := false
+	for _, result := range results {
+		if result.RowsAffected != 0 {
+			started = true
+		}
+	}
+	if !started {
+		return "", fmt.Errorf("no streams found for workflow %s in keyspace %s", workflow, targetKeyspace)
+	}
+	return uuid, nil
+}
+
+// VDiffStop stops a running vdiff job. It keeps its progress.
+func (wr *Wrangler) VDiffStop(ctx context.Context, targetKeyspace, workflow, uuid string) (*sqltypes.Result, error) {
+	return wr.vdiffSetState(ctx, targetKeyspace, workflow, uuid, tabletvdiff.StateStopped)
+}
+
+// VDiffResume resumes a stopped or failed vdiff job from where it left off.
+func (wr *Wrangler) VDiffResume(ctx context.Context, targetKeyspace, workflow, uuid string) (*sqltypes.Result, error) {
+	return wr.vdiffSetState(ctx, targetKeyspace, workflow, uuid, tabletvdiff.StatePending)
+}
+
+func (wr *Wrangler) vdiffSetState(ctx context.Context, targetKeyspace, workflow, uuid, state string) (*sqltypes.Result, error) {
+	query, err := sqlparser.ParseAndBind("update _vt.vdiff set state=%a where vdiff_uuid=%a",
+		sqltypes.StringBindVariable(state),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return wr.VExecResult(ctx, workflow, targetKeyspace, query, false)
+}
+
+// VDiffShow returns the state of the vdiff jobs of the workflow. If uuid is
+// set, the report contains the progress of the tables of that job, and the
+// first mismatches of every target master, up to limit.
+func (wr *Wrangler) VDiffShow(ctx context.Context, targetKeyspace, workflow, uuid string, limit int64) (*VDiffJobReport, error) {
+	condition := ""
+	if uuid != "" {
+		var err error
+		condition, err = sqlparser.ParseAndBind(" where vdiff_uuid=%a", sqltypes.StringBindVariable(uuid))
+		if err != nil {
+			return nil, err
+		}
+	}
+	query := "select vdiff_uuid, shard, state, created_at, started_at, completed_at, last_error from _vt.vdiff" + condition
+	jobs, err := wr.VExecResult(ctx, workflow, targetKeyspace, query, false)
+	if err != nil {
+		return nil, err
+	}
+	report := &VDiffJobReport{Jobs: jobs}
+	if uuid == "" {
+		return report, nil
+	}
+
+	// The details are in tables that are not bound to the workflow,
+	// so they are queried directly on every master of the keyspace.
+	vx := newVExec(ctx, workflow, targetKeyspace, "", wr)
+	if err := vx.getMasters(); err != nil {
+		return nil, err
+	}
+	queryMasters := func(query string) (*sqltypes.Result, error) {
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		allErrors := &concurrency.AllErrorRecorder{}
+		results := make(map[*topo.TabletInfo]*sqltypes.Result)
+		for _, master := range vx.masters {
+			wg.Add(1)
+			go func(master *topo.TabletInfo) {
+				defer wg.Done()
+				qr, err := wr.GenericVExec(ctx, master.Alias, query, workflow, targetKeyspace)
+				if err != nil {
+					allErrors.RecordError(err)
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				results[master] = sqltypes.Proto3ToResult(qr)
+			}(master)
+		}
+		wg.Wait()
+		if allErrors.HasErrors() {
+			return nil, allErrors.AggrError(vterrors.Aggregate)
+		}
+		return wr.QueryResultForTabletResults(results), nil
+	}
+	query, err = sqlparser.ParseAndBind("select table_name, state, rows_compared, mismatch, report from _vt.vdiff_table where vdiff_id=(select id from _vt.vdiff where vdiff_uuid=%a) order by table_name",
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if report.Tables, err = queryMasters(query); err != nil {
+		return nil, err
+	}
+	query, err = sqlparser.ParseAndBind("select table_name, kind, row_key from _vt.vdiff_mismatch where vdiff_id=(select id from _vt.vdiff where vdiff_uuid=%a) order by id limit %a",
+		sqltypes.StringBindVariable(uuid),
+		sqltypes.Int64BindVariable(limit),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if report.Mismatches, err = queryMasters(query); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
@@ -43,6 +43,7 @@ const (
 	vexecTableQualifier       = "_vt"
 	vreplicationTableName     = "vreplication"
 	schemaMigrationsTableName = "schema_migrations"
+	vdiffTableName            = "vdiff"
 )
 
 // vexec is the construct by which we run a query against backend shards. vexec is created by user-facing
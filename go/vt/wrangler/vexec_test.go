@@ -426,3 +426,46 @@ func TestVExecValidations(t *testing.T) {
 		})
 	}
 }
+
+func TestVExecVDiffPlan(t *testing.T) {
+	ctx := context.Background()
+	env := newWranglerTestEnv([]string{"0"}, []string{"-80", "80-"}, "", nil, 0)
+	defer env.close()
+
+	wr := New(logutil.NewConsoleLogger(), env.topoServ, env.tmc)
+	vx := newVExec(ctx, "wf", "target", "", wr)
+	require.NoError(t, vx.getMasters())
+
+	testcases := []struct {
+		query string
+		want  string
+		err   string
+	}{{
+		query: "insert into _vt.vdiff (vdiff_uuid, workflow, options, state) values ('u1', 'wf', '{}', 'pending')",
+		want:  "insert into _vt.vdiff(vdiff_uuid, workflow, options, state) values ('u1', 'wf', '{}', 'pending')",
+	}, {
+		query: "update _vt.vdiff set state='stopped' where vdiff_uuid='u1'",
+		want:  "update _vt.vdiff set state = 'stopped' where vdiff_uuid = 'u1' and db_name = 'vt_target' and workflow = 'wf'",
+	}, {
+		query: "select vdiff_uuid, state from _vt.vdiff",
+		want:  "select vdiff_uuid, state from _vt.vdiff where db_name = 'vt_target' and workflow = 'wf'",
+	}, {
+		query: "update _vt.vdiff set state='stopped'",
+		err:   "Query must match one of these templates: update _vt.vdiff set state='val1' where vdiff_uuid='val2'",
+	}, {
+		query: "insert into _vt.vdiff (vdiff_uuid, workflow, state) values ('u1', 'wf', 'pending')",
+		err:   "Query must match one of these templates: insert into _vt.vdiff (vdiff_uuid, workflow, options, state) values ('val', 'val', 'val', 'val')",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.query, func(t *testing.T) {
+			vx.query = tcase.query
+			plan, err := vx.parseAndPlan(ctx)
+			if tcase.err != "" {
+				require.EqualError(t, err, tcase.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tcase.want, plan.parsedQuery.Query)
+		})
+	}
+}
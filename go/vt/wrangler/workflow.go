@@ -2,8 +2,14 @@ package wrangler
 
 import (
 	"context"
+	"encoding/binary"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -429,10 +435,13 @@ func (vrw *VReplicationWorkflow) switchWrites() (*[]string, error) {
 
 // region Copy Progress
 
-// TableCopyProgress stores the row counts and disk sizes of the source and target tables
+// TableCopyProgress stores the row counts and disk sizes of the source and target tables.
+// The copy rate and the ETA are only set by GetWorkflowProgress.
 type TableCopyProgress struct {
 	TargetRowCount, TargetTableSize int64
 	SourceRowCount, SourceTableSize int64
+	RowsPerSecond                   float64
+	ETA                             time.Duration
 }
 
 // CopyProgress stores the TableCopyProgress for all tables still being copied
@@ -459,13 +468,78 @@ func (vrw *VReplicationWorkflow) IsCopyInProgress() (bool, error) {
 
 // GetCopyProgress returns the progress of all tables being copied in the workflow
 func (vrw *VReplicationWorkflow) GetCopyProgress() (*CopyProgress, error) {
-	ctx := context.Background()
+	snapshot, err := vrw.getCopySnapshot(context.Background())
+	if err != nil {
+		return nil, err
+	}
+	if snapshot == nil {
+		return nil, nil
+	}
+	copyProgress := CopyProgress{}
+	for table, progress := range snapshot.tableProgress() {
+		copyProgress[table] = progress
+	}
+	return &copyProgress, nil
+}
+
+// copySnapshot contains the row counts and table sizes of the tables that
+// are still being copied by a workflow, as estimated by information_schema.
+type copySnapshot struct {
+	// streamTables are the tables still being copied by each stream, by target shard.
+	streamTables map[string]map[int64][]string
+	// streamSources are the source shards of the streams, by target shard.
+	streamSources map[string]map[int64]string
+	// targetRowCounts and targetTableSizes are by target shard and table.
+	targetRowCounts  map[string]map[string]int64
+	targetTableSizes map[string]map[string]int64
+	// sourceRowCounts and sourceTableSizes are by source shard and table.
+	sourceRowCounts  map[string]map[string]int64
+	sourceTableSizes map[string]map[string]int64
+}
+
+// tableProgress returns the row counts and table sizes of the tables being
+// copied, summed over all source and target shards.
+func (snapshot *copySnapshot) tableProgress() CopyProgress {
+	progress := CopyProgress{}
+	for shard, rowCounts := range snapshot.sourceRowCounts {
+		for table, rowCount := range rowCounts {
+			tp, ok := progress[table]
+			if !ok {
+				tp = &TableCopyProgress{}
+				progress[table] = tp
+			}
+			tp.SourceRowCount += rowCount
+			tp.SourceTableSize += snapshot.sourceTableSizes[shard][table]
+		}
+	}
+	for shard, rowCounts := range snapshot.targetRowCounts {
+		for table, tp := range progress {
+			tp.TargetRowCount += rowCounts[table]
+			tp.TargetTableSize += snapshot.targetTableSizes[shard][table]
+		}
+	}
+	return progress
+}
+
+// getCopySnapshot returns a snapshot of the tables still being copied, or nil
+// if the copy is complete.
+func (vrw *VReplicationWorkflow) getCopySnapshot(ctx context.Context) (*copySnapshot, error) {
 	getTablesQuery := "select table_name from _vt.copy_state cs, _vt.vreplication vr where vr.id = cs.vrepl_id and vr.id = %d"
 	getRowCountQuery := "select table_name, table_rows, data_length from information_schema.tables where table_schema = %s and table_name in (%s)"
 	tables := make(map[string]bool)
 	const MaxRows = 1000
-	sourceMasters := make(map[*topodatapb.TabletAlias]bool)
-	for _, target := range vrw.ts.targets {
+	snapshot := &copySnapshot{
+		streamTables:     make(map[string]map[int64][]string),
+		streamSources:    make(map[string]map[int64]string),
+		targetRowCounts:  make(map[string]map[string]int64),
+		targetTableSizes: make(map[string]map[string]int64),
+		sourceRowCounts:  make(map[string]map[string]int64),
+		sourceTableSizes: make(map[string]map[string]int64),
+	}
+	sourceMasters := make(map[string]*topodatapb.TabletAlias)
+	for shard, target := range vrw.ts.targets {
+		snapshot.streamTables[shard] = make(map[int64][]string)
+		snapshot.streamSources[shard] = make(map[int64]string)
 		for id, bls := range target.sources {
 			query := fmt.Sprintf(getTablesQuery, id)
 			p3qr, err := vrw.wr.tmc.ExecuteFetchAsDba(ctx, target.master.Tablet, true, []byte(query), MaxRows, false, false)
@@ -477,41 +551,30 @@ func (vrw *VReplicationWorkflow) GetCopyProgress() (*CopyProgress, error) {
 			}
 			qr := sqltypes.Proto3ToResult(p3qr)
 			for i := 0; i < len(p3qr.Rows); i++ {
-				tables[qr.Rows[i][0].ToString()] = true
+				table := qr.Rows[i][0].ToString()
+				tables[table] = true
+				snapshot.streamTables[shard][int64(id)] = append(snapshot.streamTables[shard][int64(id)], table)
+			}
+			snapshot.streamSources[shard][int64(id)] = bls.Shard
+			if _, ok := sourceMasters[bls.Shard]; ok {
+				continue
 			}
 			sourcesi, err := vrw.wr.ts.GetShard(ctx, bls.Keyspace, bls.Shard)
 			if err != nil {
 				return nil, err
 			}
-			found := false
-			for existingSource := range sourceMasters {
-				if existingSource.Uid == sourcesi.MasterAlias.Uid {
-					found = true
-				}
-			}
-			if !found {
-				sourceMasters[sourcesi.MasterAlias] = true
-			}
+			sourceMasters[bls.Shard] = sourcesi.MasterAlias
 		}
 	}
 	if len(tables) == 0 {
 		return nil, nil
 	}
 	var tableList []string
-	targetRowCounts := make(map[string]int64)
-	sourceRowCounts := make(map[string]int64)
-	targetTableSizes := make(map[string]int64)
-	sourceTableSizes := make(map[string]int64)
-
 	for table := range tables {
 		tableList = append(tableList, encodeString(table))
-		targetRowCounts[table] = 0
-		sourceRowCounts[table] = 0
-		targetTableSizes[table] = 0
-		sourceTableSizes[table] = 0
 	}
 
-	var getTableMetrics = func(tablet *topodatapb.Tablet, query string, rowCounts *map[string]int64, tableSizes *map[string]int64) error {
+	var getTableMetrics = func(tablet *topodatapb.Tablet, query string, rowCounts map[string]int64, tableSizes map[string]int64) error {
 		p3qr, err := vrw.wr.tmc.ExecuteFetchAsDba(ctx, tablet, true, []byte(query), len(tables), false, false)
 		if err != nil {
 			return err
@@ -527,8 +590,8 @@ func (vrw *VReplicationWorkflow) GetCopyProgress() (*CopyProgress, error) {
 			if err != nil {
 				return err
 			}
-			(*rowCounts)[table] += rowCount
-			(*tableSizes)[table] += tableSize
+			rowCounts[table] += rowCount
+			tableSizes[table] += tableSize
 		}
 		return nil
 	}
@@ -551,35 +614,310 @@ func (vrw *VReplicationWorkflow) GetCopyProgress() (*CopyProgress, error) {
 	sort.Strings(tableList) // sort list for repeatability for mocking in tests
 	tablesStr := strings.Join(tableList, ",")
 	query := fmt.Sprintf(getRowCountQuery, encodeString(targetDbName), tablesStr)
-	for _, target := range vrw.ts.targets {
-		tablet := target.master.Tablet
-		if err := getTableMetrics(tablet, query, &targetRowCounts, &targetTableSizes); err != nil {
+	for shard, target := range vrw.ts.targets {
+		snapshot.targetRowCounts[shard] = make(map[string]int64)
+		snapshot.targetTableSizes[shard] = make(map[string]int64)
+		if err := getTableMetrics(target.master.Tablet, query, snapshot.targetRowCounts[shard], snapshot.targetTableSizes[shard]); err != nil {
 			return nil, err
 		}
 	}
 
 	query = fmt.Sprintf(getRowCountQuery, encodeString(sourceDbName), tablesStr)
-	for source := range sourceMasters {
+	for shard, source := range sourceMasters {
 		ti, err := vrw.wr.ts.GetTablet(ctx, source)
-		tablet := ti.Tablet
 		if err != nil {
 			return nil, err
 		}
-		if err := getTableMetrics(tablet, query, &sourceRowCounts, &sourceTableSizes); err != nil {
+		snapshot.sourceRowCounts[shard] = make(map[string]int64)
+		snapshot.sourceTableSizes[shard] = make(map[string]int64)
+		if err := getTableMetrics(ti.Tablet, query, snapshot.sourceRowCounts[shard], snapshot.sourceTableSizes[shard]); err != nil {
 			return nil, err
 		}
 	}
+	return snapshot, nil
+}
 
-	copyProgress := CopyProgress{}
-	for table, rowCount := range targetRowCounts {
-		copyProgress[table] = &TableCopyProgress{
-			TargetRowCount:  rowCount,
-			TargetTableSize: targetTableSizes[table],
-			SourceRowCount:  sourceRowCounts[table],
-			SourceTableSize: sourceTableSizes[table],
+// copyStats contains the counters of the copy phase of the streams of a
+// workflow on a target master, by stream id. The counters are exported by
+// the vreplication engine of the tablet and are reset when the stream restarts.
+type copyStats struct {
+	tableRowCounts map[int64]map[string]int64
+	tableTimings   map[int64]map[string]time.Duration
+	rowsPerSecond  map[int64]int64
+}
+
+var getCopyStatsFromTabletDebugVars = func(tabletAddr, workflow string) (*copyStats, error) {
+	resp, err := http.Get("http://" + tabletAddr + "/debug/vars")
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var vars struct {
+		VReplicationTableCopyRowCount map[string]int64
+		VReplicationTableCopyTimings  map[string]int64
+		VReplicationCopyRowsPerSecond map[string]int64
+	}
+	if err := json.Unmarshal(body, &vars); err != nil {
+		return nil, err
+	}
+
+	// The keys are source_keyspace.source_shard.workflow.id[.table].
+	parseKey := func(key string, parts int) (int64, string, bool) {
+		fields := strings.SplitN(key, ".", parts)
+		if len(fields) != parts || fields[2] != workflow {
+			return 0, "", false
+		}
+		id, err := strconv.ParseInt(fields[3], 10, 64)
+		if err != nil {
+			return 0, "", false
+		}
+		if parts == 5 {
+			return id, fields[4], true
 		}
+		return id, "", true
 	}
-	return &copyProgress, nil
+	stats := &copyStats{
+		tableRowCounts: make(map[int64]map[string]int64),
+		tableTimings:   make(map[int64]map[string]time.Duration),
+		rowsPerSecond:  make(map[int64]int64),
+	}
+	for key, count := range vars.VReplicationTableCopyRowCount {
+		if id, table, ok := parseKey(key, 5); ok {
+			if stats.tableRowCounts[id] == nil {
+				stats.tableRowCounts[id] = make(map[string]int64)
+			}
+			stats.tableRowCounts[id][table] = count
+		}
+	}
+	for key, timing := range vars.VReplicationTableCopyTimings {
+		if id, table, ok := parseKey(key, 5); ok {
+			if stats.tableTimings[id] == nil {
+				stats.tableTimings[id] = make(map[string]time.Duration)
+			}
+			stats.tableTimings[id][table] = time.Duration(timing)
+		}
+	}
+	for key, rate := range vars.VReplicationCopyRowsPerSecond {
+		if id, _, ok := parseKey(key, 4); ok {
+			stats.rowsPerSecond[id] = rate
+		}
+	}
+	return stats, nil
+}
+
+var getCopyStatsFromTablet = getCopyStatsFromTabletDebugVars
+
+// ETAUnknown is the ETA reported when the copy rate can't be measured.
+const ETAUnknown = time.Duration(-1)
+
+// StreamProgress stores the copy progress and the replication lag of a stream.
+type StreamProgress struct {
+	ID          int64
+	TargetShard string
+	SourceShard string
+	State       string
+	// Tables are the tables that the stream still has to copy.
+	Tables []string
+	// RowsCopied and RowsPerSecond are the rows copied by the stream since it
+	// was last started, and the rate at which it copied them. RowsEstimated is
+	// the part of the row count of the tables on the source shard that belongs
+	// to the target shard.
+	RowsCopied, RowsEstimated int64
+	RowsPerSecond             float64
+	// ReplicationLag is the lag of the replication phase in seconds.
+	ReplicationLag int64
+	ETA            time.Duration
+}
+
+// WorkflowProgress stores the copy progress of the tables and streams of a workflow,
+// along with the rate at which rows are copied and the estimated time left.
+type WorkflowProgress struct {
+	Tables        CopyProgress
+	Streams       []*StreamProgress
+	RowsPerSecond float64
+	ETA           time.Duration
+}
+
+// GetWorkflowProgress returns the progress of the workflow. The rows copied and
+// the copy rates are the counters of the streams on the target masters. The row
+// counts of the source tables are estimated by information_schema, and the
+// estimated total of a stream is the part of them that belongs to its target shard.
+func (vrw *VReplicationWorkflow) GetWorkflowProgress(ctx context.Context) (*WorkflowProgress, error) {
+	snapshot, err := vrw.getCopySnapshot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	stats := make(map[string]*copyStats)
+	fractions := make(map[string]map[int64]float64)
+	if snapshot != nil {
+		for shard, target := range vrw.ts.targets {
+			if stats[shard], err = getCopyStatsFromTablet(target.master.Addr(), vrw.ws.Workflow); err != nil {
+				return nil, err
+			}
+			fractions[shard] = make(map[int64]float64)
+			for id, sourceShard := range snapshot.streamSources[shard] {
+				source, ok := vrw.ts.sources[sourceShard]
+				if !ok {
+					continue
+				}
+				fractions[shard][id] = keyRangeOverlap(source.si.KeyRange, target.si.KeyRange)
+			}
+		}
+	}
+	progress := estimateWorkflowProgress(snapshot, stats, fractions)
+
+	getStreamsQuery := "select id, state, transaction_timestamp from _vt.vreplication where id in (%s)"
+	now := time.Now().Unix()
+	for shard, target := range vrw.ts.targets {
+		var ids []string
+		for id := range target.sources {
+			ids = append(ids, fmt.Sprintf("%d", id))
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		sort.Strings(ids)
+		query := fmt.Sprintf(getStreamsQuery, strings.Join(ids, ", "))
+		p3qr, err := vrw.wr.tmc.ExecuteFetchAsDba(ctx, target.master.Tablet, true, []byte(query), len(ids), false, false)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+			id, err := evalengine.ToInt64(row[0])
+			if err != nil {
+				return nil, err
+			}
+			transactionTimestamp, err := evalengine.ToInt64(row[2])
+			if err != nil {
+				return nil, err
+			}
+			sp := progress.stream(shard, id)
+			sp.State = row[1].ToString()
+			if bls, ok := target.sources[uint32(id)]; ok {
+				sp.SourceShard = bls.Shard
+			}
+			// If no events occur after the copy phase, the transaction timestamp can be 0.
+			if transactionTimestamp > 0 && len(sp.Tables) == 0 {
+				sp.ReplicationLag = now - transactionTimestamp
+			}
+		}
+	}
+	sort.Slice(progress.Streams, func(i, j int) bool {
+		if progress.Streams[i].TargetShard != progress.Streams[j].TargetShard {
+			return progress.Streams[i].TargetShard < progress.Streams[j].TargetShard
+		}
+		return progress.Streams[i].ID < progress.Streams[j].ID
+	})
+	return progress, nil
+}
+
+func (wp *WorkflowProgress) stream(shard string, id int64) *StreamProgress {
+	for _, sp := range wp.Streams {
+		if sp.TargetShard == shard && sp.ID == id {
+			return sp
+		}
+	}
+	sp := &StreamProgress{ID: id, TargetShard: shard}
+	wp.Streams = append(wp.Streams, sp)
+	return sp
+}
+
+// estimateWorkflowProgress computes the progress of a workflow from a snapshot
+// of its tables and the copy counters of its streams, by target shard. The
+// fractions are the parts of the source shard of each stream that belong to
+// its target shard. The snapshot is nil if the copy is complete.
+func estimateWorkflowProgress(snapshot *copySnapshot, stats map[string]*copyStats, fractions map[string]map[int64]float64) *WorkflowProgress {
+	progress := &WorkflowProgress{Tables: CopyProgress{}}
+	if snapshot == nil {
+		return progress
+	}
+	progress.Tables = snapshot.tableProgress()
+	for _, tp := range progress.Tables {
+		tp.TargetRowCount = 0
+	}
+
+	for shard, streams := range snapshot.streamTables {
+		shardStats := stats[shard]
+		if shardStats == nil {
+			shardStats = &copyStats{}
+		}
+		for id, tables := range streams {
+			sp := progress.stream(shard, id)
+			sp.SourceShard = snapshot.streamSources[shard][id]
+			sp.Tables = append(sp.Tables, tables...)
+			sort.Strings(sp.Tables)
+			for _, table := range tables {
+				rowsCopied := shardStats.tableRowCounts[id][table]
+				sp.RowsCopied += rowsCopied
+				sp.RowsEstimated += int64(float64(snapshot.sourceRowCounts[sp.SourceShard][table]) * fractions[shard][id])
+				tp, ok := progress.Tables[table]
+				if !ok {
+					tp = &TableCopyProgress{}
+					progress.Tables[table] = tp
+				}
+				tp.TargetRowCount += rowsCopied
+				if elapsed := shardStats.tableTimings[id][table]; elapsed >= time.Second {
+					tp.RowsPerSecond += float64(rowsCopied) / elapsed.Seconds()
+				}
+			}
+			sp.RowsPerSecond = float64(shardStats.rowsPerSecond[id])
+			sp.ETA = eta(sp.RowsEstimated-sp.RowsCopied, sp.RowsPerSecond)
+			if sp.ETA == ETAUnknown || progress.ETA == ETAUnknown {
+				progress.ETA = ETAUnknown
+			} else if sp.ETA > progress.ETA {
+				progress.ETA = sp.ETA
+			}
+			progress.RowsPerSecond += sp.RowsPerSecond
+		}
+	}
+	for _, tp := range progress.Tables {
+		tp.ETA = eta(tp.SourceRowCount-tp.TargetRowCount, tp.RowsPerSecond)
+	}
+	return progress
+}
+
+// eta returns the time needed to copy the remaining rows at the given rate.
+func eta(remaining int64, rowsPerSecond float64) time.Duration {
+	if remaining <= 0 {
+		return 0
+	}
+	if rowsPerSecond <= 0 {
+		return ETAUnknown
+	}
+	return time.Duration(float64(remaining) / rowsPerSecond * float64(time.Second)).Round(time.Second)
+}
+
+// keyRangeBounds returns the start and the end of a keyrange as fractions of the keyspace.
+func keyRangeBounds(kr *topodatapb.KeyRange) (float64, float64) {
+	toFloat := func(b []byte, def float64) float64 {
+		if len(b) == 0 {
+			return def
+		}
+		var buf [8]byte
+		copy(buf[:], b)
+		return float64(binary.BigEndian.Uint64(buf[:])) / math.Pow(2, 64)
+	}
+	if kr == nil {
+		return 0, 1
+	}
+	return toFloat(kr.Start, 0), toFloat(kr.End, 1)
+}
+
+// keyRangeOverlap returns the fraction of the source keyrange that is covered
+// by the target keyrange.
+func keyRangeOverlap(source, target *topodatapb.KeyRange) float64 {
+	sourceStart, sourceEnd := keyRangeBounds(source)
+	targetStart, targetEnd := keyRangeBounds(target)
+	start, end := math.Max(sourceStart, targetStart), math.Min(sourceEnd, targetEnd)
+	if end <= start || sourceEnd <= sourceStart {
+		return 0
+	}
+	return (end - start) / (sourceEnd - sourceStart)
 }
 
 // endregion
@@ -18,7 +18,11 @@ package wrangler
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/topo"
 
@@ -126,6 +130,149 @@ func TestCopyProgress(t *testing.T) {
 	require.True(t, isCopyInProgress)
 }
 
+func TestWorkflowProgress(t *testing.T) {
+	ctx := context.Background()
+	p := &VReplicationWorkflowParams{
+		Workflow:       "test",
+		SourceKeyspace: "ks1",
+		TargetKeyspace: "ks2",
+		Tables:         "t1,t2",
+		Cells:          "cell1,cell2",
+		TabletTypes:    "replica,rdonly,master",
+		Timeout:        DefaultActionTimeout,
+	}
+	tme := newTestTableMigrater(ctx, t)
+	defer tme.stopTablets(t)
+	wf, err := tme.wr.NewVReplicationWorkflow(ctx, MoveTablesWorkflow, p)
+	require.NoError(t, err)
+
+	expectCopyProgressQueries(t, tme)
+	tme.tmeDB.AddQuery("select id, state, transaction_timestamp from _vt.vreplication where id in (1, 2)", sqltypes.MakeTestResult(sqltypes.MakeTestFields(
+		"id|state|transaction_timestamp",
+		"int64|varchar|int64"),
+		"1|Running|0",
+		"2|Running|0"))
+
+	// Stream 1 of -80 copies from -40 and stream 2 copies from 40-, which has a third
+	// of its rows in -80. Stream 2 of 80- copies the other two thirds of 40-.
+	targetAddr := func(i int) string {
+		return (&topo.TabletInfo{Tablet: tme.targetMasters[i].Tablet}).Addr()
+	}
+	stats := map[string]*copyStats{
+		targetAddr(0): {
+			tableRowCounts: map[int64]map[string]int64{1: {"t1": 150, "t2": 50}, 2: {"t1": 30}},
+			tableTimings:   map[int64]map[string]time.Duration{1: {"t1": 10 * time.Second, "t2": 5 * time.Second}, 2: {"t1": 3 * time.Second}},
+			rowsPerSecond:  map[int64]int64{1: 20, 2: 10},
+		},
+		targetAddr(1): {
+			tableRowCounts: map[int64]map[string]int64{2: {"t1": 100}},
+			tableTimings:   map[int64]map[string]time.Duration{2: {"t1": 10 * time.Second}},
+			rowsPerSecond:  map[int64]int64{2: 50},
+		},
+	}
+	getCopyStatsFromTablet = func(tabletAddr, workflow string) (*copyStats, error) {
+		require.Equal(t, "test", workflow)
+		return stats[tabletAddr], nil
+	}
+	defer func() { getCopyStatsFromTablet = getCopyStatsFromTabletDebugVars }()
+
+	progress, err := wf.GetWorkflowProgress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &TableCopyProgress{
+		SourceRowCount:  800,
+		SourceTableSize: 4000,
+		TargetRowCount:  280,
+		TargetTableSize: 2000,
+		RowsPerSecond:   35,
+		ETA:             15 * time.Second,
+	}, progress.Tables["t1"])
+	require.Equal(t, int64(50), progress.Tables["t2"].TargetRowCount)
+	require.Equal(t, 10.0, progress.Tables["t2"].RowsPerSecond)
+	require.Equal(t, 195*time.Second, progress.Tables["t2"].ETA)
+	require.Equal(t, 80.0, progress.RowsPerSecond)
+	require.Equal(t, 60*time.Second, progress.ETA)
+
+	require.Len(t, progress.Streams, 4)
+	want := []*StreamProgress{{
+		ID:            1,
+		TargetShard:   "-80",
+		SourceShard:   "-40",
+		State:         "Running",
+		Tables:        []string{"t1", "t2"},
+		RowsCopied:    200,
+		RowsEstimated: 1400,
+		RowsPerSecond: 20,
+		ETA:           60 * time.Second,
+	}, {
+		ID:            2,
+		TargetShard:   "-80",
+		SourceShard:   "40-",
+		State:         "Running",
+		Tables:        []string{"t1", "t2"},
+		RowsCopied:    30,
+		RowsEstimated: 466,
+		RowsPerSecond: 10,
+		ETA:           44 * time.Second,
+	}, {
+		ID:          1,
+		TargetShard: "80-",
+		SourceShard: "-40",
+		State:       "Running",
+		Tables:      []string{"t1", "t2"},
+	}, {
+		ID:            2,
+		TargetShard:   "80-",
+		SourceShard:   "40-",
+		State:         "Running",
+		Tables:        []string{"t1", "t2"},
+		RowsCopied:    100,
+		RowsEstimated: 932,
+		RowsPerSecond: 50,
+		ETA:           17 * time.Second,
+	}}
+	require.Equal(t, want, progress.Streams)
+
+	// Without the counters of 80-, the rate of its second stream and the ETA are unknown.
+	delete(stats, targetAddr(1))
+	progress, err = wf.GetWorkflowProgress(ctx)
+	require.NoError(t, err)
+	require.Equal(t, ETAUnknown, progress.Streams[3].ETA)
+	require.Equal(t, ETAUnknown, progress.ETA)
+}
+
+func TestGetCopyStatsFromTabletDebugVars(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/debug/vars", r.URL.Path)
+		fmt.Fprint(w, `{
+			"VReplicationTableCopyRowCount": {"ks1.-40.test.1.t1": 150, "ks1.-40.test.1.t2": 50, "ks1.-40.other.3.t1": 10},
+			"VReplicationTableCopyTimings": {"ks1.-40.test.1.t1": 10000000000},
+			"VReplicationCopyRowsPerSecond": {"ks1.-40.test.1": 20, "ks1.-40.other.3": 1}
+		}`)
+	}))
+	defer server.Close()
+
+	stats, err := getCopyStatsFromTabletDebugVars(strings.TrimPrefix(server.URL, "http://"), "test")
+	require.NoError(t, err)
+	require.Equal(t, &copyStats{
+		tableRowCounts: map[int64]map[string]int64{1: {"t1": 150, "t2": 50}},
+		tableTimings:   map[int64]map[string]time.Duration{1: {"t1": 10 * time.Second}},
+		rowsPerSecond:  map[int64]int64{1: 20},
+	}, stats)
+}
+
+func TestEstimateWorkflowProgress(t *testing.T) {
+	require.Equal(t, 1.0, keyRangeOverlap(nil, nil))
+	require.Equal(t, 0.5, keyRangeOverlap(nil, &topodata.KeyRange{End: []byte{0x80}}))
+	require.Equal(t, 0.0, keyRangeOverlap(&topodata.KeyRange{End: []byte{0x40}}, &topodata.KeyRange{Start: []byte{0x80}}))
+	require.Equal(t, 1.0, keyRangeOverlap(&topodata.KeyRange{Start: []byte{0x40}, End: []byte{0x80}}, nil))
+
+	// The copy is complete.
+	progress := estimateWorkflowProgress(nil, nil, nil)
+	require.Empty(t, progress.Tables)
+	require.Empty(t, progress.Streams)
+	require.Equal(t, time.Duration(0), progress.ETA)
+}
+
 func expectCopyProgressQueries(t *testing.T, tme *testMigraterEnv) {
 	db := tme.tmeDB
 	query := "select table_name from _vt.copy_state cs, _vt.vreplication vr where vr.id = cs.vrepl_id and vr.id = 1"